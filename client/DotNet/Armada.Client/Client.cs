@@ -20,6 +20,8 @@ namespace GResearch.Armada.Client
         Task<ApiCancellationResult> CancelJobsAsync(ApiJobCancelRequest body);
         Task<ApiJobSubmitResponse> SubmitJobsAsync(ApiJobSubmitRequest body);
         Task<object> CreateQueueAsync(string name, ApiQueue body);
+        Task<object> CreateJobTemplateAsync(string queue, string name, ApiJobTemplate body);
+        Task<ApiJobMigrateResponse> MigrateJobsAsync(ApiJobMigrateRequest body);
         Task<IEnumerable<StreamResponse<ApiEventStreamMessage>>> GetJobEventsStream(string queue, string jobSetId, string fromMessage = null, bool watch = false);
         Task WatchEvents(
             string queue,
@@ -51,6 +53,10 @@ namespace GResearch.Armada.Client
     public partial class ApiJobCancellingEvent  : IEvent {}
     public partial class ApiJobCancelledEvent  : IEvent {}
     public partial class ApiJobTerminatedEvent : IEvent {}
+    public partial class ApiJobDeadlineExceededEvent : IEvent {}
+    public partial class ApiJobLeaseDeniedEvent : IEvent {}
+    public partial class ApiJobResourceOveruseEvent : IEvent {}
+    public partial class ApiJobRequeuedEvent : IEvent {}
 
     public class StreamResponse<T>
     {
@@ -78,11 +84,21 @@ namespace GResearch.Armada.Client
                     var eventMessage =
                         JsonConvert.DeserializeObject<StreamResponse<ApiEventStreamMessage>>(line,
                             this.JsonSerializerSettings);
+                    if (IsKeepalive(eventMessage))
+                    {
+                        continue;
+                    }
                     yield return eventMessage;
                 }
             }
         }
 
+        // Server sends messages without event on idle watch streams to keep connections open
+        private static bool IsKeepalive(StreamResponse<ApiEventStreamMessage> eventMessage)
+        {
+            return eventMessage?.Result != null && eventMessage.Result.Message == null;
+        }
+
         public async Task WatchEvents(
             string queue,
             string jobSetId, 
@@ -109,6 +125,10 @@ namespace GResearch.Armada.Client
                                 var eventMessage =
                                     JsonConvert.DeserializeObject<StreamResponse<ApiEventStreamMessage>>(line,
                                         this.JsonSerializerSettings);
+                                if (IsKeepalive(eventMessage))
+                                {
+                                    continue;
+                                }
 
                                 onMessage(eventMessage);
                                 fromMessageId = eventMessage.Result?.Id ?? fromMessageId;
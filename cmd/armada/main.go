@@ -41,7 +41,13 @@ func main() {
 	shutdownGateway := armada.ServeGateway(config.HttpPort, config.GrpcPort)
 	defer shutdownGateway()
 
-	shutdown, wg := armada.Serve(&config)
+	reloadConfig := func() (*configuration.ArmadaConfig, error) {
+		var reloaded configuration.ArmadaConfig
+		e := common.ReloadConfig(&reloaded, "./config/armada", userSpecifiedConfig)
+		return &reloaded, e
+	}
+
+	shutdown, wg := armada.Serve(&config, reloadConfig)
 	go func() {
 		<-stopSignal
 		shutdown()
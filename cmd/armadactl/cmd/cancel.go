@@ -19,13 +19,17 @@ func init() {
 	cancelCmd.Flags().String(
 		"queue", "", "queue to cancel jobs from (requires job set to be specified)")
 	cancelCmd.Flags().String(
-		"jobSet", "", "jobSet to cancel (requires queue to be specified)")
+		"jobSet", "", "jobSet to cancel (in all queues unless queue is specified)")
+	cancelCmd.Flags().Bool(
+		"onlyIfUnstarted", false, "only cancel jobs which are still queued, leased jobs keep running")
+	cancelCmd.Flags().StringToString(
+		"label", map[string]string{}, "cancel jobs with all these labels, e.g. --label experiment=abandoned (in all queues unless queue is specified)")
 }
 
 var cancelCmd = &cobra.Command{
 	Use:   "cancel",
 	Short: "Cancels jobs in armada",
-	Long:  `Cancels jobs either by jobId or by combination of queue & job set.`,
+	Long:  `Cancels jobs either by jobId, by job set (optionally only in a queue) or by labels.`,
 	Args:  cobra.ExactArgs(0),
 	Run: func(cmd *cobra.Command, args []string) {
 		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
@@ -36,14 +40,26 @@ var cancelCmd = &cobra.Command{
 			jobId, _ := cmd.Flags().GetString("jobId")
 			queue, _ := cmd.Flags().GetString("queue")
 			jobSet, _ := cmd.Flags().GetString("jobSet")
+			onlyIfUnstarted, _ := cmd.Flags().GetBool("onlyIfUnstarted")
+			labels, _ := cmd.Flags().GetStringToString("label")
 
 			ctx, cancel := common.ContextWithDefaultTimeout()
 			defer cancel()
-			result, e := client.CancelJobs(ctx, &api.JobCancelRequest{
-				JobId:    jobId,
-				JobSetId: jobSet,
-				Queue:    queue,
-			})
+			var result *api.CancellationResult
+			var e error
+			if len(labels) > 0 {
+				result, e = client.CancelJobsByLabel(ctx, &api.JobCancelByLabelRequest{
+					Queue:  queue,
+					Labels: labels,
+				})
+			} else {
+				result, e = client.CancelJobs(ctx, &api.JobCancelRequest{
+					JobId:           jobId,
+					JobSetId:        jobSet,
+					Queue:           queue,
+					OnlyIfUnstarted: onlyIfUnstarted,
+				})
+			}
 			if e != nil {
 				log.Error(e)
 				return
@@ -6,6 +6,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/G-Research/armada/pkg/api"
 	"github.com/G-Research/armada/pkg/client"
@@ -22,9 +23,48 @@ func init() {
 	createQueueCmd.Flags().StringSlice(
 		"groupOwners", []string{},
 		"Comma separated list of queue group owners, defaults to empty list.")
+	createQueueCmd.Flags().StringToString(
+		"resourcePriorityFactors", map[string]string{},
+		"Comma separated list of priority factors of the queue for individual resources, the priority factor is used for other resources. Example: --resourcePriorityFactors cpu=2,nvidia.com/gpu=1")
 	createQueueCmd.Flags().StringToString(
 		"resourceLimits", map[string]string{},
 		"Command separated list of resource limits pairs, defaults to empty list. Example: --resourceLimits cpu=0.3,memory=0.2")
+	createQueueCmd.Flags().String(
+		"namespace", "",
+		"Kubernetes namespace all jobs of the queue are created in, defaults to namespace specified by each job.")
+	createQueueCmd.Flags().String(
+		"group", "",
+		"Queue group, queues of the same group share resources of the group, defaults to no group.")
+	createQueueCmd.Flags().Float64(
+		"minJobPriority", 0,
+		"Minimal priority of jobs in the queue, job priorities are not limited when both minimal and maximal priority are 0.")
+	createQueueCmd.Flags().Float64(
+		"maxJobPriority", 0,
+		"Maximal priority of jobs in the queue.")
+	createQueueCmd.Flags().Float64(
+		"defaultJobPriority", 0,
+		"Priority of jobs submitted without priority.")
+	createQueueCmd.Flags().Bool(
+		"clampJobPriority", false,
+		"Clamp job priorities to the allowed range instead of rejecting jobs.")
+	createQueueCmd.Flags().Uint32(
+		"leaseBatchSize", 0,
+		"Number of jobs read from the queue at once when leasing, defaults to server configuration.")
+	createQueueCmd.Flags().StringToString(
+		"guaranteedResources", map[string]string{},
+		"Comma separated list of resources reserved for the queue, defaults to empty list. Example: --guaranteedResources cpu=100,memory=200Gi")
+	createQueueCmd.Flags().String(
+		"jobOrdering", api.JobOrderingStrategy_Priority.String(),
+		"Order in which jobs of the queue are leased: Priority, FIFO or ShortestResourceFirst.")
+	createQueueCmd.Flags().Uint32(
+		"maxQueuedJobs", 0,
+		"Maximal number of jobs waiting in the queue, submitted jobs over the limit are rejected, defaults to no limit.")
+	createQueueCmd.Flags().Uint32(
+		"maxConcurrentJobs", 0,
+		"Maximal number of leased jobs of the queue, further jobs stay queued regardless of resources, defaults to no limit.")
+	createQueueCmd.Flags().String(
+		"pool", "",
+		"Pool of clusters jobs of the queue are leased to, defaults to clusters of any pool.")
 }
 
 // createQueueCmd represents the createQueue command
@@ -41,22 +81,63 @@ Job priority is evaluated inside queue, queue has its own priority.`,
 		owners, _ := cmd.Flags().GetStringSlice("owners")
 		groups, _ := cmd.Flags().GetStringSlice("groupOwners")
 		resourceLimits, _ := cmd.Flags().GetStringToString("resourceLimits")
+		resourcePriorityFactors, _ := cmd.Flags().GetStringToString("resourcePriorityFactors")
+		namespace, _ := cmd.Flags().GetString("namespace")
+		group, _ := cmd.Flags().GetString("group")
+		minJobPriority, _ := cmd.Flags().GetFloat64("minJobPriority")
+		maxJobPriority, _ := cmd.Flags().GetFloat64("maxJobPriority")
+		defaultJobPriority, _ := cmd.Flags().GetFloat64("defaultJobPriority")
+		clampJobPriority, _ := cmd.Flags().GetBool("clampJobPriority")
+		leaseBatchSize, _ := cmd.Flags().GetUint32("leaseBatchSize")
+		guaranteedResources, _ := cmd.Flags().GetStringToString("guaranteedResources")
+		jobOrdering, _ := cmd.Flags().GetString("jobOrdering")
+		maxQueuedJobs, _ := cmd.Flags().GetUint32("maxQueuedJobs")
+		maxConcurrentJobs, _ := cmd.Flags().GetUint32("maxConcurrentJobs")
+		pool, _ := cmd.Flags().GetString("pool")
 		resourceLimitsFloat, err := convertResourceLimitsToFloat64(resourceLimits)
 		if err != nil {
 			log.Error(err)
 			return
 		}
+		resourcePriorityFactorsFloat, err := convertResourceLimitsToFloat64(resourcePriorityFactors)
+		if err != nil {
+			log.Error(err)
+			return
+		}
+		guaranteedQuantities, err := convertResourcesToQuantities(guaranteedResources)
+		if err != nil {
+			log.Error(err)
+			return
+		}
+		jobOrderingStrategy, ok := api.JobOrderingStrategy_value[jobOrdering]
+		if !ok {
+			log.Errorf("Unknown job ordering strategy %s", jobOrdering)
+			return
+		}
 
 		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
 
 		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
 			submissionClient := api.NewSubmitClient(conn)
 			e := client.CreateQueue(submissionClient, &api.Queue{
-				Name:           queue,
-				PriorityFactor: priority,
-				UserOwners:     owners,
-				GroupOwners:    groups,
-				ResourceLimits: resourceLimitsFloat})
+				Name:                    queue,
+				PriorityFactor:          priority,
+				UserOwners:              owners,
+				GroupOwners:             groups,
+				ResourceLimits:          resourceLimitsFloat,
+				Namespace:               namespace,
+				Group:                   group,
+				MinJobPriority:          minJobPriority,
+				MaxJobPriority:          maxJobPriority,
+				DefaultJobPriority:      defaultJobPriority,
+				ClampJobPriority:        clampJobPriority,
+				LeaseBatchSize:          leaseBatchSize,
+				GuaranteedResources:     guaranteedQuantities,
+				JobOrdering:             api.JobOrderingStrategy(jobOrderingStrategy),
+				MaxQueuedJobs:           maxQueuedJobs,
+				MaxConcurrentJobs:       maxConcurrentJobs,
+				Pool:                    pool,
+				ResourcePriorityFactors: resourcePriorityFactorsFloat})
 
 			if e != nil {
 				log.Error(e)
@@ -79,3 +160,16 @@ func convertResourceLimitsToFloat64(resourceLimits map[string]string) (map[strin
 
 	return resourceLimitsFloat, nil
 }
+
+func convertResourcesToQuantities(resources map[string]string) (map[string]resource.Quantity, error) {
+	quantities := make(map[string]resource.Quantity, len(resources))
+	for resourceName, value := range resources {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, err
+		}
+		quantities[resourceName] = quantity
+	}
+
+	return quantities, nil
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(deleteQueueCmd)
+	deleteQueueCmd.Flags().Bool(
+		"force", false,
+		"Cancel queued and leased jobs of the queue instead of refusing to delete it.")
+}
+
+var deleteQueueCmd = &cobra.Command{
+	Use:   "delete-queue name",
+	Short: "Deletes a queue",
+	Long:  `Deletes a queue without queued or leased jobs, with --force the jobs are cancelled first. Deleting a queue which does not exist succeeds.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		queue := args[0]
+		force, _ := cmd.Flags().GetBool("force")
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			client := api.NewSubmitClient(conn)
+
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			result, e := client.DeleteQueue(ctx, &api.QueueDeleteRequest{Name: queue, Force: force})
+			if e != nil {
+				log.Error(e)
+				return
+			}
+			if len(result.CancelledIds) > 0 {
+				log.Infof("Cancelled %d jobs of queue %s.", len(result.CancelledIds), queue)
+			}
+			log.Infof("Queue %s deleted.", queue)
+		})
+	},
+}
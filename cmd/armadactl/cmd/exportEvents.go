@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(exportEventsCmd)
+	exportEventsCmd.Flags().StringP("output", "o", "", "File to write the events to, standard output by default")
+}
+
+var exportEventsCmd = &cobra.Command{
+	Use:   "export-events queue jobSet",
+	Short: "Exports event history of job set",
+	Long:  `Writes all stored events of the job set in order as newline delimited JSON, one event per line.`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		queue := args[0]
+		jobSetId := args[1]
+		output, _ := cmd.Flags().GetString("output")
+
+		var out io.Writer = os.Stdout
+		if output != "" {
+			file, e := os.Create(output)
+			if e != nil {
+				log.Error(e)
+				return
+			}
+			defer file.Close()
+			out = file
+		}
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			eventsClient := api.NewEventClient(conn)
+			exported, e := client.ExportJobSetEvents(eventsClient, queue, jobSetId, context.Background(), out)
+			if e != nil {
+				log.Error(e)
+				return
+			}
+			if output != "" {
+				log.Infof("Exported %d events of job set %s to %s", exported, jobSetId, output)
+			}
+		})
+	},
+}
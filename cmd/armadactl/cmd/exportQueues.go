@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(exportQueuesCmd)
+}
+
+var exportQueuesCmd = &cobra.Command{
+	Use:   "export-queues",
+	Short: "Exports definitions of all queues",
+	Long:  `Prints definitions of all queues as JSON, which can be restored by import-queues.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			client := api.NewSubmitClient(conn)
+
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			result, e := client.ExportQueues(ctx, &api.QueueExportRequest{})
+			if e != nil {
+				log.Error(e)
+				return
+			}
+			data, e := json.MarshalIndent(result, "", "  ")
+			if e != nil {
+				log.Error(e)
+				return
+			}
+			fmt.Println(string(data))
+		})
+	},
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+	"github.com/G-Research/armada/pkg/client/util"
+)
+
+func init() {
+	rootCmd.AddCommand(importQueuesCmd)
+	importQueuesCmd.Flags().Bool(
+		"overwrite", false,
+		"Replace existing queues of the same name instead of skipping them.")
+}
+
+var importQueuesCmd = &cobra.Command{
+	Use:   "import-queues ./path/to/queues.json",
+	Short: "Imports queues exported by export-queues",
+	Long:  `Creates queues from a file written by export-queues. Existing queues of the same name are skipped unless --overwrite is given.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		overwrite, _ := cmd.Flags().GetBool("overwrite")
+
+		exported := &api.QueueExportResponse{}
+		if e := util.BindJsonOrYaml(args[0], exported); e != nil {
+			log.Error(e)
+			os.Exit(1)
+		}
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			client := api.NewSubmitClient(conn)
+
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			result, e := client.ImportQueues(ctx, &api.QueueImportRequest{Queues: exported.Queues, Overwrite: overwrite})
+			if e != nil {
+				log.Error(e)
+				return
+			}
+			log.Infof("Imported queues %v.", result.ImportedNames)
+			if len(result.SkippedNames) > 0 {
+				log.Infof("Skipped existing queues %v.", result.SkippedNames)
+			}
+		})
+	},
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+}
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate <sourceQueue> <targetQueue>",
+	Short: "Moves queued jobs to another queue",
+	Long:  `Moves all jobs waiting in the source queue to the target queue, leased jobs stay in the source queue.`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		sourceQueue := args[0]
+		targetQueue := args[1]
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			client := api.NewSubmitClient(conn)
+
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			result, e := client.MigrateJobs(ctx, &api.JobMigrateRequest{
+				SourceQueue: sourceQueue,
+				TargetQueue: targetQueue,
+			})
+			if e != nil {
+				log.Error(e)
+				return
+			}
+			log.Infof("Migrated jobs to queue %s: %s", targetQueue, strings.Join(result.MigratedIds, ", "))
+		})
+	},
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(reconcileCmd)
+	reconcileCmd.Flags().Bool(
+		"dryRun", false, "only report inconsistencies without repairing them")
+}
+
+var reconcileCmd = &cobra.Command{
+	Use:   "reconcile",
+	Short: "Repairs jobs left in inconsistent state",
+	Long: `Returns jobs leased to clusters which stopped reporting to their queue, queues jobs missing in their queue
+and removes deleted jobs from job indexes.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		dryRun, _ := cmd.Flags().GetBool("dryRun")
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			client := api.NewSubmitClient(conn)
+
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			result, e := client.ReconcileState(ctx, &api.StateReconcileRequest{DryRun: dryRun})
+			if e != nil {
+				log.Error(e)
+				return
+			}
+			log.Infof("Jobs returned from inactive clusters: %s", strings.Join(result.ReturnedJobIds, ", "))
+			log.Infof("Jobs queued again: %s", strings.Join(result.RequeuedJobIds, ", "))
+			log.Infof("Deleted jobs removed from job sets: %s", strings.Join(result.RemovedJobSetEntries, ", "))
+			log.Infof("Deleted jobs removed from queues: %s", strings.Join(result.RemovedQueueEntries, ", "))
+			log.Infof("Jobs removed from cluster associations: %s", strings.Join(result.RemovedClusterAssociations, ", "))
+		})
+	},
+}
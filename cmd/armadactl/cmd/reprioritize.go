@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(reprioritizeCmd)
+	reprioritizeCmd.Flags().StringSlice(
+		"jobId", []string{}, "jobs to reprioritize")
+	reprioritizeCmd.Flags().String(
+		"queue", "", "queue of the job set to reprioritize")
+	reprioritizeCmd.Flags().String(
+		"jobSet", "", "jobSet to reprioritize (in all queues unless queue is specified)")
+}
+
+var reprioritizeCmd = &cobra.Command{
+	Use:   "reprioritize <priority>",
+	Short: "Changes priority of jobs",
+	Long:  `Changes priority of jobs either by jobId or by job set (optionally only in a queue), leased jobs keep running.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		priority, e := strconv.ParseFloat(args[0], 64)
+		if e != nil {
+			log.Errorf("Invalid priority %s: %s", args[0], e)
+			return
+		}
+		jobIds, _ := cmd.Flags().GetStringSlice("jobId")
+		queue, _ := cmd.Flags().GetString("queue")
+		jobSet, _ := cmd.Flags().GetString("jobSet")
+
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			client := api.NewSubmitClient(conn)
+
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			result, e := client.ReprioritizeJobs(ctx, &api.JobReprioritizeRequest{
+				JobIds:      jobIds,
+				JobSetId:    jobSet,
+				Queue:       queue,
+				NewPriority: priority,
+			})
+			if e != nil {
+				log.Error(e)
+				return
+			}
+			log.Infof("Reprioritized jobs: %s", strings.Join(result.ReprioritizedIds, ", "))
+		})
+	},
+}
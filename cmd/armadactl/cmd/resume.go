@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(resumeCmd)
+}
+
+var resumeCmd = &cobra.Command{
+	Use:   "resume <jobId> [<jobId>...]",
+	Short: "Resumes suspended jobs",
+	Long:  `Lets suspended jobs to be leased again, in the same position of their queue as before suspending.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			client := api.NewSubmitClient(conn)
+
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			result, e := client.ResumeJobs(ctx, &api.JobResumeRequest{JobIds: args})
+			if e != nil {
+				log.Error(e)
+				return
+			}
+			log.Infof("Resumed jobs: %s", strings.Join(result.ResumedIds, ", "))
+		})
+	},
+}
@@ -16,11 +16,16 @@ import (
 func init() {
 	rootCmd.AddCommand(submitCmd)
 	submitCmd.Flags().Bool("dry-run", false, "Performs basic validation on the submitted file. Does no actual submission of jobs to the server.")
+	submitCmd.Flags().Bool("strict", false, "Rejects all jobs of a request when any of them is invalid.")
+	submitCmd.Flags().Bool("cancel-on-failure", false, "Cancels all remaining jobs of the job set when any of its jobs fails.")
+	submitCmd.Flags().String("callback-url", "", "URL receiving POST notifications of state transitions of jobs of the job set.")
 }
 
 type JobSubmitFile struct {
 	Queue    string
 	JobSetId string
+	// Values used by jobs of the file which don't set them
+	Defaults *api.JobSubmitDefaults      `json:"defaults"`
 	Jobs     []*api.JobSubmitRequestItem `json:"jobs"`
 }
 
@@ -31,6 +36,10 @@ var submitCmd = &cobra.Command{
 
 	Example jobs.yaml:
 	
+	defaults:
+	  priority: 1
+	  labels:
+	    team: research
 	jobs:
 	  - queue: test
 		priority: 0
@@ -41,6 +50,9 @@ var submitCmd = &cobra.Command{
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		strict, _ := cmd.Flags().GetBool("strict")
+		cancelOnFailure, _ := cmd.Flags().GetBool("cancel-on-failure")
+		callbackUrl, _ := cmd.Flags().GetString("callback-url")
 		filePath := args[0]
 
 		ok, err := validation.ValidateSubmitFile(filePath)
@@ -69,6 +81,10 @@ var submitCmd = &cobra.Command{
 		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
 			submissionClient := api.NewSubmitClient(conn)
 			for _, request := range requests {
+				request.Strict = strict
+				request.CancelOnFailure = cancelOnFailure
+				request.CallbackUrl = callbackUrl
+				request.Defaults = submitFile.Defaults
 				response, e := client.SubmitJobs(submissionClient, request)
 
 				if e != nil {
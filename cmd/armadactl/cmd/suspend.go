@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(suspendCmd)
+}
+
+var suspendCmd = &cobra.Command{
+	Use:   "suspend <jobId> [<jobId>...]",
+	Short: "Holds queued jobs in their queue",
+	Long:  `Holds queued jobs in their queue until they are resumed, jobs keep their position and priority. Leased jobs are not suspended.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			client := api.NewSubmitClient(conn)
+
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			result, e := client.SuspendJobs(ctx, &api.JobSuspendRequest{JobIds: args})
+			if e != nil {
+				log.Error(e)
+				return
+			}
+			log.Infof("Suspended jobs: %s", strings.Join(result.SuspendedIds, ", "))
+		})
+	},
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client"
+)
+
+func init() {
+	rootCmd.AddCommand(ungateCmd)
+}
+
+var ungateCmd = &cobra.Command{
+	Use:   "ungate <jobId> [<jobId>...]",
+	Short: "Releases gated jobs",
+	Long:  `Releases jobs submitted gated, so they can be leased in their position of the queue.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiConnectionDetails := client.ExtractCommandlineArmadaApiConnectionDetails()
+
+		client.WithConnection(apiConnectionDetails, func(conn *grpc.ClientConn) {
+			client := api.NewSubmitClient(conn)
+
+			ctx, cancel := common.ContextWithDefaultTimeout()
+			defer cancel()
+			result, e := client.UngateJobs(ctx, &api.JobUngateRequest{JobIds: args})
+			if e != nil {
+				log.Error(e)
+				return
+			}
+			log.Infof("Ungated jobs: %s", strings.Join(result.UngatedIds, ", "))
+		})
+	},
+}
@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+)
+
+var droppedRecordsCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "armada_audit_records_dropped_total",
+	Help: "Number of audit records dropped because the audit buffer was full",
+})
+
+// AsyncSink buffers records and writes them from a background goroutine,
+// when the buffer is full records are dropped and counted rather than blocking the request.
+type AsyncSink struct {
+	writers []Writer
+	records chan *Record
+	wg      *sync.WaitGroup
+}
+
+func NewAsyncSink(bufferSize int, writers ...Writer) *AsyncSink {
+	sink := &AsyncSink{
+		writers: writers,
+		records: make(chan *Record, bufferSize),
+		wg:      &sync.WaitGroup{},
+	}
+	sink.wg.Add(1)
+	go sink.run()
+	return sink
+}
+
+func (s *AsyncSink) Record(record *Record) {
+	select {
+	case s.records <- record:
+	default:
+		droppedRecordsCounter.Inc()
+		log.Warnf("Audit buffer is full, dropping %s record of %s", record.Action, record.Principal)
+	}
+}
+
+// Stop writes out all buffered records, Record must not be called after Stop.
+func (s *AsyncSink) Stop() {
+	close(s.records)
+	s.wg.Wait()
+}
+
+func (s *AsyncSink) run() {
+	defer s.wg.Done()
+	for record := range s.records {
+		for _, w := range s.writers {
+			e := w.Write(record)
+			if e != nil {
+				log.Errorf("Failed to write audit record: %v", e)
+			}
+		}
+	}
+}
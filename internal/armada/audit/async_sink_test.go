@@ -0,0 +1,70 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/authorization"
+)
+
+func TestAsyncSink_WritesJsonRecords(t *testing.T) {
+	out := &bytes.Buffer{}
+	sink := NewAsyncSink(10, NewJsonWriter(out))
+
+	ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("alice", []string{}))
+	sink.Record(NewRecord(ctx, SubmitJobs, "queue1", "set1", []string{"job1", "job2"}))
+	sink.Record(NewRecord(ctx, CreateQueue, "queue2", "", nil))
+	sink.Stop()
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, 2, len(lines))
+
+	record := &Record{}
+	e := json.Unmarshal([]byte(lines[0]), record)
+	assert.Nil(t, e)
+	assert.Equal(t, "alice", record.Principal)
+	assert.Equal(t, SubmitJobs, record.Action)
+	assert.Equal(t, "queue1", record.Queue)
+	assert.Equal(t, "set1", record.JobSetId)
+	assert.Equal(t, []string{"job1", "job2"}, record.Ids)
+}
+
+func TestAsyncSink_DropsRecordsWhenBufferIsFull(t *testing.T) {
+	writer := &blockingWriter{release: make(chan bool)}
+	sink := NewAsyncSink(1, writer)
+
+	before := testutil.ToFloat64(droppedRecordsCounter)
+
+	// first record is picked up by the writer and blocks it, second fills the buffer
+	sink.Record(&Record{Action: CancelJobs})
+	for len(sink.records) > 0 {
+		time.Sleep(time.Millisecond)
+	}
+	sink.Record(&Record{Action: CancelJobs})
+	sink.Record(&Record{Action: CancelJobs})
+	sink.Record(&Record{Action: CancelJobs})
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(droppedRecordsCounter)-before)
+
+	close(writer.release)
+	sink.Stop()
+	assert.Equal(t, 2, writer.written)
+}
+
+type blockingWriter struct {
+	release chan bool
+	written int
+}
+
+func (w *blockingWriter) Write(record *Record) error {
+	<-w.release
+	w.written++
+	return nil
+}
@@ -0,0 +1,54 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/G-Research/armada/internal/armada/authorization"
+)
+
+type Action string
+
+const (
+	SubmitJobs        Action = "submit_jobs"
+	CancelJobs        Action = "cancel_jobs"
+	CreateQueue       Action = "create_queue"
+	DeleteQueue       Action = "delete_queue"
+	ImportQueue       Action = "import_queue"
+	CreateJobTemplate Action = "create_job_template"
+	MigrateJobs       Action = "migrate_jobs"
+	SuspendJobs       Action = "suspend_jobs"
+	ResumeJobs        Action = "resume_jobs"
+	UngateJobs        Action = "ungate_jobs"
+	ReprioritizeJobs  Action = "reprioritize_jobs"
+	ReconcileState    Action = "reconcile_state"
+)
+
+type Record struct {
+	Time      time.Time `json:"time"`
+	Principal string    `json:"principal"`
+	Action    Action    `json:"action"`
+	Queue     string    `json:"queue,omitempty"`
+	JobSetId  string    `json:"jobSetId,omitempty"`
+	Ids       []string  `json:"ids,omitempty"`
+}
+
+// Sink receives records of mutating API calls, implementations should not block the caller.
+type Sink interface {
+	Record(record *Record)
+}
+
+type NoopSink struct{}
+
+func (NoopSink) Record(record *Record) {}
+
+func NewRecord(ctx context.Context, action Action, queue string, jobSetId string, ids []string) *Record {
+	return &Record{
+		Time:      time.Now(),
+		Principal: authorization.GetPrincipal(ctx).GetName(),
+		Action:    action,
+		Queue:     queue,
+		JobSetId:  jobSetId,
+		Ids:       ids,
+	}
+}
@@ -0,0 +1,55 @@
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/go-redis/redis"
+)
+
+type Writer interface {
+	Write(record *Record) error
+}
+
+type JsonWriter struct {
+	out   io.Writer
+	mutex sync.Mutex
+}
+
+func NewJsonWriter(out io.Writer) *JsonWriter {
+	return &JsonWriter{out: out}
+}
+
+func (w *JsonWriter) Write(record *Record) error {
+	data, e := json.Marshal(record)
+	if e != nil {
+		return e
+	}
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	_, e = w.out.Write(append(data, '\n'))
+	return e
+}
+
+type RedisStreamWriter struct {
+	db     redis.UniversalClient
+	stream string
+}
+
+func NewRedisStreamWriter(db redis.UniversalClient, stream string) *RedisStreamWriter {
+	return &RedisStreamWriter{db: db, stream: stream}
+}
+
+func (w *RedisStreamWriter) Write(record *Record) error {
+	data, e := json.Marshal(record)
+	if e != nil {
+		return e
+	}
+	return w.db.XAdd(&redis.XAddArgs{
+		Stream: w.stream,
+		Values: map[string]interface{}{
+			"record": data,
+		},
+	}).Err()
+}
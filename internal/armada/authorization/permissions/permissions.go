@@ -3,12 +3,33 @@ package permissions
 type Permission string
 
 const (
-	SubmitJobs     Permission = "submit_jobs"
-	SubmitAnyJobs             = "submit_any_jobs"
-	CreateQueue               = "create_queue"
-	CancelJobs                = "cancel_jobs"
-	CancelAnyJobs             = "cancel_any_jobs"
-	WatchAllEvents            = "watch_all_events"
+	SubmitJobs       Permission = "submit_jobs"
+	SubmitAnyJobs               = "submit_any_jobs"
+	CreateQueue                 = "create_queue"
+	DeleteQueue                 = "delete_queue"
+	CancelJobs                  = "cancel_jobs"
+	CancelAnyJobs               = "cancel_any_jobs"
+	WatchAllEvents              = "watch_all_events"
+	MigrateJobs                 = "migrate_jobs"
+	ReconcileState              = "reconcile_state"
+	SimulateSchedule            = "simulate_schedule"
+	GetClusterLeases            = "get_cluster_leases"
 
 	ExecuteJobs = "execute_jobs"
 )
+
+// All permissions which can be granted in permission group and scope mappings
+var All = []Permission{
+	SubmitJobs,
+	SubmitAnyJobs,
+	CreateQueue,
+	DeleteQueue,
+	CancelJobs,
+	CancelAnyJobs,
+	WatchAllEvents,
+	MigrateJobs,
+	ReconcileState,
+	SimulateSchedule,
+	GetClusterLeases,
+	ExecuteJobs,
+}
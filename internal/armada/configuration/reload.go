@@ -0,0 +1,41 @@
+package configuration
+
+// WithHotReloadableFields returns a copy of the current scheduling config with the fields which can be changed
+// without restarting the server taken from the updated config. These are the fields read by the scheduler
+// in each lease round (batch sizes, scheduling limits, resource scarcity and the scheduling algorithm options)
+// and the job size, deduplication, retry and lease attempt limits. Other fields (e.g. lease expiry or priority
+// classes) are used when the server starts and require a restart to change.
+func WithHotReloadableFields(current SchedulingConfig, updated SchedulingConfig) SchedulingConfig {
+	result := current
+	result.UseProbabilisticSchedulingForAllResources = updated.UseProbabilisticSchedulingForAllResources
+	result.UseBackfill = updated.UseBackfill
+	result.FairnessStrategy = updated.FairnessStrategy
+	result.QueueShards = updated.QueueShards
+	result.QueueLeaseBatchSize = updated.QueueLeaseBatchSize
+	result.MinimumResourceToSchedule = updated.MinimumResourceToSchedule
+	result.MaximalClusterFractionToSchedule = updated.MaximalClusterFractionToSchedule
+	result.MaximalResourceFractionToSchedulePerQueue = updated.MaximalResourceFractionToSchedulePerQueue
+	result.MaximalResourceFractionPerQueue = updated.MaximalResourceFractionPerQueue
+	result.MaxJobsPerLeaseRequest = updated.MaxJobsPerLeaseRequest
+	result.MinJobsToLease = updated.MinJobsToLease
+	result.ResourceScarcity = updated.ResourceScarcity
+	result.ResourceRounding = updated.ResourceRounding
+	result.AgingFactor = updated.AgingFactor
+	result.ClusterFairnessWindow = updated.ClusterFairnessWindow
+	result.ClusterWeights = updated.ClusterWeights
+	result.ClusterCapacityFractions = updated.ClusterCapacityFractions
+	result.GlobalResourceCeiling = updated.GlobalResourceCeiling
+	result.ReservedResources = updated.ReservedResources
+	result.DeadlineMargin = updated.DeadlineMargin
+	result.JobEvaluationTimeout = updated.JobEvaluationTimeout
+	result.LeaseDeniedEventInterval = updated.LeaseDeniedEventInterval
+	result.Lease.LongPollTimeout = updated.Lease.LongPollTimeout
+	result.UnmatchableJobs = updated.UnmatchableJobs
+	result.CapacitySmoothing = updated.CapacitySmoothing
+	result.MaxJobSize = updated.MaxJobSize
+	result.JobDeduplicationTtl = updated.JobDeduplicationTtl
+	result.OOMRetry = updated.OOMRetry
+	result.FailureRetry = updated.FailureRetry
+	result.MaxLeaseAttempts = updated.MaxLeaseAttempts
+	return result
+}
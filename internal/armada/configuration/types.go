@@ -20,17 +20,41 @@ type ArmadaConfig struct {
 	GrpcPort               uint16
 	HttpPort               uint16
 	MetricsPort            uint16
+	HealthPort             uint16
+	HungTaskTimeout        time.Duration
 	PriorityHalfTime       time.Duration
+	ShutdownTimeout        time.Duration
+	ConfigReloadInterval   time.Duration
 	Redis                  redis.UniversalOptions
 	EventsRedis            redis.UniversalOptions
+	CompressJobs           bool
+	RedisKeyPrefix         string
 	BasicAuth              BasicAuthenticationConfig
 	OpenIdAuth             OpenIdAuthenticationConfig
 	Kerberos               KerberosAuthenticationConfig
 	PermissionGroupMapping map[permissions.Permission][]string
 	PermissionScopeMapping map[permissions.Permission][]string
 
-	Scheduling     SchedulingConfig
-	EventRetention EventRetentionPolicy
+	Scheduling      SchedulingConfig
+	EventRetention  EventRetentionPolicy
+	JobRetention    JobRetentionPolicy
+	JsonEventStream JsonEventStreamConfig
+	Audit           AuditConfig
+	Webhook         WebhookConfig
+	Tracing         TracingConfig
+
+	LeaseConcurrency LeaseConcurrencyConfig
+	Backpressure     BackpressureConfig
+	StatusReads      StatusReadsConfig
+
+	SubmissionPolicy SubmissionPolicyConfig
+
+	MetricsPush MetricsPushConfig
+
+	// How often an empty message is sent on idle event watch streams, so proxies don't close them, 0 disables keepalives
+	EventWatchKeepaliveInterval time.Duration
+	// Maximum number of event watch streams open at once, further watches fail with ResourceExhausted, 0 disables the limit
+	MaxEventWatchSubscribers int
 }
 
 type OpenIdAuthenticationConfig struct {
@@ -50,12 +74,38 @@ type KerberosAuthenticationConfig struct {
 
 type SchedulingConfig struct {
 	UseProbabilisticSchedulingForAllResources bool
+	UseBackfill                               bool
+	FairnessStrategy                          string
+	QueueShards                               int
 	QueueLeaseBatchSize                       uint
 	MinimumResourceToSchedule                 common.ComputeResourcesFloat
 	MaximalClusterFractionToSchedule          map[string]float64
 	MaximalResourceFractionToSchedulePerQueue map[string]float64
 	MaximalResourceFractionPerQueue           map[string]float64
+	MaxJobsPerLeaseRequest                    int
+	MinJobsToLease                            int
+	ResourceScarcity                          map[string]float64
+	ResourceRounding                          map[string]string
+	MaxJobSize                                int
+	JobDeduplicationTtl                       time.Duration
+	AgingFactor                               float64
+	ClusterFairnessWindow                     time.Duration
+	ClusterWeights                            map[string]float64
+	ClusterCapacityFractions                  map[string]float64
+	GlobalResourceCeiling                     map[string]float64
+	ReservedResources                         common.ComputeResourcesFloat
+	PriorityClasses                           map[string]int
+	PreemptionBudget                          PreemptionBudget
+	DeadlineMargin                            time.Duration
+	JobEvaluationTimeout                      time.Duration
+	LeaseDeniedEventInterval                  time.Duration
+	MaxLeaseAttempts                          uint
 	Lease                                     LeaseSettings
+	ResourceOveruse                           ResourceOveruseSettings
+	OOMRetry                                  OOMRetrySettings
+	FailureRetry                              FailureRetrySettings
+	UnmatchableJobs                           UnmatchableJobsSettings
+	CapacitySmoothing                         CapacitySmoothingSettings
 }
 
 type EventRetentionPolicy struct {
@@ -63,7 +113,138 @@ type EventRetentionPolicy struct {
 	RetentionDuration time.Duration
 }
 
+type JobRetentionPolicy struct {
+	// How long finished jobs are kept to serve status queries, a week when 0
+	RetentionDuration time.Duration
+	// How often states of jobs finished longer than RetentionDuration ago are purged, 0 disables purging
+	CleanupInterval time.Duration
+}
+
+type MetricsPushConfig struct {
+	// Url of the Prometheus push gateway metrics are pushed to, pushing is disabled when empty
+	Url string
+	// Job label of the pushed metrics, "armada" when empty
+	Job      string
+	Interval time.Duration
+}
+
+type TracingConfig struct {
+	// Address of OpenTelemetry collector receiving spans over OTLP/gRPC, tracing is disabled when empty
+	OtlpEndpoint string
+	// Connect to the collector without TLS
+	Insecure bool
+}
+
+type JsonEventStreamConfig struct {
+	// When set, all events are also published as JSON to this Redis stream
+	Stream    string
+	MaxLength int64
+}
+
+type SubmissionPolicyConfig struct {
+	Default SubmissionPolicy
+	// Policy configured for a queue is used instead of the default one
+	Queues map[string]SubmissionPolicy
+}
+
+type SubmissionPolicy struct {
+	MaxResourcesPerJob common.ComputeResourcesFloat
+	DisallowedImages   []string
+	RequiredLabels     []string
+	ForbidHostPath     bool
+	MaxActiveDeadline  time.Duration
+	// Secrets referenced by jobs must have a name starting with one of these prefixes, any secret is allowed when empty
+	AllowedSecretPrefixes []string
+}
+
+type AuditConfig struct {
+	Enabled     bool
+	BufferSize  int
+	RedisStream string
+}
+
+type WebhookConfig struct {
+	Enabled bool
+	// URL notified of state transitions of all jobs, job sets can add their own URL on submission
+	Url string
+	// Hosts callback URLs of job sets may point to, callback URLs are rejected when there are none
+	CallbackHosts  []string
+	BufferSize     int
+	Workers        int
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	Timeout        time.Duration
+}
+
+type LeaseConcurrencyConfig struct {
+	// Maximum number of LeaseJobs and RenewLease requests handled at once, 0 disables the limit
+	MaxConcurrentRequests int
+	// How long an excess request waits for a free slot before failing with ResourceExhausted, 0 fails it immediately
+	MaxWait time.Duration
+}
+
+type BackpressureConfig struct {
+	// Status queries fail with Unavailable while the average latency of Redis operations is above this, 0 disables it
+	RedisLatencyThreshold time.Duration
+}
+
+type StatusReadsConfig struct {
+	// Replica of the events Redis serving event watches and status queries, the events Redis serves them when it has no addresses
+	Replica redis.UniversalOptions
+	// How long statuses of jobs and job sets are cached by the server, 0 disables the cache
+	MaxStaleness time.Duration
+}
+
 type LeaseSettings struct {
 	ExpireAfter        time.Duration
 	ExpiryLoopInterval time.Duration
+	// How long a lease request without jobs to lease waits for new jobs, 0 disables long polling
+	LongPollTimeout time.Duration
+}
+
+type ResourceOveruseSettings struct {
+	// Job using more than Ratio times its requested resource is overusing, 0 disables the detection
+	Ratio float64
+	// How long a job has to keep overusing before it is reported
+	Period time.Duration
+	// Cancel jobs which keep overusing instead of only reporting them
+	Preempt bool
+}
+
+type PreemptionBudget struct {
+	// Most jobs preempted in a scheduling cycle, 0 means no limit
+	MaxJobs int
+	// Most resources requested by jobs preempted in a scheduling cycle, resources not listed are not limited
+	MaxResources common.ComputeResourcesFloat
+}
+
+type OOMRetrySettings struct {
+	// Job failed because it ran out of memory is queued again with memory requests and limits multiplied by MemoryFactor,
+	// 0 disables requeueing
+	MemoryFactor float64
+	// Memory in bytes the requests and limits are not increased above, job failing with this memory fails for good
+	MaxMemory float64
+}
+
+type FailureRetrySettings struct {
+	// How many times a failed job is queued again, 0 disables retries
+	MaxRetries uint
+	// Whether failures of each category, e.g. NodeLost or ImagePullBackOff, are retried, other categories are not
+	Categories map[string]bool
+}
+
+type CapacitySmoothingSettings struct {
+	// Over how many lease requests of a cluster its newly available capacity is leased out, disabled when 0 or 1
+	Cycles uint
+	// Fraction by which each part of the new capacity is randomly reduced, so clusters gaining capacity
+	// at the same time don't lease in lockstep, 0 disables jitter
+	Jitter float64
+}
+
+type UnmatchableJobsSettings struct {
+	// What happens to job whose required node labels no active cluster has: "leave" keeps it queued (default),
+	// "warn" reports lease denied event with NoMatchingCluster reason and "cancel" cancels the job
+	Policy string
+	// How many lease requests have to find no cluster matching the job before the policy applies
+	Cycles uint
 }
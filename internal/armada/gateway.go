@@ -13,6 +13,7 @@ import (
 
 	protoutil "github.com/G-Research/armada/internal/armada/protoutils"
 	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/logging"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -23,11 +24,17 @@ func ServeGateway(port uint16, grpcPort uint16) (shutdown func()) {
 
 	mux := http.NewServeMux()
 
-	mux.HandleFunc("/health", health)
+	mux.HandleFunc("/health", gatewayHealth)
 
 	m := new(protoutil.JSONMarshaller)
 	gw := gwruntime.NewServeMux(
 		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, m),
+		gwruntime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			if strings.ToLower(key) == logging.CorrelationIdHeader {
+				return logging.CorrelationIdHeader, true
+			}
+			return gwruntime.DefaultHeaderMatcher(key)
+		}),
 		gwruntime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
 			if key == strings.ToLower(spnego.HTTPHeaderAuthResponse) {
 				return spnego.HTTPHeaderAuthResponse, true
@@ -59,6 +66,6 @@ func ServeGateway(port uint16, grpcPort uint16) (shutdown func()) {
 	}
 }
 
-func health(w http.ResponseWriter, r *http.Request) {
+func gatewayHealth(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+)
+
+const defaultPushJob = "armada"
+
+// StartMetricsPush pushes all metrics of the gatherer to the configured push gateway every interval,
+// for servers which don't live long enough to be scraped. The returned function stops pushing
+// and pushes the metrics one last time, so the gateway has their final values.
+func StartMetricsPush(config *configuration.MetricsPushConfig, gatherer prometheus.Gatherer) (stop func()) {
+	if config.Url == "" || config.Interval <= 0 {
+		return func() {}
+	}
+	job := config.Job
+	if job == "" {
+		job = defaultPushJob
+	}
+	pusher := push.New(config.Url, job).Gatherer(gatherer)
+	pushMetrics := func() {
+		if e := pusher.Push(); e != nil {
+			log.Errorf("Failed to push metrics to %s: %s", config.Url, e)
+		}
+	}
+
+	ticker := time.NewTicker(config.Interval)
+	done := make(chan bool)
+	stopped := make(chan bool)
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-ticker.C:
+				pushMetrics()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		ticker.Stop()
+		close(done)
+		<-stopped
+		pushMetrics()
+	}
+}
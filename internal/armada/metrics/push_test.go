@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+)
+
+func TestStartMetricsPush_PushesOnIntervalAndAtShutdown(t *testing.T) {
+	gateway := &fakePushGateway{}
+	server := httptest.NewServer(gateway)
+	defer server.Close()
+
+	registry := prometheus.NewRegistry()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: MetricPrefix + "test_total", Help: "Test counter"})
+	registry.MustRegister(counter)
+	counter.Inc()
+
+	stop := StartMetricsPush(&configuration.MetricsPushConfig{Url: server.URL, Interval: 50 * time.Millisecond}, registry)
+
+	for i := 0; i < 100 && gateway.count() < 2; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.True(t, gateway.count() >= 2, "metrics are pushed on the interval")
+
+	stop()
+	pushed := gateway.count()
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, pushed, gateway.count(), "no pushes after shutdown")
+
+	requests := gateway.received()
+	assert.True(t, len(requests) >= 3, "metrics are pushed at shutdown")
+	for _, request := range requests {
+		assert.Equal(t, http.MethodPut, request.method)
+		assert.Equal(t, "/metrics/job/armada", request.path)
+		assert.True(t, request.length > 0, "pushed request contains metrics")
+	}
+}
+
+func TestStartMetricsPush_DisabledWithoutUrl(t *testing.T) {
+	stop := StartMetricsPush(&configuration.MetricsPushConfig{Interval: time.Millisecond}, prometheus.NewRegistry())
+	stop()
+}
+
+type pushRequest struct {
+	method string
+	path   string
+	length int64
+}
+
+type fakePushGateway struct {
+	lock     sync.Mutex
+	requests []pushRequest
+}
+
+func (g *fakePushGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.requests = append(g.requests, pushRequest{method: r.Method, path: r.URL.Path, length: r.ContentLength})
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (g *fakePushGateway) count() int {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return len(g.requests)
+}
+
+func (g *fakePushGateway) received() []pushRequest {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return append([]pushRequest{}, g.requests...)
+}
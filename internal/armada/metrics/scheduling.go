@@ -0,0 +1,129 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/G-Research/armada/internal/armada/scheduling"
+)
+
+// SchedulingMetrics are updated by every lease request, they are registered when created,
+// so each server registers them in the registry current at its start.
+type SchedulingMetrics struct {
+	stepDuration *prometheus.HistogramVec
+	leasedJobs   prometheus.Counter
+	queuedJobs   prometheus.Gauge
+	queueShares  *queueShareCollector
+}
+
+func NewSchedulingMetrics() *SchedulingMetrics {
+	m := &SchedulingMetrics{
+		stepDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    MetricPrefix + "scheduling_step_duration_seconds",
+				Help:    "Duration of a step of the scheduling cycle (e.g. distributeRemainder)",
+				Buckets: prometheus.ExponentialBuckets(0.001, 2, 15),
+			},
+			[]string{"step"}),
+		leasedJobs: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: MetricPrefix + "scheduling_leased_jobs_total",
+			Help: "Number of jobs leased by scheduling cycles",
+		}),
+		queuedJobs: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: MetricPrefix + "queued_jobs",
+			Help: "Number of jobs waiting in all queues after the last scheduling cycle",
+		}),
+		queueShares: newQueueShareCollector(),
+	}
+	prometheus.MustRegister(m.stepDuration, m.leasedJobs, m.queuedJobs, m.queueShares)
+	return m
+}
+
+func (m *SchedulingMetrics) RecordStepDuration(step string, duration time.Duration) {
+	m.stepDuration.WithLabelValues(step).Observe(duration.Seconds())
+}
+
+func (m *SchedulingMetrics) RecordCycle(leasedJobs int, queuedJobs int64) {
+	m.leasedJobs.Add(float64(leasedJobs))
+	m.queuedJobs.Set(float64(queuedJobs))
+}
+
+// RecordQueueShares replaces shares of queues of the pool with shares of the last scheduling pass.
+func (m *SchedulingMetrics) RecordQueueShares(pool string, shares []*scheduling.QueueShare) {
+	m.queueShares.record(pool, shares)
+}
+
+var queueAdjustedShareDesc = prometheus.NewDesc(
+	MetricPrefix+"queue_adjusted_share",
+	"Share of resources to schedule of a queue in the last scheduling pass, limited by its scheduling limit",
+	[]string{"pool", "queueName", "resourceType"},
+	nil,
+)
+
+var queueRemainingSchedulingLimitDesc = prometheus.NewDesc(
+	MetricPrefix+"queue_remaining_scheduling_limit",
+	"Resources a queue could still be scheduled in the last scheduling pass",
+	[]string{"pool", "queueName", "resourceType"},
+	nil,
+)
+
+var queueCurrentUsageDesc = prometheus.NewDesc(
+	MetricPrefix+"queue_current_usage",
+	"Resources used by a queue as seen by the last scheduling pass",
+	[]string{"pool", "queueName", "resourceType"},
+	nil,
+)
+
+var queueIdleDesc = prometheus.NewDesc(
+	MetricPrefix+"queue_idle",
+	"Set to 1 for a queue which leased no jobs in the last scheduling pass, with the reason why",
+	[]string{"pool", "queueName", "reason"},
+	nil,
+)
+
+// queueShareCollector keeps shares of queues from the last scheduling pass of each pool,
+// shares of a pool are replaced at once, so scrapes never see a partially updated pass.
+type queueShareCollector struct {
+	lock   sync.Mutex
+	shares map[string][]*scheduling.QueueShare
+}
+
+func newQueueShareCollector() *queueShareCollector {
+	return &queueShareCollector{shares: map[string][]*scheduling.QueueShare{}}
+}
+
+func (c *queueShareCollector) record(pool string, shares []*scheduling.QueueShare) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.shares[pool] = shares
+}
+
+func (c *queueShareCollector) Describe(desc chan<- *prometheus.Desc) {
+	desc <- queueAdjustedShareDesc
+	desc <- queueRemainingSchedulingLimitDesc
+	desc <- queueCurrentUsageDesc
+	desc <- queueIdleDesc
+}
+
+func (c *queueShareCollector) Collect(metrics chan<- prometheus.Metric) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for pool, shares := range c.shares {
+		for _, share := range shares {
+			for resourceType, value := range share.AdjustedShare {
+				metrics <- prometheus.MustNewConstMetric(queueAdjustedShareDesc, prometheus.GaugeValue, value, pool, share.Queue, resourceType)
+			}
+			for resourceType, value := range share.RemainingSchedulingLimit {
+				metrics <- prometheus.MustNewConstMetric(queueRemainingSchedulingLimitDesc, prometheus.GaugeValue, value, pool, share.Queue, resourceType)
+			}
+			for resourceType, value := range share.CurrentUsage {
+				metrics <- prometheus.MustNewConstMetric(queueCurrentUsageDesc, prometheus.GaugeValue, value, pool, share.Queue, resourceType)
+			}
+			if share.IdleReason != "" {
+				metrics <- prometheus.MustNewConstMetric(queueIdleDesc, prometheus.GaugeValue, 1, pool, share.Queue, string(share.IdleReason))
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/scheduling"
+	"github.com/G-Research/armada/internal/common"
+)
+
+func Test_queueShareCollector_ExposesSharesOfLastPass(t *testing.T) {
+	collector := newQueueShareCollector()
+	collector.record("pool1", []*scheduling.QueueShare{
+		{
+			Queue:                    "queue1",
+			AdjustedShare:            common.ComputeResourcesFloat{"cpu": 1},
+			RemainingSchedulingLimit: common.ComputeResourcesFloat{"cpu": 10},
+			CurrentUsage:             common.ComputeResourcesFloat{"cpu": 8},
+		},
+	})
+	collector.record("pool1", []*scheduling.QueueShare{
+		{
+			Queue:                    "queue1",
+			AdjustedShare:            common.ComputeResourcesFloat{"cpu": 4},
+			RemainingSchedulingLimit: common.ComputeResourcesFloat{"cpu": 10},
+			CurrentUsage:             common.ComputeResourcesFloat{"cpu": 2},
+		},
+		{
+			Queue:                    "queue2",
+			AdjustedShare:            common.ComputeResourcesFloat{"cpu": 6},
+			RemainingSchedulingLimit: common.ComputeResourcesFloat{"cpu": 10},
+			CurrentUsage:             common.ComputeResourcesFloat{},
+		},
+	})
+
+	expected := `
+# HELP armada_queue_adjusted_share Share of resources to schedule of a queue in the last scheduling pass, limited by its scheduling limit
+# TYPE armada_queue_adjusted_share gauge
+armada_queue_adjusted_share{pool="pool1",queueName="queue1",resourceType="cpu"} 4
+armada_queue_adjusted_share{pool="pool1",queueName="queue2",resourceType="cpu"} 6
+# HELP armada_queue_current_usage Resources used by a queue as seen by the last scheduling pass
+# TYPE armada_queue_current_usage gauge
+armada_queue_current_usage{pool="pool1",queueName="queue1",resourceType="cpu"} 2
+# HELP armada_queue_remaining_scheduling_limit Resources a queue could still be scheduled in the last scheduling pass
+# TYPE armada_queue_remaining_scheduling_limit gauge
+armada_queue_remaining_scheduling_limit{pool="pool1",queueName="queue1",resourceType="cpu"} 10
+armada_queue_remaining_scheduling_limit{pool="pool1",queueName="queue2",resourceType="cpu"} 10
+`
+	assert.Nil(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
+
+func Test_queueShareCollector_ExposesReasonsOfIdleQueues(t *testing.T) {
+	collector := newQueueShareCollector()
+	collector.record("pool1", []*scheduling.QueueShare{
+		{Queue: "queue1", IdleReason: scheduling.LimitReached},
+		{Queue: "queue2"},
+	})
+
+	expected := `
+# HELP armada_queue_idle Set to 1 for a queue which leased no jobs in the last scheduling pass, with the reason why
+# TYPE armada_queue_idle gauge
+armada_queue_idle{pool="pool1",queueName="queue1",reason="LimitReached"} 1
+`
+	assert.Nil(t, testutil.CollectAndCompare(collector, strings.NewReader(expected), "armada_queue_idle"))
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+)
+
+// Field number 0 is not valid in protobuf, so uncompressed jobs never start with this prefix.
+var compressedJobPrefix = []byte{0, 'g', 'z'}
+
+func compressJob(data []byte) ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.Write(compressedJobPrefix)
+	writer := gzip.NewWriter(&buffer)
+	if _, e := writer.Write(data); e != nil {
+		return nil, e
+	}
+	if e := writer.Close(); e != nil {
+		return nil, e
+	}
+	return buffer.Bytes(), nil
+}
+
+// decompressJob returns data of jobs stored without compression unchanged.
+func decompressJob(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, compressedJobPrefix) {
+		return data, nil
+	}
+	reader, e := gzip.NewReader(bytes.NewReader(data[len(compressedJobPrefix):]))
+	if e != nil {
+		return nil, e
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
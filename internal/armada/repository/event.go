@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"encoding/json"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis"
@@ -11,22 +14,44 @@ import (
 )
 
 const eventStreamPrefix = "Events:"
+const jobSetJobStatesPrefix = "JobSetJobStates:"
+const jobSetStateCountsPrefix = "JobSetStateCounts:"
+const jobSetFinishedJobsPrefix = "JobSetFinishedJobs:"
+const jobSetCallbackUrlPrefix = "JobSetCallbackUrl:"
 const dataKey = "message"
+const queueKey = "queue"
+const jobSetIdKey = "jobSetId"
+
+// EventReader serves the read-heavy queries of clients, event watches and statuses of jobs and job sets.
+type EventReader interface {
+	ReadEvents(queue, jobSetId string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, error)
+	GetLastMessageId(queue, jobSetId string) (string, error)
+	GetJobSetStatus(queue, jobSetId string) (*api.JobSetStatusResponse, error)
+	GetJobState(queue, jobSetId, jobId string) (string, error)
+}
 
 type EventRepository interface {
+	EventReader
 	ReportEvent(message *api.EventMessage) error
 	ReportEvents(message []*api.EventMessage) error
-	ReadEvents(queue, jobSetId string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, error)
-	GetLastMessageId(queue, jobSetId string) (string, error)
+	PurgeFinishedJobStates(before time.Time) (purged int, e error)
+	SetJobSetCallbackUrl(queue, jobSetId, url string) (string, error)
+	GetJobSetCallbackUrl(queue, jobSetId string) (string, error)
 }
 
 type RedisEventRepository struct {
-	db             redis.UniversalClient
-	eventRetention configuration.EventRetentionPolicy
+	db              redis.UniversalClient
+	keyPrefix       string
+	eventRetention  configuration.EventRetentionPolicy
+	jsonEventStream configuration.JsonEventStreamConfig
 }
 
-func NewRedisEventRepository(db redis.UniversalClient, eventRetention configuration.EventRetentionPolicy) *RedisEventRepository {
-	return &RedisEventRepository{db: db, eventRetention: eventRetention}
+func NewRedisEventRepository(
+	db redis.UniversalClient,
+	keyPrefix string,
+	eventRetention configuration.EventRetentionPolicy,
+	jsonEventStream configuration.JsonEventStreamConfig) *RedisEventRepository {
+	return &RedisEventRepository{db: db, keyPrefix: keyPrefix, eventRetention: eventRetention, jsonEventStream: jsonEventStream}
 }
 
 func (repo *RedisEventRepository) ReportEvent(message *api.EventMessage) error {
@@ -36,11 +61,14 @@ func (repo *RedisEventRepository) ReportEvent(message *api.EventMessage) error {
 func (repo *RedisEventRepository) ReportEvents(message []*api.EventMessage) error {
 
 	type eventData struct {
-		key  string
-		data []byte
+		key      string
+		data     []byte
+		jsonData []byte
+		event    api.Event
 	}
 	data := []eventData{}
 	uniqueJobSets := make(map[string]bool)
+	jobSetStateChanges := make(map[jobSet][]interface{})
 
 	for _, m := range message {
 		event, e := api.UnwrapEvent(m)
@@ -51,9 +79,21 @@ func (repo *RedisEventRepository) ReportEvents(message []*api.EventMessage) erro
 		if e != nil {
 			return e
 		}
-		key := getJobSetEventsKey(event.GetQueue(), event.GetJobSetId())
-		data = append(data, eventData{key: key, data: messageData})
+		var jsonData []byte
+		if repo.jsonEventStream.Stream != "" {
+			jsonData, e = json.Marshal(m)
+			if e != nil {
+				return e
+			}
+		}
+		key := repo.getJobSetEventsKey(event.GetQueue(), event.GetJobSetId())
+		data = append(data, eventData{key: key, data: messageData, jsonData: jsonData, event: event})
 		uniqueJobSets[key] = true
+
+		if state := jobStateAfterEvent(event); state != "" {
+			set := jobSet{queue: event.GetQueue(), jobSetId: event.GetJobSetId()}
+			jobSetStateChanges[set] = append(jobSetStateChanges[set], event.GetJobId(), state)
+		}
 	}
 
 	pipe := repo.db.Pipeline()
@@ -64,6 +104,34 @@ func (repo *RedisEventRepository) ReportEvents(message []*api.EventMessage) erro
 				dataKey: e.data,
 			},
 		})
+		if e.jsonData != nil {
+			pipe.XAdd(&redis.XAddArgs{
+				Stream:       repo.keyPrefix + repo.jsonEventStream.Stream,
+				MaxLenApprox: repo.jsonEventStream.MaxLength,
+				Values: map[string]interface{}{
+					queueKey:    e.event.GetQueue(),
+					jobSetIdKey: e.event.GetJobSetId(),
+					dataKey:     e.jsonData,
+				},
+			})
+		}
+	}
+
+	if len(jobSetStateChanges) > 0 {
+		updateJobSetStatesScript.Load(pipe)
+	}
+	now := float64(time.Now().UnixNano())
+	for set, changes := range jobSetStateChanges {
+		keys := []string{
+			repo.getJobSetJobStatesKey(set.queue, set.jobSetId),
+			repo.getJobSetStateCountsKey(set.queue, set.jobSetId),
+			repo.getJobSetFinishedJobsKey(set.queue, set.jobSetId)}
+		updateJobSetStatesScript.Run(pipe, keys, append([]interface{}{now}, changes...)...)
+		if repo.eventRetention.ExpiryEnabled {
+			for _, key := range keys {
+				pipe.Expire(key, repo.eventRetention.RetentionDuration)
+			}
+		}
 	}
 
 	if repo.eventRetention.ExpiryEnabled {
@@ -83,7 +151,7 @@ func (repo *RedisEventRepository) ReadEvents(queue, jobSetId string, lastId stri
 	}
 
 	cmd, e := repo.db.XRead(&redis.XReadArgs{
-		Streams: []string{getJobSetEventsKey(queue, jobSetId), lastId},
+		Streams: []string{repo.getJobSetEventsKey(queue, jobSetId), lastId},
 		Count:   limit,
 		Block:   block,
 	}).Result()
@@ -112,7 +180,7 @@ func (repo *RedisEventRepository) ReadEvents(queue, jobSetId string, lastId stri
 }
 
 func (repo *RedisEventRepository) GetLastMessageId(queue, jobSetId string) (string, error) {
-	msg, err := repo.db.XRevRangeN(getJobSetEventsKey(queue, jobSetId), "+", "-", 1).Result()
+	msg, err := repo.db.XRevRangeN(repo.getJobSetEventsKey(queue, jobSetId), "+", "-", 1).Result()
 	if err != nil {
 		return "", err
 	}
@@ -122,6 +190,225 @@ func (repo *RedisEventRepository) GetLastMessageId(queue, jobSetId string) (stri
 	return "0", nil
 }
 
-func getJobSetEventsKey(queue, jobSetId string) string {
-	return eventStreamPrefix + queue + ":" + jobSetId
+// GetJobSetStatus returns numbers of jobs of the job set in each state, the numbers are maintained as events are reported.
+func (repo *RedisEventRepository) GetJobSetStatus(queue, jobSetId string) (*api.JobSetStatusResponse, error) {
+	counts, e := repo.db.HGetAll(repo.getJobSetStateCountsKey(queue, jobSetId)).Result()
+	if e != nil {
+		return nil, e
+	}
+	count := func(state string) (int32, error) {
+		value, ok := counts[state]
+		if !ok {
+			return 0, nil
+		}
+		n, e := strconv.ParseInt(value, 10, 32)
+		return int32(n), e
+	}
+
+	status := &api.JobSetStatusResponse{}
+	for state, field := range map[string]*int32{
+		queuedState:    &status.Queued,
+		gatedState:     &status.Gated,
+		leasedState:    &status.Leased,
+		pendingState:   &status.Pending,
+		runningState:   &status.Running,
+		succeededState: &status.Succeeded,
+		failedState:    &status.Failed,
+		cancelledState: &status.Cancelled,
+	} {
+		*field, e = count(state)
+		if e != nil {
+			return nil, e
+		}
+	}
+	return status, nil
+}
+
+// GetJobState returns the current state of the job, it is empty for unknown jobs and jobs whose state was purged.
+func (repo *RedisEventRepository) GetJobState(queue, jobSetId, jobId string) (string, error) {
+	state, e := repo.db.HGet(repo.getJobSetJobStatesKey(queue, jobSetId), jobId).Result()
+	if e == redis.Nil {
+		return "", nil
+	}
+	return state, e
+}
+
+// PurgeFinishedJobStates removes states of jobs which finished before the given time, numbers of jobs in each state
+// of their job sets are kept. Returns the number of purged jobs.
+func (repo *RedisEventRepository) PurgeFinishedJobStates(before time.Time) (int, error) {
+	prefix := repo.keyPrefix + jobSetFinishedJobsPrefix
+	maxScore := strconv.FormatInt(before.UnixNano(), 10)
+	purged := 0
+
+	var cursor uint64
+	for {
+		keys, next, e := repo.db.Scan(cursor, prefix+"*", 1000).Result()
+		if e != nil {
+			return purged, e
+		}
+		for _, key := range keys {
+			jobIds, e := repo.db.ZRangeByScore(key, redis.ZRangeBy{Min: "-Inf", Max: maxScore}).Result()
+			if e != nil {
+				return purged, e
+			}
+			if len(jobIds) == 0 {
+				continue
+			}
+			members := make([]interface{}, 0, len(jobIds))
+			for _, jobId := range jobIds {
+				members = append(members, jobId)
+			}
+
+			pipe := repo.db.TxPipeline()
+			pipe.HDel(repo.keyPrefix+jobSetJobStatesPrefix+strings.TrimPrefix(key, prefix), jobIds...)
+			pipe.ZRem(key, members...)
+			if _, e := pipe.Exec(); e != nil {
+				return purged, e
+			}
+			purged += len(jobIds)
+		}
+		if next == 0 {
+			return purged, nil
+		}
+		cursor = next
+	}
+}
+
+// SetJobSetCallbackUrl stores the URL notified of state transitions of jobs of the job set, it expires together with
+// events of the job set. The URL is stored only when the job set is created, i.e. it has no events and no URL yet,
+// so later submissions can't redirect notifications of jobs already in the set. Returns the URL of the job set
+// after the call, empty when the job set exists without URL.
+func (repo *RedisEventRepository) SetJobSetCallbackUrl(queue, jobSetId, url string) (string, error) {
+	var expiration time.Duration
+	if repo.eventRetention.ExpiryEnabled {
+		expiration = repo.eventRetention.RetentionDuration
+	}
+	return setJobSetCallbackUrlScript.Run(repo.db,
+		[]string{repo.getJobSetEventsKey(queue, jobSetId), repo.getJobSetCallbackUrlKey(queue, jobSetId)},
+		url, int64(expiration/time.Millisecond)).String()
+}
+
+// GetJobSetCallbackUrl returns the URL notified of state transitions of jobs of the job set, empty when there is none.
+func (repo *RedisEventRepository) GetJobSetCallbackUrl(queue, jobSetId string) (string, error) {
+	url, e := repo.db.Get(repo.getJobSetCallbackUrlKey(queue, jobSetId)).Result()
+	if e == redis.Nil {
+		return "", nil
+	}
+	return url, e
+}
+
+func (repo *RedisEventRepository) getJobSetEventsKey(queue, jobSetId string) string {
+	return repo.keyPrefix + eventStreamPrefix + queue + ":" + jobSetId
+}
+
+func (repo *RedisEventRepository) getJobSetJobStatesKey(queue, jobSetId string) string {
+	return repo.keyPrefix + jobSetJobStatesPrefix + queue + ":" + jobSetId
+}
+
+func (repo *RedisEventRepository) getJobSetStateCountsKey(queue, jobSetId string) string {
+	return repo.keyPrefix + jobSetStateCountsPrefix + queue + ":" + jobSetId
+}
+
+func (repo *RedisEventRepository) getJobSetFinishedJobsKey(queue, jobSetId string) string {
+	return repo.keyPrefix + jobSetFinishedJobsPrefix + queue + ":" + jobSetId
 }
+
+func (repo *RedisEventRepository) getJobSetCallbackUrlKey(queue, jobSetId string) string {
+	return repo.keyPrefix + jobSetCallbackUrlPrefix + queue + ":" + jobSetId
+}
+
+type jobSet struct {
+	queue    string
+	jobSetId string
+}
+
+const (
+	queuedState    = "Queued"
+	gatedState     = "Gated"
+	leasedState    = "Leased"
+	pendingState   = "Pending"
+	runningState   = "Running"
+	succeededState = "Succeeded"
+	failedState    = "Failed"
+	cancelledState = "Cancelled"
+)
+
+// jobStateAfterEvent returns the state the event moves the job to, it is empty for events not changing the state.
+func jobStateAfterEvent(event api.Event) string {
+	switch e := event.(type) {
+	case *api.JobSubmittedEvent:
+		if e.Job.Gated {
+			return gatedState
+		}
+		return queuedState
+	case *api.JobQueuedEvent:
+		if e.Gated {
+			return gatedState
+		}
+		return queuedState
+	case *api.JobLeaseReturnedEvent, *api.JobLeaseExpiredEvent, *api.JobRequeuedEvent:
+		return queuedState
+	case *api.JobLeasedEvent:
+		return leasedState
+	case *api.JobPendingEvent:
+		return pendingState
+	case *api.JobRunningEvent:
+		return runningState
+	case *api.JobSucceededEvent:
+		return succeededState
+	case *api.JobFailedEvent:
+		return failedState
+	case *api.JobCancelledEvent:
+		return cancelledState
+	}
+	return ""
+}
+
+var setJobSetCallbackUrlScript = redis.NewScript(`
+local events = KEYS[1]
+local callbackUrl = KEYS[2]
+
+local url = ARGV[1]
+local expiration = tonumber(ARGV[2])
+
+local current = redis.call('GET', callbackUrl)
+if current then
+	return current
+end
+if redis.call('EXISTS', events) == 1 then
+	return ''
+end
+if expiration > 0 then
+	redis.call('SET', callbackUrl, url, 'PX', expiration)
+else
+	redis.call('SET', callbackUrl, url)
+end
+return url
+`)
+
+// stores the state of each job of the job set and moves it between the state counts,
+// jobs in a final state are not changed any more and are recorded with the time they finished
+var updateJobSetStatesScript = redis.NewScript(`
+local jobStates = KEYS[1]
+local stateCounts = KEYS[2]
+local finishedJobs = KEYS[3]
+
+local now = ARGV[1]
+
+for i = 2, #ARGV, 2 do
+	local jobId = ARGV[i]
+	local state = ARGV[i + 1]
+	local current = redis.call('HGET', jobStates, jobId)
+	if current ~= state and current ~= 'Succeeded' and current ~= 'Failed' and current ~= 'Cancelled' then
+		if current then
+			redis.call('HINCRBY', stateCounts, current, -1)
+		end
+		redis.call('HINCRBY', stateCounts, state, 1)
+		redis.call('HSET', jobStates, jobId, state)
+		if state == 'Succeeded' or state == 'Failed' or state == 'Cancelled' then
+			redis.call('ZADD', finishedJobs, now, jobId)
+		end
+	end
+end
+return 0
+`)
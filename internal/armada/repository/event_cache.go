@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"sync"
+	"time"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// CachingEventReader serves statuses of jobs and job sets read less than max staleness ago from memory,
+// so clients polling them don't load Redis with each request. Events are always read from the underlying reader.
+type CachingEventReader struct {
+	EventReader
+	maxStaleness time.Duration
+
+	lock           sync.Mutex
+	lastSweep      time.Time
+	jobSetStatuses map[jobSet]cachedJobSetStatus
+	jobStates      map[jobStateKey]cachedJobState
+}
+
+type jobStateKey struct {
+	jobSet
+	jobId string
+}
+
+type cachedJobSetStatus struct {
+	status *api.JobSetStatusResponse
+	read   time.Time
+}
+
+type cachedJobState struct {
+	state string
+	read  time.Time
+}
+
+func NewCachingEventReader(reader EventReader, maxStaleness time.Duration) *CachingEventReader {
+	return &CachingEventReader{
+		EventReader:    reader,
+		maxStaleness:   maxStaleness,
+		lastSweep:      time.Now(),
+		jobSetStatuses: map[jobSet]cachedJobSetStatus{},
+		jobStates:      map[jobStateKey]cachedJobState{},
+	}
+}
+
+func (r *CachingEventReader) GetJobSetStatus(queue, jobSetId string) (*api.JobSetStatusResponse, error) {
+	key := jobSet{queue: queue, jobSetId: jobSetId}
+	r.lock.Lock()
+	cached, ok := r.jobSetStatuses[key]
+	r.lock.Unlock()
+	if ok && r.fresh(cached.read) {
+		return cached.status, nil
+	}
+
+	read := time.Now()
+	status, e := r.EventReader.GetJobSetStatus(queue, jobSetId)
+	if e != nil {
+		return nil, e
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.jobSetStatuses[key] = cachedJobSetStatus{status: status, read: read}
+	r.sweep()
+	return status, nil
+}
+
+func (r *CachingEventReader) GetJobState(queue, jobSetId, jobId string) (string, error) {
+	key := jobStateKey{jobSet: jobSet{queue: queue, jobSetId: jobSetId}, jobId: jobId}
+	r.lock.Lock()
+	cached, ok := r.jobStates[key]
+	r.lock.Unlock()
+	if ok && r.fresh(cached.read) {
+		return cached.state, nil
+	}
+
+	read := time.Now()
+	state, e := r.EventReader.GetJobState(queue, jobSetId, jobId)
+	if e != nil {
+		return "", e
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.jobStates[key] = cachedJobState{state: state, read: read}
+	r.sweep()
+	return state, nil
+}
+
+// fresh tells whether value read at the given time may still be served, the time is taken before the read started,
+// so the value is never older than max staleness.
+func (r *CachingEventReader) fresh(read time.Time) bool {
+	return time.Since(read) < r.maxStaleness
+}
+
+// sweep removes stale values at most once per max staleness, so statuses which are no longer polled don't stay in memory.
+func (r *CachingEventReader) sweep() {
+	if time.Since(r.lastSweep) < r.maxStaleness {
+		return
+	}
+	r.lastSweep = time.Now()
+	for key, cached := range r.jobSetStatuses {
+		if !r.fresh(cached.read) {
+			delete(r.jobSetStatuses, key)
+		}
+	}
+	for key, cached := range r.jobStates {
+		if !r.fresh(cached.read) {
+			delete(r.jobStates, key)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestCachingEventReader_ServesRecentStatusesWhilePrimaryIsUnderLoad(t *testing.T) {
+	withEventRepository(configuration.JsonEventStreamConfig{}, func(r *RedisEventRepository) {
+		maxStaleness := 200 * time.Millisecond
+		primary := &loadedEventReader{EventReader: r}
+		reader := NewCachingEventReader(primary, maxStaleness)
+		reportEvents(t, r, &api.JobQueuedEvent{JobId: "job1", JobSetId: "set1", Queue: "queue1"})
+
+		assertJobStatuses(t, reader, &api.JobSetStatusResponse{Queued: 1}, "Queued")
+
+		reportEvents(t, r, &api.JobRunningEvent{JobId: "job1", JobSetId: "set1", Queue: "queue1"})
+		primary.delay = time.Second
+		start := time.Now()
+		assertJobStatuses(t, reader, &api.JobSetStatusResponse{Queued: 1}, "Queued")
+		assert.True(t, time.Since(start) < maxStaleness, "statuses are served from memory without waiting for the primary")
+
+		time.Sleep(maxStaleness)
+		primary.delay = 0
+		assertJobStatuses(t, reader, &api.JobSetStatusResponse{Running: 1}, "Running")
+	})
+}
+
+func assertJobStatuses(t *testing.T, reader EventReader, expectedJobSetStatus *api.JobSetStatusResponse, expectedJobState string) {
+	status, e := reader.GetJobSetStatus("queue1", "set1")
+	assert.Nil(t, e)
+	assert.Equal(t, expectedJobSetStatus, status)
+	state, e := reader.GetJobState("queue1", "set1", "job1")
+	assert.Nil(t, e)
+	assert.Equal(t, expectedJobState, state)
+}
+
+// loadedEventReader answers status queries after a delay, as Redis does when it is busy with leasing and submitting
+type loadedEventReader struct {
+	EventReader
+	delay time.Duration
+}
+
+func (r *loadedEventReader) GetJobSetStatus(queue, jobSetId string) (*api.JobSetStatusResponse, error) {
+	time.Sleep(r.delay)
+	return r.EventReader.GetJobSetStatus(queue, jobSetId)
+}
+
+func (r *loadedEventReader) GetJobState(queue, jobSetId, jobId string) (string, error) {
+	time.Sleep(r.delay)
+	return r.EventReader.GetJobState(queue, jobSetId, jobId)
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestReportEvents_PublishesEventsAsJsonToConfiguredStream(t *testing.T) {
+	withEventRepository(configuration.JsonEventStreamConfig{Stream: "EventsJson", MaxLength: 100}, func(r *RedisEventRepository) {
+		created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		submitted, e := api.Wrap(&api.JobSubmittedEvent{
+			JobId:    "job1",
+			JobSetId: "set1",
+			Queue:    "queue1",
+			Created:  created,
+			Job:      api.Job{Id: "job1", JobSetId: "set1", Queue: "queue1", Priority: 2},
+		})
+		assert.Nil(t, e)
+		leased, e := api.Wrap(&api.JobLeasedEvent{
+			JobId:     "job1",
+			JobSetId:  "set1",
+			Queue:     "queue1",
+			Created:   created,
+			ClusterId: "cluster1",
+		})
+		assert.Nil(t, e)
+
+		e = r.ReportEvents([]*api.EventMessage{submitted, leased})
+		assert.Nil(t, e)
+
+		streams, e := r.db.XRead(&redis.XReadArgs{Streams: []string{"EventsJson", "0"}, Count: 10}).Result()
+		assert.Nil(t, e)
+		messages := streams[0].Messages
+		assert.Equal(t, 2, len(messages))
+
+		for _, m := range messages {
+			assert.Equal(t, "queue1", m.Values[queueKey])
+			assert.Equal(t, "set1", m.Values[jobSetIdKey])
+		}
+
+		submittedJson := decodeJsonEvent(t, messages[0].Values[dataKey])
+		assert.Equal(t, "job1", submittedJson["submitted"]["JobId"])
+		assert.Equal(t, "set1", submittedJson["submitted"]["JobSetId"])
+		assert.Equal(t, "queue1", submittedJson["submitted"]["Queue"])
+		assert.Equal(t, "2020-01-02T03:04:05Z", submittedJson["submitted"]["Created"])
+		assert.Equal(t, 2.0, submittedJson["submitted"]["Job"].(map[string]interface{})["Priority"])
+
+		leasedJson := decodeJsonEvent(t, messages[1].Values[dataKey])
+		assert.Equal(t, "job1", leasedJson["leased"]["JobId"])
+		assert.Equal(t, "cluster1", leasedJson["leased"]["ClusterId"])
+		assert.Equal(t, "2020-01-02T03:04:05Z", leasedJson["leased"]["Created"])
+	})
+}
+
+func TestReportEvents_DoesNotPublishJsonWhenStreamIsNotConfigured(t *testing.T) {
+	withEventRepository(configuration.JsonEventStreamConfig{}, func(r *RedisEventRepository) {
+		event, e := api.Wrap(&api.JobQueuedEvent{JobId: "job1", JobSetId: "set1", Queue: "queue1"})
+		assert.Nil(t, e)
+
+		e = r.ReportEvents([]*api.EventMessage{event})
+		assert.Nil(t, e)
+
+		keys, e := r.db.Keys("*").Result()
+		assert.Nil(t, e)
+		assert.ElementsMatch(t, []string{
+			r.getJobSetEventsKey("queue1", "set1"),
+			r.getJobSetJobStatesKey("queue1", "set1"),
+			r.getJobSetStateCountsKey("queue1", "set1"),
+		}, keys)
+	})
+}
+
+func TestPurgeFinishedJobStates_RemovesStatesOfJobsFinishedBeforeTime(t *testing.T) {
+	withEventRepository(configuration.JsonEventStreamConfig{}, func(r *RedisEventRepository) {
+		reportEvents(t, r,
+			&api.JobQueuedEvent{JobId: "succeeded", JobSetId: "set1", Queue: "queue1"},
+			&api.JobSucceededEvent{JobId: "succeeded", JobSetId: "set1", Queue: "queue1"},
+			&api.JobQueuedEvent{JobId: "running", JobSetId: "set1", Queue: "queue1"},
+			&api.JobRunningEvent{JobId: "running", JobSetId: "set1", Queue: "queue1"},
+			&api.JobCancelledEvent{JobId: "cancelled", JobSetId: "set2", Queue: "queue1"})
+
+		purged, e := r.PurgeFinishedJobStates(time.Now().Add(-time.Minute))
+		assert.Nil(t, e)
+		assert.Equal(t, 0, purged, "jobs finished after the time are kept")
+
+		purged, e = r.PurgeFinishedJobStates(time.Now())
+		assert.Nil(t, e)
+		assert.Equal(t, 2, purged)
+
+		for jobId, expected := range map[string]string{"succeeded": "", "running": "Running"} {
+			state, e := r.GetJobState("queue1", "set1", jobId)
+			assert.Nil(t, e)
+			assert.Equal(t, expected, state)
+		}
+		state, e := r.GetJobState("queue1", "set2", "cancelled")
+		assert.Nil(t, e)
+		assert.Equal(t, "", state)
+
+		status, e := r.GetJobSetStatus("queue1", "set1")
+		assert.Nil(t, e)
+		assert.Equal(t, &api.JobSetStatusResponse{Running: 1, Succeeded: 1}, status)
+	})
+}
+
+func TestSetJobSetCallbackUrl_StoresUrlOnlyWhenJobSetIsCreated(t *testing.T) {
+	withEventRepository(configuration.JsonEventStreamConfig{}, func(r *RedisEventRepository) {
+		url, e := r.SetJobSetCallbackUrl("queue1", "new", "https://example.com/first")
+		assert.Nil(t, e)
+		assert.Equal(t, "https://example.com/first", url)
+
+		url, e = r.SetJobSetCallbackUrl("queue1", "new", "https://example.com/second")
+		assert.Nil(t, e)
+		assert.Equal(t, "https://example.com/first", url)
+
+		reportEvents(t, r, &api.JobQueuedEvent{JobId: "job1", JobSetId: "existing", Queue: "queue1"})
+		url, e = r.SetJobSetCallbackUrl("queue1", "existing", "https://example.com/first")
+		assert.Nil(t, e)
+		assert.Equal(t, "", url)
+
+		for jobSetId, expected := range map[string]string{"new": "https://example.com/first", "existing": ""} {
+			url, e := r.GetJobSetCallbackUrl("queue1", jobSetId)
+			assert.Nil(t, e)
+			assert.Equal(t, expected, url)
+		}
+	})
+}
+
+func reportEvents(t *testing.T, r *RedisEventRepository, events ...api.Event) {
+	messages := []*api.EventMessage{}
+	for _, event := range events {
+		message, e := api.Wrap(event)
+		assert.Nil(t, e)
+		messages = append(messages, message)
+	}
+	assert.Nil(t, r.ReportEvents(messages))
+}
+
+func decodeJsonEvent(t *testing.T, data interface{}) map[string]map[string]interface{} {
+	decoded := map[string]map[string]interface{}{}
+	e := json.Unmarshal([]byte(data.(string)), &decoded)
+	assert.Nil(t, e)
+	return decoded
+}
+
+func withEventRepository(jsonEventStream configuration.JsonEventStreamConfig, action func(r *RedisEventRepository)) {
+	// using real redis instance as miniredis does not support streams
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+
+	client.FlushDB()
+
+	repo := NewRedisEventRepository(client, "", configuration.EventRetentionPolicy{}, jsonEventStream)
+	action(repo)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -19,39 +20,74 @@ const jobObjectPrefix = "Job:"
 const jobQueuePrefix = "Job:Queue:"
 const jobSetPrefix = "Job:Set:"
 const jobLeasedPrefix = "Job:Leased:"
+const jobLabelPrefix = "Job:Label:"
 const jobClusterMapKey = "Job:ClusterId"
+const jobClientIdPrefix = "Job:ClientId:"
+const jobLeaseDeniedPrefix = "Job:LeaseDenied:"
+const jobSuspendedPrefix = "Job:Suspended:"
+const jobGatedPrefix = "Job:Gated:"
+const jobUnmatchablePrefix = "Job:Unmatchable:"
 
 type JobQueueRepository interface {
 	PeekQueue(queue string, limit int64) ([]*api.Job, error)
 	TryLeaseJobs(clusterId string, queue string, jobs []*api.Job) ([]*api.Job, error)
+	ReturnLease(clusterId string, jobId string) (returnedJob *api.Job, err error)
+	GetLeasedJobCount(queue string) (int64, error)
 }
 
 type JobRepository interface {
 	JobQueueRepository
-	CreateJobs(request *api.JobSubmitRequest, principal authorization.Principal) ([]*api.Job, error)
+	CreateJob(request *api.JobSubmitRequest, item *api.JobSubmitRequestItem, principal authorization.Principal) (*api.Job, error)
 	AddJobs(job []*api.Job) ([]*SubmitJobResult, error)
+	AddJobsAtomically(jobs []*api.Job) error
 	GetExistingJobsByIds(ids []string) ([]*api.Job, error)
 	FilterActiveQueues(queues []*api.Queue) ([]*api.Queue, error)
 	GetQueueSizes(queues []*api.Queue) (sizes []int64, e error)
-	RenewLease(clusterId string, jobIds []string) (renewed []string, e error)
+	FilterNotQueuedJobs(jobs []*api.Job) ([]*api.Job, error)
+	RenewLease(clusterId string, jobIds []string) (statuses map[string]api.LeaseRenewalStatus, e error)
+	GetLeasedJobs(clusterId string) ([]*api.Job, error)
+	RequeueJob(clusterId string, job *api.Job) (requeued bool, e error)
 	ExpireLeases(queue string, deadline time.Time) (expired []*api.Job, e error)
-	ReturnLease(clusterId string, jobId string) (returnedJob *api.Job, err error)
 	DeleteJobs(jobs []*api.Job) map[*api.Job]error
+	DeleteQueuedJobs(jobs []*api.Job) map[*api.Job]error
+	MigrateQueuedJobs(sourceQueue string, targetQueue string) (migrated []*api.Job, e error)
+	SuspendJobs(jobs []*api.Job) (suspended []*api.Job, e error)
+	ResumeJobs(jobs []*api.Job) (resumed []*api.Job, e error)
+	UngateJobs(jobs []*api.Job) (ungated []*api.Job, e error)
+	ReprioritizeJobs(jobs []*api.Job, priority float64) (reprioritized []*api.Job, e error)
 	GetActiveJobIds(queue string, jobSetId string) ([]string, error)
+	GetQueueActiveJobIds(queue string) ([]string, error)
+	GetJobSetActiveJobIds(jobSetId string) (idsByQueue map[string][]string, e error)
 	GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error)
+	GetQueuedJobIdsByLabels(queue string, labels map[string]string) ([]string, error)
+	GetActiveJobIdsByLabels(queue string, labels map[string]string) ([]string, error)
+	ReserveClientIds(jobs []*api.Job, ttl time.Duration) (duplicates map[string]string, e error)
+	ReleaseClientIds(jobs []*api.Job) error
+	ReserveLeaseDeniedReports(jobIds []string, interval time.Duration) (reservedJobIds []string, e error)
+	CountUnmatchableCycles(jobIds []string) (cycles map[string]int64, e error)
+	IncrementLeaseAttempts(jobs []*api.Job) error
+	UpdateJobs(jobs []*api.Job) error
+	ReconcileState(activeClusterIds map[string]bool, queues []string, dryRun bool) (*StateReconciliation, error)
 }
 
+// defaultJobRetention is how long deleted jobs are kept when no retention is configured
+const defaultJobRetention = 7 * 24 * time.Hour
+
 type RedisJobRepository struct {
-	db redis.UniversalClient
+	db           redis.UniversalClient
+	keyPrefix    string
+	compressJobs bool
+	jobRetention time.Duration
 }
 
-func NewRedisJobRepository(db redis.UniversalClient) *RedisJobRepository {
-	return &RedisJobRepository{db: db}
+func NewRedisJobRepository(db redis.UniversalClient, keyPrefix string, compressJobs bool, jobRetention time.Duration) *RedisJobRepository {
+	if jobRetention <= 0 {
+		jobRetention = defaultJobRetention
+	}
+	return &RedisJobRepository{db: db, keyPrefix: keyPrefix, compressJobs: compressJobs, jobRetention: jobRetention}
 }
 
-func (repo *RedisJobRepository) CreateJobs(request *api.JobSubmitRequest, principal authorization.Principal) ([]*api.Job, error) {
-	jobs := make([]*api.Job, 0, len(request.JobRequestItems))
-
+func (repo *RedisJobRepository) CreateJob(request *api.JobSubmitRequest, item *api.JobSubmitRequestItem, principal authorization.Principal) (*api.Job, error) {
 	if request.JobSetId == "" {
 		return nil, fmt.Errorf("job set is not specified")
 	}
@@ -60,39 +96,56 @@ func (repo *RedisJobRepository) CreateJobs(request *api.JobSubmitRequest, princi
 		return nil, fmt.Errorf("queue is not specified")
 	}
 
-	for i, item := range request.JobRequestItems {
-
-		e := validation.ValidatePodSpec(item.PodSpec)
-		if e != nil {
-			return nil, fmt.Errorf("error validating pod spec of job with index %v: %v", i, e)
-		}
-
-		namespace := item.Namespace
-		if namespace == "" {
-			namespace = "default"
-		}
-
-		j := &api.Job{
-			Id:       util.NewULID(),
-			Queue:    request.Queue,
-			JobSetId: request.JobSetId,
-
-			Namespace:   namespace,
-			Labels:      item.Labels,
-			Annotations: item.Annotations,
+	e := validation.ValidatePodSpec(item.PodSpec)
+	if e != nil {
+		return nil, fmt.Errorf("error validating pod spec: %v", e)
+	}
 
-			RequiredNodeLabels: item.RequiredNodeLabels,
+	namespace := item.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	e = validation.ValidateNamespace(namespace)
+	if e != nil {
+		return nil, fmt.Errorf("error validating namespace: %v", e)
+	}
 
-			Priority: item.Priority,
+	e = validation.ValidateAnnotations(item.Annotations)
+	if e != nil {
+		return nil, fmt.Errorf("error validating annotations: %v", e)
+	}
 
-			PodSpec: item.PodSpec,
-			Created: time.Now(),
-			Owner:   principal.GetName(),
-		}
-		jobs = append(jobs, j)
+	e = validation.ValidateServices(item.Services, item.PodSpec)
+	if e != nil {
+		return nil, fmt.Errorf("error validating services: %v", e)
 	}
 
-	return jobs, nil
+	j := &api.Job{
+		Id:       util.NewULID(),
+		Queue:    request.Queue,
+		JobSetId: request.JobSetId,
+
+		Namespace:   namespace,
+		Labels:      item.Labels,
+		Annotations: item.Annotations,
+
+		RequiredNodeLabels: item.RequiredNodeLabels,
+		ClientId:           item.ClientId,
+		CancelOnFailure:    request.CancelOnFailure,
+		PreferredCluster:   item.PreferredCluster,
+		PriorityClass:      item.PriorityClass,
+		MinResources:       item.MinResources,
+		Gated:              item.Gated,
+		NotBefore:          item.NotBefore,
+		Services:           item.Services,
+
+		Priority: item.Priority,
+
+		PodSpec: item.PodSpec,
+		Created: time.Now(),
+		Owner:   principal.GetName(),
+	}
+	return j, nil
 }
 
 type submitJobRedisResponse struct {
@@ -100,6 +153,8 @@ type submitJobRedisResponse struct {
 	queueJobResult    *redis.IntCmd
 	saveJobResult     *redis.StatusCmd
 	jobSetIndexResult *redis.IntCmd
+	gateJobResult     *redis.IntCmd
+	labelIndexResults []*redis.IntCmd
 }
 
 type SubmitJobResult struct {
@@ -110,29 +165,62 @@ type SubmitJobResult struct {
 func (repo *RedisJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, error) {
 	pipe := repo.db.Pipeline()
 
+	submitResults, e := repo.addJobs(pipe, jobs)
+	if e != nil {
+		return nil, e
+	}
+
+	_, _ = pipe.Exec() // ignoring error here as it will be part of individual commands
+
+	return submitJobResults(jobs, submitResults), nil
+}
+
+// AddJobsAtomically stores the jobs in one MULTI/EXEC transaction, so other clients never see only some of them
+// and nothing is stored when the transaction can't be queued (e.g. job can't be marshalled or Redis is unreachable).
+// Redis doesn't roll back the transaction when a command fails on execution (e.g. a key holds a value of wrong type),
+// such failure is returned as error and the jobs stored by the other commands remain.
+func (repo *RedisJobRepository) AddJobsAtomically(jobs []*api.Job) error {
+	pipe := repo.db.TxPipeline()
+	if _, e := repo.addJobs(pipe, jobs); e != nil {
+		_ = pipe.Close()
+		return e
+	}
+	_, e := pipe.Exec()
+	return e
+}
+
+func (repo *RedisJobRepository) addJobs(pipe redis.Pipeliner, jobs []*api.Job) ([]*submitJobRedisResponse, error) {
 	submitResults := make([]*submitJobRedisResponse, 0, len(jobs))
 
 	for _, job := range jobs {
 		submitResult := &submitJobRedisResponse{job: job}
 
-		jobData, e := proto.Marshal(job)
+		jobData, e := repo.marshalJob(job)
 		if e != nil {
 			return nil, e
 		}
 
 		submitResult.queueJobResult =
-			pipe.ZAdd(jobQueuePrefix+job.Queue, redis.Z{
+			pipe.ZAdd(repo.keyPrefix+jobQueuePrefix+job.Queue, redis.Z{
 				Member: job.Id,
 				Score:  job.Priority},
 			)
 
-		submitResult.saveJobResult = pipe.Set(jobObjectPrefix+job.Id, jobData, 0)
-		submitResult.jobSetIndexResult = pipe.SAdd(jobSetPrefix+job.JobSetId, job.Id)
+		submitResult.saveJobResult = pipe.Set(repo.keyPrefix+jobObjectPrefix+job.Id, jobData, 0)
+		if job.Gated {
+			submitResult.gateJobResult = pipe.SAdd(repo.keyPrefix+jobGatedPrefix+job.Queue, job.Id)
+		}
+		submitResult.jobSetIndexResult = pipe.SAdd(repo.keyPrefix+jobSetPrefix+job.JobSetId, job.Id)
+		for key, value := range job.Labels {
+			submitResult.labelIndexResults = append(submitResult.labelIndexResults,
+				pipe.SAdd(repo.jobLabelKey(job.Queue, key, value), job.Id))
+		}
 		submitResults = append(submitResults, submitResult)
 	}
+	return submitResults, nil
+}
 
-	_, _ = pipe.Exec() // ignoring error here as it will be part of individual commands
-
+func submitJobResults(jobs []*api.Job, submitResults []*submitJobRedisResponse) []*SubmitJobResult {
 	result := make([]*SubmitJobResult, 0, len(jobs))
 	for _, submitResult := range submitResults {
 		response := &SubmitJobResult{Job: submitResult.job}
@@ -148,18 +236,201 @@ func (repo *RedisJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, er
 			response.Error = e
 		}
 
+		if submitResult.gateJobResult != nil {
+			if _, e := submitResult.gateJobResult.Result(); e != nil {
+				response.Error = e
+			}
+		}
+
+		for _, labelIndexResult := range submitResult.labelIndexResults {
+			if _, e := labelIndexResult.Result(); e != nil {
+				response.Error = e
+			}
+		}
+
 		result = append(result, response)
 	}
 
-	return result, nil
+	return result
+}
+
+// ReserveClientIds stores ids of jobs with ClientId for the ttl, so the same job is not submitted twice.
+// Jobs which ClientId is already reserved in their queue and job set are returned as map from job id to the id of the original job.
+func (repo *RedisJobRepository) ReserveClientIds(jobs []*api.Job, ttl time.Duration) (duplicates map[string]string, e error) {
+	duplicates = map[string]string{}
+
+	pipe := repo.db.Pipeline()
+	reservations := map[*api.Job]*redis.BoolCmd{}
+	for _, job := range jobs {
+		if job.ClientId != "" {
+			reservations[job] = pipe.SetNX(repo.jobClientIdKey(job), job.Id, ttl)
+		}
+	}
+	if len(reservations) == 0 {
+		return duplicates, nil
+	}
+	if _, e := pipe.Exec(); e != nil {
+		return nil, e
+	}
+
+	for job, reservation := range reservations {
+		if reservation.Val() {
+			continue
+		}
+		originalId, e := repo.db.Get(repo.jobClientIdKey(job)).Result()
+		if e == redis.Nil {
+			// reservation expired in the meantime
+			continue
+		}
+		if e != nil {
+			return nil, e
+		}
+		duplicates[job.Id] = originalId
+	}
+	return duplicates, nil
 }
 
-func (repo *RedisJobRepository) RenewLease(clusterId string, jobIds []string) (renewedJobIds []string, e error) {
+// ReleaseClientIds removes reservations of ClientIds of jobs which were not stored, so the jobs can be submitted again.
+// Reservations held by other jobs are kept.
+func (repo *RedisJobRepository) ReleaseClientIds(jobs []*api.Job) error {
+	for _, job := range jobs {
+		if job.ClientId == "" {
+			continue
+		}
+		if e := releaseClientIdScript.Run(repo.db, []string{repo.jobClientIdKey(job)}, job.Id).Err(); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+var releaseClientIdScript = redis.NewScript(`
+local clientIdKey = KEYS[1]
+local jobId = ARGV[1]
+
+if redis.call('GET', clientIdKey) == jobId then
+	return redis.call('DEL', clientIdKey)
+end
+return 0
+`)
+
+func (repo *RedisJobRepository) jobClientIdKey(job *api.Job) string {
+	return repo.keyPrefix + jobClientIdPrefix + job.Queue + ":" + job.JobSetId + ":" + job.ClientId
+}
+
+// ReserveLeaseDeniedReports returns ids of jobs which lease denial was not reported within the interval,
+// and marks them as reported for the next interval.
+func (repo *RedisJobRepository) ReserveLeaseDeniedReports(jobIds []string, interval time.Duration) (reservedJobIds []string, e error) {
+	reservedJobIds = []string{}
+	if len(jobIds) == 0 {
+		return reservedJobIds, nil
+	}
+
+	pipe := repo.db.Pipeline()
+	reservations := make([]*redis.BoolCmd, 0, len(jobIds))
+	for _, id := range jobIds {
+		reservations = append(reservations, pipe.SetNX(repo.keyPrefix+jobLeaseDeniedPrefix+id, "", interval))
+	}
+	if _, e := pipe.Exec(); e != nil {
+		return nil, e
+	}
+
+	for i, reservation := range reservations {
+		if reservation.Val() {
+			reservedJobIds = append(reservedJobIds, jobIds[i])
+		}
+	}
+	return reservedJobIds, nil
+}
+
+// CountUnmatchableCycles counts another lease request which found no cluster matching each of the jobs
+// and returns the number of such requests so far. Counts expire with the retention of deleted jobs.
+func (repo *RedisJobRepository) CountUnmatchableCycles(jobIds []string) (cycles map[string]int64, e error) {
+	cycles = make(map[string]int64, len(jobIds))
+	if len(jobIds) == 0 {
+		return cycles, nil
+	}
+
+	pipe := repo.db.Pipeline()
+	counts := make([]*redis.IntCmd, 0, len(jobIds))
+	for _, id := range jobIds {
+		key := repo.keyPrefix + jobUnmatchablePrefix + id
+		counts = append(counts, pipe.Incr(key))
+		pipe.Expire(key, repo.jobRetention)
+	}
+	if _, e := pipe.Exec(); e != nil {
+		return nil, e
+	}
+
+	for i, count := range counts {
+		cycles[jobIds[i]] = count.Val()
+	}
+	return cycles, nil
+}
+
+// RenewLease renews leases of the jobs held by the cluster and returns status of each requested lease,
+// jobs whose lease could not be checked have no status.
+func (repo *RedisJobRepository) RenewLease(clusterId string, jobIds []string) (map[string]api.LeaseRenewalStatus, error) {
 	jobs, e := repo.GetExistingJobsByIds(jobIds)
 	if e != nil {
 		return nil, e
 	}
-	return repo.leaseJobs(clusterId, jobs)
+	statuses, e := repo.leaseJobs(clusterId, jobs)
+	if e != nil {
+		return nil, e
+	}
+
+	existing := make(map[string]bool, len(jobs))
+	for _, job := range jobs {
+		existing[job.Id] = true
+	}
+	for _, jobId := range jobIds {
+		if !existing[jobId] {
+			statuses[jobId] = api.LeaseRenewalStatus_UnknownJob
+		}
+	}
+	return statuses, nil
+}
+
+// GetLeasedJobs returns jobs currently leased by the cluster, these are jobs associated with the cluster which are
+// in the leased set of their queue, the same state lease renewal and lease expiry operate on.
+func (repo *RedisJobRepository) GetLeasedJobs(clusterId string) ([]*api.Job, error) {
+	associations, e := repo.db.HGetAll(repo.keyPrefix + jobClusterMapKey).Result()
+	if e != nil {
+		return nil, e
+	}
+	jobIds := []string{}
+	for jobId, associatedClusterId := range associations {
+		if associatedClusterId == clusterId {
+			jobIds = append(jobIds, jobId)
+		}
+	}
+	sort.Strings(jobIds)
+
+	jobs, e := repo.GetExistingJobsByIds(jobIds)
+	if e != nil {
+		return nil, e
+	}
+
+	pipe := repo.db.Pipeline()
+	cmds := make([]*redis.FloatCmd, len(jobs))
+	for i, job := range jobs {
+		cmds[i] = pipe.ZScore(repo.keyPrefix+jobLeasedPrefix+job.Queue, job.Id)
+	}
+	_, _ = pipe.Exec() // ignoring error here as it will be part of individual commands
+
+	leased := []*api.Job{}
+	for i, cmd := range cmds {
+		e := cmd.Err()
+		if e == redis.Nil {
+			continue
+		}
+		if e != nil {
+			return nil, e
+		}
+		leased = append(leased, jobs[i])
+	}
+	return leased, nil
 }
 
 func (repo *RedisJobRepository) ReturnLease(clusterId string, jobId string) (returnedJob *api.Job, err error) {
@@ -172,7 +443,7 @@ func (repo *RedisJobRepository) ReturnLease(clusterId string, jobId string) (ret
 	}
 	job := jobs[0]
 
-	returned, e := returnLease(repo.db, clusterId, job.Queue, job.Id, job.Created).Int()
+	returned, e := repo.returnLease(repo.db, clusterId, job.Queue, job.Id, job.Created).Int()
 	if e != nil {
 		return nil, e
 	}
@@ -182,6 +453,91 @@ func (repo *RedisJobRepository) ReturnLease(clusterId string, jobId string) (ret
 	return nil, nil
 }
 
+// RequeueJob stores the changed job and returns it to its queue, only when it is still leased by the cluster.
+func (repo *RedisJobRepository) RequeueJob(clusterId string, job *api.Job) (requeued bool, e error) {
+	jobData, e := repo.marshalJob(job)
+	if e != nil {
+		return false, e
+	}
+	result, e := requeueJobScript.Run(repo.db, []string{
+		repo.keyPrefix + jobQueuePrefix + job.Queue,
+		repo.keyPrefix + jobLeasedPrefix + job.Queue,
+		repo.keyPrefix + jobClusterMapKey,
+		repo.keyPrefix + jobObjectPrefix + job.Id},
+		clusterId, job.Id, float64(job.Created.UnixNano()), jobData).Int()
+	if e != nil {
+		return false, e
+	}
+	return result > 0, nil
+}
+
+// deleted jobs are kept with expiry for some time, these are not requeued
+var requeueJobScript = redis.NewScript(`
+local queue = KEYS[1]
+local leasedJobsSet = KEYS[2]
+local clusterAssociation = KEYS[3]
+local job = KEYS[4]
+
+local clusterId = ARGV[1]
+local jobId = ARGV[2]
+local created = tonumber(ARGV[3])
+local data = ARGV[4]
+
+local currentClusterId = redis.call('HGET', clusterAssociation, jobId)
+
+if currentClusterId == clusterId and redis.call('PTTL', job) == -1 then
+	redis.call('HDEL', clusterAssociation, jobId)
+	local exists = redis.call('ZREM', leasedJobsSet, jobId)
+	if exists ~= 0 then
+		redis.call('SET', job, data)
+		return redis.call('ZADD', queue, created, jobId)
+	end
+end
+return 0
+`)
+
+// IncrementLeaseAttempts increases LeaseAttempts of the jobs and stores them, jobs already deleted are not stored again.
+func (repo *RedisJobRepository) IncrementLeaseAttempts(jobs []*api.Job) error {
+	pipe := repo.db.Pipeline()
+	for _, job := range jobs {
+		job.LeaseAttempts++
+		jobData, e := repo.marshalJob(job)
+		if e != nil {
+			return e
+		}
+		pipe.SetXX(repo.keyPrefix+jobObjectPrefix+job.Id, jobData, 0)
+	}
+	_, e := pipe.Exec()
+	return e
+}
+
+// UpdateJobs stores changed jobs, jobs already deleted are not stored again.
+func (repo *RedisJobRepository) UpdateJobs(jobs []*api.Job) error {
+	pipe := repo.db.Pipeline()
+	updateJobScript.Load(pipe)
+	for _, job := range jobs {
+		jobData, e := repo.marshalJob(job)
+		if e != nil {
+			return e
+		}
+		updateJobScript.Run(pipe, []string{repo.keyPrefix + jobObjectPrefix + job.Id}, jobData)
+	}
+	_, e := pipe.Exec()
+	return e
+}
+
+// deleted jobs are kept with expiry for some time, these are not updated
+var updateJobScript = redis.NewScript(`
+local job = KEYS[1]
+local data = ARGV[1]
+
+if redis.call('PTTL', job) == -1 then
+	redis.call('SET', job, data)
+	return 1
+end
+return 0
+`)
+
 type deleteJobRedisResponse struct {
 	job                            *api.Job
 	expiryAlreadySet               bool
@@ -198,13 +554,18 @@ func (repo *RedisJobRepository) DeleteJobs(jobs []*api.Job) map[*api.Job]error {
 	deletionResults := make([]*deleteJobRedisResponse, 0, len(jobs))
 	for _, job := range jobs {
 		deletionResult := &deleteJobRedisResponse{job: job, expiryAlreadySet: expiryStatus[job]}
-		deletionResult.removeFromQueueResult = pipe.ZRem(jobQueuePrefix+job.Queue, job.Id)
-		deletionResult.removeFromLeasedResult = pipe.ZRem(jobLeasedPrefix+job.Queue, job.Id)
-		deletionResult.removeClusterAssociationResult = pipe.HDel(jobClusterMapKey, job.Id)
-		deletionResult.deleteJobSetIndexResult = pipe.SRem(jobSetPrefix+job.JobSetId, job.Id)
+		deletionResult.removeFromQueueResult = pipe.ZRem(repo.keyPrefix+jobQueuePrefix+job.Queue, job.Id)
+		deletionResult.removeFromLeasedResult = pipe.ZRem(repo.keyPrefix+jobLeasedPrefix+job.Queue, job.Id)
+		deletionResult.removeClusterAssociationResult = pipe.HDel(repo.keyPrefix+jobClusterMapKey, job.Id)
+		deletionResult.deleteJobSetIndexResult = pipe.SRem(repo.keyPrefix+jobSetPrefix+job.JobSetId, job.Id)
+		pipe.SRem(repo.keyPrefix+jobSuspendedPrefix+job.Queue, job.Id)
+		pipe.SRem(repo.keyPrefix+jobGatedPrefix+job.Queue, job.Id)
+		for key, value := range job.Labels {
+			pipe.SRem(repo.jobLabelKey(job.Queue, key, value), job.Id)
+		}
 
 		if !deletionResult.expiryAlreadySet {
-			deletionResult.setJobExpiryResult = pipe.Expire(jobObjectPrefix+job.Id, time.Hour*24*7)
+			deletionResult.setJobExpiryResult = pipe.Expire(repo.keyPrefix+jobObjectPrefix+job.Id, repo.jobRetention)
 		}
 		deletionResults = append(deletionResults, deletionResult)
 	}
@@ -226,13 +587,286 @@ func (repo *RedisJobRepository) DeleteJobs(jobs []*api.Job) map[*api.Job]error {
 	return cancelledJobs
 }
 
+// DeleteQueuedJobs deletes only the jobs which are still waiting in their queue, leased jobs are left untouched.
+// Jobs are removed from the queue first, so a job cannot be leased while it is being deleted.
+func (repo *RedisJobRepository) DeleteQueuedJobs(jobs []*api.Job) map[*api.Job]error {
+	pipe := repo.db.Pipeline()
+	removeFromQueueResults := make([]*redis.IntCmd, 0, len(jobs))
+	for _, job := range jobs {
+		removeFromQueueResults = append(removeFromQueueResults, pipe.ZRem(repo.keyPrefix+jobQueuePrefix+job.Queue, job.Id))
+	}
+	_, _ = pipe.Exec() // ignoring error here as it will be part of individual commands
+
+	cancelledJobs := map[*api.Job]error{}
+	dequeuedJobs := []*api.Job{}
+	for i, result := range removeFromQueueResults {
+		removed, e := result.Result()
+		if e != nil {
+			cancelledJobs[jobs[i]] = e
+		} else if removed > 0 {
+			dequeuedJobs = append(dequeuedJobs, jobs[i])
+		}
+	}
+
+	for job, e := range repo.DeleteJobs(dequeuedJobs) {
+		cancelledJobs[job] = e
+	}
+	return cancelledJobs
+}
+
+// MigrateQueuedJobs moves all jobs waiting in the source queue to the target queue keeping their ids and priorities,
+// leased jobs stay in the source queue. Returns the migrated jobs.
+func (repo *RedisJobRepository) MigrateQueuedJobs(sourceQueue string, targetQueue string) ([]*api.Job, error) {
+	ids, e := repo.db.ZRange(repo.keyPrefix+jobQueuePrefix+sourceQueue, 0, -1).Result()
+	if e != nil {
+		return nil, e
+	}
+	jobs, e := repo.GetExistingJobsByIds(ids)
+	if e != nil {
+		return nil, e
+	}
+
+	pipe := repo.db.Pipeline()
+	migrateJobScript.Load(pipe)
+
+	cmds := make([]*redis.Cmd, 0, len(jobs))
+	for _, job := range jobs {
+		job.Queue = targetQueue
+		jobData, e := repo.marshalJob(job)
+		if e != nil {
+			return nil, e
+		}
+		cmds = append(cmds, repo.migrateJob(pipe, sourceQueue, targetQueue, job, jobData))
+	}
+	_, e = pipe.Exec()
+	if e != nil {
+		return nil, e
+	}
+
+	migrated := []*api.Job{}
+	for i, cmd := range cmds {
+		moved, e := cmd.Int()
+		if e != nil {
+			return nil, e
+		}
+		// job was leased or deleted meanwhile
+		if moved == 0 {
+			continue
+		}
+		migrated = append(migrated, jobs[i])
+	}
+	return migrated, nil
+}
+
+// migrateJob moves the job with its label index entries in one script, so the job is never indexed under the other queue.
+func (repo *RedisJobRepository) migrateJob(db redis.Cmdable, sourceQueue string, targetQueue string, job *api.Job, jobData []byte) *redis.Cmd {
+	keys := []string{
+		repo.keyPrefix + jobQueuePrefix + sourceQueue,
+		repo.keyPrefix + jobQueuePrefix + targetQueue,
+		repo.keyPrefix + jobObjectPrefix + job.Id,
+		repo.keyPrefix + jobSuspendedPrefix + sourceQueue,
+		repo.keyPrefix + jobSuspendedPrefix + targetQueue,
+		repo.keyPrefix + jobGatedPrefix + sourceQueue,
+		repo.keyPrefix + jobGatedPrefix + targetQueue}
+	for key, value := range job.Labels {
+		keys = append(keys, repo.jobLabelKey(sourceQueue, key, value), repo.jobLabelKey(targetQueue, key, value))
+	}
+	return migrateJobScript.Run(db, keys, job.Id, jobData)
+}
+
+var migrateJobScript = redis.NewScript(`
+local sourceQueue = KEYS[1]
+local targetQueue = KEYS[2]
+local jobKey = KEYS[3]
+local sourceSuspended = KEYS[4]
+local targetSuspended = KEYS[5]
+local sourceGated = KEYS[6]
+local targetGated = KEYS[7]
+
+local jobId = ARGV[1]
+local jobData = ARGV[2]
+
+local priority = redis.call('ZSCORE', sourceQueue, jobId)
+if priority == false then
+	return 0
+end
+
+redis.call('ZREM', sourceQueue, jobId)
+redis.call('ZADD', targetQueue, priority, jobId)
+redis.call('SET', jobKey, jobData)
+if redis.call('SREM', sourceSuspended, jobId) == 1 then
+	redis.call('SADD', targetSuspended, jobId)
+end
+if redis.call('SREM', sourceGated, jobId) == 1 then
+	redis.call('SADD', targetGated, jobId)
+end
+-- remaining keys are pairs of source and target label index of each label of the job
+for i = 8, #KEYS, 2 do
+	redis.call('SREM', KEYS[i], jobId)
+	redis.call('SADD', KEYS[i + 1], jobId)
+end
+return 1
+`)
+
+// SuspendJobs holds queued jobs in their queue, so they are not leased until resumed. Leased jobs are not suspended.
+// Returns the suspended jobs.
+func (repo *RedisJobRepository) SuspendJobs(jobs []*api.Job) ([]*api.Job, error) {
+	pipe := repo.db.Pipeline()
+	suspendJobScript.Load(pipe)
+
+	cmds := make([]*redis.Cmd, 0, len(jobs))
+	for _, job := range jobs {
+		cmds = append(cmds, suspendJobScript.Run(pipe, []string{
+			repo.keyPrefix + jobQueuePrefix + job.Queue,
+			repo.keyPrefix + jobSuspendedPrefix + job.Queue},
+			job.Id))
+	}
+	_, e := pipe.Exec()
+	if e != nil {
+		return nil, e
+	}
+
+	suspended := []*api.Job{}
+	for i, cmd := range cmds {
+		added, e := cmd.Int()
+		if e != nil {
+			return nil, e
+		}
+		if added == 1 {
+			suspended = append(suspended, jobs[i])
+		}
+	}
+	return suspended, nil
+}
+
+var suspendJobScript = redis.NewScript(`
+local queue = KEYS[1]
+local suspendedJobs = KEYS[2]
+
+local jobId = ARGV[1]
+
+if redis.call('ZSCORE', queue, jobId) == false then
+	return 0
+end
+return redis.call('SADD', suspendedJobs, jobId)
+`)
+
+// ResumeJobs lets suspended jobs to be leased again, in the same position of their queue as before suspending.
+// Returns the resumed jobs.
+func (repo *RedisJobRepository) ResumeJobs(jobs []*api.Job) ([]*api.Job, error) {
+	pipe := repo.db.Pipeline()
+	cmds := make([]*redis.IntCmd, 0, len(jobs))
+	for _, job := range jobs {
+		cmds = append(cmds, pipe.SRem(repo.keyPrefix+jobSuspendedPrefix+job.Queue, job.Id))
+	}
+	_, e := pipe.Exec()
+	if e != nil {
+		return nil, e
+	}
+
+	resumed := []*api.Job{}
+	for i, cmd := range cmds {
+		if cmd.Val() == 1 {
+			resumed = append(resumed, jobs[i])
+		}
+	}
+	return resumed, nil
+}
+
+// UngateJobs releases jobs submitted gated, so they can be leased in their position of the queue.
+// Returns the ungated jobs.
+func (repo *RedisJobRepository) UngateJobs(jobs []*api.Job) ([]*api.Job, error) {
+	pipe := repo.db.Pipeline()
+	cmds := make([]*redis.IntCmd, 0, len(jobs))
+	for _, job := range jobs {
+		cmds = append(cmds, pipe.SRem(repo.keyPrefix+jobGatedPrefix+job.Queue, job.Id))
+	}
+	_, e := pipe.Exec()
+	if e != nil {
+		return nil, e
+	}
+
+	ungated := []*api.Job{}
+	for i, cmd := range cmds {
+		if cmd.Val() == 1 {
+			ungated = append(ungated, jobs[i])
+		}
+	}
+	return ungated, nil
+}
+
+// ReprioritizeJobs stores the new priority of queued and leased jobs and moves queued jobs to their new position
+// in the queue, leased jobs keep running. Returns the reprioritized jobs.
+func (repo *RedisJobRepository) ReprioritizeJobs(jobs []*api.Job, priority float64) ([]*api.Job, error) {
+	pipe := repo.db.Pipeline()
+	reprioritizeJobScript.Load(pipe)
+
+	cmds := make([]*redis.Cmd, 0, len(jobs))
+	for _, job := range jobs {
+		changed := *job
+		changed.Priority = priority
+		jobData, e := repo.marshalJob(&changed)
+		if e != nil {
+			return nil, e
+		}
+		cmds = append(cmds, reprioritizeJobScript.Run(pipe, []string{
+			repo.keyPrefix + jobQueuePrefix + job.Queue,
+			repo.keyPrefix + jobLeasedPrefix + job.Queue,
+			repo.keyPrefix + jobObjectPrefix + job.Id},
+			job.Id, priority, jobData))
+	}
+	_, e := pipe.Exec()
+	if e != nil {
+		return nil, e
+	}
+
+	reprioritized := []*api.Job{}
+	for i, cmd := range cmds {
+		changed, e := cmd.Int()
+		if e != nil {
+			return nil, e
+		}
+		if changed == 1 {
+			jobs[i].Priority = priority
+			reprioritized = append(reprioritized, jobs[i])
+		}
+	}
+	return reprioritized, nil
+}
+
+// deleted jobs are kept with expiry for some time, these are not reprioritized
+var reprioritizeJobScript = redis.NewScript(`
+local queue = KEYS[1]
+local leasedJobsSet = KEYS[2]
+local job = KEYS[3]
+
+local jobId = ARGV[1]
+local priority = tonumber(ARGV[2])
+local data = ARGV[3]
+
+if redis.call('PTTL', job) ~= -1 then
+	return 0
+end
+
+local queued = redis.call('ZSCORE', queue, jobId) ~= false
+if not queued and redis.call('ZSCORE', leasedJobsSet, jobId) == false then
+	return 0
+end
+
+redis.call('SET', job, data)
+if queued then
+	redis.call('ZADD', queue, priority, jobId)
+end
+return 1
+`)
+
 // Returns details on if the expiry for each job is already set or not
 func (repo *RedisJobRepository) getExpiryStatus(jobs []*api.Job) map[*api.Job]bool {
 	pipe := repo.db.Pipeline()
 
 	var cmds []*redis.DurationCmd
 	for _, job := range jobs {
-		cmds = append(cmds, pipe.TTL(jobObjectPrefix+job.Id))
+		cmds = append(cmds, pipe.TTL(repo.keyPrefix+jobObjectPrefix+job.Id))
 	}
 	_, _ = pipe.Exec() // ignoring error here as it will be part of individual commands
 
@@ -291,14 +925,31 @@ func processDeletionResponse(deletionResponse *deleteJobRedisResponse) (int64, e
 	return totalUpdates, errorMessage
 }
 
+// PeekQueue returns the first jobs waiting in the queue, suspended and gated jobs are skipped.
 func (repo *RedisJobRepository) PeekQueue(queue string, limit int64) ([]*api.Job, error) {
-	ids, e := repo.db.ZRange(jobQueuePrefix+queue, 0, limit-1).Result()
+	suspended, e := repo.db.SUnion(repo.keyPrefix+jobSuspendedPrefix+queue, repo.keyPrefix+jobGatedPrefix+queue).Result()
 	if e != nil {
 		return nil, e
 	}
+	// reading as many more jobs as there are suspended or gated ones is enough to fill the limit
+	ids, e := repo.db.ZRange(repo.keyPrefix+jobQueuePrefix+queue, 0, limit-1+int64(len(suspended))).Result()
+	if e != nil {
+		return nil, e
+	}
+	if len(suspended) > 0 {
+		ids = util.SubtractStringList(ids, suspended)
+		if int64(len(ids)) > limit {
+			ids = ids[:limit]
+		}
+	}
 	return repo.GetExistingJobsByIds(ids)
 }
 
+// GetLeasedJobCount returns the number of jobs of the queue currently leased to any cluster.
+func (repo *RedisJobRepository) GetLeasedJobCount(queue string) (int64, error) {
+	return repo.db.ZCard(repo.keyPrefix + jobLeasedPrefix + queue).Result()
+}
+
 // returns list of jobs which are successfully leased
 func (repo *RedisJobRepository) TryLeaseJobs(clusterId string, queue string, jobs []*api.Job) ([]*api.Job, error) {
 	jobById := map[string]*api.Job{}
@@ -306,18 +957,41 @@ func (repo *RedisJobRepository) TryLeaseJobs(clusterId string, queue string, job
 		jobById[job.Id] = job
 	}
 
-	leasedIds, e := repo.leaseJobs(clusterId, jobs)
+	statuses, e := repo.leaseJobs(clusterId, jobs)
 	if e != nil {
 		return nil, e
 	}
 
 	leasedJobs := make([]*api.Job, 0)
-	for _, id := range leasedIds {
-		leasedJobs = append(leasedJobs, jobById[id])
+	for id, status := range statuses {
+		if status == api.LeaseRenewalStatus_Renewed {
+			leasedJobs = append(leasedJobs, jobById[id])
+		}
 	}
 	return leasedJobs, nil
 }
 
+// FilterNotQueuedJobs returns the jobs which are not waiting in their queue.
+func (repo *RedisJobRepository) FilterNotQueuedJobs(jobs []*api.Job) ([]*api.Job, error) {
+	pipe := repo.db.Pipeline()
+	cmds := make([]*redis.FloatCmd, 0, len(jobs))
+	for _, job := range jobs {
+		cmds = append(cmds, pipe.ZScore(repo.keyPrefix+jobQueuePrefix+job.Queue, job.Id))
+	}
+	_, e := pipe.Exec()
+	if e != nil && e != redis.Nil {
+		return nil, e
+	}
+
+	result := make([]*api.Job, 0, len(jobs))
+	for i, cmd := range cmds {
+		if cmd.Err() == redis.Nil {
+			result = append(result, jobs[i])
+		}
+	}
+	return result, nil
+}
+
 // Returns existing jobs by Id
 // If an Id is supplied that no longer exists, that job will simply be omitted from the result.
 // No error will be thrown for missing jobs
@@ -325,7 +999,7 @@ func (repo *RedisJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job,
 	pipe := repo.db.Pipeline()
 	var cmds []*redis.StringCmd
 	for _, id := range ids {
-		cmds = append(cmds, pipe.Get(jobObjectPrefix+id))
+		cmds = append(cmds, pipe.Get(repo.keyPrefix+jobObjectPrefix+id))
 	}
 	_, _ = pipe.Exec() // ignoring error here as it will be part of individual commands
 
@@ -335,13 +1009,13 @@ func (repo *RedisJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job,
 		if e != nil {
 			if e == redis.Nil {
 				log.Warnf("No job found with with job id %s", ids[index])
+				continue
 			} else {
 				return nil, e
 			}
 		}
 		d, _ := cmd.Bytes()
-		job := &api.Job{}
-		e = proto.Unmarshal(d, job)
+		job, e := unmarshalJob(d)
 		if e != nil {
 			return nil, e
 		}
@@ -350,12 +1024,33 @@ func (repo *RedisJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job,
 	return jobs, nil
 }
 
+func (repo *RedisJobRepository) marshalJob(job *api.Job) ([]byte, error) {
+	data, e := proto.Marshal(job)
+	if e != nil || !repo.compressJobs {
+		return data, e
+	}
+	return compressJob(data)
+}
+
+func unmarshalJob(data []byte) (*api.Job, error) {
+	data, e := decompressJob(data)
+	if e != nil {
+		return nil, e
+	}
+	job := &api.Job{}
+	e = proto.Unmarshal(data, job)
+	if e != nil {
+		return nil, e
+	}
+	return job, nil
+}
+
 func (repo *RedisJobRepository) FilterActiveQueues(queues []*api.Queue) ([]*api.Queue, error) {
 	pipe := repo.db.Pipeline()
 	cmds := make(map[*api.Queue]*redis.IntCmd)
 	for _, queue := range queues {
 		// empty (even sorted) sets gets deleted by redis automatically
-		cmds[queue] = pipe.Exists(jobQueuePrefix + queue.Name)
+		cmds[queue] = pipe.Exists(repo.keyPrefix + jobQueuePrefix + queue.Name)
 	}
 	_, e := pipe.Exec()
 	if e != nil {
@@ -375,7 +1070,7 @@ func (repo *RedisJobRepository) GetQueueSizes(queues []*api.Queue) (sizes []int6
 	pipe := repo.db.Pipeline()
 	cmds := []*redis.IntCmd{}
 	for _, queue := range queues {
-		cmds = append(cmds, pipe.ZCount(jobQueuePrefix+queue.Name, "-Inf", "+Inf"))
+		cmds = append(cmds, pipe.ZCard(repo.keyPrefix+jobQueuePrefix+queue.Name))
 	}
 	_, e := pipe.Exec()
 	if e != nil {
@@ -391,15 +1086,15 @@ func (repo *RedisJobRepository) GetQueueSizes(queues []*api.Queue) (sizes []int6
 
 func (repo *RedisJobRepository) GetActiveJobIds(queue string, jobSetId string) ([]string, error) {
 
-	queuedIds, e := repo.db.ZRange(jobQueuePrefix+queue, 0, -1).Result()
+	queuedIds, e := repo.db.ZRange(repo.keyPrefix+jobQueuePrefix+queue, 0, -1).Result()
 	if e != nil {
 		return nil, e
 	}
-	leasedIds, e := repo.db.ZRange(jobLeasedPrefix+queue, 0, -1).Result()
+	leasedIds, e := repo.db.ZRange(repo.keyPrefix+jobLeasedPrefix+queue, 0, -1).Result()
 	if e != nil {
 		return nil, e
 	}
-	jobSetIds, e := repo.db.SMembers(jobSetPrefix + jobSetId).Result()
+	jobSetIds, e := repo.db.SMembers(repo.keyPrefix + jobSetPrefix + jobSetId).Result()
 	if e != nil {
 		return nil, e
 	}
@@ -414,13 +1109,123 @@ func (repo *RedisJobRepository) GetActiveJobIds(queue string, jobSetId string) (
 	return activeSetIds, nil
 }
 
+// GetQueueActiveJobIds returns ids of all queued and leased jobs of the queue.
+func (repo *RedisJobRepository) GetQueueActiveJobIds(queue string) ([]string, error) {
+	queuedIds, e := repo.db.ZRange(repo.keyPrefix+jobQueuePrefix+queue, 0, -1).Result()
+	if e != nil {
+		return nil, e
+	}
+	leasedIds, e := repo.db.ZRange(repo.keyPrefix+jobLeasedPrefix+queue, 0, -1).Result()
+	if e != nil {
+		return nil, e
+	}
+	return append(queuedIds, leasedIds...), nil
+}
+
+// GetJobSetActiveJobIds returns ids of queued and leased jobs of the job set in any queue, grouped by queue.
+func (repo *RedisJobRepository) GetJobSetActiveJobIds(jobSetId string) (map[string][]string, error) {
+	ids, e := repo.db.SMembers(repo.keyPrefix + jobSetPrefix + jobSetId).Result()
+	if e != nil {
+		return nil, e
+	}
+	jobs, e := repo.GetExistingJobsByIds(ids)
+	if e != nil {
+		return nil, e
+	}
+
+	pipe := repo.db.Pipeline()
+	queuedScores := make([]*redis.FloatCmd, 0, len(jobs))
+	leasedScores := make([]*redis.FloatCmd, 0, len(jobs))
+	for _, job := range jobs {
+		queuedScores = append(queuedScores, pipe.ZScore(repo.keyPrefix+jobQueuePrefix+job.Queue, job.Id))
+		leasedScores = append(leasedScores, pipe.ZScore(repo.keyPrefix+jobLeasedPrefix+job.Queue, job.Id))
+	}
+	_, _ = pipe.Exec() // ignoring error here as it will be part of individual commands
+
+	idsByQueue := map[string][]string{}
+	for i, job := range jobs {
+		for _, score := range []*redis.FloatCmd{queuedScores[i], leasedScores[i]} {
+			e := score.Err()
+			if e == redis.Nil {
+				continue
+			}
+			if e != nil {
+				return nil, e
+			}
+			idsByQueue[job.Queue] = append(idsByQueue[job.Queue], job.Id)
+			break
+		}
+	}
+	return idsByQueue, nil
+}
+
+// GetQueuedJobIdsByLabels returns ids of jobs waiting in the queue which have all the specified labels.
+func (repo *RedisJobRepository) GetQueuedJobIdsByLabels(queue string, labels map[string]string) ([]string, error) {
+	return repo.getJobIdsByLabels(queue, labels, jobQueuePrefix)
+}
+
+// GetActiveJobIdsByLabels returns ids of queued and leased jobs of the queue which have all the specified labels.
+func (repo *RedisJobRepository) GetActiveJobIdsByLabels(queue string, labels map[string]string) ([]string, error) {
+	return repo.getJobIdsByLabels(queue, labels, jobQueuePrefix, jobLeasedPrefix)
+}
+
+// getJobIdsByLabels returns sorted ids of jobs with all the specified labels which are in any of the queue's sorted sets
+// with the specified prefixes.
+func (repo *RedisJobRepository) getJobIdsByLabels(queue string, labels map[string]string, setPrefixes ...string) ([]string, error) {
+	if len(labels) == 0 {
+		return nil, fmt.Errorf("at least one label has to be specified")
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key, value := range labels {
+		keys = append(keys, repo.jobLabelKey(queue, key, value))
+	}
+	labeledIds, e := repo.db.SInter(keys...).Result()
+	if e != nil {
+		return nil, e
+	}
+
+	pipe := repo.db.Pipeline()
+	scores := make([][]*redis.FloatCmd, 0, len(labeledIds))
+	for _, id := range labeledIds {
+		idScores := make([]*redis.FloatCmd, 0, len(setPrefixes))
+		for _, prefix := range setPrefixes {
+			idScores = append(idScores, pipe.ZScore(repo.keyPrefix+prefix+queue, id))
+		}
+		scores = append(scores, idScores)
+	}
+	_, _ = pipe.Exec() // ignoring error here as it will be part of individual commands
+
+	ids := []string{}
+	for i, idScores := range scores {
+		for _, score := range idScores {
+			e := score.Err()
+			if e == redis.Nil {
+				continue
+			}
+			if e != nil {
+				return nil, e
+			}
+			ids = append(ids, labeledIds[i])
+			break
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// jobLabelKey prefixes queue and label key with their lengths, so separators in labels can't make keys of different labels equal.
+func (repo *RedisJobRepository) jobLabelKey(queue string, key string, value string) string {
+	return fmt.Sprintf("%s%s%d:%s:%d:%s=%s", repo.keyPrefix, jobLabelPrefix, len(queue), queue, len(key), key, value)
+}
+
 func (repo *RedisJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error) {
 
-	queuedIds, e := repo.db.ZRange(jobQueuePrefix+queue, 0, -1).Result()
+	queuedIds, e := repo.db.ZRange(repo.keyPrefix+jobQueuePrefix+queue, 0, -1).Result()
 	if e != nil {
 		return nil, e
 	}
-	leasedIds, e := repo.db.ZRange(jobLeasedPrefix+queue, 0, -1).Result()
+	leasedIds, e := repo.db.ZRange(repo.keyPrefix+jobLeasedPrefix+queue, 0, -1).Result()
 	if e != nil {
 		return nil, e
 	}
@@ -464,7 +1269,7 @@ func (repo *RedisJobRepository) ExpireLeases(queue string, deadline time.Time) (
 	maxScore := strconv.FormatInt(deadline.UnixNano(), 10)
 
 	// TODO: expire just limited number here ???
-	ids, e := repo.db.ZRangeByScore(jobLeasedPrefix+queue, redis.ZRangeBy{Max: maxScore, Min: "-Inf"}).Result()
+	ids, e := repo.db.ZRangeByScore(repo.keyPrefix+jobLeasedPrefix+queue, redis.ZRangeBy{Max: maxScore, Min: "-Inf"}).Result()
 	if e != nil {
 		return nil, e
 	}
@@ -483,7 +1288,7 @@ func (repo *RedisJobRepository) ExpireLeases(queue string, deadline time.Time) (
 	pipe := repo.db.Pipeline()
 	expireScript.Load(pipe)
 	for _, job := range expiringJobs {
-		cmds[job] = expire(pipe, job.Queue, job.Id, job.Created, deadline)
+		cmds[job] = repo.expire(pipe, job.Queue, job.Id, job.Created, deadline)
 	}
 	_, e = pipe.Exec()
 
@@ -502,64 +1307,107 @@ func (repo *RedisJobRepository) ExpireLeases(queue string, deadline time.Time) (
 	return expired, nil
 }
 
-func (repo *RedisJobRepository) leaseJobs(clusterId string, jobs []*api.Job) ([]string, error) {
+func (repo *RedisJobRepository) leaseJobs(clusterId string, jobs []*api.Job) (map[string]api.LeaseRenewalStatus, error) {
 
 	now := time.Now()
-	pipe := repo.db.Pipeline()
+	// jobs are leased in one transaction, so the lease is either committed for all the jobs or for none of them
+	pipe := repo.db.TxPipeline()
 
 	leaseJobScript.Load(pipe)
 
 	cmds := make(map[string]*redis.Cmd)
 	for _, job := range jobs {
-		cmds[job.Id] = leaseJob(pipe, job.Queue, clusterId, job.Id, now)
+		// jobs leased with less resources than they requested are stored with their granted pod spec by the lease
+		grantedJobData := []byte{}
+		if len(job.GrantedResources) > 0 {
+			data, e := repo.marshalJob(job)
+			if e != nil {
+				return nil, e
+			}
+			grantedJobData = data
+		}
+		cmds[job.Id] = repo.leaseJob(pipe, job.Queue, clusterId, job.Id, grantedJobData, now)
 	}
 	_, e := pipe.Exec()
 	if e != nil {
 		return nil, e
 	}
 
-	leasedJobs := make([]string, 0)
+	statuses := make(map[string]api.LeaseRenewalStatus, len(cmds))
 	for jobId, cmd := range cmds {
 		value, e := cmd.Int()
 		if e != nil {
 			log.Error(e)
 		} else if value == alreadyAllocatedByDifferentCluster {
 			log.WithField("jobId", jobId).Info("Job Already allocated to different cluster")
+			statuses[jobId] = api.LeaseRenewalStatus_Expired
 		} else if value == jobCancelled {
 			log.WithField("jobId", jobId).Info("Trying to renew cancelled job")
+			statuses[jobId] = api.LeaseRenewalStatus_Cancelled
+		} else if value == jobSuspended {
+			log.WithField("jobId", jobId).Info("Job was suspended before it could be leased")
+		} else if value == jobGated {
+			log.WithField("jobId", jobId).Info("Job is gated, it can not be leased until ungated")
 		} else {
-			leasedJobs = append(leasedJobs, jobId)
+			statuses[jobId] = api.LeaseRenewalStatus_Renewed
 		}
 	}
-	return leasedJobs, nil
+	return statuses, nil
 }
 
-func leaseJob(db redis.Cmdable, queueName string, clusterId string, jobId string, now time.Time) *redis.Cmd {
-	return leaseJobScript.Run(db, []string{jobQueuePrefix + queueName, jobLeasedPrefix + queueName, jobClusterMapKey},
-		clusterId, jobId, float64(now.UnixNano()))
+func (repo *RedisJobRepository) leaseJob(db redis.Cmdable, queueName string, clusterId string, jobId string, grantedJobData []byte, now time.Time) *redis.Cmd {
+	return leaseJobScript.Run(db, []string{
+		repo.keyPrefix + jobQueuePrefix + queueName,
+		repo.keyPrefix + jobLeasedPrefix + queueName,
+		repo.keyPrefix + jobClusterMapKey,
+		repo.keyPrefix + jobSuspendedPrefix + queueName,
+		repo.keyPrefix + jobGatedPrefix + queueName,
+		repo.keyPrefix + jobObjectPrefix + jobId},
+		clusterId, jobId, float64(now.UnixNano()), grantedJobData)
 }
 
 const alreadyAllocatedByDifferentCluster = -42
 const jobCancelled = -43
+const jobSuspended = -44
+const jobGated = -45
 
 var leaseJobScript = redis.NewScript(`
 local queue = KEYS[1]
 local leasedJobsSet = KEYS[2]
 local clusterAssociation = KEYS[3]
+local suspendedJobs = KEYS[4]
+local gatedJobs = KEYS[5]
+local job = KEYS[6]
 
 local clusterId = ARGV[1]
 local jobId = ARGV[2]
 local currentTime = ARGV[3]
+local grantedJobData = ARGV[4]
+
+if redis.call('SISMEMBER', suspendedJobs, jobId) == 1 then
+	return -44
+end
+
+if redis.call('SISMEMBER', gatedJobs, jobId) == 1 then
+	return -45
+end
 
 local exists = redis.call('ZREM', queue, jobId)
 
 if exists == 1 then 
+	if grantedJobData ~= '' then
+		redis.call('SET', job, grantedJobData)
+	end
 	redis.call('HSET', clusterAssociation, jobId, clusterId)
 	return redis.call('ZADD', leasedJobsSet, currentTime, jobId)
 else
 	local currentClusterId = redis.call('HGET', clusterAssociation, jobId)
 	local score = redis.call('ZSCORE', leasedJobsSet, jobId)
 	
+	if currentClusterId == false then
+		return -43
+	end
+
 	if currentClusterId ~= clusterId then
 		return -42
 	end
@@ -572,8 +1420,11 @@ else
 end
 `)
 
-func expire(db redis.Cmdable, queueName string, jobId string, created time.Time, deadline time.Time) *redis.Cmd {
-	return expireScript.Run(db, []string{jobQueuePrefix + queueName, jobLeasedPrefix + queueName, jobClusterMapKey},
+func (repo *RedisJobRepository) expire(db redis.Cmdable, queueName string, jobId string, created time.Time, deadline time.Time) *redis.Cmd {
+	return expireScript.Run(db, []string{
+		repo.keyPrefix + jobQueuePrefix + queueName,
+		repo.keyPrefix + jobLeasedPrefix + queueName,
+		repo.keyPrefix + jobClusterMapKey},
 		jobId, float64(created.UnixNano()), float64(deadline.UnixNano()))
 }
 
@@ -599,8 +1450,11 @@ if leasedTime ~= nil and leasedTime < deadline then
 end
 `)
 
-func returnLease(db redis.Cmdable, clusterId string, queueName string, jobId string, created time.Time) *redis.Cmd {
-	return returnLeaseScript.Run(db, []string{jobQueuePrefix + queueName, jobLeasedPrefix + queueName, jobClusterMapKey},
+func (repo *RedisJobRepository) returnLease(db redis.Cmdable, clusterId string, queueName string, jobId string, created time.Time) *redis.Cmd {
+	return returnLeaseScript.Run(db, []string{
+		repo.keyPrefix + jobQueuePrefix + queueName,
+		repo.keyPrefix + jobLeasedPrefix + queueName,
+		repo.keyPrefix + jobClusterMapKey},
 		clusterId, jobId, float64(created.UnixNano()))
 }
 
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const jobTemplatePrefix = "JobTemplate:"
+
+type JobTemplateRepository interface {
+	GetJobTemplate(queue string, name string) (*api.JobTemplate, error)
+	CreateJobTemplate(template *api.JobTemplate) error
+}
+
+type RedisJobTemplateRepository struct {
+	db        redis.UniversalClient
+	keyPrefix string
+}
+
+func NewRedisJobTemplateRepository(db redis.UniversalClient, keyPrefix string) *RedisJobTemplateRepository {
+	return &RedisJobTemplateRepository{db: db, keyPrefix: keyPrefix}
+}
+
+// GetJobTemplate returns nil when the queue has no template of the name.
+func (r *RedisJobTemplateRepository) GetJobTemplate(queue string, name string) (*api.JobTemplate, error) {
+	result, err := r.db.HGet(r.keyPrefix+jobTemplatePrefix+queue, name).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	template := &api.JobTemplate{}
+	e := proto.Unmarshal([]byte(result), template)
+	if e != nil {
+		return nil, e
+	}
+	return template, nil
+}
+
+func (r *RedisJobTemplateRepository) CreateJobTemplate(template *api.JobTemplate) error {
+	data, e := proto.Marshal(template)
+	if e != nil {
+		return e
+	}
+	result := r.db.HSet(r.keyPrefix+jobTemplatePrefix+template.Queue, template.Name, data)
+	return result.Err()
+}
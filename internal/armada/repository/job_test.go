@@ -1,10 +1,12 @@
 package repository
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
 	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -24,14 +26,53 @@ func TestJobCanBeLeasedOnlyOnce(t *testing.T) {
 	})
 }
 
+func TestTryLeaseJobs_StoresGrantedPodSpecOfLeasedJob(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addTestJob(t, r, "queue1")
+		granted := withGrantedCpu(job, resource.MustParse("500m"))
+
+		leased, e := r.TryLeaseJobs("cluster1", "queue1", []*api.Job{granted})
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(leased))
+
+		stored, e := r.GetExistingJobsByIds([]string{job.Id})
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(stored))
+		assert.Equal(t, int64(500), stored[0].PodSpec.Containers[0].Resources.Requests.Cpu().MilliValue())
+		grantedCpu := stored[0].GrantedResources["cpu"]
+		assert.Equal(t, int64(500), grantedCpu.MilliValue())
+
+		leasedJobs, e := r.GetLeasedJobs("cluster1")
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(leasedJobs))
+		assert.Equal(t, int64(500), leasedJobs[0].PodSpec.Containers[0].Resources.Requests.Cpu().MilliValue())
+	})
+}
+
+func TestTryLeaseJobs_DoesNotStoreGrantedPodSpecOfJobNotLeased(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addLeasedJob(t, r, "queue1", "cluster1")
+		granted := withGrantedCpu(job, resource.MustParse("500m"))
+
+		leased, e := r.TryLeaseJobs("cluster2", "queue1", []*api.Job{granted})
+		assert.Nil(t, e)
+		assert.Equal(t, 0, len(leased))
+
+		stored, e := r.GetExistingJobsByIds([]string{job.Id})
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(stored))
+		assert.Equal(t, int64(1000), stored[0].PodSpec.Containers[0].Resources.Requests.Cpu().MilliValue())
+		assert.Empty(t, stored[0].GrantedResources)
+	})
+}
+
 func TestJobLeaseCanBeRenewed(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		job := addLeasedJob(t, r, "queue1", "cluster1")
 
 		renewed, e := r.RenewLease("cluster1", []string{job.Id})
 		assert.Nil(t, e)
-		assert.Equal(t, 1, len(renewed))
-		assert.Equal(t, job.Id, renewed[0])
+		assert.Equal(t, map[string]api.LeaseRenewalStatus{job.Id: api.LeaseRenewalStatus_Renewed}, renewed)
 	})
 }
 
@@ -51,6 +92,25 @@ func TestJobLeaseExpiry(t *testing.T) {
 	})
 }
 
+func TestGetLeasedJobs_OmitsJobsOfOtherClustersAndExpiredLeases(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		expired := addLeasedJob(t, r, "queue1", "cluster1")
+		deadline := time.Now()
+		leased := addLeasedJob(t, r, "queue1", "cluster1")
+		addLeasedJob(t, r, "queue1", "cluster2")
+		addTestJob(t, r, "queue1")
+
+		_, e := r.ExpireLeases("queue1", deadline)
+		assert.Nil(t, e)
+
+		jobs, e := r.GetLeasedJobs("cluster1")
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(jobs))
+		assert.Equal(t, leased.Id, jobs[0].Id)
+		assert.NotEqual(t, expired.Id, jobs[0].Id)
+	})
+}
+
 func TestEvenExpiredLeaseCanBeRenewed(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		job := addLeasedJob(t, r, "queue1", "cluster1")
@@ -61,8 +121,7 @@ func TestEvenExpiredLeaseCanBeRenewed(t *testing.T) {
 
 		renewed, e := r.RenewLease("cluster1", []string{job.Id})
 		assert.Nil(t, e)
-		assert.Equal(t, 1, len(renewed))
-		assert.Equal(t, job.Id, renewed[0])
+		assert.Equal(t, map[string]api.LeaseRenewalStatus{job.Id: api.LeaseRenewalStatus_Renewed}, renewed)
 	})
 }
 
@@ -72,7 +131,7 @@ func TestRenewingLeaseFailsForJobAssignedToDifferentCluster(t *testing.T) {
 
 		renewed, e := r.RenewLease("cluster2", []string{job.Id})
 		assert.Nil(t, e)
-		assert.Equal(t, 0, len(renewed))
+		assert.Equal(t, map[string]api.LeaseRenewalStatus{job.Id: api.LeaseRenewalStatus_Expired}, renewed)
 	})
 }
 
@@ -80,7 +139,7 @@ func TestRenewingNonExistentLease(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		renewed, e := r.RenewLease("cluster2", []string{"missingJobId"})
 		assert.Nil(t, e)
-		assert.Equal(t, 0, len(renewed))
+		assert.Equal(t, map[string]api.LeaseRenewalStatus{"missingJobId": api.LeaseRenewalStatus_UnknownJob}, renewed)
 	})
 }
 
@@ -167,6 +226,205 @@ func TestDeleteQueuedJob(t *testing.T) {
 	})
 }
 
+func TestDeletedJobIsRemovedAfterRetention(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		r.jobRetention = time.Second
+		job := addLeasedJob(t, r, "queue1", "cluster1")
+
+		result := r.DeleteJobs([]*api.Job{job})
+		assert.Nil(t, result[job])
+
+		jobs, e := r.GetExistingJobsByIds([]string{job.Id})
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(jobs), "deleted job is kept during the retention")
+
+		time.Sleep(r.jobRetention + time.Millisecond*100)
+
+		jobs, e = r.GetExistingJobsByIds([]string{job.Id})
+		assert.Nil(t, e)
+		assert.Equal(t, 0, len(jobs))
+	})
+}
+
+func TestDeleteQueuedJobs_LeavesLeasedJobsUntouched(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		queuedJob := addTestJob(t, r, "queue1")
+		leasedJob := addLeasedJob(t, r, "queue1", "cluster1")
+
+		result := r.DeleteQueuedJobs([]*api.Job{queuedJob, leasedJob})
+		err, deletionOccurred := result[queuedJob]
+		assert.Nil(t, err)
+		assert.True(t, deletionOccurred)
+
+		_, deletionOccurred = result[leasedJob]
+		assert.False(t, deletionOccurred)
+
+		renewed, e := r.RenewLease("cluster1", []string{leasedJob.Id})
+		assert.Nil(t, e)
+		assert.Equal(t, map[string]api.LeaseRenewalStatus{leasedJob.Id: api.LeaseRenewalStatus_Renewed}, renewed)
+	})
+}
+
+func TestMigrateQueuedJobs_LeavesLeasedJobsInSourceQueue(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		queuedJob := addLabeledTestJob(t, r, "queue1", map[string]string{"team": "a"})
+		leasedJob := addLeasedJob(t, r, "queue1", "cluster1")
+
+		migrated, e := r.MigrateQueuedJobs("queue1", "queue2")
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(migrated))
+		assert.Equal(t, queuedJob.Id, migrated[0].Id)
+
+		stored, e := r.GetExistingJobsByIds([]string{queuedJob.Id, leasedJob.Id})
+		assert.Nil(t, e)
+		assert.Equal(t, "queue2", stored[0].Queue)
+		assert.Equal(t, "queue1", stored[1].Queue)
+
+		queued, e := r.PeekQueue("queue2", 100)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(queued))
+		assert.Equal(t, queuedJob.Id, queued[0].Id)
+
+		ids, e := r.GetQueuedJobIdsByLabels("queue2", map[string]string{"team": "a"})
+		assert.Nil(t, e)
+		assert.Equal(t, []string{queuedJob.Id}, ids)
+
+		renewed, e := r.RenewLease("cluster1", []string{leasedJob.Id})
+		assert.Nil(t, e)
+		assert.Equal(t, map[string]api.LeaseRenewalStatus{leasedJob.Id: api.LeaseRenewalStatus_Renewed}, renewed)
+	})
+}
+
+func TestSuspendJobs_SuspendedJobIsNotLeased(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		suspendedJob := addTestJob(t, r, "queue1")
+		queuedJob := addTestJob(t, r, "queue1")
+
+		suspended, e := r.SuspendJobs([]*api.Job{suspendedJob})
+		assert.Nil(t, e)
+		assert.Equal(t, []*api.Job{suspendedJob}, suspended)
+
+		queued, e := r.PeekQueue("queue1", 1)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(queued))
+		assert.Equal(t, queuedJob.Id, queued[0].Id)
+
+		leased, e := r.TryLeaseJobs("cluster1", "queue1", []*api.Job{suspendedJob})
+		assert.Nil(t, e)
+		assert.Equal(t, 0, len(leased))
+	})
+}
+
+func TestReprioritizeJobs_QueuedJobMovesAheadAndLeasedJobKeepsRunning(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		first := addTestJob(t, r, "queue1")
+		second := addTestJob(t, r, "queue1")
+		leased := addLeasedJob(t, r, "queue1", "cluster1")
+		deleted := addTestJob(t, r, "queue1")
+		r.DeleteJobs([]*api.Job{deleted})
+
+		reprioritized, e := r.ReprioritizeJobs([]*api.Job{second, leased, deleted}, 0)
+		assert.Nil(t, e)
+		assert.Equal(t, []string{second.Id, leased.Id}, jobIds(reprioritized))
+
+		queued, e := r.PeekQueue("queue1", 10)
+		assert.Nil(t, e)
+		assert.Equal(t, []string{second.Id, first.Id}, jobIds(queued))
+		assert.Equal(t, float64(0), queued[0].Priority)
+		assert.Equal(t, float64(1), queued[1].Priority)
+
+		stillLeased, e := r.GetLeasedJobs("cluster1")
+		assert.Nil(t, e)
+		assert.Equal(t, []string{leased.Id}, jobIds(stillLeased))
+		assert.Equal(t, float64(0), stillLeased[0].Priority)
+	})
+}
+
+func TestResumeJobs_ResumedJobKeepsItsPositionInQueue(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		addTestJob(t, r, "queue1")
+		addTestJob(t, r, "queue1")
+		queuedBefore, e := r.PeekQueue("queue1", 2)
+		assert.Nil(t, e)
+		job := queuedBefore[0]
+
+		_, e = r.SuspendJobs([]*api.Job{job})
+		assert.Nil(t, e)
+
+		resumed, e := r.ResumeJobs([]*api.Job{job})
+		assert.Nil(t, e)
+		assert.Equal(t, []*api.Job{job}, resumed)
+
+		queuedAfter, e := r.PeekQueue("queue1", 2)
+		assert.Nil(t, e)
+		assert.Equal(t, jobIds(queuedBefore), jobIds(queuedAfter))
+
+		leased, e := r.TryLeaseJobs("cluster1", "queue1", []*api.Job{job})
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(leased))
+	})
+}
+
+func TestUngateJobs_GatedJobIsNotLeasedUntilUngated(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		gatedJob := addGatedTestJob(t, r, "queue1")
+		queuedJob := addTestJob(t, r, "queue1")
+
+		queued, e := r.PeekQueue("queue1", 1)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(queued))
+		assert.Equal(t, queuedJob.Id, queued[0].Id)
+
+		leased, e := r.TryLeaseJobs("cluster1", "queue1", []*api.Job{gatedJob})
+		assert.Nil(t, e)
+		assert.Equal(t, 0, len(leased))
+
+		ungated, e := r.UngateJobs([]*api.Job{gatedJob, queuedJob})
+		assert.Nil(t, e)
+		assert.Equal(t, []*api.Job{gatedJob}, ungated)
+
+		queued, e = r.PeekQueue("queue1", 2)
+		assert.Nil(t, e)
+		assert.Equal(t, []string{gatedJob.Id, queuedJob.Id}, jobIds(queued))
+
+		leased, e = r.TryLeaseJobs("cluster1", "queue1", []*api.Job{gatedJob})
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(leased))
+	})
+}
+
+func TestSuspendJobs_LeasedJobIsNotSuspended(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addLeasedJob(t, r, "queue1", "cluster1")
+
+		suspended, e := r.SuspendJobs([]*api.Job{job})
+		assert.Nil(t, e)
+		assert.Equal(t, 0, len(suspended))
+
+		renewed, e := r.RenewLease("cluster1", []string{job.Id})
+		assert.Nil(t, e)
+		assert.Equal(t, map[string]api.LeaseRenewalStatus{job.Id: api.LeaseRenewalStatus_Renewed}, renewed)
+	})
+}
+
+func TestUpdateJobs_DoesNotStoreDeletedJobsAgain(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addLeasedJob(t, r, "queue1", "cluster1")
+		deletedJob := addLeasedJob(t, r, "queue1", "cluster1")
+		r.DeleteJobs([]*api.Job{deletedJob})
+
+		job.LeaseAttempts = 5
+		deletedJob.LeaseAttempts = 5
+		e := r.UpdateJobs([]*api.Job{job, deletedJob})
+		assert.Nil(t, e)
+
+		stored, e := r.GetExistingJobsByIds([]string{job.Id, deletedJob.Id})
+		assert.Nil(t, e)
+		assert.Equal(t, uint32(5), stored[0].LeaseAttempts)
+		assert.Equal(t, uint32(0), stored[1].LeaseAttempts)
+	})
+}
+
 func TestDeleteWithSomeMissingJobs(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		missingJob := &api.Job{Id: "jobId"}
@@ -201,6 +459,25 @@ func TestReturnLeaseForDeletedJobShouldKeepJobDeleted(t *testing.T) {
 	})
 }
 
+func TestAddJobsAtomically_AddsJobsOfAllJobSets(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		principal := authorization.NewStaticPrincipal("user", []string{})
+		job1, e := r.CreateJob(&api.JobSubmitRequest{Queue: "queue1", JobSetId: "set1"}, &api.JobSubmitRequestItem{PodSpec: testPodSpec()}, principal)
+		assert.NoError(t, e)
+		job2, e := r.CreateJob(&api.JobSubmitRequest{Queue: "queue2", JobSetId: "set2"}, &api.JobSubmitRequestItem{PodSpec: testPodSpec()}, principal)
+		assert.NoError(t, e)
+
+		assert.NoError(t, r.AddJobsAtomically([]*api.Job{job1, job2}))
+
+		jobs, e := r.GetExistingJobsByIds([]string{job1.Id, job2.Id})
+		assert.NoError(t, e)
+		assert.ElementsMatch(t, []string{job1.Id, job2.Id}, jobIds(jobs))
+		ids, e := r.GetActiveJobIds("queue2", "set2")
+		assert.NoError(t, e)
+		assert.Equal(t, []string{job2.Id}, ids)
+	})
+}
+
 func TestGetActiveJobIds(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		addTestJob(t, r, "queue1")
@@ -229,6 +506,129 @@ func TestGetQueueActiveJobSets(t *testing.T) {
 	})
 }
 
+func TestGetQueuedJobIdsByLabels(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job1 := addLabeledTestJob(t, r, "queue1", map[string]string{"experiment": "foo", "team": "a"})
+		job2 := addLabeledTestJob(t, r, "queue1", map[string]string{"experiment": "foo", "team": "b"})
+		leased := addLabeledTestJob(t, r, "queue1", map[string]string{"experiment": "foo"})
+		addLabeledTestJob(t, r, "queue1", map[string]string{"experiment": "bar"})
+		addLabeledTestJob(t, r, "queue2", map[string]string{"experiment": "foo"})
+
+		_, e := r.TryLeaseJobs("cluster1", "queue1", []*api.Job{leased})
+		assert.Nil(t, e)
+
+		ids, e := r.GetQueuedJobIdsByLabels("queue1", map[string]string{"experiment": "foo"})
+		assert.Nil(t, e)
+		assert.Equal(t, []string{job1.Id, job2.Id}, ids)
+
+		ids, e = r.GetQueuedJobIdsByLabels("queue1", map[string]string{"experiment": "foo", "team": "b"})
+		assert.Nil(t, e)
+		assert.Equal(t, []string{job2.Id}, ids)
+
+		r.DeleteJobs([]*api.Job{job1})
+		ids, e = r.GetQueuedJobIdsByLabels("queue1", map[string]string{"team": "a"})
+		assert.Nil(t, e)
+		assert.Empty(t, ids)
+	})
+}
+
+func TestGetQueuedJobIdsByLabels_SeparatorsInLabelsDoNotCollide(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job1 := addLabeledTestJob(t, r, "queue1", map[string]string{"a": "b=c"})
+		job2 := addLabeledTestJob(t, r, "queue1", map[string]string{"a=b": "c"})
+		job3 := addLabeledTestJob(t, r, "queue1:a", map[string]string{"b": "c"})
+
+		ids, e := r.GetQueuedJobIdsByLabels("queue1", map[string]string{"a": "b=c"})
+		assert.Nil(t, e)
+		assert.Equal(t, []string{job1.Id}, ids)
+
+		ids, e = r.GetQueuedJobIdsByLabels("queue1", map[string]string{"a=b": "c"})
+		assert.Nil(t, e)
+		assert.Equal(t, []string{job2.Id}, ids)
+
+		ids, e = r.GetQueuedJobIdsByLabels("queue1:a", map[string]string{"b": "c"})
+		assert.Nil(t, e)
+		assert.Equal(t, []string{job3.Id}, ids)
+	})
+}
+
+func TestGetActiveJobIdsByLabels(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		queued := addLabeledTestJob(t, r, "queue1", map[string]string{"experiment": "foo"})
+		leased := addLabeledTestJob(t, r, "queue1", map[string]string{"experiment": "foo"})
+		deleted := addLabeledTestJob(t, r, "queue1", map[string]string{"experiment": "foo"})
+		addLabeledTestJob(t, r, "queue1", map[string]string{"experiment": "bar"})
+		addLabeledTestJob(t, r, "queue2", map[string]string{"experiment": "foo"})
+
+		_, e := r.TryLeaseJobs("cluster1", "queue1", []*api.Job{leased})
+		assert.Nil(t, e)
+		r.DeleteJobs([]*api.Job{deleted})
+
+		ids, e := r.GetActiveJobIdsByLabels("queue1", map[string]string{"experiment": "foo"})
+		assert.Nil(t, e)
+		assert.Equal(t, []string{queued.Id, leased.Id}, ids)
+	})
+}
+
+func TestReserveLeaseDeniedReportsReservesEachJobOncePerInterval(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		reserved, e := r.ReserveLeaseDeniedReports([]string{"job1", "job2"}, time.Minute)
+		assert.Nil(t, e)
+		assert.Equal(t, []string{"job1", "job2"}, reserved)
+
+		reserved, e = r.ReserveLeaseDeniedReports([]string{"job1", "job3"}, time.Minute)
+		assert.Nil(t, e)
+		assert.Equal(t, []string{"job3"}, reserved)
+	})
+}
+
+func TestCountUnmatchableCyclesCountsEachJobSeparately(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		cycles, e := r.CountUnmatchableCycles([]string{"job1", "job2"})
+		assert.Nil(t, e)
+		assert.Equal(t, map[string]int64{"job1": 1, "job2": 1}, cycles)
+
+		cycles, e = r.CountUnmatchableCycles([]string{"job1"})
+		assert.Nil(t, e)
+		assert.Equal(t, map[string]int64{"job1": 2}, cycles)
+	})
+}
+
+func TestCompressedJobCanBeReadBack(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		uncompressed := addTestJob(t, r, "queue1")
+
+		r.compressJobs = true
+		compressed := addTestJob(t, r, "queue1")
+
+		stored, e := r.db.Get(jobObjectPrefix + compressed.Id).Bytes()
+		assert.Nil(t, e)
+		assert.True(t, bytes.HasPrefix(stored, compressedJobPrefix))
+
+		jobs, e := r.GetExistingJobsByIds([]string{uncompressed.Id, compressed.Id})
+		assert.Nil(t, e)
+		assert.Equal(t, 2, len(jobs))
+		assertSameJobBytes(t, uncompressed, jobs[0])
+		assertSameJobBytes(t, compressed, jobs[1])
+	})
+}
+
+func jobIds(jobs []*api.Job) []string {
+	ids := []string{}
+	for _, job := range jobs {
+		ids = append(ids, job.Id)
+	}
+	return ids
+}
+
+func assertSameJobBytes(t *testing.T, expected *api.Job, actual *api.Job) {
+	expectedData, e := proto.Marshal(expected)
+	assert.Nil(t, e)
+	actualData, e := proto.Marshal(actual)
+	assert.Nil(t, e)
+	assert.Equal(t, expectedData, actualData)
+}
+
 func addLeasedJob(t *testing.T, r *RedisJobRepository, queue string, cluster string) *api.Job {
 	job := addTestJob(t, r, queue)
 	leased, e := r.TryLeaseJobs(cluster, queue, []*api.Job{job})
@@ -238,37 +638,54 @@ func addLeasedJob(t *testing.T, r *RedisJobRepository, queue string, cluster str
 	return job
 }
 
+func withGrantedCpu(job *api.Job, cpu resource.Quantity) *api.Job {
+	granted := *job
+	granted.PodSpec = job.PodSpec.DeepCopy()
+	granted.PodSpec.Containers[0].Resources.Requests["cpu"] = cpu
+	granted.PodSpec.Containers[0].Resources.Limits["cpu"] = cpu
+	granted.GrantedResources = map[string]resource.Quantity{"cpu": cpu}
+	return &granted
+}
+
 func addTestJob(t *testing.T, r *RedisJobRepository, queue string) *api.Job {
-	cpu := resource.MustParse("1")
-	memory := resource.MustParse("512Mi")
+	return addLabeledTestJob(t, r, queue, nil)
+}
 
-	jobs, e := r.CreateJobs(&api.JobSubmitRequest{
-		Queue:    queue,
-		JobSetId: "set1",
-		JobRequestItems: []*api.JobSubmitRequestItem{
-			{
-				Priority: 1,
-				PodSpec: &v1.PodSpec{
-					Containers: []v1.Container{
-						{
-							Resources: v1.ResourceRequirements{
-								Limits:   v1.ResourceList{"cpu": cpu, "memory": memory},
-								Requests: v1.ResourceList{"cpu": cpu, "memory": memory},
-							},
-						},
-					},
-				},
-			},
-		},
-	}, authorization.NewStaticPrincipal("user", []string{}))
+func addLabeledTestJob(t *testing.T, r *RedisJobRepository, queue string, labels map[string]string) *api.Job {
+	return addTestJobOfItem(t, r, queue, &api.JobSubmitRequestItem{Labels: labels})
+}
+
+func addGatedTestJob(t *testing.T, r *RedisJobRepository, queue string) *api.Job {
+	return addTestJobOfItem(t, r, queue, &api.JobSubmitRequestItem{Gated: true})
+}
+
+func addTestJobOfItem(t *testing.T, r *RedisJobRepository, queue string, item *api.JobSubmitRequestItem) *api.Job {
+	item.Priority = 1
+	item.PodSpec = testPodSpec()
+	job, e := r.CreateJob(&api.JobSubmitRequest{Queue: queue, JobSetId: "set1"}, item, authorization.NewStaticPrincipal("user", []string{}))
 	assert.NoError(t, e)
 
-	results, e := r.AddJobs(jobs)
+	results, e := r.AddJobs([]*api.Job{job})
 	assert.Nil(t, e)
 	for _, result := range results {
 		assert.Empty(t, result.Error)
 	}
-	return jobs[0]
+	return job
+}
+
+func testPodSpec() *v1.PodSpec {
+	cpu := resource.MustParse("1")
+	memory := resource.MustParse("512Mi")
+	return &v1.PodSpec{
+		Containers: []v1.Container{
+			{
+				Resources: v1.ResourceRequirements{
+					Limits:   v1.ResourceList{"cpu": cpu, "memory": memory},
+					Requests: v1.ResourceList{"cpu": cpu, "memory": memory},
+				},
+			},
+		},
+	}
 }
 
 func withRepository(action func(r *RedisJobRepository)) {
@@ -279,6 +696,6 @@ func withRepository(action func(r *RedisJobRepository)) {
 
 	client.FlushDB()
 
-	repo := NewRedisJobRepository(client)
+	repo := NewRedisJobRepository(client, "", false, 0)
 	action(repo)
 }
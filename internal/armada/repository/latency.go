@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+// weight of the latest operation in the moving average of latency
+const latencySmoothing = 0.2
+
+// RedisLatencyMonitor tracks a moving average of latency of Redis operations, so requests which are not critical
+// can be rejected while Redis is slow instead of piling up with the lease and submit requests.
+type RedisLatencyMonitor struct {
+	// latency above which Redis is overloaded, 0 disables the monitor
+	threshold time.Duration
+	lock      sync.Mutex
+	average   time.Duration
+}
+
+func NewRedisLatencyMonitor(threshold time.Duration) *RedisLatencyMonitor {
+	return &RedisLatencyMonitor{threshold: threshold}
+}
+
+// Monitor records latency of all commands and pipelines of the client. Blocking reads wait for new data
+// rather than for Redis, their latency is not recorded.
+func (m *RedisLatencyMonitor) Monitor(db redis.UniversalClient) {
+	db.WrapProcess(func(process func(cmd redis.Cmder) error) func(cmd redis.Cmder) error {
+		return func(cmd redis.Cmder) error {
+			start := time.Now()
+			e := process(cmd)
+			if !isBlocking(cmd) {
+				m.Record(time.Since(start))
+			}
+			return e
+		}
+	})
+	db.WrapProcessPipeline(func(process func(cmds []redis.Cmder) error) func(cmds []redis.Cmder) error {
+		return func(cmds []redis.Cmder) error {
+			start := time.Now()
+			e := process(cmds)
+			m.Record(time.Since(start))
+			return e
+		}
+	})
+}
+
+func (m *RedisLatencyMonitor) Record(latency time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.average = time.Duration(latencySmoothing*float64(latency) + (1-latencySmoothing)*float64(m.average))
+}
+
+// Overloaded reports whether the average latency of Redis operations is above the threshold.
+func (m *RedisLatencyMonitor) Overloaded() bool {
+	if m.threshold <= 0 {
+		return false
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.average > m.threshold
+}
+
+func isBlocking(cmd redis.Cmder) bool {
+	for _, arg := range cmd.Args() {
+		if s, ok := arg.(string); ok && strings.EqualFold(s, "block") {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"errors"
+
 	"github.com/go-redis/redis"
 	"github.com/gogo/protobuf/proto"
 
@@ -9,22 +11,30 @@ import (
 
 const queueHashKey = "Queue"
 
+var (
+	ErrQueueNotFound      = errors.New("queue does not exist")
+	ErrQueueAlreadyExists = errors.New("queue already exists")
+)
+
 type QueueRepository interface {
 	GetAllQueues() ([]*api.Queue, error)
 	GetQueue(name string) (*api.Queue, error)
 	CreateQueue(queue *api.Queue) error
+	UpdateQueue(queue *api.Queue) error
+	DeleteQueue(name string) error
 }
 
 type RedisQueueRepository struct {
-	db redis.UniversalClient
+	db        redis.UniversalClient
+	keyPrefix string
 }
 
-func NewRedisQueueRepository(db redis.UniversalClient) *RedisQueueRepository {
-	return &RedisQueueRepository{db: db}
+func NewRedisQueueRepository(db redis.UniversalClient, keyPrefix string) *RedisQueueRepository {
+	return &RedisQueueRepository{db: db, keyPrefix: keyPrefix}
 }
 
 func (r *RedisQueueRepository) GetAllQueues() ([]*api.Queue, error) {
-	result, err := r.db.HGetAll(queueHashKey).Result()
+	result, err := r.db.HGetAll(r.keyPrefix + queueHashKey).Result()
 	if err != nil {
 		return nil, err
 	}
@@ -41,8 +51,12 @@ func (r *RedisQueueRepository) GetAllQueues() ([]*api.Queue, error) {
 	return queues, nil
 }
 
+// GetQueue returns ErrQueueNotFound when the queue does not exist.
 func (r *RedisQueueRepository) GetQueue(name string) (*api.Queue, error) {
-	result, err := r.db.HGet(queueHashKey, name).Result()
+	result, err := r.db.HGet(r.keyPrefix+queueHashKey, name).Result()
+	if err == redis.Nil {
+		return nil, ErrQueueNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -54,12 +68,33 @@ func (r *RedisQueueRepository) GetQueue(name string) (*api.Queue, error) {
 	return queue, nil
 }
 
+// CreateQueue returns ErrQueueAlreadyExists when a queue of the same name exists, the existing queue is kept.
 func (r *RedisQueueRepository) CreateQueue(queue *api.Queue) error {
 
 	data, e := proto.Marshal(queue)
 	if e != nil {
 		return e
 	}
-	result := r.db.HSet(queueHashKey, queue.Name, data)
-	return result.Err()
+	created, e := r.db.HSetNX(r.keyPrefix+queueHashKey, queue.Name, data).Result()
+	if e != nil {
+		return e
+	}
+	if !created {
+		return ErrQueueAlreadyExists
+	}
+	return nil
+}
+
+// UpdateQueue stores the queue, replacing an existing queue of the same name.
+func (r *RedisQueueRepository) UpdateQueue(queue *api.Queue) error {
+	data, e := proto.Marshal(queue)
+	if e != nil {
+		return e
+	}
+	return r.db.HSet(r.keyPrefix+queueHashKey, queue.Name, data).Err()
+}
+
+// DeleteQueue deletes the queue, deleting a queue which does not exist succeeds.
+func (r *RedisQueueRepository) DeleteQueue(name string) error {
+	return r.db.HDel(r.keyPrefix+queueHashKey, name).Err()
 }
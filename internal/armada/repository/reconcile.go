@@ -0,0 +1,370 @@
+package repository
+
+import (
+	"sort"
+
+	"github.com/go-redis/redis"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// StateReconciliation lists jobs which state was inconsistent between job indexes.
+type StateReconciliation struct {
+	// ReturnedJobs were leased to clusters which are not active, they are returned to their queue
+	ReturnedJobs []*api.Job
+	// RequeuedJobs were missing in both queued and leased jobs of their queue, they are added back to the queue
+	RequeuedJobs []*api.Job
+	// RemovedJobSetEntries are ids of deleted jobs removed from job set indexes
+	RemovedJobSetEntries []string
+	// RemovedQueueEntries are ids of deleted jobs removed from queued or leased jobs of their queue
+	RemovedQueueEntries []string
+	// RemovedClusterAssociations are ids of jobs which were associated with a cluster without being leased
+	RemovedClusterAssociations []string
+}
+
+// ReconcileState finds jobs in inconsistent state (e.g. left after a crash in the middle of an update) and repairs them,
+// unless dryRun is set, then the inconsistencies are only reported.
+// Every repair checks the inconsistency again atomically, so jobs changed by other requests since they were
+// read are left untouched, and reconciling is safe while jobs are submitted and leased.
+func (repo *RedisJobRepository) ReconcileState(activeClusterIds map[string]bool, queues []string, dryRun bool) (*StateReconciliation, error) {
+	result := &StateReconciliation{
+		ReturnedJobs:               []*api.Job{},
+		RequeuedJobs:               []*api.Job{},
+		RemovedJobSetEntries:       []string{},
+		RemovedQueueEntries:        []string{},
+		RemovedClusterAssociations: []string{},
+	}
+	if e := repo.reconcileQueues(queues, dryRun, result); e != nil {
+		return nil, e
+	}
+	if e := repo.reconcileJobSets(dryRun, result); e != nil {
+		return nil, e
+	}
+	if e := repo.reconcileClusterAssociations(activeClusterIds, dryRun, result); e != nil {
+		return nil, e
+	}
+	sort.Strings(result.RemovedJobSetEntries)
+	sort.Strings(result.RemovedQueueEntries)
+	sort.Strings(result.RemovedClusterAssociations)
+	return result, nil
+}
+
+// reconcileQueues removes deleted jobs from queued and leased jobs of the queues.
+func (repo *RedisJobRepository) reconcileQueues(queues []string, dryRun bool, result *StateReconciliation) error {
+	for _, queue := range queues {
+		for _, setKey := range []string{repo.keyPrefix + jobQueuePrefix + queue, repo.keyPrefix + jobLeasedPrefix + queue} {
+			ids, e := repo.db.ZRange(setKey, 0, -1).Result()
+			if e != nil {
+				return e
+			}
+			deleted, e := repo.deletedJobIds(ids)
+			if e != nil {
+				return e
+			}
+			for _, id := range deleted {
+				removed := 1
+				if !dryRun {
+					removed, e = removeDeletedQueueEntryScript.Run(repo.db, []string{
+						setKey,
+						repo.keyPrefix + jobObjectPrefix + id,
+						repo.keyPrefix + jobSuspendedPrefix + queue,
+						repo.keyPrefix + jobGatedPrefix + queue},
+						id).Int()
+					if e != nil {
+						return e
+					}
+				}
+				if removed > 0 {
+					result.RemovedQueueEntries = append(result.RemovedQueueEntries, id)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+var removeDeletedQueueEntryScript = redis.NewScript(`
+local jobSet = KEYS[1]
+local job = KEYS[2]
+local suspendedJobs = KEYS[3]
+local gatedJobs = KEYS[4]
+
+local jobId = ARGV[1]
+
+if redis.call('PTTL', job) == -1 then
+	return 0
+end
+redis.call('SREM', suspendedJobs, jobId)
+redis.call('SREM', gatedJobs, jobId)
+return redis.call('ZREM', jobSet, jobId)
+`)
+
+// reconcileJobSets removes deleted jobs from job set indexes and adds jobs which are neither queued nor leased back to their queue.
+func (repo *RedisJobRepository) reconcileJobSets(dryRun bool, result *StateReconciliation) error {
+	jobSetKeys, e := repo.scanKeys(repo.keyPrefix + jobSetPrefix + "*")
+	if e != nil {
+		return e
+	}
+	for _, jobSetKey := range jobSetKeys {
+		ids, e := repo.db.SMembers(jobSetKey).Result()
+		if e != nil {
+			return e
+		}
+		deleted, e := repo.deletedJobIds(ids)
+		if e != nil {
+			return e
+		}
+		for _, id := range deleted {
+			removed := 1
+			if !dryRun {
+				removed, e = removeDeletedJobSetEntryScript.Run(repo.db, []string{jobSetKey, repo.keyPrefix + jobObjectPrefix + id}, id).Int()
+				if e != nil {
+					return e
+				}
+			}
+			if removed > 0 {
+				result.RemovedJobSetEntries = append(result.RemovedJobSetEntries, id)
+			}
+		}
+
+		jobs, e := repo.GetExistingJobsByIds(ids)
+		if e != nil {
+			return e
+		}
+		lost, e := repo.filterLostJobs(jobs)
+		if e != nil {
+			return e
+		}
+		for _, job := range lost {
+			requeued := 1
+			if !dryRun {
+				requeued, e = requeueLostJobScript.Run(repo.db, []string{
+					repo.keyPrefix + jobQueuePrefix + job.Queue,
+					repo.keyPrefix + jobLeasedPrefix + job.Queue,
+					repo.keyPrefix + jobObjectPrefix + job.Id,
+					jobSetKey},
+					job.Id, job.Priority).Int()
+				if e != nil {
+					return e
+				}
+			}
+			if requeued > 0 {
+				result.RequeuedJobs = append(result.RequeuedJobs, job)
+			}
+		}
+	}
+	return nil
+}
+
+var removeDeletedJobSetEntryScript = redis.NewScript(`
+local jobSet = KEYS[1]
+local job = KEYS[2]
+
+local jobId = ARGV[1]
+
+if redis.call('PTTL', job) == -1 then
+	return 0
+end
+return redis.call('SREM', jobSet, jobId)
+`)
+
+// jobs are added back with their priority as score, the same way they are queued when submitted
+var requeueLostJobScript = redis.NewScript(`
+local queue = KEYS[1]
+local leasedJobsSet = KEYS[2]
+local job = KEYS[3]
+local jobSet = KEYS[4]
+
+local jobId = ARGV[1]
+local priority = tonumber(ARGV[2])
+
+if redis.call('PTTL', job) ~= -1 or redis.call('SISMEMBER', jobSet, jobId) == 0 then
+	return 0
+end
+if redis.call('ZSCORE', queue, jobId) ~= false or redis.call('ZSCORE', leasedJobsSet, jobId) ~= false then
+	return 0
+end
+return redis.call('ZADD', queue, priority, jobId)
+`)
+
+// reconcileClusterAssociations returns leases of jobs leased to clusters which are not active and removes
+// cluster associations of jobs which are not leased.
+func (repo *RedisJobRepository) reconcileClusterAssociations(activeClusterIds map[string]bool, dryRun bool, result *StateReconciliation) error {
+	associations, e := repo.db.HGetAll(repo.keyPrefix + jobClusterMapKey).Result()
+	if e != nil {
+		return e
+	}
+	ids := make([]string, 0, len(associations))
+	for id := range associations {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	jobs, e := repo.GetExistingJobsByIds(ids)
+	if e != nil {
+		return e
+	}
+	leased, e := repo.filterLeasedJobs(jobs)
+	if e != nil {
+		return e
+	}
+	jobsById := map[string]*api.Job{}
+	for _, job := range jobs {
+		jobsById[job.Id] = job
+	}
+	leasedById := map[string]*api.Job{}
+	for _, job := range leased {
+		leasedById[job.Id] = job
+	}
+
+	for _, id := range ids {
+		clusterId := associations[id]
+		job, isLeased := leasedById[id]
+		if isLeased && activeClusterIds[clusterId] {
+			continue
+		}
+		if isLeased {
+			returned := 1
+			if !dryRun {
+				returned, e = repo.returnLease(repo.db, clusterId, job.Queue, job.Id, job.Created).Int()
+				if e != nil {
+					return e
+				}
+			}
+			if returned > 0 {
+				result.ReturnedJobs = append(result.ReturnedJobs, job)
+			}
+			continue
+		}
+
+		// associations of jobs which no longer exist are removed regardless of the leased jobs set
+		leasedSetKey := repo.keyPrefix + jobLeasedPrefix
+		if job, exists := jobsById[id]; exists {
+			leasedSetKey += job.Queue
+		}
+		removed := 1
+		if !dryRun {
+			removed, e = removeStaleClusterAssociationScript.Run(repo.db, []string{
+				repo.keyPrefix + jobClusterMapKey,
+				leasedSetKey,
+				repo.keyPrefix + jobObjectPrefix + id},
+				id, clusterId).Int()
+			if e != nil {
+				return e
+			}
+		}
+		if removed > 0 {
+			result.RemovedClusterAssociations = append(result.RemovedClusterAssociations, id)
+		}
+	}
+	return nil
+}
+
+var removeStaleClusterAssociationScript = redis.NewScript(`
+local clusterAssociation = KEYS[1]
+local leasedJobsSet = KEYS[2]
+local job = KEYS[3]
+
+local jobId = ARGV[1]
+local clusterId = ARGV[2]
+
+if redis.call('HGET', clusterAssociation, jobId) ~= clusterId then
+	return 0
+end
+if redis.call('PTTL', job) == -1 and redis.call('ZSCORE', leasedJobsSet, jobId) ~= false then
+	return 0
+end
+return redis.call('HDEL', clusterAssociation, jobId)
+`)
+
+// deletedJobIds returns ids of the jobs which do not exist or are deleted, deleted jobs are kept with expiry.
+func (repo *RedisJobRepository) deletedJobIds(ids []string) ([]string, error) {
+	pipe := repo.db.Pipeline()
+	existsCmds := make([]*redis.IntCmd, 0, len(ids))
+	ttlCmds := make([]*redis.DurationCmd, 0, len(ids))
+	for _, id := range ids {
+		existsCmds = append(existsCmds, pipe.Exists(repo.keyPrefix+jobObjectPrefix+id))
+		ttlCmds = append(ttlCmds, pipe.TTL(repo.keyPrefix+jobObjectPrefix+id))
+	}
+	_, e := pipe.Exec()
+	if e != nil && e != redis.Nil {
+		return nil, e
+	}
+
+	deleted := []string{}
+	for i, id := range ids {
+		if existsCmds[i].Val() == 0 || ttlCmds[i].Val() > 0 {
+			deleted = append(deleted, id)
+		}
+	}
+	return deleted, nil
+}
+
+// filterLostJobs returns the jobs which are neither queued nor leased in their queue.
+func (repo *RedisJobRepository) filterLostJobs(jobs []*api.Job) ([]*api.Job, error) {
+	pipe := repo.db.Pipeline()
+	queuedCmds := make([]*redis.FloatCmd, 0, len(jobs))
+	leasedCmds := make([]*redis.FloatCmd, 0, len(jobs))
+	for _, job := range jobs {
+		queuedCmds = append(queuedCmds, pipe.ZScore(repo.keyPrefix+jobQueuePrefix+job.Queue, job.Id))
+		leasedCmds = append(leasedCmds, pipe.ZScore(repo.keyPrefix+jobLeasedPrefix+job.Queue, job.Id))
+	}
+	_, e := pipe.Exec()
+	if e != nil && e != redis.Nil {
+		return nil, e
+	}
+
+	lost := []*api.Job{}
+	for i, job := range jobs {
+		if queuedCmds[i].Err() == redis.Nil && leasedCmds[i].Err() == redis.Nil {
+			lost = append(lost, job)
+		}
+	}
+	return lost, nil
+}
+
+// filterLeasedJobs returns the jobs which are leased in their queue.
+func (repo *RedisJobRepository) filterLeasedJobs(jobs []*api.Job) ([]*api.Job, error) {
+	pipe := repo.db.Pipeline()
+	cmds := make([]*redis.FloatCmd, 0, len(jobs))
+	for _, job := range jobs {
+		cmds = append(cmds, pipe.ZScore(repo.keyPrefix+jobLeasedPrefix+job.Queue, job.Id))
+	}
+	_, e := pipe.Exec()
+	if e != nil && e != redis.Nil {
+		return nil, e
+	}
+
+	leased := []*api.Job{}
+	for i, job := range jobs {
+		if cmds[i].Err() == nil {
+			leased = append(leased, job)
+		}
+	}
+	return leased, nil
+}
+
+// scanKeys returns keys matching the pattern without blocking redis, keys returned more than once by the scan are deduplicated.
+func (repo *RedisJobRepository) scanKeys(pattern string) ([]string, error) {
+	keys := map[string]bool{}
+	var cursor uint64
+	for {
+		batch, next, e := repo.db.Scan(cursor, pattern, 1000).Result()
+		if e != nil {
+			return nil, e
+		}
+		for _, key := range batch {
+			keys[key] = true
+		}
+		cursor = next
+		if cursor == 0 {
+			break
+		}
+	}
+	result := make([]string, 0, len(keys))
+	for key := range keys {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result, nil
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestReconcileState_ConsistentStateIsNotChanged(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		queued := addTestJob(t, r, "queue1")
+		leased := addLeasedJob(t, r, "queue1", "cluster1")
+
+		result, e := r.ReconcileState(map[string]bool{"cluster1": true}, []string{"queue1"}, false)
+		assert.Nil(t, e)
+		assert.Equal(t, &StateReconciliation{
+			ReturnedJobs:               []*api.Job{},
+			RequeuedJobs:               []*api.Job{},
+			RemovedJobSetEntries:       []string{},
+			RemovedQueueEntries:        []string{},
+			RemovedClusterAssociations: []string{},
+		}, result)
+
+		assertQueuedJobs(t, r, "queue1", queued)
+		assertLeasedJobs(t, r, "cluster1", leased)
+	})
+}
+
+func TestReconcileState_ReturnsJobsLeasedToInactiveClusters(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		returned := addLeasedJob(t, r, "queue1", "deadCluster")
+		leased := addLeasedJob(t, r, "queue1", "cluster1")
+
+		result, e := r.ReconcileState(map[string]bool{"cluster1": true}, []string{"queue1"}, false)
+		assert.Nil(t, e)
+		assert.Equal(t, []string{returned.Id}, jobIds(result.ReturnedJobs))
+
+		assertQueuedJobs(t, r, "queue1", returned)
+		assertLeasedJobs(t, r, "cluster1", leased)
+		assertLeasedJobs(t, r, "deadCluster")
+	})
+}
+
+func TestReconcileState_RequeuesJobsMissingInTheirQueue(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		lost := addTestJob(t, r, "queue1")
+		queued := addTestJob(t, r, "queue1")
+		leased := addLeasedJob(t, r, "queue1", "cluster1")
+		r.db.ZRem(r.keyPrefix+jobQueuePrefix+"queue1", lost.Id)
+
+		result, e := r.ReconcileState(map[string]bool{"cluster1": true}, []string{"queue1"}, false)
+		assert.Nil(t, e)
+		assert.Equal(t, []string{lost.Id}, jobIds(result.RequeuedJobs))
+
+		assertQueuedJobs(t, r, "queue1", lost, queued)
+		assertLeasedJobs(t, r, "cluster1", leased)
+	})
+}
+
+func TestReconcileState_RemovesDeletedJobsFromIndexes(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		deletedQueued := addTestJob(t, r, "queue1")
+		deletedLeased := addLeasedJob(t, r, "queue1", "cluster1")
+		queued := addTestJob(t, r, "queue1")
+		r.db.Del(r.keyPrefix+jobObjectPrefix+deletedQueued.Id, r.keyPrefix+jobObjectPrefix+deletedLeased.Id)
+
+		result, e := r.ReconcileState(map[string]bool{"cluster1": true}, []string{"queue1"}, false)
+		assert.Nil(t, e)
+		assert.ElementsMatch(t, []string{deletedQueued.Id, deletedLeased.Id}, result.RemovedQueueEntries)
+		assert.ElementsMatch(t, []string{deletedQueued.Id, deletedLeased.Id}, result.RemovedJobSetEntries)
+		assert.Equal(t, []string{deletedLeased.Id}, result.RemovedClusterAssociations)
+		assert.Empty(t, result.ReturnedJobs)
+		assert.Empty(t, result.RequeuedJobs)
+
+		jobSetIds, e := r.db.SMembers(r.keyPrefix + jobSetPrefix + "set1").Result()
+		assert.Nil(t, e)
+		assert.Equal(t, []string{queued.Id}, jobSetIds)
+		activeIds, e := r.GetQueueActiveJobIds("queue1")
+		assert.Nil(t, e)
+		assert.Equal(t, []string{queued.Id}, activeIds)
+		associations, e := r.db.HGetAll(r.keyPrefix + jobClusterMapKey).Result()
+		assert.Nil(t, e)
+		assert.Empty(t, associations)
+	})
+}
+
+func TestReconcileState_RemovesClusterAssociationsOfJobsNotLeased(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		queued := addTestJob(t, r, "queue1")
+		leased := addLeasedJob(t, r, "queue1", "cluster1")
+		r.db.HSet(r.keyPrefix+jobClusterMapKey, queued.Id, "cluster1")
+
+		result, e := r.ReconcileState(map[string]bool{"cluster1": true}, []string{"queue1"}, false)
+		assert.Nil(t, e)
+		assert.Equal(t, []string{queued.Id}, result.RemovedClusterAssociations)
+
+		associations, e := r.db.HGetAll(r.keyPrefix + jobClusterMapKey).Result()
+		assert.Nil(t, e)
+		assert.Equal(t, map[string]string{leased.Id: "cluster1"}, associations)
+		assertQueuedJobs(t, r, "queue1", queued)
+	})
+}
+
+func TestReconcileState_DryRunOnlyReportsInconsistencies(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		leased := addLeasedJob(t, r, "queue1", "deadCluster")
+		lost := addTestJob(t, r, "queue1")
+		r.db.ZRem(r.keyPrefix+jobQueuePrefix+"queue1", lost.Id)
+
+		result, e := r.ReconcileState(map[string]bool{}, []string{"queue1"}, true)
+		assert.Nil(t, e)
+		assert.Equal(t, []string{leased.Id}, jobIds(result.ReturnedJobs))
+		assert.Equal(t, []string{lost.Id}, jobIds(result.RequeuedJobs))
+
+		assertQueuedJobs(t, r, "queue1")
+		assertLeasedJobs(t, r, "deadCluster", leased)
+	})
+}
+
+func assertQueuedJobs(t *testing.T, r *RedisJobRepository, queue string, expected ...*api.Job) {
+	queued, e := r.PeekQueue(queue, 100)
+	assert.Nil(t, e)
+	assert.ElementsMatch(t, jobIds(expected), jobIds(queued))
+}
+
+func assertLeasedJobs(t *testing.T, r *RedisJobRepository, clusterId string, expected ...*api.Job) {
+	leased, e := r.GetLeasedJobs(clusterId)
+	assert.Nil(t, e)
+	assert.ElementsMatch(t, jobIds(expected), jobIds(leased))
+}
@@ -2,10 +2,12 @@ package repository
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/go-redis/redis"
 	"github.com/gogo/protobuf/proto"
 
+	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -17,6 +19,7 @@ type Usage struct {
 const clusterReportKey = "Cluster:Report"
 const clusterLeasedReportKey = "Cluster:Leased"
 const clusterPrioritiesPrefix = "Cluster:Priority:"
+const clusterLeasesWindowKey = "Cluster:LeasesWindow"
 
 type UsageRepository interface {
 	GetClusterUsageReports() (map[string]*api.ClusterUsageReport, error)
@@ -26,18 +29,23 @@ type UsageRepository interface {
 
 	UpdateCluster(report *api.ClusterUsageReport, priorities map[string]float64) error
 	UpdateClusterLeased(report *api.ClusterLeasedReport) error
+
+	GetClusterLeasesSince(since time.Time) (map[string]*api.ClusterLeasedReport, error)
+	RecordClusterLeases(report *api.ClusterLeasedReport, window time.Duration) error
+	CommitClusterLeases(leasedReport *api.ClusterLeasedReport, leases *api.ClusterLeasedReport, window time.Duration) error
 }
 
 type RedisUsageRepository struct {
-	db redis.UniversalClient
+	db        redis.UniversalClient
+	keyPrefix string
 }
 
-func NewRedisUsageRepository(db redis.UniversalClient) *RedisUsageRepository {
-	return &RedisUsageRepository{db: db}
+func NewRedisUsageRepository(db redis.UniversalClient, keyPrefix string) *RedisUsageRepository {
+	return &RedisUsageRepository{db: db, keyPrefix: keyPrefix}
 }
 
 func (r *RedisUsageRepository) GetClusterUsageReports() (map[string]*api.ClusterUsageReport, error) {
-	result, err := r.db.HGetAll(clusterReportKey).Result()
+	result, err := r.db.HGetAll(r.keyPrefix + clusterReportKey).Result()
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +63,7 @@ func (r *RedisUsageRepository) GetClusterUsageReports() (map[string]*api.Cluster
 }
 
 func (r *RedisUsageRepository) GetClusterLeasedReports() (map[string]*api.ClusterLeasedReport, error) {
-	result, err := r.db.HGetAll(clusterLeasedReportKey).Result()
+	result, err := r.db.HGetAll(r.keyPrefix + clusterLeasedReportKey).Result()
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +81,7 @@ func (r *RedisUsageRepository) GetClusterLeasedReports() (map[string]*api.Cluste
 }
 
 func (r *RedisUsageRepository) GetClusterPriority(clusterId string) (map[string]float64, error) {
-	result, err := r.db.HGetAll(clusterPrioritiesPrefix + clusterId).Result()
+	result, err := r.db.HGetAll(r.keyPrefix + clusterPrioritiesPrefix + clusterId).Result()
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +92,7 @@ func (r *RedisUsageRepository) GetClusterPriorities(clusterIds []string) (map[st
 	pipe := r.db.Pipeline()
 	cmds := make(map[string]*redis.StringStringMapCmd)
 	for _, id := range clusterIds {
-		cmds[id] = pipe.HGetAll(clusterPrioritiesPrefix + id)
+		cmds[id] = pipe.HGetAll(r.keyPrefix + clusterPrioritiesPrefix + id)
 	}
 	_, e := pipe.Exec()
 	if e != nil {
@@ -110,14 +118,14 @@ func (r *RedisUsageRepository) UpdateCluster(report *api.ClusterUsageReport, pri
 	if e != nil {
 		return e
 	}
-	pipe.HSet(clusterReportKey, report.ClusterId, data)
+	pipe.HSet(r.keyPrefix+clusterReportKey, report.ClusterId, data)
 
 	if len(priorities) > 0 {
 		untyped := make(map[string]interface{})
 		for k, v := range priorities {
 			untyped[k] = v
 		}
-		pipe.HMSet(clusterPrioritiesPrefix+report.ClusterId, untyped)
+		pipe.HMSet(r.keyPrefix+clusterPrioritiesPrefix+report.ClusterId, untyped)
 	}
 
 	_, err := pipe.Exec()
@@ -129,10 +137,91 @@ func (r *RedisUsageRepository) UpdateClusterLeased(report *api.ClusterLeasedRepo
 	if e != nil {
 		return e
 	}
-	_, e = r.db.HSet(clusterLeasedReportKey, report.ClusterId, data).Result()
+	_, e = r.db.HSet(r.keyPrefix+clusterLeasedReportKey, report.ClusterId, data).Result()
 	return e
 }
 
+// GetClusterLeasesSince returns resources leased by each cluster since the given time, combined per queue.
+func (r *RedisUsageRepository) GetClusterLeasesSince(since time.Time) (map[string]*api.ClusterLeasedReport, error) {
+	result, e := r.db.ZRangeByScore(r.keyPrefix+clusterLeasesWindowKey, redis.ZRangeBy{
+		Min: strconv.FormatInt(since.UnixNano(), 10),
+		Max: "+inf",
+	}).Result()
+	if e != nil {
+		return nil, e
+	}
+
+	leasedByCluster := map[string]map[string]common.ComputeResources{}
+	for _, data := range result {
+		report := &api.ClusterLeasedReport{}
+		if e := proto.Unmarshal([]byte(data), report); e != nil {
+			return nil, e
+		}
+		leasedByQueue, ok := leasedByCluster[report.ClusterId]
+		if !ok {
+			leasedByQueue = map[string]common.ComputeResources{}
+			leasedByCluster[report.ClusterId] = leasedByQueue
+		}
+		for _, queueReport := range report.Queues {
+			if _, ok := leasedByQueue[queueReport.Name]; !ok {
+				leasedByQueue[queueReport.Name] = common.ComputeResources{}
+			}
+			leasedByQueue[queueReport.Name].Add(queueReport.ResourcesLeased)
+		}
+	}
+
+	reports := make(map[string]*api.ClusterLeasedReport, len(leasedByCluster))
+	for clusterId, leasedByQueue := range leasedByCluster {
+		report := &api.ClusterLeasedReport{ClusterId: clusterId, ReportTime: time.Now()}
+		for queue, leased := range leasedByQueue {
+			report.Queues = append(report.Queues, &api.QueueLeasedReport{Name: queue, ResourcesLeased: leased})
+		}
+		reports[clusterId] = report
+	}
+	return reports, nil
+}
+
+// RecordClusterLeases stores resources leased by a single lease request and removes leases older than the window.
+func (r *RedisUsageRepository) RecordClusterLeases(report *api.ClusterLeasedReport, window time.Duration) error {
+	pipe := r.db.TxPipeline()
+	if e := r.recordClusterLeases(pipe, report, window); e != nil {
+		return e
+	}
+	_, e := pipe.Exec()
+	return e
+}
+
+// CommitClusterLeases stores the leased report of a cluster after a scheduling cycle together with resources
+// leased by the cycle in one transaction, leases are nil when the cluster fairness window is not used.
+func (r *RedisUsageRepository) CommitClusterLeases(leasedReport *api.ClusterLeasedReport, leases *api.ClusterLeasedReport, window time.Duration) error {
+	data, e := proto.Marshal(leasedReport)
+	if e != nil {
+		return e
+	}
+	pipe := r.db.TxPipeline()
+	pipe.HSet(r.keyPrefix+clusterLeasedReportKey, leasedReport.ClusterId, data)
+	if leases != nil {
+		if e := r.recordClusterLeases(pipe, leases, window); e != nil {
+			return e
+		}
+	}
+	_, e = pipe.Exec()
+	return e
+}
+
+func (r *RedisUsageRepository) recordClusterLeases(pipe redis.Pipeliner, report *api.ClusterLeasedReport, window time.Duration) error {
+	data, e := proto.Marshal(report)
+	if e != nil {
+		return e
+	}
+	pipe.ZAdd(r.keyPrefix+clusterLeasesWindowKey, redis.Z{
+		Member: data,
+		Score:  float64(report.ReportTime.UnixNano()),
+	})
+	pipe.ZRemRangeByScore(r.keyPrefix+clusterLeasesWindowKey, "-inf", "("+strconv.FormatInt(report.ReportTime.Add(-window).UnixNano(), 10))
+	return nil
+}
+
 func toFloat64Map(result map[string]string) (map[string]float64, error) {
 	reports := make(map[string]float64)
 	for k, v := range result {
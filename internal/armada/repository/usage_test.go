@@ -48,6 +48,53 @@ func TestUpdateClusterLeased(t *testing.T) {
 	})
 }
 
+func TestGetClusterLeasesSince_CombinesLeasesWithinWindow(t *testing.T) {
+	withUsageRepository(func(r *RedisUsageRepository) {
+		window := time.Minute
+		oldLease := makeClusterLeasedReport("cluster-1", "queue-1")
+		oldLease.ReportTime = time.Now().Add(-2 * window)
+		assert.Nil(t, r.RecordClusterLeases(oldLease, window))
+
+		assert.Nil(t, r.RecordClusterLeases(makeClusterLeasedReport("cluster-1", "queue-1"), window))
+		assert.Nil(t, r.RecordClusterLeases(makeClusterLeasedReport("cluster-1", "queue-1", "queue-2"), window))
+		assert.Nil(t, r.RecordClusterLeases(makeClusterLeasedReport("cluster-2", "queue-2"), window))
+
+		leases, e := r.GetClusterLeasesSince(time.Now().Add(-window))
+		assert.Nil(t, e)
+		assert.Len(t, leases, 2)
+
+		leasedByQueue := map[string]common.ComputeResources{}
+		for _, queueReport := range leases["cluster-1"].Queues {
+			leasedByQueue[queueReport.Name] = queueReport.ResourcesLeased
+		}
+		assert.Equal(t, 2.0, leasedByQueue["queue-1"].AsFloat()["cpu"])
+		assert.Equal(t, 1.0, leasedByQueue["queue-2"].AsFloat()["cpu"])
+		assert.Len(t, leases["cluster-2"].Queues, 1)
+	})
+}
+
+func TestCommitClusterLeases_StoresLeasedReportWithLeases(t *testing.T) {
+	withUsageRepository(func(r *RedisUsageRepository) {
+		window := time.Minute
+		leasedReport := makeClusterLeasedReport("cluster-1", "queue-1", "queue-2")
+		assert.Nil(t, r.CommitClusterLeases(leasedReport, makeClusterLeasedReport("cluster-1", "queue-2"), window))
+
+		leasedReports, e := r.GetClusterLeasedReports()
+		assert.Nil(t, e)
+		assert.Equal(t, leasedReport, leasedReports["cluster-1"])
+
+		leases, e := r.GetClusterLeasesSince(time.Now().Add(-window))
+		assert.Nil(t, e)
+		assert.Len(t, leases, 1)
+		assert.Equal(t, "queue-2", leases["cluster-1"].Queues[0].Name)
+
+		assert.Nil(t, r.CommitClusterLeases(makeClusterLeasedReport("cluster-1"), nil, window))
+		leasedReports, e = r.GetClusterLeasedReports()
+		assert.Nil(t, e)
+		assert.Empty(t, leasedReports["cluster-1"].Queues)
+	})
+}
+
 func makeClusterLeasedReport(clusterId string, queueNames ...string) *api.ClusterLeasedReport {
 	cpuAndMemory := common.ComputeResources{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")}
 	queueReports := make([]*api.QueueLeasedReport, 0, len(queueNames))
@@ -74,6 +121,6 @@ func withUsageRepository(action func(r *RedisUsageRepository)) {
 
 	client.FlushDB()
 
-	repo := NewRedisUsageRepository(client)
+	repo := NewRedisUsageRepository(client, "")
 	action(repo)
 }
@@ -1,8 +1,12 @@
 package scheduling
 
 import (
+	"math"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -37,3 +41,195 @@ func GetClusterReportIds(reports map[string]*api.ClusterUsageReport) []string {
 	}
 	return result
 }
+
+// clusterWeight returns the configured weight of the cluster, clusters without configured weight have weight 1.
+func clusterWeight(weights map[string]float64, clusterId string) float64 {
+	if weight, ok := weights[clusterId]; ok {
+		return weight
+	}
+	return 1
+}
+
+// preferredClustersFreeCapacity sums capacity not leased yet of active clusters with higher weight than the requesting one,
+// it is nil when there are no such clusters.
+func preferredClustersFreeCapacity(
+	weights map[string]float64,
+	clusterId string,
+	activeClusterReports map[string]*api.ClusterUsageReport,
+	activeClusterLeaseJobReports map[string]*api.ClusterLeasedReport) common.ComputeResourcesFloat {
+
+	if len(weights) == 0 {
+		return nil
+	}
+	weight := clusterWeight(weights, clusterId)
+
+	var free common.ComputeResourcesFloat
+	for id, report := range activeClusterReports {
+		if clusterWeight(weights, id) <= weight {
+			continue
+		}
+		if free == nil {
+			free = common.ComputeResourcesFloat{}
+		}
+		free.Add(clusterFreeCapacity(report, activeClusterLeaseJobReports[id]))
+	}
+	return free
+}
+
+// clustersFreeCapacity returns capacity not leased yet of each active cluster.
+func clustersFreeCapacity(
+	activeClusterReports map[string]*api.ClusterUsageReport,
+	activeClusterLeaseJobReports map[string]*api.ClusterLeasedReport) map[string]common.ComputeResourcesFloat {
+
+	result := make(map[string]common.ComputeResourcesFloat, len(activeClusterReports))
+	for id, report := range activeClusterReports {
+		result[id] = clusterFreeCapacity(report, activeClusterLeaseJobReports[id])
+	}
+	return result
+}
+
+func clusterFreeCapacity(report *api.ClusterUsageReport, leasedReport *api.ClusterLeasedReport) common.ComputeResourcesFloat {
+	clusterFree := common.ComputeResources(report.ClusterAvailableCapacity).AsFloat()
+	if leasedReport != nil {
+		for _, queueReport := range leasedReport.Queues {
+			clusterFree.Sub(common.ComputeResources(queueReport.ResourcesLeased).AsFloat())
+		}
+	}
+	clusterFree.LimitToZero()
+	return clusterFree
+}
+
+// clusterCapacityCap returns how much more may be leased to the cluster without its leased jobs using more than
+// the configured fraction of its capacity, ok is false when the cluster has no configured fraction.
+func clusterCapacityCap(
+	fractions map[string]float64,
+	clusterId string,
+	report *api.ClusterUsageReport,
+	leasedReport *api.ClusterLeasedReport) (remaining common.ComputeResourcesFloat, ok bool) {
+
+	fraction, ok := fractions[clusterId]
+	if !ok {
+		return nil, false
+	}
+	remaining = common.ComputeResources(report.ClusterCapacity).Mul(fraction)
+	if leasedReport != nil {
+		for _, queueReport := range leasedReport.Queues {
+			remaining.Sub(common.ComputeResources(queueReport.ResourcesLeased).AsFloat())
+		}
+	}
+	remaining.LimitToZero()
+	return remaining, true
+}
+
+// globalCeilingHeadroom returns how much more may be leased to all clusters together without their leased jobs using
+// more than the configured fraction of capacity of all clusters, ok is false when no ceiling is configured.
+// Resources without configured fraction are limited only by the capacity itself.
+func globalCeilingHeadroom(
+	ceiling map[string]float64,
+	reports map[string]*api.ClusterUsageReport,
+	leasedReports map[string]*api.ClusterLeasedReport) (headroom common.ComputeResourcesFloat, ok bool) {
+
+	if len(ceiling) == 0 {
+		return nil, false
+	}
+	totalCapacity := common.ComputeResources{}
+	for _, report := range reports {
+		totalCapacity.Add(report.ClusterCapacity)
+	}
+	headroom = totalCapacity.MulByResource(ceiling)
+	for _, leasedReport := range leasedReports {
+		for _, queueReport := range leasedReport.Queues {
+			headroom.Sub(common.ComputeResources(queueReport.ResourcesLeased).AsFloat())
+		}
+	}
+	headroom.LimitToZero()
+	return headroom, true
+}
+
+// withoutReservedResources returns copies of the reports with resources reserved on each cluster (e.g. for daemonsets
+// and system pods) subtracted from their available capacity.
+func withoutReservedResources(reports map[string]*api.ClusterUsageReport, reserved common.ComputeResourcesFloat) map[string]*api.ClusterUsageReport {
+	if len(reserved) == 0 {
+		return reports
+	}
+	reservedQuantities := common.ComputeResources{}
+	for key, value := range reserved {
+		reservedQuantities[key] = *resource.NewMilliQuantity(int64(math.Round(value*1000)), resource.DecimalSI)
+	}
+
+	result := make(map[string]*api.ClusterUsageReport, len(reports))
+	for id, report := range reports {
+		available := common.ComputeResources(report.ClusterAvailableCapacity).DeepCopy()
+		available.Sub(reservedQuantities)
+		for key, value := range available {
+			if value.Sign() < 0 {
+				available[key] = *resource.NewQuantity(0, value.Format)
+			}
+		}
+		reportCopy := *report
+		reportCopy.ClusterAvailableCapacity = available
+		result[id] = &reportCopy
+	}
+	return result
+}
+
+// clusterPool holds usage and priorities of clusters of the pool of the requesting cluster and queues allowed
+// to lease to the pool, so fair share is calculated within the pool only.
+type clusterPool struct {
+	queues              []*api.Queue
+	clusterReports      map[string]*api.ClusterUsageReport
+	clusterLeaseReports map[string]*api.ClusterLeasedReport
+	leasesInWindow      map[string]*api.ClusterLeasedReport
+	clusterPriorities   map[string]map[string]float64
+}
+
+// poolOfRequest keeps clusters reporting the same pool as the requesting cluster and queues not restricted
+// to another pool, the leased report of the requesting cluster is kept even before it reports its usage.
+func poolOfRequest(
+	request *api.LeaseRequest,
+	queues []*api.Queue,
+	clusterReports map[string]*api.ClusterUsageReport,
+	clusterLeaseReports map[string]*api.ClusterLeasedReport,
+	leasesInWindow map[string]*api.ClusterLeasedReport,
+	clusterPriorities map[string]map[string]float64) *clusterPool {
+
+	pool := &clusterPool{
+		queues:              []*api.Queue{},
+		clusterReports:      map[string]*api.ClusterUsageReport{},
+		clusterLeaseReports: map[string]*api.ClusterLeasedReport{},
+		clusterPriorities:   map[string]map[string]float64{},
+	}
+	for _, queue := range queues {
+		if queue.Pool == "" || queue.Pool == request.Pool {
+			pool.queues = append(pool.queues, queue)
+		}
+	}
+	for id, report := range clusterReports {
+		if report.Pool == request.Pool {
+			pool.clusterReports[id] = report
+		}
+	}
+	inPool := func(clusterId string) bool {
+		_, ok := pool.clusterReports[clusterId]
+		return ok || clusterId == request.ClusterId
+	}
+	for id, report := range clusterLeaseReports {
+		if inPool(id) {
+			pool.clusterLeaseReports[id] = report
+		}
+	}
+	if leasesInWindow != nil {
+		pool.leasesInWindow = map[string]*api.ClusterLeasedReport{}
+		for id, report := range leasesInWindow {
+			if inPool(id) {
+				pool.leasesInWindow[id] = report
+			}
+		}
+	}
+	for id, priorities := range clusterPriorities {
+		if inPool(id) {
+			pool.clusterPriorities[id] = priorities
+		}
+	}
+	return pool
+}
@@ -0,0 +1,64 @@
+package scheduling
+
+import (
+	"math"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// elasticRequirement returns resources to lease the job with. Jobs with MinResources whose pod spec resources
+// don't fit into the slice or the available capacity get less of each resource with a min, down to the min.
+// Returns whether the requirement is smaller than resources of the pod spec.
+func elasticRequirement(job *api.Job, slice common.ComputeResourcesFloat, capacity common.ComputeResourcesFloat) (common.ComputeResourcesFloat, bool) {
+	requirement := common.TotalResourceRequest(job.PodSpec).AsFloat()
+	if len(job.MinResources) == 0 || (fits(requirement, slice) && fits(requirement, capacity)) {
+		return requirement, false
+	}
+	reduced := false
+	for name, minimum := range common.ComputeResources(job.MinResources).AsFloat() {
+		available := math.Min(slice[name], capacity[name])
+		if requirement[name] > available {
+			requirement[name] = math.Max(minimum, available)
+			reduced = true
+		}
+	}
+	return requirement, reduced
+}
+
+// grantResources returns a copy of the job with requests and limits of containers scaled down to the granted resources
+// and the granted resources recorded, so the executor creates the pod with them. The pod overhead is not scaled.
+// The job itself is not changed, the copy replaces it in the repository only when it is leased.
+func grantResources(job *api.Job, granted common.ComputeResourcesFloat) *api.Job {
+	requested := common.TotalResourceRequest(job.PodSpec).AsFloat()
+	overhead := common.FromResourceList(job.PodSpec.Overhead).AsFloat()
+	podSpec := job.PodSpec.DeepCopy()
+	for name := range job.MinResources {
+		scalable := requested[name] - overhead[name]
+		if granted[name] >= requested[name] || scalable <= 0 {
+			continue
+		}
+		ratio := math.Max(granted[name]-overhead[name], 0) / scalable
+		for i := range podSpec.Containers {
+			scaleResource(&podSpec.Containers[i].Resources, v1.ResourceName(name), ratio)
+		}
+		for i := range podSpec.InitContainers {
+			scaleResource(&podSpec.InitContainers[i].Resources, v1.ResourceName(name), ratio)
+		}
+	}
+	grantedJob := *job
+	grantedJob.PodSpec = podSpec
+	grantedJob.GrantedResources = common.TotalResourceRequest(podSpec)
+	return &grantedJob
+}
+
+func scaleResource(requirements *v1.ResourceRequirements, name v1.ResourceName, ratio float64) {
+	for _, list := range []v1.ResourceList{requirements.Requests, requirements.Limits} {
+		if quantity, ok := list[name]; ok {
+			list[name] = *resource.NewMilliQuantity(int64(float64(quantity.MilliValue())*ratio), quantity.Format)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package scheduling
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// FailureRetriable returns whether failures of the category are retried. Categories are compared case insensitively,
+// as keys of configured maps are lower cased when the configuration is loaded.
+func FailureRetriable(settings *configuration.FailureRetrySettings, category string) bool {
+	if category == "" {
+		return false
+	}
+	for configured, retriable := range settings.Categories {
+		if strings.EqualFold(configured, category) {
+			return retriable
+		}
+	}
+	return false
+}
+
+// RetryFailedJob counts another failed attempt of the job and returns the reason it is retried,
+// or false when the failure is not retriable or the job has no retries left.
+func RetryFailedJob(job *api.Job, failure *api.JobFailedEvent, settings *configuration.FailureRetrySettings) (string, bool) {
+	if job.FailedAttempts >= uint32(settings.MaxRetries) || !FailureRetriable(settings, failure.Category) {
+		return "", false
+	}
+	job.FailedAttempts++
+	return fmt.Sprintf("Retry %d of %d after %s failure", job.FailedAttempts, settings.MaxRetries, failure.Category), true
+}
@@ -0,0 +1,46 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+var testFailureRetry = &configuration.FailureRetrySettings{
+	MaxRetries: 2,
+	Categories: map[string]bool{"nodelost": true, "ImagePullBackOff": false},
+}
+
+func Test_FailureRetriable_LooksUpConfiguredCategory(t *testing.T) {
+	assert.True(t, FailureRetriable(testFailureRetry, "NodeLost"))
+	assert.False(t, FailureRetriable(testFailureRetry, "ImagePullBackOff"))
+	assert.False(t, FailureRetriable(testFailureRetry, "Error"))
+	assert.False(t, FailureRetriable(testFailureRetry, ""))
+}
+
+func Test_RetryFailedJob_RetriesUntilMaxRetries(t *testing.T) {
+	job := &api.Job{Id: "job"}
+	failure := &api.JobFailedEvent{JobId: "job", Category: "NodeLost"}
+
+	reason, retried := RetryFailedJob(job, failure, testFailureRetry)
+	assert.True(t, retried)
+	assert.Equal(t, "Retry 1 of 2 after NodeLost failure", reason)
+
+	_, retried = RetryFailedJob(job, failure, testFailureRetry)
+	assert.True(t, retried)
+
+	_, retried = RetryFailedJob(job, failure, testFailureRetry)
+	assert.False(t, retried)
+	assert.Equal(t, uint32(2), job.FailedAttempts)
+}
+
+func Test_RetryFailedJob_SkipsNonRetriableFailureWithRetriesLeft(t *testing.T) {
+	job := &api.Job{Id: "job"}
+
+	_, retried := RetryFailedJob(job, &api.JobFailedEvent{JobId: "job", Category: "ImagePullBackOff"}, testFailureRetry)
+	assert.False(t, retried)
+	assert.Equal(t, uint32(0), job.FailedAttempts)
+}
@@ -0,0 +1,72 @@
+package scheduling
+
+import (
+	"fmt"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const (
+	FairnessDRF              = "DRF"
+	FairnessWeightedResource = "WeightedResource"
+)
+
+// FairnessStrategy divides resources to schedule between queues according to their priority and current usage.
+type FairnessStrategy interface {
+	// SliceResources returns scheduling info of queues with their share of the resources to slice,
+	// the share limited by their remaining scheduling limit. Queues which reached their limit get no share.
+	SliceResources(
+		resourceScarcity map[string]float64,
+		queueSchedulingInfo map[*api.Queue]*QueueSchedulingInfo,
+		queuePriorities map[*api.Queue]QueuePriorityInfo,
+		quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]*QueueSchedulingInfo
+}
+
+// NewFairnessStrategy returns the strategy with the configured name, DRF is used when the name is empty.
+func NewFairnessStrategy(name string) (FairnessStrategy, error) {
+	switch name {
+	case "", FairnessDRF:
+		return drfStrategy{}, nil
+	case FairnessWeightedResource:
+		return weightedResourceStrategy{resource: "cpu"}, nil
+	default:
+		return nil, fmt.Errorf("unknown fairness strategy %s, expected %s or %s", name, FairnessDRF, FairnessWeightedResource)
+	}
+}
+
+// drfStrategy measures usage of all resources weighted by their scarcity, so queues using a lot of a scarce resource
+// get a smaller share of all resources.
+type drfStrategy struct{}
+
+func (drfStrategy) SliceResources(
+	resourceScarcity map[string]float64,
+	queueSchedulingInfo map[*api.Queue]*QueueSchedulingInfo,
+	queuePriorities map[*api.Queue]QueuePriorityInfo,
+	quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]*QueueSchedulingInfo {
+
+	return SliceResourceWithLimits(resourceScarcity, queueSchedulingInfo, queuePriorities, quantityToSlice)
+}
+
+// weightedResourceStrategy measures usage of a single resource only, ignoring other resources and their scarcity,
+// so queues share the resource in proportion to their priority for the resource. When there is none of the resource
+// to slice, resources are divided as by DRF.
+type weightedResourceStrategy struct {
+	resource string
+}
+
+func (s weightedResourceStrategy) SliceResources(
+	resourceScarcity map[string]float64,
+	queueSchedulingInfo map[*api.Queue]*QueueSchedulingInfo,
+	queuePriorities map[*api.Queue]QueuePriorityInfo,
+	quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]*QueueSchedulingInfo {
+
+	if quantityToSlice[s.resource] <= 0 {
+		return SliceResourceWithLimits(resourceScarcity, queueSchedulingInfo, queuePriorities, quantityToSlice)
+	}
+	queuesWithCapacity := prioritiesOfResource(filterQueuesWithNoCapacity(queueSchedulingInfo, queuePriorities), s.resource)
+	usage := func(resources common.ComputeResourcesFloat) float64 {
+		return resources[s.resource]
+	}
+	return withSchedulingLimits(queueSchedulingInfo, sliceResourceByUsage(usage, queuesWithCapacity, quantityToSlice))
+}
@@ -0,0 +1,57 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// In the shared scenario 1Gi of memory is as scarce as 1 cpu, queue q1 uses 4Gi of memory and queue q2 uses 2 cpu,
+// both queues have the same priority and 10 cpu and 10Gi of memory are divided between them.
+func sliceSharedScenario(t *testing.T, strategyName string) (q1Share common.ComputeResourcesFloat, q2Share common.ComputeResourcesFloat) {
+	strategy, e := NewFairnessStrategy(strategyName)
+	assert.Nil(t, e)
+
+	q1 := &api.Queue{Name: "q1"}
+	q2 := &api.Queue{Name: "q2"}
+	queuePriorities := map[*api.Queue]QueuePriorityInfo{
+		q1: {Priority: 1, CurrentUsage: common.ComputeResources{"memory": resource.MustParse("4Gi")}},
+		q2: {Priority: 1, CurrentUsage: common.ComputeResources{"cpu": resource.MustParse("2")}},
+	}
+	resourceToSlice := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}.AsFloat()
+	queueSchedulingInfo := map[*api.Queue]*QueueSchedulingInfo{
+		q1: NewQueueSchedulingInfo(resourceToSlice, common.ComputeResourcesFloat{}, common.ComputeResourcesFloat{}),
+		q2: NewQueueSchedulingInfo(resourceToSlice, common.ComputeResourcesFloat{}, common.ComputeResourcesFloat{}),
+	}
+
+	slices := strategy.SliceResources(scarcity, queueSchedulingInfo, queuePriorities, resourceToSlice)
+	return slices[q1].adjustedShare, slices[q2].adjustedShare
+}
+
+func Test_FairnessStrategy_DRF_CountsUsageOfAllResources(t *testing.T) {
+	// usage of q1 is 4 and q2 is 2, 20 more is divided so both reach 13: q1 gets 9/20 and q2 11/20 of resources
+	q1Share, q2Share := sliceSharedScenario(t, FairnessDRF)
+	assert.InDelta(t, 4.5, q1Share["cpu"], 1e-9)
+	assert.InDelta(t, 5.5, q2Share["cpu"], 1e-9)
+
+	defaultQ1Share, defaultQ2Share := sliceSharedScenario(t, "")
+	assert.Equal(t, q1Share, defaultQ1Share)
+	assert.Equal(t, q2Share, defaultQ2Share)
+}
+
+func Test_FairnessStrategy_WeightedResource_CountsCpuUsageOnly(t *testing.T) {
+	// usage of q1 is 0 and q2 is 2, 10 more is divided so both reach 6: q1 gets 6/10 and q2 4/10 of resources
+	q1Share, q2Share := sliceSharedScenario(t, FairnessWeightedResource)
+	assert.InDelta(t, 6, q1Share["cpu"], 1e-9)
+	assert.InDelta(t, 4, q2Share["cpu"], 1e-9)
+	assert.InDelta(t, 0.6*10*1024*1024*1024, q1Share["memory"], 1)
+}
+
+func Test_NewFairnessStrategy_RejectsUnknownStrategy(t *testing.T) {
+	_, e := NewFairnessStrategy("RoundRobin")
+	assert.Error(t, e)
+}
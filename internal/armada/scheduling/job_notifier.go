@@ -0,0 +1,29 @@
+package scheduling
+
+import "sync"
+
+// JobNotifier signals lease requests waiting for work that new jobs were queued on this server.
+type JobNotifier struct {
+	mutex  sync.Mutex
+	queued chan struct{}
+}
+
+func NewJobNotifier() *JobNotifier {
+	return &JobNotifier{queued: make(chan struct{})}
+}
+
+// Queued returns a channel which is closed when jobs are queued next time,
+// it has to be obtained before checking for jobs so no notification is missed.
+func (n *JobNotifier) Queued() <-chan struct{} {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.queued
+}
+
+// Notify wakes all current waiters.
+func (n *JobNotifier) Notify() {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	close(n.queued)
+	n.queued = make(chan struct{})
+}
@@ -0,0 +1,75 @@
+package scheduling
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Weight of the latest usage sample in the rolling resource usage of a job
+const jobUsageSampleWeight = 0.5
+
+// UpdateJobUsage folds the usage sample reported by the executor into the rolling resource usage of the job.
+func UpdateJobUsage(job *api.Job, sample common.ComputeResources) {
+	if len(job.ResourcesUsed) == 0 {
+		job.ResourcesUsed = sample.DeepCopy()
+		return
+	}
+	rolling := common.ComputeResources(job.ResourcesUsed).Mul(1 - jobUsageSampleWeight)
+	rolling.Add(sample.Mul(jobUsageSampleWeight))
+
+	resourcesUsed := common.ComputeResources{}
+	for key, value := range rolling {
+		resourcesUsed[key] = *resource.NewMilliQuantity(int64(math.Round(value*1000)), resource.DecimalSI)
+	}
+	job.ResourcesUsed = resourcesUsed
+}
+
+// OverusedResource returns the first resource used more than ratio times of its request,
+// resources which were not requested are ignored.
+func OverusedResource(requested common.ComputeResourcesFloat, used common.ComputeResourcesFloat, ratio float64) (string, bool) {
+	resources := make([]string, 0, len(used))
+	for key := range used {
+		resources = append(resources, key)
+	}
+	sort.Strings(resources)
+
+	for _, key := range resources {
+		request := requested[key]
+		if request > 0 && used[key] > request*ratio {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// UpdateJobOveruse records since when the job uses more resources than it requested
+// and returns true when the overuse lasted for the configured period.
+func UpdateJobOveruse(job *api.Job, now time.Time, settings *configuration.ResourceOveruseSettings) bool {
+	overusing := false
+	if settings.Ratio > 0 {
+		requested := common.TotalResourceRequest(job.PodSpec).AsFloat()
+		_, overusing = OverusedResource(requested, common.ComputeResources(job.ResourcesUsed).AsFloat(), settings.Ratio)
+	}
+	if !overusing {
+		job.OverusingSince = nil
+		return false
+	}
+	if job.OverusingSince == nil {
+		since := now
+		job.OverusingSince = &since
+	}
+	if now.Before(job.OverusingSince.Add(settings.Period)) {
+		return false
+	}
+	// the period starts again, so continuing overuse is reported once per period
+	since := now
+	job.OverusingSince = &since
+	return true
+}
@@ -0,0 +1,77 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_UpdateJobUsage_AveragesSamples(t *testing.T) {
+	job := &api.Job{}
+
+	UpdateJobUsage(job, common.ComputeResources{"cpu": resource.MustParse("2")})
+	assert.Equal(t, 2.0, common.QuantityAsFloat64(job.ResourcesUsed["cpu"]))
+
+	UpdateJobUsage(job, common.ComputeResources{"cpu": resource.MustParse("4")})
+	assert.Equal(t, 3.0, common.QuantityAsFloat64(job.ResourcesUsed["cpu"]))
+}
+
+func Test_OverusedResource(t *testing.T) {
+	requested := common.ComputeResourcesFloat{"cpu": 1, "memory": 100}
+
+	_, overusing := OverusedResource(requested, common.ComputeResourcesFloat{"cpu": 2, "memory": 100}, 2)
+	assert.False(t, overusing, "usage equal to the ratio is not overuse")
+
+	overused, overusing := OverusedResource(requested, common.ComputeResourcesFloat{"cpu": 1, "memory": 201}, 2)
+	assert.True(t, overusing)
+	assert.Equal(t, "memory", overused)
+
+	_, overusing = OverusedResource(requested, common.ComputeResourcesFloat{"nvidia.com/gpu": 1}, 2)
+	assert.False(t, overusing, "resources which were not requested are ignored")
+}
+
+func Test_UpdateJobOveruse_ReportsOnlySustainedOveruse(t *testing.T) {
+	settings := &configuration.ResourceOveruseSettings{Ratio: 2, Period: 5 * time.Minute}
+	job := jobRequestingCpu("1")
+	now := time.Now()
+
+	job.ResourcesUsed = common.ComputeResources{"cpu": resource.MustParse("3")}
+	assert.False(t, UpdateJobOveruse(job, now, settings))
+	assert.Equal(t, now, *job.OverusingSince)
+
+	assert.False(t, UpdateJobOveruse(job, now.Add(4*time.Minute), settings))
+	assert.True(t, UpdateJobOveruse(job, now.Add(5*time.Minute), settings))
+	assert.False(t, UpdateJobOveruse(job, now.Add(6*time.Minute), settings), "overuse is reported once per period")
+	assert.True(t, UpdateJobOveruse(job, now.Add(10*time.Minute), settings))
+
+	job.ResourcesUsed = common.ComputeResources{"cpu": resource.MustParse("1")}
+	assert.False(t, UpdateJobOveruse(job, now.Add(11*time.Minute), settings))
+	assert.Nil(t, job.OverusingSince)
+}
+
+func Test_UpdateJobOveruse_DisabledWithZeroRatio(t *testing.T) {
+	job := jobRequestingCpu("1")
+	job.ResourcesUsed = common.ComputeResources{"cpu": resource.MustParse("100")}
+
+	assert.False(t, UpdateJobOveruse(job, time.Now(), &configuration.ResourceOveruseSettings{}))
+	assert.Nil(t, job.OverusingSince)
+}
+
+func jobRequestingCpu(cpu string) *api.Job {
+	return &api.Job{
+		PodSpec: &v1.PodSpec{
+			Containers: []v1.Container{{
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{"cpu": resource.MustParse(cpu)},
+				},
+			}},
+		},
+	}
+}
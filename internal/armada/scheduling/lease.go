@@ -4,65 +4,177 @@ import (
 	"context"
 	"math"
 	"math/rand"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/logging"
+	"github.com/G-Research/armada/internal/common/tracing"
 	"github.com/G-Research/armada/pkg/api"
 
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 const maxJobsPerLease = 10000
 
+const defaultDeadlineMargin = time.Second
+
+// maxAgingBoost bounds how much waiting jobs can increase the share of a queue, so aging does not override fairness.
+const maxAgingBoost = 4.0
+
 type leaseContext struct {
 	schedulingConfig *configuration.SchedulingConfig
 	repository       repository.JobQueueRepository
 	onJobsLeased     func([]*api.Job)
+	onJobsDenied     func([]*LeaseDenial)
+	onStepFinished   func(step string, duration time.Duration)
 
 	ctx     context.Context
 	request *api.LeaseRequest
 
-	schedulingInfo   map[*api.Queue]*QueueSchedulingInfo
-	resourceScarcity map[string]float64
-	priorities       map[*api.Queue]QueuePriorityInfo
+	resourcesToSchedule common.ComputeResourcesFloat
+	unusedGuarantees    map[*api.Queue]common.ComputeResourcesFloat
+	schedulingInfo      map[*api.Queue]*QueueSchedulingInfo
+	resourceScarcity    map[string]float64
+	priorities          map[*api.Queue]QueuePriorityInfo
 
 	queueCache map[string][]*api.Job
+	denials    map[string]*LeaseDenial
+
+	// free capacity of clusters with higher weight, jobs fitting into it are not leased to the requesting cluster
+	preferredClustersCapacity common.ComputeResourcesFloat
+	// ids of jobs left for preferred clusters by queue
+	reservedJobs map[string]map[string]bool
+	// capacity not leased yet of active clusters, jobs preferring another cluster are left for it while it has capacity for them
+	clustersFreeCapacity map[string]common.ComputeResourcesFloat
+	// source of random queue picks, the global one is used when nil
+	random *rand.Rand
+	// divides resources between queues, DRF is used when nil
+	fairness FairnessStrategy
+	// number of leased jobs by queue, loaded for queues limiting their concurrent jobs
+	leasedJobCounts map[string]int64
+	// context scheduling all queues when this one schedules only a shard of them
+	parent *leaseContext
+	// guards clustersFreeCapacity while shards of queues are scheduled concurrently
+	capacityLock sync.Mutex
+	// matches required node labels of jobs, matchNodeLabelingBefore is used when nil
+	labelMatcher func(ctx context.Context, deadline time.Time, job *api.Job, request *api.LeaseRequest) (labeling *api.NodeLabeling, matched bool, timedOut bool)
+	// ids of jobs which took longer than the job evaluation timeout to match, they are skipped for the rest of the cycle
+	slowJobs map[string]bool
+	// resources which can still be leased in the cycle below the global resource ceiling, nil when no ceiling is configured,
+	// guarded by capacityLock
+	globalHeadroom common.ComputeResourcesFloat
+}
+
+// LeaseDenial describes why a job considered for the lease request could not be leased.
+type LeaseDenial struct {
+	Job    *api.Job
+	Reason api.LeaseDeniedReason
+}
+
+// QueueShare describes resources of a queue in a scheduling pass: its share of the resources to schedule
+// limited by its scheduling limit, the limit itself and resources currently used by the queue.
+type QueueShare struct {
+	Queue                    string
+	AdjustedShare            common.ComputeResourcesFloat
+	RemainingSchedulingLimit common.ComputeResourcesFloat
+	CurrentUsage             common.ComputeResourcesFloat
+	// why the queue leased no jobs in the pass, empty when it leased some
+	IdleReason QueueIdleReason
 }
 
+// QueueIdleReason explains why an active queue leased no jobs in a scheduling pass.
+type QueueIdleReason string
+
+const (
+	// the queue reached its scheduling limit, resource limit or limit of concurrent jobs
+	LimitReached QueueIdleReason = "LimitReached"
+	// jobs of the queue require node labels the cluster doesn't have
+	NoMatchingCluster QueueIdleReason = "NoMatchingCluster"
+	// jobs of the queue didn't fit into the resources left to schedule
+	InsufficientCapacity QueueIdleReason = "InsufficientCapacity"
+	// the queue had no jobs ready to lease
+	NoQueuedJobs QueueIdleReason = "NoQueuedJobs"
+	// resources to schedule went to queues further below their share
+	AtShare QueueIdleReason = "AtShare"
+)
+
+// newLeaseRandom returns the source of random queue picks of a lease request, nil picks with the global source.
+var newLeaseRandom = func() *rand.Rand { return nil }
+
 func LeaseJobs(
 	ctx context.Context,
 	config *configuration.SchedulingConfig,
 	jobQueueRepository repository.JobQueueRepository,
 	onJobLease func([]*api.Job),
+	onJobsDenied func([]*LeaseDenial),
+	onStepFinished func(step string, duration time.Duration),
+	onSchedulingFinished func(pool string, shares []*QueueShare),
 	request *api.LeaseRequest,
 	activeClusterReports map[string]*api.ClusterUsageReport,
 	activeClusterLeaseJobReports map[string]*api.ClusterLeasedReport,
+	clusterLeasesInWindow map[string]*api.ClusterLeasedReport,
 	clusterPriorities map[string]map[string]float64,
 	activeQueues []*api.Queue,
 ) ([]*api.Job, error) {
+	snapshot, e := takeCapacitySnapshot(request, activeClusterReports, activeClusterLeaseJobReports, clusterLeasesInWindow, clusterPriorities)
+	if e != nil {
+		return nil, e
+	}
+	request = snapshot.request
+	pool := poolOfRequest(request, activeQueues, snapshot.clusterReports, snapshot.clusterLeasedReports, snapshot.clusterLeasesInWindow, snapshot.clusterPriorities)
+	// queues outside their scheduling windows don't lease jobs, jobs already leased are not affected
+	activeQueues = queuesInSchedulingWindows(pool.queues, time.Now())
+	activeClusterLeaseJobReports = pool.clusterLeaseReports
+	clusterLeasesInWindow = pool.leasesInWindow
+	clusterPriorities = pool.clusterPriorities
+	activeClusterReports = withoutReservedResources(pool.clusterReports, config.ReservedResources)
 	resourcesToSchedule := common.ComputeResources(request.Resources).AsFloat()
+	if len(config.ReservedResources) > 0 {
+		resourcesToSchedule.Sub(config.ReservedResources)
+		resourcesToSchedule.LimitToZero()
+	}
 	currentClusterReport, ok := activeClusterReports[request.ClusterId]
 
 	totalCapacity := &common.ComputeResources{}
 	for _, clusterReport := range activeClusterReports {
 		totalCapacity.Add(clusterReport.ClusterAvailableCapacity)
 	}
+	scarcity := ResourceScarcityFromReports(activeClusterReports, config.ResourceScarcity)
+
+	var window *clusterLeaseWindow
+	if ok && clusterLeasesInWindow != nil {
+		window = newClusterLeaseWindow(scarcity, request.ClusterId, currentClusterReport, totalCapacity, clusterLeasesInWindow)
+	}
 
 	resourceAllocatedByQueue := CombineLeasedReportResourceByQueue(activeClusterLeaseJobReports)
 	maxResourceToSchedulePerQueue := totalCapacity.MulByResource(config.MaximalResourceFractionToSchedulePerQueue)
 	maxResourcePerQueue := totalCapacity.MulByResource(config.MaximalResourceFractionPerQueue)
-	queueSchedulingInfo := calculateQueueSchedulingLimits(activeQueues, maxResourceToSchedulePerQueue, maxResourcePerQueue, totalCapacity, resourceAllocatedByQueue)
+	queueSchedulingInfo := calculateQueueSchedulingLimits(activeQueues, maxResourceToSchedulePerQueue, maxResourcePerQueue, totalCapacity, resourceAllocatedByQueue, window, config.ResourceRounding)
 
 	if ok {
 		capacity := common.ComputeResources(currentClusterReport.ClusterCapacity)
 		resourcesToSchedule = resourcesToSchedule.LimitWith(capacity.MulByResource(config.MaximalClusterFractionToSchedule))
+		if remaining, capped := clusterCapacityCap(config.ClusterCapacityFractions, request.ClusterId, currentClusterReport, &request.ClusterLeasedReport); capped {
+			resourcesToSchedule = resourcesToSchedule.LimitWith(remaining)
+		}
+	}
+	globalHeadroom, ceiled := globalCeilingHeadroom(config.GlobalResourceCeiling, activeClusterReports, activeClusterLeaseJobReports)
+	if ceiled {
+		resourcesToSchedule = resourcesToSchedule.LimitWith(globalHeadroom)
 	}
 
+	fairness, e := NewFairnessStrategy(config.FairnessStrategy)
+	if e != nil {
+		return nil, e
+	}
 	activeQueuePriority := CalculateQueuesPriorityInfo(clusterPriorities, activeClusterReports, activeQueues)
-	scarcity := ResourceScarcityFromReports(activeClusterReports)
-	activeQueueSchedulingInfo := SliceResourceWithLimits(scarcity, queueSchedulingInfo, activeQueuePriority, resourcesToSchedule)
+	activeQueueSchedulingInfo := fairness.SliceResources(scarcity, queueSchedulingInfo, activeQueuePriority, resourcesToSchedule)
 
 	lc := &leaseContext{
 		schedulingConfig: config,
@@ -71,16 +183,139 @@ func LeaseJobs(
 		ctx:     ctx,
 		request: request,
 
-		resourceScarcity: scarcity,
-		schedulingInfo:   activeQueueSchedulingInfo,
-		priorities:       activeQueuePriority,
+		resourcesToSchedule: resourcesToSchedule,
+		unusedGuarantees:    unusedGuarantees(activeQueues, resourceAllocatedByQueue),
+		resourceScarcity:    scarcity,
+		schedulingInfo:      activeQueueSchedulingInfo,
+		priorities:          activeQueuePriority,
+		fairness:            fairness,
 
 		queueCache: map[string][]*api.Job{},
+		denials:    map[string]*LeaseDenial{},
+
+		preferredClustersCapacity: preferredClustersFreeCapacity(config.ClusterWeights, request.ClusterId, activeClusterReports, activeClusterLeaseJobReports),
+		reservedJobs:              map[string]map[string]bool{},
+		clustersFreeCapacity:      clustersFreeCapacity(activeClusterReports, activeClusterLeaseJobReports),
+		globalHeadroom:            globalHeadroom,
 
-		onJobsLeased: onJobLease,
+		onJobsLeased:   onJobLease,
+		onJobsDenied:   onJobsDenied,
+		onStepFinished: onStepFinished,
+
+		random: newLeaseRandom(),
 	}
 
-	return lc.scheduleJobs(maxJobsPerLease)
+	shares := queueShares(queueSchedulingInfo, activeQueueSchedulingInfo, activeQueuePriority)
+
+	limit := maxJobsPerLease
+	if config.MaxJobsPerLeaseRequest > 0 {
+		limit = config.MaxJobsPerLeaseRequest
+	}
+	jobs, e := lc.scheduleJobs(limit)
+	if e == nil && onSchedulingFinished != nil {
+		lc.explainIdleQueues(shares, queueSchedulingInfo, jobs)
+		onSchedulingFinished(request.Pool, shares)
+	}
+	return jobs, e
+}
+
+// queueShares returns shares of all active queues as sliced at the start of the scheduling pass,
+// queues which reached their scheduling limit have no share.
+func queueShares(
+	limits map[*api.Queue]*QueueSchedulingInfo,
+	sliced map[*api.Queue]*QueueSchedulingInfo,
+	priorities map[*api.Queue]QueuePriorityInfo) []*QueueShare {
+
+	shares := make([]*QueueShare, 0, len(priorities))
+	for queue, priority := range priorities {
+		share := &QueueShare{
+			Queue:                    queue.Name,
+			AdjustedShare:            common.ComputeResourcesFloat{},
+			RemainingSchedulingLimit: common.ComputeResourcesFloat{},
+			CurrentUsage:             priority.CurrentUsage.AsFloat(),
+		}
+		if info, ok := sliced[queue]; ok {
+			share.AdjustedShare = info.adjustedShare.DeepCopy()
+			share.RemainingSchedulingLimit = info.remainingSchedulingLimit.DeepCopy()
+		} else if info, ok := limits[queue]; ok {
+			share.RemainingSchedulingLimit = info.remainingSchedulingLimit.DeepCopy()
+		}
+		shares = append(shares, share)
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		return shares[i].Queue < shares[j].Queue
+	})
+	return shares
+}
+
+// explainIdleQueues sets reasons of queues which leased none of the jobs. Queues without capacity under their
+// scheduling limits at the start of the pass reached their limit, other queues are explained by denials of their jobs
+// or, without denials, by whether they had any jobs to lease.
+func (c *leaseContext) explainIdleQueues(shares []*QueueShare, limits map[*api.Queue]*QueueSchedulingInfo, leasedJobs []*api.Job) {
+	leasedQueues := map[string]bool{}
+	for _, job := range leasedJobs {
+		leasedQueues[job.Queue] = true
+	}
+	withCapacity := map[string]bool{}
+	for queue := range filterQueuesWithNoCapacity(limits, nil) {
+		withCapacity[queue.Name] = true
+	}
+	deniedFor := map[string]api.LeaseDeniedReason{}
+	for _, denial := range c.remainingDenials(leasedJobs) {
+		queue := denial.Job.Queue
+		if reason, ok := deniedFor[queue]; !ok || denialPrecedence(denial.Reason) > denialPrecedence(reason) {
+			deniedFor[queue] = denial.Reason
+		}
+	}
+
+	for _, share := range shares {
+		if leasedQueues[share.Queue] {
+			continue
+		}
+		if !withCapacity[share.Queue] {
+			share.IdleReason = LimitReached
+			continue
+		}
+		if reason, denied := deniedFor[share.Queue]; denied {
+			switch reason {
+			case api.LeaseDeniedReason_QueueLimitReached:
+				share.IdleReason = LimitReached
+			case api.LeaseDeniedReason_NoMatchingNodeLabels:
+				share.IdleReason = NoMatchingCluster
+			default:
+				share.IdleReason = InsufficientCapacity
+			}
+			continue
+		}
+		if jobs, peeked := c.queueCache[share.Queue]; peeked && len(readyJobs(jobs)) == 0 {
+			share.IdleReason = NoQueuedJobs
+			continue
+		}
+		share.IdleReason = AtShare
+	}
+}
+
+// denialPrecedence orders denial reasons of jobs of a queue, the reason with highest precedence explains the queue.
+func denialPrecedence(reason api.LeaseDeniedReason) int {
+	switch reason {
+	case api.LeaseDeniedReason_QueueLimitReached:
+		return 3
+	case api.LeaseDeniedReason_InsufficientCapacity:
+		return 2
+	case api.LeaseDeniedReason_NoMatchingNodeLabels:
+		return 1
+	}
+	return 0
+}
+
+func readyJobs(jobs []*api.Job) []*api.Job {
+	ready := make([]*api.Job, 0, len(jobs))
+	for _, job := range jobs {
+		if !waitsForStartTime(job) {
+			ready = append(ready, job)
+		}
+	}
+	return ready
 }
 
 func calculateQueueSchedulingLimits(
@@ -88,7 +323,9 @@ func calculateQueueSchedulingLimits(
 	schedulingLimitPerQueue common.ComputeResourcesFloat,
 	resourceLimitPerQueue common.ComputeResourcesFloat,
 	totalCapacity *common.ComputeResources,
-	currentQueueResourceAllocation map[string]common.ComputeResources) map[*api.Queue]*QueueSchedulingInfo {
+	currentQueueResourceAllocation map[string]common.ComputeResources,
+	window *clusterLeaseWindow,
+	resourceRounding map[string]string) map[*api.Queue]*QueueSchedulingInfo {
 	schedulingInfo := make(map[*api.Queue]*QueueSchedulingInfo, len(activeQueues))
 	for _, queue := range activeQueues {
 		remainingGlobalLimit := resourceLimitPerQueue.DeepCopy()
@@ -100,89 +337,393 @@ func calculateQueueSchedulingLimits(
 			remainingGlobalLimit.Sub(usage.AsFloat())
 			remainingGlobalLimit.LimitToZero()
 		}
+		if window != nil {
+			remainingGlobalLimit = remainingGlobalLimit.LimitWith(window.clusterLimit(queue.Name, remainingGlobalLimit))
+		}
 
 		schedulingRoundLimit := schedulingLimitPerQueue.DeepCopy()
 
 		schedulingRoundLimit = schedulingRoundLimit.LimitWith(remainingGlobalLimit)
+		if len(queue.GuaranteedResources) > 0 {
+			// limits can't prevent the queue from reaching its guaranteed resources
+			schedulingRoundLimit = schedulingRoundLimit.MaxWith(unusedGuarantee(queue, currentQueueResourceAllocation))
+		}
+		schedulingRoundLimit = roundResources(schedulingRoundLimit, resourceRounding)
 		schedulingInfo[queue] = NewQueueSchedulingInfo(schedulingRoundLimit, common.ComputeResourcesFloat{}, common.ComputeResourcesFloat{})
 	}
 	return schedulingInfo
 }
 
+// unusedGuarantees returns for queues with guaranteed resources how much of the guarantee they are not using.
+func unusedGuarantees(activeQueues []*api.Queue, currentQueueResourceAllocation map[string]common.ComputeResources) map[*api.Queue]common.ComputeResourcesFloat {
+	unused := map[*api.Queue]common.ComputeResourcesFloat{}
+	for _, queue := range activeQueues {
+		if len(queue.GuaranteedResources) > 0 {
+			unused[queue] = unusedGuarantee(queue, currentQueueResourceAllocation)
+		}
+	}
+	return unused
+}
+
+func unusedGuarantee(queue *api.Queue, currentQueueResourceAllocation map[string]common.ComputeResources) common.ComputeResourcesFloat {
+	unused := common.ComputeResources(queue.GuaranteedResources).AsFloat()
+	usage := currentQueueResourceAllocation[queue.Name].AsFloat()
+	for resource, guaranteed := range unused {
+		unused[resource] = math.Max(guaranteed-usage[resource], 0)
+	}
+	return unused
+}
+
+// shareGuarantees returns unused guarantees of schedulable queues, cut proportionally for every resource the guarantees
+// together request more of than is available.
+func shareGuarantees(
+	unusedGuarantees map[*api.Queue]common.ComputeResourcesFloat,
+	schedulingInfo map[*api.Queue]*QueueSchedulingInfo,
+	available common.ComputeResourcesFloat) map[*api.Queue]common.ComputeResourcesFloat {
+
+	total := common.ComputeResourcesFloat{}
+	for queue, unused := range unusedGuarantees {
+		if _, ok := schedulingInfo[queue]; ok {
+			total.Add(unused)
+		}
+	}
+
+	shared := map[*api.Queue]common.ComputeResourcesFloat{}
+	for queue, unused := range unusedGuarantees {
+		if _, ok := schedulingInfo[queue]; !ok {
+			continue
+		}
+		share := unused.DeepCopy()
+		for resource, amount := range share {
+			if total[resource] > available[resource] {
+				share[resource] = amount * math.Max(available[resource], 0) / total[resource]
+			}
+		}
+		shared[queue] = share
+	}
+	return shared
+}
+
+// clusterLeaseWindow holds resources leased from queues within the cluster fairness window.
+type clusterLeaseWindow struct {
+	// fraction of total capacity provided by the requesting cluster
+	capacityFraction float64
+	leasedByQueue    map[string]common.ComputeResources
+	leasedByCluster  map[string]common.ComputeResources
+}
+
+func newClusterLeaseWindow(
+	resourceScarcity map[string]float64,
+	clusterId string,
+	clusterReport *api.ClusterUsageReport,
+	totalCapacity *common.ComputeResources,
+	clusterLeasesInWindow map[string]*api.ClusterLeasedReport) *clusterLeaseWindow {
+
+	capacityFraction := 0.0
+	if total := ResourcesAsUsage(resourceScarcity, *totalCapacity); total > 0 {
+		capacityFraction = ResourcesAsUsage(resourceScarcity, clusterReport.ClusterAvailableCapacity) / total
+	}
+
+	leasedByCluster := map[string]common.ComputeResources{}
+	if report, ok := clusterLeasesInWindow[clusterId]; ok {
+		leasedByCluster = CombineLeasedReportResourceByQueue(map[string]*api.ClusterLeasedReport{clusterId: report})
+	}
+
+	return &clusterLeaseWindow{
+		capacityFraction: capacityFraction,
+		leasedByQueue:    CombineLeasedReportResourceByQueue(clusterLeasesInWindow),
+		leasedByCluster:  leasedByCluster,
+	}
+}
+
+// clusterLimit returns how much the requesting cluster can lease from the queue, so it gets only its part,
+// proportional to its capacity, of everything the queue leased within the window and can still lease.
+func (w *clusterLeaseWindow) clusterLimit(queue string, remainingQueueLimit common.ComputeResourcesFloat) common.ComputeResourcesFloat {
+	limit := remainingQueueLimit.DeepCopy()
+	limit.Add(w.leasedByQueue[queue].AsFloat())
+	limit = limit.Mul(w.capacityFraction)
+	limit.Sub(w.leasedByCluster[queue].AsFloat())
+	limit.LimitToZero()
+	return limit
+}
+
 func (c *leaseContext) scheduleJobs(limit int) ([]*api.Job, error) {
-	jobs := []*api.Job{}
+	c.reserveJobsForPreferredClusters()
+
+	jobs, _ := c.traceStep("leaseGuaranteedResources", func() ([]*api.Job, error) {
+		return c.leaseGuaranteedResources(limit), nil
+	})
+	limit -= len(jobs)
 
 	if !c.schedulingConfig.UseProbabilisticSchedulingForAllResources {
-		assignedJobs, e := c.assignJobs(limit)
+		assignedJobs, e := c.traceStep("assignJobs", func() ([]*api.Job, error) {
+			return c.assignJobs(limit)
+		})
 		if e != nil {
-			log.Errorf("Error when leasing jobs for cluster %s: %s", c.request.ClusterId, e)
+			c.logger().Errorf("Error when leasing jobs for cluster %s: %s", c.request.ClusterId, e)
+			c.returnLeases(jobs)
 			return nil, e
 		}
-		jobs = assignedJobs
-		limit -= len(jobs)
+		jobs = append(jobs, assignedJobs...)
+		limit -= len(assignedJobs)
 	}
 
-	additionalJobs, e := c.distributeRemainder(limit)
+	additionalJobs, e := c.traceStep("distributeRemainder", func() ([]*api.Job, error) {
+		return c.distributeRemainder(limit)
+	})
 	if e != nil {
-		log.Errorf("Error when leasing jobs for cluster %s: %s", c.request.ClusterId, e)
+		c.logger().Errorf("Error when leasing jobs for cluster %s: %s", c.request.ClusterId, e)
+		c.returnLeases(jobs)
 		return nil, e
 	}
 	jobs = append(jobs, additionalJobs...)
 
+	if c.schedulingConfig.UseBackfill {
+		backfilledJobs, _ := c.traceStep("backfill", func() ([]*api.Job, error) {
+			return c.backfill(limit-len(additionalJobs), jobs), nil
+		})
+		jobs = append(jobs, backfilledJobs...)
+	}
+
+	// the request was cancelled (e.g. server is shutting down), jobs would never reach the executor
+	if e := c.ctx.Err(); e != nil {
+		c.logger().Warnf("Lease request cancelled, returning %d jobs.", len(jobs))
+		c.returnLeases(jobs)
+		return nil, e
+	}
+
+	if len(jobs) < c.schedulingConfig.MinJobsToLease {
+		c.logger().Infof("Returning %d jobs, minimum number of jobs to lease is %d.", len(jobs), c.schedulingConfig.MinJobsToLease)
+		c.returnLeases(jobs)
+		return []*api.Job{}, nil
+	}
+	go c.onJobsLeased(jobs)
+	if denials := c.remainingDenials(jobs); len(denials) > 0 && c.onJobsDenied != nil {
+		c.onJobsDenied(denials)
+	}
+
 	if c.schedulingConfig.UseProbabilisticSchedulingForAllResources {
-		log.WithField("clusterId", c.request.ClusterId).Infof("Leasing %d jobs. (using probabilistic scheduling)", len(jobs))
+		c.logger().Infof("Leasing %d jobs. (using probabilistic scheduling)", len(jobs))
 	} else {
-		log.WithField("clusterId", c.request.ClusterId).Infof("Leasing %d jobs. (by remainder distribution: %d)", len(jobs), len(additionalJobs))
+		c.logger().Infof("Leasing %d jobs. (by remainder distribution: %d)", len(jobs), len(additionalJobs))
 	}
+	LogJobsBySubmission(c.logger(), jobs, "Leasing jobs")
 
 	return jobs, nil
 }
 
+// logger returns log entry of the lease request, with its cluster and correlation id.
+func (c *leaseContext) logger() *log.Entry {
+	return logging.FromContext(c.ctx).WithField("clusterId", c.request.ClusterId)
+}
+
+// reserveJobsForPreferredClusters marks top jobs of queues which fit into free capacity of clusters with higher weight,
+// these jobs are left in the queue for those clusters, so the requesting cluster gets only jobs they can't take.
+func (c *leaseContext) reserveJobsForPreferredClusters() {
+	if c.preferredClustersCapacity == nil {
+		return
+	}
+	remaining := c.preferredClustersCapacity.DeepCopy()
+	for _, queue := range sortedQueues(c.schedulingInfo) {
+		topJobs, e := c.repository.PeekQueue(queue.Name, int64(c.leaseBatchSize(queue)))
+		if e != nil {
+			c.logger().Error(e)
+			continue
+		}
+		reserved := map[string]bool{}
+		for _, job := range topJobs {
+			requirement := common.TotalResourceRequest(job.PodSpec).AsFloat()
+			if fits(requirement, remaining) {
+				remaining.Sub(requirement)
+				reserved[job.Id] = true
+			}
+		}
+		if len(reserved) > 0 {
+			c.reservedJobs[queue.Name] = reserved
+		}
+	}
+}
+
+// leaveForPreferredCluster reports whether the job prefers another active cluster which has free capacity for it,
+// the capacity is counted as taken by the job, so the cluster is not expected to take more jobs than it can run.
+func (c *leaseContext) leaveForPreferredCluster(job *api.Job) bool {
+	if job.PreferredCluster == "" || job.PreferredCluster == c.request.ClusterId {
+		return false
+	}
+	if c.parent != nil {
+		return c.parent.leaveForPreferredCluster(job)
+	}
+	c.capacityLock.Lock()
+	defer c.capacityLock.Unlock()
+	free, ok := c.clustersFreeCapacity[job.PreferredCluster]
+	if !ok {
+		return false
+	}
+	requirement := common.TotalResourceRequest(job.PodSpec).AsFloat()
+	if !fits(requirement, free) {
+		return false
+	}
+	free.Sub(requirement)
+	return true
+}
+
+// reserveJob leaves the job in the queue for another cluster for the rest of the lease request.
+func (c *leaseContext) reserveJob(queue *api.Queue, job *api.Job) {
+	reserved, ok := c.reservedJobs[queue.Name]
+	if !ok {
+		reserved = map[string]bool{}
+		c.reservedJobs[queue.Name] = reserved
+	}
+	reserved[job.Id] = true
+}
+
+// traceStep runs a step of the scheduling in a span which is a child of the lease request span,
+// and reports the duration of the step.
+func (c *leaseContext) traceStep(name string, step func() ([]*api.Job, error)) ([]*api.Job, error) {
+	_, span := tracing.StartSpan(c.ctx, name)
+	defer span.End()
+	span.SetAttributes(attribute.String("clusterId", c.request.ClusterId))
+
+	start := time.Now()
+	jobs, e := step()
+	if c.onStepFinished != nil {
+		c.onStepFinished(name, time.Since(start))
+	}
+	span.SetAttributes(attribute.Int("jobCount", len(jobs)))
+	if e != nil {
+		span.RecordError(e)
+		span.SetStatus(codes.Error, e.Error())
+	}
+	return jobs, e
+}
+
+// leaseGuaranteedResources leases jobs of queues using less than their guaranteed resources, up to the guarantee,
+// before the rest of the request is divided between all queues.
+func (c *leaseContext) leaseGuaranteedResources(limit int) []*api.Job {
+	jobs := []*api.Job{}
+	if len(c.unusedGuarantees) == 0 || c.resourcesToSchedule == nil {
+		return jobs
+	}
+
+	remainder := c.resourcesToSchedule.DeepCopy()
+	guarantees := shareGuarantees(c.unusedGuarantees, c.schedulingInfo, remainder)
+	for _, queue := range sortedQueues(c.schedulingInfo) {
+		unused, ok := guarantees[queue]
+		if !ok {
+			continue
+		}
+		info := c.schedulingInfo[queue]
+		// only guaranteed resources are limited, the queue can use any amount of the others
+		slice := remainder.LimitWith(info.remainingSchedulingLimit)
+		slice = slice.LimitWith(slice.MergeWith(unused))
+
+		leased, remaining, e := c.leaseJobs(queue, slice, limit)
+		if e != nil {
+			c.logger().Error(e)
+			continue
+		}
+		scheduled := slice.DeepCopy()
+		scheduled.Sub(remaining)
+		info.UpdateLimits(scheduled)
+		remainder.Sub(scheduled)
+		jobs = append(jobs, leased...)
+
+		limit -= len(leased)
+		if limit <= 0 || c.closeToDeadline() {
+			break
+		}
+	}
+
+	if len(jobs) > 0 {
+		c.schedulingInfo = c.sliceResources(remainder)
+	}
+	return jobs
+}
+
 func (c *leaseContext) assignJobs(limit int) ([]*api.Job, error) {
+	if len(c.schedulingInfo) == 0 {
+		return []*api.Job{}, nil
+	}
+	// TODO: partition limit by priority instead
+	limitPerQueue := limit / len(c.schedulingInfo)
+	if shards := c.shardQueues(); shards != nil {
+		return c.scheduleInShards(shards, limit, func(shard *leaseContext, _ int) []*api.Job {
+			return shard.assignQueueShares(limitPerQueue)
+		}), nil
+	}
+	return c.assignQueueShares(limitPerQueue), nil
+}
+
+// assignQueueShares leases jobs of each queue within its share of the resources.
+func (c *leaseContext) assignQueueShares(limitPerQueue int) []*api.Job {
 	jobs := make([]*api.Job, 0)
-	// TODO: parallelize
-	for queue, info := range c.schedulingInfo {
-		// TODO: partition limit by priority instead
-		leased, remainder, e := c.leaseJobs(queue, info.adjustedShare, limit/len(c.schedulingInfo))
+	for _, queue := range sortedQueues(c.schedulingInfo) {
+		info := c.schedulingInfo[queue]
+		leased, remainder, e := c.leaseJobs(queue, info.adjustedShare, limitPerQueue)
 		if e != nil {
-			log.Error(e)
+			c.logger().Error(e)
 			continue
 		}
 		scheduled := info.adjustedShare.DeepCopy()
 		scheduled.Sub(remainder)
-		c.schedulingInfo[queue].UpdateLimits(scheduled)
+		info.UpdateLimits(scheduled)
 		jobs = append(jobs, leased...)
 
 		if c.closeToDeadline() {
 			break
 		}
 	}
-	return jobs, nil
+	return jobs
 }
 
+// distributeRemainder leases jobs of randomly picked queues, each queue picked with probability proportional
+// to its share, until the resources are used up. With queue shards configured, shards first distribute their
+// own shares concurrently, then resources they left unused are distributed between all queues.
 func (c *leaseContext) distributeRemainder(limit int) ([]*api.Job, error) {
-
 	jobs := []*api.Job{}
 	if limit <= 0 {
 		return jobs, nil
 	}
+	if shards := c.shardQueues(); shards != nil {
+		remainder := SumRemainingResource(c.schedulingInfo)
+		jobs = c.scheduleInShards(shards, limit, func(shard *leaseContext, limit int) []*api.Job {
+			leased, _ := shard.distributeRemainder(limit)
+			return leased
+		})
+		limit -= len(jobs)
+		if limit <= 0 || c.closeToDeadline() {
+			return jobs, nil
+		}
+		for _, job := range jobs {
+			remainder.Sub(common.TotalResourceRequest(job.PodSpec).AsFloat())
+		}
+		remainder.LimitToZero()
+		c.schedulingInfo = c.sliceResources(remainder)
+	}
+	remainingJobs, e := c.distributeRemainderSerially(limit)
+	return append(jobs, remainingJobs...), e
+}
 
-	remainder := SumRemainingResource(c.schedulingInfo)
+func (c *leaseContext) distributeRemainderSerially(limit int) ([]*api.Job, error) {
+	jobs := []*api.Job{}
+
+	remainder := c.withinGlobalCeiling(SumRemainingResource(c.schedulingInfo))
 	shares := QueueSlicesToShares(c.resourceScarcity, c.schedulingInfo)
 
 	queueCount := len(c.schedulingInfo)
 	emptySteps := 0
 	minimumResource := c.schedulingConfig.MinimumResourceToSchedule
+	agingBoosts := c.agingBoosts()
 
 	for !remainder.IsLessThanOrEqual(minimumResource) && len(shares) > 0 && emptySteps < queueCount {
-		queue := pickQueueRandomly(shares)
+		queue := pickQueueRandomly(applyAgingBoosts(shares, agingBoosts), c.randomFloat())
 		emptySteps++
 
 		amountToSchedule := remainder.DeepCopy()
 		amountToSchedule = amountToSchedule.LimitWith(c.schedulingInfo[queue].remainingSchedulingLimit)
 		leased, remaining, e := c.leaseJobs(queue, amountToSchedule, 1)
 		if e != nil {
-			log.Error(e)
+			c.logger().Error(e)
 			continue
 		}
 		if len(leased) > 0 {
@@ -194,12 +735,14 @@ func (c *leaseContext) distributeRemainder(limit int) ([]*api.Job, error) {
 
 			c.schedulingInfo[queue].UpdateLimits(scheduled)
 			remainder.Sub(scheduled)
+			// jobs leased by all steps of the cycle count towards the ceiling
+			remainder = c.withinGlobalCeiling(remainder)
 			shares[queue] = math.Max(0, ResourcesFloatAsUsage(c.resourceScarcity, c.schedulingInfo[queue].schedulingShare))
 		} else {
 			// if there are no suitable jobs to lease eliminate queue from the scheduling
 			delete(c.schedulingInfo, queue)
 			delete(c.priorities, queue)
-			c.schedulingInfo = SliceResourceWithLimits(c.resourceScarcity, c.schedulingInfo, c.priorities, remainder)
+			c.schedulingInfo = c.sliceResources(remainder)
 			shares = QueueSlicesToShares(c.resourceScarcity, c.schedulingInfo)
 		}
 
@@ -212,38 +755,269 @@ func (c *leaseContext) distributeRemainder(limit int) ([]*api.Job, error) {
 	return jobs, nil
 }
 
+// withinGlobalCeiling limits the resources by what can still be leased below the global resource ceiling.
+func (c *leaseContext) withinGlobalCeiling(resources common.ComputeResourcesFloat) common.ComputeResourcesFloat {
+	if c.parent != nil {
+		return c.parent.withinGlobalCeiling(resources)
+	}
+	c.capacityLock.Lock()
+	defer c.capacityLock.Unlock()
+	if c.globalHeadroom == nil {
+		return resources
+	}
+	headroom := c.globalHeadroom.DeepCopy()
+	headroom.LimitToZero()
+	return resources.LimitWith(headroom)
+}
+
+// takeGlobalHeadroom counts resources of the leased jobs towards the global resource ceiling.
+func (c *leaseContext) takeGlobalHeadroom(jobs []*api.Job) {
+	c.changeGlobalHeadroom(jobs, -1)
+}
+
+// releaseGlobalHeadroom gives resources of jobs returned to their queues back to the global headroom.
+func (c *leaseContext) releaseGlobalHeadroom(jobs []*api.Job) {
+	c.changeGlobalHeadroom(jobs, 1)
+}
+
+func (c *leaseContext) changeGlobalHeadroom(jobs []*api.Job, sign float64) {
+	if c.parent != nil {
+		c.parent.changeGlobalHeadroom(jobs, sign)
+		return
+	}
+	c.capacityLock.Lock()
+	defer c.capacityLock.Unlock()
+	if c.globalHeadroom == nil {
+		return
+	}
+	for _, job := range jobs {
+		c.globalHeadroom.Add(common.TotalResourceRequest(job.PodSpec).AsFloat().Mul(sign))
+	}
+}
+
+// agingBoosts calculates for each queue a multiplier of its share based on how long the oldest of its top jobs has been queued.
+func (c *leaseContext) agingBoosts() map[*api.Queue]float64 {
+	boosts := map[*api.Queue]float64{}
+	if c.schedulingConfig.AgingFactor <= 0 {
+		return boosts
+	}
+	now := time.Now()
+	for queue := range c.schedulingInfo {
+		topJobs, e := c.topJobs(queue)
+		if e != nil {
+			c.logger().Error(e)
+			continue
+		}
+		var oldest time.Time
+		for _, job := range topJobs {
+			if oldest.IsZero() || job.Created.Before(oldest) {
+				oldest = job.Created
+			}
+		}
+		if oldest.IsZero() {
+			continue
+		}
+		boosts[queue] = math.Min(1+c.schedulingConfig.AgingFactor*now.Sub(oldest).Hours(), maxAgingBoost)
+	}
+	return boosts
+}
+
+func applyAgingBoosts(shares map[*api.Queue]float64, boosts map[*api.Queue]float64) map[*api.Queue]float64 {
+	if len(boosts) == 0 {
+		return shares
+	}
+	boosted := make(map[*api.Queue]float64, len(shares))
+	for queue, share := range shares {
+		boost, ok := boosts[queue]
+		if !ok {
+			boost = 1
+		}
+		boosted[queue] = share * boost
+	}
+	return boosted
+}
+
+// leaseBatchSize returns batch size configured for the queue, or the global one when not set
+func (c *leaseContext) leaseBatchSize(queue *api.Queue) uint {
+	if queue.LeaseBatchSize > 0 {
+		return uint(queue.LeaseBatchSize)
+	}
+	return c.schedulingConfig.QueueLeaseBatchSize
+}
+
+func (c *leaseContext) topJobs(queue *api.Queue) ([]*api.Job, error) {
+	batchSize := c.leaseBatchSize(queue)
+	topJobs, ok := c.queueCache[queue.Name]
+	if !ok || len(topJobs) < int(batchSize/2) {
+		reserved := c.reservedJobs[queue.Name]
+		newTop, e := c.repository.PeekQueue(queue.Name, int64(batchSize)+int64(len(reserved)))
+		if e != nil {
+			return nil, e
+		}
+		topJobs = make([]*api.Job, 0, len(newTop))
+		for _, job := range newTop {
+			if reserved[job.Id] {
+				continue
+			}
+			if c.leaveForPreferredCluster(job) {
+				c.reserveJob(queue, job)
+				continue
+			}
+			topJobs = append(topJobs, job)
+		}
+		orderJobs(queue.JobOrdering, c.resourceScarcity, topJobs)
+		c.queueCache[queue.Name] = topJobs
+	}
+	return topJobs, nil
+}
+
+// backfill fills capacity left after fair share scheduling with the smallest jobs which fit,
+// within scheduling limits of their queues.
+func (c *leaseContext) backfill(limit int, leasedJobs []*api.Job) []*api.Job {
+	jobs := []*api.Job{}
+	if limit <= 0 || c.resourcesToSchedule == nil {
+		return jobs
+	}
+
+	remainder := c.resourcesToSchedule.DeepCopy()
+	for _, job := range leasedJobs {
+		remainder.Sub(common.TotalResourceRequest(job.PodSpec).AsFloat())
+	}
+
+	type candidate struct {
+		queue       *api.Queue
+		job         *api.Job
+		requirement common.ComputeResourcesFloat
+		size        float64
+	}
+	candidates := []*candidate{}
+	for _, queue := range sortedQueues(c.schedulingInfo) {
+		topJobs, e := c.topJobs(queue)
+		if e != nil {
+			c.logger().Error(e)
+			continue
+		}
+		for _, job := range topJobs {
+			requirement := common.TotalResourceRequest(job.PodSpec).AsFloat()
+			candidates = append(candidates, &candidate{queue, job, requirement, ResourcesFloatAsUsage(c.resourceScarcity, requirement)})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].size < candidates[j].size
+	})
+
+	for _, candidate := range candidates {
+		if limit <= 0 || c.closeToDeadline() {
+			break
+		}
+		info := c.schedulingInfo[candidate.queue]
+		if !fits(candidate.requirement, remainder) || !fits(candidate.requirement, info.remainingSchedulingLimit) ||
+			waitsForStartTime(candidate.job) || !c.matchRequirements(candidate.job) {
+			continue
+		}
+		if remaining, limited, e := c.remainingConcurrentJobs(candidate.queue); e != nil {
+			c.logger().Error(e)
+			continue
+		} else if limited && remaining <= 0 {
+			continue
+		}
+		leased, e := c.repository.TryLeaseJobs(c.request.ClusterId, candidate.queue.Name, []*api.Job{candidate.job})
+		if e != nil {
+			c.logger().Error(e)
+			continue
+		}
+		c.queueCache[candidate.queue.Name] = removeJob(c.queueCache[candidate.queue.Name], candidate.job)
+		if len(leased) == 0 {
+			continue
+		}
+		remainder.Sub(candidate.requirement)
+		info.UpdateLimits(candidate.requirement)
+		jobs = append(jobs, leased...)
+		limit -= len(leased)
+		c.countLeasedJobs(candidate.queue, len(leased))
+		c.takeGlobalHeadroom(leased)
+	}
+	return jobs
+}
+
+// waitsForStartTime tells whether the job must not be leased yet because its NotBefore time has not passed,
+// such job stays queued without being denied.
+func waitsForStartTime(job *api.Job) bool {
+	return job.NotBefore != nil && time.Now().Before(*job.NotBefore)
+}
+
+func fits(requirement common.ComputeResourcesFloat, available common.ComputeResourcesFloat) bool {
+	remainder := available.DeepCopy()
+	remainder.Sub(requirement)
+	return nonNegative(remainder)
+}
+
+func removeJob(jobs []*api.Job, job *api.Job) []*api.Job {
+	result := make([]*api.Job, 0, len(jobs))
+	for _, j := range jobs {
+		if j != job {
+			result = append(result, j)
+		}
+	}
+	return result
+}
+
 func (c *leaseContext) leaseJobs(queue *api.Queue, slice common.ComputeResourcesFloat, limit int) ([]*api.Job, common.ComputeResourcesFloat, error) {
 	jobs := make([]*api.Job, 0)
 	remainder := slice
-	for slice.IsValid() {
+
+	concurrencyLimit, limited, e := c.remainingConcurrentJobs(queue)
+	if e != nil {
+		return nil, slice, e
+	}
+	if limited && concurrencyLimit < limit {
+		limit = concurrencyLimit
+		if limit <= 0 {
+			e = c.denyTopJobs(queue, api.LeaseDeniedReason_QueueLimitReached)
+			return jobs, slice, e
+		}
+	}
+
+	for nonNegative(slice) {
 		if limit <= 0 {
 			break
 		}
 
-		topJobs, ok := c.queueCache[queue.Name]
-		if !ok || len(topJobs) < int(c.schedulingConfig.QueueLeaseBatchSize/2) {
-			newTop, e := c.repository.PeekQueue(queue.Name, int64(c.schedulingConfig.QueueLeaseBatchSize))
-			if e != nil {
-				return nil, slice, e
-			}
-			topJobs = newTop
+		topJobs, e := c.topJobs(queue)
+		if e != nil {
+			return nil, slice, e
 		}
 
 		candidates := make([]*api.Job, 0)
-		for i, job := range topJobs {
-			requirement := common.TotalResourceRequest(job.PodSpec).AsFloat()
+		remainingJobs := make([]*api.Job, 0, len(topJobs))
+		for _, job := range topJobs {
+			if len(candidates) >= limit {
+				remainingJobs = append(remainingJobs, job)
+				continue
+			}
+			requirement, reduced := elasticRequirement(job, slice, common.ComputeResources(c.request.Resources).AsFloat())
 			remainder = slice.DeepCopy()
 			remainder.Sub(requirement)
-			if remainder.IsValid() && matchRequirements(job, c.request) {
+			if matched, timedOut := c.matchJobLabeling(job); timedOut || waitsForStartTime(job) {
+				remainingJobs = append(remainingJobs, job)
+			} else if !matched {
+				c.deny(job, nodeLabelingDenialReason(job, c.request))
+				remainingJobs = append(remainingJobs, job)
+			} else if !fitsAvailableCapacity(requirement, c.request) {
+				c.deny(job, api.LeaseDeniedReason_InsufficientCapacity)
+				remainingJobs = append(remainingJobs, job)
+			} else if !nonNegative(remainder) {
+				c.deny(job, c.sizeDenialReason(queue, requirement))
+				remainingJobs = append(remainingJobs, job)
+			} else {
+				if reduced {
+					job = grantResources(job, requirement)
+				}
 				slice = remainder
 				candidates = append(candidates, job)
-
-				c.queueCache[queue.Name] = append(topJobs[:i], topJobs[i+1:]...)
-			}
-			if len(candidates) >= limit {
-				break
 			}
 		}
+		c.queueCache[queue.Name] = remainingJobs
 
 		leased, e := c.repository.TryLeaseJobs(c.request.ClusterId, queue.Name, candidates)
 		if e != nil {
@@ -252,10 +1026,12 @@ func (c *leaseContext) leaseJobs(queue *api.Queue, slice common.ComputeResources
 
 		jobs = append(jobs, leased...)
 		limit -= len(leased)
+		c.countLeasedJobs(queue, len(leased))
+		c.takeGlobalHeadroom(leased)
 
 		// stop scheduling round if we leased less then batch (either the slice is too small or queue is empty)
 		// TODO: should we look at next batch?
-		if len(candidates) < int(c.schedulingConfig.QueueLeaseBatchSize) {
+		if len(candidates) < int(c.leaseBatchSize(queue)) {
 			break
 		}
 		if c.closeToDeadline() {
@@ -263,28 +1039,128 @@ func (c *leaseContext) leaseJobs(queue *api.Queue, slice common.ComputeResources
 		}
 	}
 
-	go c.onJobsLeased(jobs)
-
 	return jobs, slice, nil
 }
 
+// remainingConcurrentJobs returns how many more jobs of the queue can be leased, limited is false when the queue
+// does not limit the number of its leased jobs.
+func (c *leaseContext) remainingConcurrentJobs(queue *api.Queue) (remaining int, limited bool, e error) {
+	if queue.MaxConcurrentJobs == 0 {
+		return 0, false, nil
+	}
+	if c.leasedJobCounts == nil {
+		c.leasedJobCounts = map[string]int64{}
+	}
+	count, loaded := c.leasedJobCounts[queue.Name]
+	if !loaded {
+		count, e = c.repository.GetLeasedJobCount(queue.Name)
+		if e != nil {
+			return 0, true, e
+		}
+		c.leasedJobCounts[queue.Name] = count
+	}
+	return int(int64(queue.MaxConcurrentJobs) - count), true, nil
+}
+
+func (c *leaseContext) countLeasedJobs(queue *api.Queue, leased int) {
+	if _, loaded := c.leasedJobCounts[queue.Name]; loaded {
+		c.leasedJobCounts[queue.Name] += int64(leased)
+	}
+}
+
+func (c *leaseContext) denyTopJobs(queue *api.Queue, reason api.LeaseDeniedReason) error {
+	topJobs, e := c.topJobs(queue)
+	if e != nil {
+		return e
+	}
+	for _, job := range topJobs {
+		c.deny(job, reason)
+	}
+	return nil
+}
+
+func (c *leaseContext) deny(job *api.Job, reason api.LeaseDeniedReason) {
+	if c.denials == nil {
+		c.denials = map[string]*LeaseDenial{}
+	}
+	c.denials[job.Id] = &LeaseDenial{Job: job, Reason: reason}
+}
+
+// sizeDenialReason tells whether job too big for its slice hit the limit of its queue or there is not enough capacity left.
+func (c *leaseContext) sizeDenialReason(queue *api.Queue, requirement common.ComputeResourcesFloat) api.LeaseDeniedReason {
+	if info, ok := c.schedulingInfo[queue]; ok && !fits(requirement, info.remainingSchedulingLimit) {
+		return api.LeaseDeniedReason_QueueLimitReached
+	}
+	return api.LeaseDeniedReason_InsufficientCapacity
+}
+
+// remainingDenials returns denials of jobs which were not leased later in the scheduling round.
+func (c *leaseContext) remainingDenials(leasedJobs []*api.Job) []*LeaseDenial {
+	leased := make(map[string]bool, len(leasedJobs))
+	for _, job := range leasedJobs {
+		leased[job.Id] = true
+	}
+	denials := []*LeaseDenial{}
+	for id, denial := range c.denials {
+		if !leased[id] {
+			denials = append(denials, denial)
+		}
+	}
+	return denials
+}
+
+func (c *leaseContext) returnLeases(jobs []*api.Job) {
+	for _, job := range jobs {
+		_, e := c.repository.ReturnLease(c.request.ClusterId, job.Id)
+		if e != nil {
+			c.logger().Errorf("Failed to return lease of job %s: %s", job.Id, e)
+		}
+	}
+}
+
+// closeToDeadline reports whether scheduling should stop, leaving time to finish leasing before the request deadline.
 func (c *leaseContext) closeToDeadline() bool {
+	margin := c.schedulingConfig.DeadlineMargin
+	if margin <= 0 {
+		margin = defaultDeadlineMargin
+	}
 	d, exists := c.ctx.Deadline()
-	return exists && d.Before(time.Now().Add(time.Second))
+	return exists && d.Before(time.Now().Add(margin))
+}
+
+func (c *leaseContext) sliceResources(quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]*QueueSchedulingInfo {
+	if c.fairness == nil {
+		return SliceResourceWithLimits(c.resourceScarcity, c.schedulingInfo, c.priorities, quantityToSlice)
+	}
+	return c.fairness.SliceResources(c.resourceScarcity, c.schedulingInfo, c.priorities, quantityToSlice)
+}
+
+func (c *leaseContext) randomFloat() float64 {
+	if c.random == nil {
+		return rand.Float64()
+	}
+	return c.random.Float64()
 }
 
-func pickQueueRandomly(shares map[*api.Queue]float64) *api.Queue {
+// pickQueueRandomly picks a queue with probability proportional to its share, random is uniform in [0, 1).
+// Queues are considered in order of their names, so the same random number picks the same queue every time.
+func pickQueueRandomly(shares map[*api.Queue]float64, random float64) *api.Queue {
+	queues := make([]*api.Queue, 0, len(shares))
 	sum := 0.0
-	for _, share := range shares {
+	for queue, share := range shares {
+		queues = append(queues, queue)
 		sum += share
 	}
+	sort.Slice(queues, func(i, j int) bool {
+		return queues[i].Name < queues[j].Name
+	})
 
-	pick := sum * rand.Float64()
+	pick := sum * random
 	current := 0.0
 
 	var lastQueue *api.Queue
-	for queue, share := range shares {
-		current += share
+	for _, queue := range queues {
+		current += shares[queue]
 		if current >= pick {
 			return queue
 		}
@@ -294,21 +1170,159 @@ func pickQueueRandomly(shares map[*api.Queue]float64) *api.Queue {
 	return lastQueue
 }
 
+// matchRequirements tells whether the cluster has nodes with labels required by the job and enough resources
+// available on them, and reported enough available capacity to hold the whole resource request of the job.
 func matchRequirements(job *api.Job, request *api.LeaseRequest) bool {
-	if len(job.RequiredNodeLabels) == 0 {
-		return true
+	_, ok := matchNodeLabeling(job, request)
+	if !ok || job.PodSpec == nil {
+		return ok
+	}
+	return fitsAvailableCapacity(common.TotalResourceRequest(job.PodSpec).AsFloat(), request)
+}
+
+// matchRequirements checks the job like the matchRequirements function, with node labels matched within
+// the job evaluation timeout. A job which timed out does not match.
+func (c *leaseContext) matchRequirements(job *api.Job) bool {
+	if matched, _ := c.matchJobLabeling(job); !matched || job.PodSpec == nil {
+		return matched
 	}
+	return fitsAvailableCapacity(common.TotalResourceRequest(job.PodSpec).AsFloat(), c.request)
+}
+
+// matchJobLabeling matches required node labels of the job against the request. With JobEvaluationTimeout set,
+// a job taking longer to match is skipped for the rest of the cycle, so a single pathological job can't use up
+// the time of the whole cycle. Matching also stops when the lease request is cancelled.
+func (c *leaseContext) matchJobLabeling(job *api.Job) (matched bool, timedOut bool) {
+	if c.slowJobs[job.Id] {
+		return false, true
+	}
+	match := c.labelMatcher
+	if match == nil {
+		match = matchNodeLabelingBefore
+	}
+	var deadline time.Time
+	timeout := c.schedulingConfig.JobEvaluationTimeout
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	_, matched, timedOut = match(c.ctx, deadline, job, c.request)
+	if timedOut && c.ctx.Err() == nil {
+		c.logger().Warnf("Skipping job %s in this scheduling cycle, matching it to the lease request took longer than %s", job.Id, timeout)
+		if c.slowJobs == nil {
+			c.slowJobs = map[string]bool{}
+		}
+		c.slowJobs[job.Id] = true
+	}
+	return matched && !timedOut, timedOut
+}
+
+// fitsAvailableCapacity checks every requested resource against the capacity the cluster reported available.
+func fitsAvailableCapacity(requirement common.ComputeResourcesFloat, request *api.LeaseRequest) bool {
+	return fits(requirement, common.ComputeResources(request.Resources).AsFloat())
+}
 
-Labels:
+// matchNodeLabeling returns the first labeling of the request satisfying required node labels of the job
+// with enough resources available for the job, the labeling is nil when the job has no required labels.
+// Labelings without reported resources are matched on labels only.
+func matchNodeLabeling(job *api.Job, request *api.LeaseRequest) (*api.NodeLabeling, bool) {
+	labeling, matched, _ := matchNodeLabelingBefore(context.Background(), time.Time{}, job, request)
+	return labeling, matched
+}
+
+// matchNodeLabelingBefore matches like matchNodeLabeling, giving up when the deadline passes or the context is done
+// before a labeling is matched. A zero deadline never passes.
+func matchNodeLabelingBefore(ctx context.Context, deadline time.Time, job *api.Job, request *api.LeaseRequest) (labeling *api.NodeLabeling, matched bool, timedOut bool) {
+	requiredLabels := requiredNodeLabels(job)
+	if len(requiredLabels) == 0 {
+		return nil, true, false
+	}
+
+	requirement := minimalRequirement(job)
 	for _, labeling := range request.AvailableLabels {
-		for k, v := range job.RequiredNodeLabels {
-			if labeling.Labels[k] != v {
-				continue Labels
-			}
+		if ctx.Err() != nil || (!deadline.IsZero() && time.Now().After(deadline)) {
+			return nil, false, true
+		}
+		if hasLabels(labeling, requiredLabels) && fitsLabelingResources(requirement, labeling) {
+			return labeling, true, false
+		}
+	}
+	return nil, false, false
+}
+
+// nodeLabelingDenialReason tells whether no labeling of the request has node labels required by the job,
+// or labelings with the labels don't have enough resources available for it.
+func nodeLabelingDenialReason(job *api.Job, request *api.LeaseRequest) api.LeaseDeniedReason {
+	requiredLabels := requiredNodeLabels(job)
+	for _, labeling := range request.AvailableLabels {
+		if hasLabels(labeling, requiredLabels) {
+			return api.LeaseDeniedReason_InsufficientCapacity
 		}
+	}
+	return api.LeaseDeniedReason_NoMatchingNodeLabels
+}
+
+func hasLabels(labeling *api.NodeLabeling, labels map[string]string) bool {
+	for k, v := range labels {
+		if labeling.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func fitsLabelingResources(requirement common.ComputeResourcesFloat, labeling *api.NodeLabeling) bool {
+	if len(labeling.Resources) == 0 {
 		return true
 	}
-	return false
+	return fits(requirement, common.ComputeResources(labeling.Resources).AsFloat())
+}
+
+// minimalRequirement returns the least resources the job can be leased with, elastic jobs can be leased with their min resources.
+func minimalRequirement(job *api.Job) common.ComputeResourcesFloat {
+	if job.PodSpec == nil {
+		return common.ComputeResourcesFloat{}
+	}
+	requirement := common.TotalResourceRequest(job.PodSpec).AsFloat()
+	for name, minimum := range common.ComputeResources(job.MinResources).AsFloat() {
+		requirement[name] = math.Min(requirement[name], minimum)
+	}
+	return requirement
+}
+
+// SchedulingHints returns node selector hints for leased jobs which were matched to a node group
+// because of their required node labels, so the executor can keep pods on nodes of that group.
+func SchedulingHints(jobs []*api.Job, request *api.LeaseRequest) map[string]*api.SchedulingHint {
+	hints := map[string]*api.SchedulingHint{}
+	for _, job := range jobs {
+		labeling, ok := matchNodeLabeling(job, request)
+		if !ok || labeling == nil {
+			continue
+		}
+		nodeSelector := map[string]string{}
+		for k, v := range labeling.Labels {
+			nodeSelector[k] = v
+		}
+		hints[job.Id] = &api.SchedulingHint{NodeSelector: nodeSelector}
+	}
+	return hints
+}
+
+// requiredNodeLabels adds GPU model requested by pod node selector to job required node labels,
+// so the job is not leased to clusters without such GPUs.
+func requiredNodeLabels(job *api.Job) map[string]string {
+	if job.PodSpec == nil {
+		return job.RequiredNodeLabels
+	}
+	gpuType, ok := job.PodSpec.NodeSelector[common.GpuProductLabel]
+	if !ok {
+		return job.RequiredNodeLabels
+	}
+	labels := map[string]string{common.GpuProductLabel: gpuType}
+	for k, v := range job.RequiredNodeLabels {
+		labels[k] = v
+	}
+	return labels
 }
 
 func filterPriorityMapByKeys(original map[*api.Queue]QueuePriorityInfo, keys []*api.Queue) map[*api.Queue]QueuePriorityInfo {
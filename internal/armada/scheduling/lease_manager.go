@@ -1,6 +1,8 @@
 package scheduling
 
 import (
+	"fmt"
+	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -10,22 +12,39 @@ import (
 )
 
 type LeaseManager struct {
-	jobRepository       repository.JobRepository
-	queueRepository     repository.QueueRepository
-	eventRepository     repository.EventRepository
-	leaseExpiryDuration time.Duration
+	jobRepository        repository.JobRepository
+	queueRepository      repository.QueueRepository
+	eventRepository      repository.EventRepository
+	leaseExpiryDuration  time.Duration
+	maxLeaseAttemptsLock sync.RWMutex
+	maxLeaseAttempts     uint
 }
 
 func NewLeaseManager(
 	jobRepository repository.JobRepository,
 	queueRepository repository.QueueRepository,
 	eventRepository repository.EventRepository,
-	leaseExpiryDuration time.Duration) *LeaseManager {
+	leaseExpiryDuration time.Duration,
+	maxLeaseAttempts uint) *LeaseManager {
 	return &LeaseManager{
 		jobRepository:       jobRepository,
 		queueRepository:     queueRepository,
 		eventRepository:     eventRepository,
-		leaseExpiryDuration: leaseExpiryDuration}
+		leaseExpiryDuration: leaseExpiryDuration,
+		maxLeaseAttempts:    maxLeaseAttempts}
+}
+
+// SetMaxLeaseAttempts changes the limit of lease attempts applied to jobs with expired leases from now on.
+func (l *LeaseManager) SetMaxLeaseAttempts(maxLeaseAttempts uint) {
+	l.maxLeaseAttemptsLock.Lock()
+	defer l.maxLeaseAttemptsLock.Unlock()
+	l.maxLeaseAttempts = maxLeaseAttempts
+}
+
+func (l *LeaseManager) getMaxLeaseAttempts() uint {
+	l.maxLeaseAttemptsLock.RLock()
+	defer l.maxLeaseAttemptsLock.RUnlock()
+	return l.maxLeaseAttempts
 }
 
 func (l *LeaseManager) ExpireLeases() {
@@ -58,6 +77,65 @@ func (l *LeaseManager) ExpireLeases() {
 					}
 				}
 			}
+			RecordReturnedLeases(l.jobRepository, l.eventRepository, jobs, "", l.getMaxLeaseAttempts())
+		}
+	}
+}
+
+// RecordReturnedLeases counts lease attempts of jobs returned to their queue after being leased,
+// jobs which reached maxLeaseAttempts are deleted and reported as failed, 0 disables the limit.
+func RecordReturnedLeases(
+	jobRepository repository.JobRepository,
+	eventRepository repository.EventRepository,
+	jobs []*api.Job,
+	clusterId string,
+	maxLeaseAttempts uint) {
+
+	if len(jobs) == 0 {
+		return
+	}
+	e := jobRepository.IncrementLeaseAttempts(jobs)
+	if e != nil {
+		log.Error(e)
+		return
+	}
+	if maxLeaseAttempts == 0 {
+		return
+	}
+
+	unschedulable := []*api.Job{}
+	for _, job := range jobs {
+		if uint(job.LeaseAttempts) >= maxLeaseAttempts {
+			unschedulable = append(unschedulable, job)
 		}
 	}
+	if len(unschedulable) == 0 {
+		return
+	}
+
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for job, e := range jobRepository.DeleteJobs(unschedulable) {
+		if e != nil {
+			log.Error(e)
+			continue
+		}
+		event, e := api.Wrap(&api.JobFailedEvent{
+			JobId:     job.Id,
+			Queue:     job.Queue,
+			JobSetId:  job.JobSetId,
+			Created:   now,
+			ClusterId: clusterId,
+			Reason:    fmt.Sprintf("Job is repeatedly unschedulable, its lease was returned %d times", job.LeaseAttempts),
+		})
+		if e != nil {
+			log.Error(e)
+		} else {
+			events = append(events, event)
+		}
+	}
+	e = eventRepository.ReportEvents(events)
+	if e != nil {
+		log.Error(e)
+	}
 }
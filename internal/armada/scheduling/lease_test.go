@@ -2,15 +2,28 @@ package scheduling
 
 import (
 	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/tracing"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -33,6 +46,136 @@ func Test_matchRequirements(t *testing.T) {
 	}}))
 }
 
+func Test_matchRequirements_LabelsMatchButCapacityIsInsufficient(t *testing.T) {
+	podSpec := classicPodSpec.DeepCopy()
+	podSpec.Containers[0].Resources.Requests["memory"] = resource.MustParse("64Gi")
+	podSpec.Containers[0].Resources.Limits["memory"] = resource.MustParse("64Gi")
+	job := &api.Job{PodSpec: podSpec, RequiredNodeLabels: map[string]string{"armada/region": "eu"}}
+	labels := []*api.NodeLabeling{{Labels: map[string]string{"armada/region": "eu"}}}
+
+	assert.False(t, matchRequirements(job, &api.LeaseRequest{
+		AvailableLabels: labels,
+		Resources:       common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("8Gi")},
+	}))
+	assert.True(t, matchRequirements(job, &api.LeaseRequest{
+		AvailableLabels: labels,
+		Resources:       common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")},
+	}))
+}
+
+func Test_matchRequirements_LabelGroupWithoutFreeGpusDoesNotMatch(t *testing.T) {
+	job := createJobWithGpu("queue1", "job1", "1")
+	job.RequiredNodeLabels = map[string]string{"armada/region": "eu"}
+	clusterResources := common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi"), common.GpuResourceName: resource.MustParse("4")}
+	groupResources := func(gpus string) common.ComputeResources {
+		return common.ComputeResources{"cpu": resource.MustParse("50"), "memory": resource.MustParse("50Gi"), common.GpuResourceName: resource.MustParse(gpus)}
+	}
+
+	// the cluster has free GPUs, but not in the region required by the job
+	assert.False(t, matchRequirements(job, &api.LeaseRequest{
+		Resources: clusterResources,
+		AvailableLabels: []*api.NodeLabeling{
+			{Labels: map[string]string{"armada/region": "eu"}, Resources: groupResources("0")},
+			{Labels: map[string]string{"armada/region": "us"}, Resources: groupResources("4")},
+		},
+	}))
+	assert.True(t, matchRequirements(job, &api.LeaseRequest{
+		Resources: clusterResources,
+		AvailableLabels: []*api.NodeLabeling{
+			{Labels: map[string]string{"armada/region": "eu", "armada/zone": "1"}, Resources: groupResources("0")},
+			{Labels: map[string]string{"armada/region": "eu", "armada/zone": "2"}, Resources: groupResources("2")},
+		},
+	}))
+	// labelings reported without resources are matched on labels only
+	assert.True(t, matchRequirements(job, &api.LeaseRequest{
+		Resources:       clusterResources,
+		AvailableLabels: []*api.NodeLabeling{{Labels: map[string]string{"armada/region": "eu"}}},
+	}))
+}
+
+func Test_SchedulingHints_ContainLabelingWithResourcesForJob(t *testing.T) {
+	job := createJobWithCpu("queue1", "job1", "2")
+	job.RequiredNodeLabels = map[string]string{"armada/region": "eu"}
+	request := &api.LeaseRequest{AvailableLabels: []*api.NodeLabeling{
+		{Labels: map[string]string{"armada/region": "eu", "armada/zone": "1"}, Resources: common.ComputeResources{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")}},
+		{Labels: map[string]string{"armada/region": "eu", "armada/zone": "2"}, Resources: common.ComputeResources{"cpu": resource.MustParse("4"), "memory": resource.MustParse("1Gi")}},
+	}}
+
+	hints := SchedulingHints([]*api.Job{job}, request)
+
+	assert.Equal(t, map[string]*api.SchedulingHint{
+		"job1": {NodeSelector: map[string]string{"armada/region": "eu", "armada/zone": "2"}},
+	}, hints)
+}
+
+func Test_leaseJobs_DeniesJobWhoseLabelGroupLacksResourcesForInsufficientCapacity(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	job := createJobWithGpu("queue1", "job1", "1")
+	job.RequiredNodeLabels = map[string]string{"armada/region": "eu"}
+
+	c := leaseContext{
+		ctx:              context.Background(),
+		schedulingConfig: &configuration.SchedulingConfig{QueueLeaseBatchSize: 10},
+		onJobsLeased:     func(a []*api.Job) {},
+		request: &api.LeaseRequest{
+			ClusterId: "c1",
+			Resources: common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("1Gi"), common.GpuResourceName: resource.MustParse("4")},
+			AvailableLabels: []*api.NodeLabeling{
+				{Labels: map[string]string{"armada/region": "eu"}, Resources: common.ComputeResources{"cpu": resource.MustParse("5"), "memory": resource.MustParse("1Gi"), common.GpuResourceName: resource.MustParse("0")}},
+				{Labels: map[string]string{"armada/region": "us"}, Resources: common.ComputeResources{"cpu": resource.MustParse("5"), "memory": resource.MustParse("1Gi"), common.GpuResourceName: resource.MustParse("4")}},
+			},
+		},
+		repository: &fakeJobQueueRepository{jobsByQueue: map[string][]*api.Job{"queue1": {job}}},
+		queueCache: map[string][]*api.Job{},
+	}
+
+	slice := common.ComputeResourcesFloat{"cpu": 10, "memory": 1024 * 1024 * 1024, common.GpuResourceName: 4}
+	jobs, _, e := c.leaseJobs(queue1, slice, 10)
+	assert.Nil(t, e)
+	assert.Empty(t, jobs)
+	assert.Equal(t, api.LeaseDeniedReason_InsufficientCapacity, c.denials["job1"].Reason)
+}
+
+func Test_SchedulingHints_ContainMatchedNodeLabeling(t *testing.T) {
+	request := &api.LeaseRequest{AvailableLabels: []*api.NodeLabeling{
+		{Labels: map[string]string{"armada/region": "us", "armada/zone": "1"}},
+		{Labels: map[string]string{"armada/region": "eu", "armada/zone": "2", "x": "y"}},
+	}}
+	constrained := &api.Job{Id: "constrained", RequiredNodeLabels: map[string]string{"armada/region": "eu"}}
+	unconstrained := &api.Job{Id: "unconstrained"}
+
+	hints := SchedulingHints([]*api.Job{constrained, unconstrained}, request)
+
+	assert.Equal(t, map[string]*api.SchedulingHint{
+		"constrained": {NodeSelector: map[string]string{"armada/region": "eu", "armada/zone": "2", "x": "y"}},
+	}, hints)
+}
+
+func Test_matchRequirements_GpuType(t *testing.T) {
+	v100Cluster := &api.LeaseRequest{
+		AvailableLabels: []*api.NodeLabeling{
+			{Labels: map[string]string{}},
+			{Labels: map[string]string{common.GpuProductLabel: "Tesla-V100-SXM2-16GB"}},
+		},
+		Resources: common.ComputeResources{common.GpuResourceName: resource.MustParse("4")},
+	}
+	gpuPodSpec := &v1.PodSpec{Containers: []v1.Container{{
+		Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{common.GpuResourceName: resource.MustParse("1")},
+			Limits:   v1.ResourceList{common.GpuResourceName: resource.MustParse("1")},
+		}}}}
+
+	a100PodSpec := gpuPodSpec.DeepCopy()
+	a100PodSpec.NodeSelector = map[string]string{common.GpuProductLabel: "A100-SXM4-40GB"}
+	assert.False(t, matchRequirements(&api.Job{PodSpec: a100PodSpec}, v100Cluster))
+	assert.False(t, matchRequirements(&api.Job{PodSpec: gpuPodSpec, RequiredNodeLabels: map[string]string{common.GpuProductLabel: "A100-SXM4-40GB"}}, v100Cluster))
+
+	assert.True(t, matchRequirements(&api.Job{PodSpec: gpuPodSpec}, v100Cluster))
+	v100PodSpec := gpuPodSpec.DeepCopy()
+	v100PodSpec.NodeSelector = map[string]string{common.GpuProductLabel: "Tesla-V100-SXM2-16GB"}
+	assert.True(t, matchRequirements(&api.Job{PodSpec: v100PodSpec}, v100Cluster))
+}
+
 func Test_distributeRemainder_highPriorityUserDoesNotBlockOthers(t *testing.T) {
 
 	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
@@ -70,7 +213,7 @@ func Test_distributeRemainder_highPriorityUserDoesNotBlockOthers(t *testing.T) {
 		},
 	}
 
-	// the leasing logic stops scheduling 1s before the deadline
+	// the leasing logic stops scheduling 1s (default deadline margin) before the deadline
 	ctx, _ := context.WithDeadline(context.Background(), time.Now().Add(2*time.Second))
 
 	c := leaseContext{
@@ -122,7 +265,7 @@ func Test_distributeRemainder_DoesNotExceedSchedulingLimits(t *testing.T) {
 		},
 	}
 
-	// the leasing logic stops scheduling 1s before the deadline
+	// the leasing logic stops scheduling 1s (default deadline margin) before the deadline
 	ctx, _ := context.WithDeadline(context.Background(), time.Now().Add(2*time.Second))
 
 	c := leaseContext{
@@ -144,86 +287,2046 @@ func Test_distributeRemainder_DoesNotExceedSchedulingLimits(t *testing.T) {
 	assert.Equal(t, 2, len(jobs))
 }
 
-var classicPodSpec = &v1.PodSpec{
-	Containers: []v1.Container{{
-		Name:  "Container1",
-		Image: "index.docker.io/library/ubuntu:latest",
-		Args:  []string{"sleep", "10s"},
-		Resources: v1.ResourceRequirements{
-			Requests: v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Mi")},
-			Limits:   v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Mi")},
-		}}}}
+func Test_distributeRemainder_LeasesSameJobsInSameOrderForSameInputs(t *testing.T) {
+	distribute := func() []string {
+		queues := []*api.Queue{
+			{Name: "queue1", PriorityFactor: 1},
+			{Name: "queue2", PriorityFactor: 1},
+			{Name: "queue3", PriorityFactor: 1},
+		}
+		scarcity := map[string]float64{"cpu": 1, "memory": 1}
+		requestSize := common.ComputeResources{"cpu": resource.MustParse("9"), "memory": resource.MustParse("9Gi")}
 
-type fakeJobQueueRepository struct {
-	jobsByQueue map[string][]*api.Job
-}
+		priorities := map[*api.Queue]QueuePriorityInfo{}
+		schedulingInfo := map[*api.Queue]*QueueSchedulingInfo{}
+		jobsByQueue := map[string][]*api.Job{}
+		for _, queue := range queues {
+			priorities[queue] = QueuePriorityInfo{Priority: 1}
+			schedulingInfo[queue] = &QueueSchedulingInfo{
+				remainingSchedulingLimit: requestSize.AsFloat(),
+				schedulingShare:          requestSize.AsFloat(),
+				adjustedShare:            requestSize.AsFloat(),
+			}
+			jobsByQueue[queue.Name] = createJobs(queue.Name, 10)
+		}
 
-func (r *fakeJobQueueRepository) PeekQueue(queue string, limit int64) ([]*api.Job, error) {
-	jobs, exists := r.jobsByQueue[queue]
-	if !exists {
-		return []*api.Job{}, nil
+		c := leaseContext{
+			ctx: context.Background(),
+			schedulingConfig: &configuration.SchedulingConfig{
+				QueueLeaseBatchSize: 10,
+			},
+			onJobsLeased:     func(a []*api.Job) {},
+			request:          &api.LeaseRequest{ClusterId: "c1", Resources: requestSize},
+			resourceScarcity: scarcity,
+			priorities:       priorities,
+			schedulingInfo:   SliceResourceWithLimits(scarcity, schedulingInfo, priorities, requestSize.AsFloat()),
+			repository:       &fakeJobQueueRepository{jobsByQueue: jobsByQueue},
+			queueCache:       map[string][]*api.Job{},
+			random:           rand.New(rand.NewSource(42)),
+		}
+
+		jobs, e := c.distributeRemainder(1000)
+		assert.Nil(t, e)
+		return jobIds(jobs)
 	}
-	if int64(len(jobs)) > limit {
-		jobs = jobs[:limit]
+
+	expected := distribute()
+	assert.Equal(t, 9, len(expected))
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, expected, distribute())
 	}
-	return jobs, nil
 }
 
-func (r *fakeJobQueueRepository) TryLeaseJobs(clusterId string, queue string, jobs []*api.Job) ([]*api.Job, error) {
-	remainingJobs := []*api.Job{}
-outer:
-	for _, j := range r.jobsByQueue[queue] {
-		for _, l := range jobs {
-			if j == l {
-				continue outer
+func Test_LeaseJobs_LeasesSameJobsInSameOrderForSameInputs(t *testing.T) {
+	lease := func() []string {
+		queues := []*api.Queue{
+			{Name: "queue1", PriorityFactor: 1},
+			{Name: "queue2", PriorityFactor: 1},
+			{Name: "queue3", PriorityFactor: 1},
+		}
+		jobsByQueue := map[string][]*api.Job{}
+		for _, queue := range queues {
+			for i := 0; i < 10; i++ {
+				cpu := "2"
+				if i%3 == 0 {
+					cpu = "500m"
+				}
+				jobsByQueue[queue.Name] = append(jobsByQueue[queue.Name], createJobWithCpu(queue.Name, fmt.Sprintf("%s-job%d", queue.Name, i), cpu))
 			}
 		}
-		remainingJobs = append(remainingJobs, j)
+
+		newLeaseRandom = func() *rand.Rand { return rand.New(rand.NewSource(42)) }
+		defer func() { newLeaseRandom = func() *rand.Rand { return nil } }()
+		config := leaseTestConfig()
+		config.UseBackfill = true
+		capacity := common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")}
+		jobs, e := LeaseJobs(
+			context.Background(),
+			config,
+			&fakeJobQueueRepository{jobsByQueue: jobsByQueue},
+			func(jobs []*api.Job) {},
+			func(denials []*LeaseDenial) {},
+			nil,
+			nil,
+			&api.LeaseRequest{ClusterId: "c1", Resources: common.ComputeResources{"cpu": resource.MustParse("11"), "memory": resource.MustParse("10Gi")}},
+			map[string]*api.ClusterUsageReport{"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity}},
+			map[string]*api.ClusterLeasedReport{},
+			nil,
+			map[string]map[string]float64{},
+			queues)
+		assert.Nil(t, e)
+		return jobIds(jobs)
+	}
+
+	expected := lease()
+	assert.True(t, len(expected) > 3)
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, expected, lease())
 	}
-	r.jobsByQueue[queue] = remainingJobs
-	return jobs, nil
 }
 
-func Test_calculateQueueSchedulingLimits(t *testing.T) {
+func Test_distributeRemainder_StopsBeforeDeadlineByConfiguredMargin(t *testing.T) {
 	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
-	activeQueues := []*api.Queue{queue1}
-	schedulingLimitPerQueue := common.ComputeResourcesFloat{"cpu": 300.0}
-	resourceLimitPerQueue := common.ComputeResourcesFloat{"cpu": 400.0}
-	totalCapacity := &common.ComputeResources{"cpu": resource.MustParse("1000")}
-	currentQueueResourceAllocation := map[string]common.ComputeResources{queue1.Name: {"cpu": resource.MustParse("250")}}
+	scarcity := map[string]float64{"cpu": 1, "memory": 1}
+	priorities := map[*api.Queue]QueuePriorityInfo{queue1: {Priority: 1}}
+	requestSize := common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")}
+	schedulingInfo := map[*api.Queue]*QueueSchedulingInfo{
+		queue1: {remainingSchedulingLimit: requestSize.AsFloat(), schedulingShare: requestSize.AsFloat(), adjustedShare: requestSize.AsFloat()},
+	}
 
-	result := calculateQueueSchedulingLimits(activeQueues, schedulingLimitPerQueue, resourceLimitPerQueue, totalCapacity, currentQueueResourceAllocation)
+	repository := &slowJobQueueRepository{
+		fakeJobQueueRepository: fakeJobQueueRepository{jobsByQueue: map[string][]*api.Job{"queue1": createJobs("queue1", 100)}},
+		delay:                  20 * time.Millisecond,
+	}
 
-	assert.Equal(t, len(result), 1)
-	assert.Equal(t, result[queue1].remainingSchedulingLimit, common.ComputeResourcesFloat{"cpu": 150.0})
+	deadline := time.Now().Add(300 * time.Millisecond)
+	margin := 200 * time.Millisecond
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	c := leaseContext{
+		ctx: ctx,
+		schedulingConfig: &configuration.SchedulingConfig{
+			QueueLeaseBatchSize: 10,
+			DeadlineMargin:      margin,
+		},
+		onJobsLeased:     func(a []*api.Job) {},
+		request:          &api.LeaseRequest{ClusterId: "c1", Resources: requestSize},
+		resourceScarcity: scarcity,
+		priorities:       priorities,
+		schedulingInfo:   SliceResourceWithLimits(scarcity, schedulingInfo, priorities, requestSize.AsFloat()),
+		repository:       repository,
+		queueCache:       map[string][]*api.Job{},
+	}
+
+	jobs, e := c.distributeRemainder(1000)
+	assert.Nil(t, e)
+
+	// scheduling stops with the first lease attempt finished within the margin
+	assert.True(t, time.Now().Before(deadline.Add(-margin).Add(repository.delay*2)))
+	assert.True(t, len(jobs) > 0)
+	assert.True(t, len(jobs) < 100)
+	// all returned jobs are leased and no other jobs are
+	assert.Equal(t, 100-len(jobs), len(repository.jobsByQueue["queue1"]))
 }
 
-func Test_calculateQueueSchedulingLimits_WithSmallSchedulingLimitPerQueue(t *testing.T) {
+type slowJobQueueRepository struct {
+	fakeJobQueueRepository
+	delay time.Duration
+}
+
+func newSlowJobQueueRepository(jobsByQueue map[string][]*api.Job, delay time.Duration) *slowJobQueueRepository {
+	return &slowJobQueueRepository{fakeJobQueueRepository: fakeJobQueueRepository{jobsByQueue: jobsByQueue}, delay: delay}
+}
+
+func (r *slowJobQueueRepository) TryLeaseJobs(clusterId string, queue string, jobs []*api.Job) ([]*api.Job, error) {
+	time.Sleep(r.delay)
+	return r.fakeJobQueueRepository.TryLeaseJobs(clusterId, queue, jobs)
+}
+
+func Test_leaseJobs_LeasesMultiContainerJobsOnlyOnceAndWithinSlice(t *testing.T) {
 	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
-	activeQueues := []*api.Queue{queue1}
-	schedulingLimitPerQueue := common.ComputeResourcesFloat{"cpu": 100.0}
-	resourceLimitPerQueue := common.ComputeResourcesFloat{"cpu": 400.0}
-	totalCapacity := &common.ComputeResources{"cpu": resource.MustParse("1000")}
-	currentQueueResourceAllocation := map[string]common.ComputeResources{queue1.Name: {"cpu": resource.MustParse("250")}}
 
-	result := calculateQueueSchedulingLimits(activeQueues, schedulingLimitPerQueue, resourceLimitPerQueue, totalCapacity, currentQueueResourceAllocation)
+	job1 := &api.Job{Id: "job1", PodSpec: multiContainerPodSpec}
+	job2 := &api.Job{Id: "job2", PodSpec: multiContainerPodSpec}
+	job3 := &api.Job{Id: "job3", PodSpec: multiContainerPodSpec}
+	job4 := &api.Job{Id: "job4", PodSpec: multiContainerPodSpec}
 
-	assert.Equal(t, len(result), 1)
-	assert.Equal(t, result[queue1].remainingSchedulingLimit, common.ComputeResourcesFloat{"cpu": 100.0})
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{"queue1": {job1, job2, job3, job4}},
+	}
+
+	c := leaseContext{
+		ctx: context.Background(),
+		schedulingConfig: &configuration.SchedulingConfig{
+			QueueLeaseBatchSize: 10,
+		},
+		onJobsLeased: func(a []*api.Job) {},
+		request:      &api.LeaseRequest{ClusterId: "c1", Resources: common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("1Gi")}},
+		repository:   repository,
+		queueCache:   map[string][]*api.Job{},
+	}
+
+	// every job effectively requests 3 cpu (max of containers sum 2 and init container 3)
+	slice := common.ComputeResourcesFloat{"cpu": 9.5, "memory": 100 * 1024 * 1024}
+	jobs, remainder, e := c.leaseJobs(queue1, slice, 10)
+	assert.Nil(t, e)
+	assert.Equal(t, []*api.Job{job1, job2, job3}, jobs)
+	assert.Equal(t, 0.5, remainder["cpu"])
+	assert.Equal(t, []*api.Job{job4}, c.queueCache["queue1"])
 }
 
-func Test_calculateQueueSchedulingLimits_WithCustomQueueLimitsLessThanGlobal(t *testing.T) {
-	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1, ResourceLimits: map[string]float64{"cpu": 0.3}}
-	activeQueues := []*api.Queue{queue1}
-	schedulingLimitPerQueue := common.ComputeResourcesFloat{"cpu": 300.0}
-	resourceLimitPerQueue := common.ComputeResourcesFloat{"cpu": 400.0}
-	totalCapacity := &common.ComputeResources{"cpu": resource.MustParse("1000")}
-	currentQueueResourceAllocation := map[string]common.ComputeResources{queue1.Name: {"cpu": resource.MustParse("250")}}
+func Test_LeaseJobs_DoesNotLeaseMoreThanMaxJobsPerLeaseRequest(t *testing.T) {
+	for _, probabilistic := range []bool{false, true} {
+		queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+		queue2 := &api.Queue{Name: "queue2", PriorityFactor: 1}
+		repository := &fakeJobQueueRepository{
+			jobsByQueue: map[string][]*api.Job{
+				"queue1": createJobs("queue1", 100),
+				"queue2": createJobs("queue2", 100),
+			},
+		}
+		config := leaseTestConfig()
+		config.UseProbabilisticSchedulingForAllResources = probabilistic
+		config.MaxJobsPerLeaseRequest = 15
 
-	result := calculateQueueSchedulingLimits(activeQueues, schedulingLimitPerQueue, resourceLimitPerQueue, totalCapacity, currentQueueResourceAllocation)
+		jobs, e := leaseTestJobs(config, repository, []*api.Queue{queue1, queue2})
+		assert.Nil(t, e)
+		assert.Equal(t, 15, len(jobs))
+	}
+}
 
-	assert.Equal(t, len(result), 1)
-	assert.Equal(t, result[queue1].remainingSchedulingLimit, common.ComputeResourcesFloat{"cpu": 50.0})
+func Test_LeaseJobs_ReturnsNoJobsWhenLessThanMinJobsToLease(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	queue1Jobs := createJobs("queue1", 2)
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{"queue1": queue1Jobs},
+	}
+	config := leaseTestConfig()
+	config.MinJobsToLease = 3
+
+	jobs, e := leaseTestJobs(config, repository, []*api.Queue{queue1})
+	assert.Nil(t, e)
+	assert.Empty(t, jobs)
+	assert.Equal(t, []string{queue1Jobs[0].Id, queue1Jobs[1].Id}, repository.returnedJobIds)
+}
+
+func Test_LeaseJobs_ConfiguredResourceScarcityChangesWinningQueue(t *testing.T) {
+	assert.Equal(t, "queue2", leaseContestedJob(t, map[string]float64{}))
+	assert.Equal(t, "queue1", leaseContestedJob(t, map[string]float64{"nvidia.com/gpu": 100}))
+}
+
+// queue1 uses 8 cpu, queue2 uses 1 gpu and only one job can be leased
+func leaseContestedJob(t *testing.T, resourceScarcity map[string]float64) string {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	queue2 := &api.Queue{Name: "queue2", PriorityFactor: 1}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{
+			"queue1": createJobs("queue1", 5),
+			"queue2": createJobs("queue2", 5),
+		},
+	}
+	config := leaseTestConfig()
+	config.ResourceScarcity = resourceScarcity
+
+	capacity := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi"), "nvidia.com/gpu": resource.MustParse("10")}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"c1": {
+			ClusterId:                "c1",
+			ClusterCapacity:          capacity,
+			ClusterAvailableCapacity: capacity,
+			Queues: []*api.QueueReport{
+				{Name: "queue1", Resources: common.ComputeResources{"cpu": resource.MustParse("8")}},
+				{Name: "queue2", Resources: common.ComputeResources{"nvidia.com/gpu": resource.MustParse("1")}},
+			},
+		},
+	}
+
+	jobs, e := LeaseJobs(
+		context.Background(),
+		config,
+		repository,
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) {},
+		nil,
+		nil,
+		&api.LeaseRequest{ClusterId: "c1", Resources: common.ComputeResources{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")}},
+		clusterReports,
+		map[string]*api.ClusterLeasedReport{},
+		nil,
+		map[string]map[string]float64{},
+		[]*api.Queue{queue1, queue2})
+
+	assert.Nil(t, e)
+	assert.Equal(t, 1, len(jobs))
+	return jobs[0].Queue
+}
+
+func Test_LeaseJobs_AgingLetsLongWaitingJobLeaseAheadOfHigherPriorityWork(t *testing.T) {
+	trials := 300
+	assert.True(t, countLeasesOfWaitingJob(t, 0, trials) < trials/2)
+	assert.True(t, countLeasesOfWaitingJob(t, 1, trials) > trials/2)
+}
+
+func countLeasesOfWaitingJob(t *testing.T, agingFactor float64, trials int) int {
+	config := leaseTestConfig()
+	config.UseProbabilisticSchedulingForAllResources = true
+	config.MaxJobsPerLeaseRequest = 1
+	config.AgingFactor = agingFactor
+
+	freshQueue := &api.Queue{Name: "fresh", PriorityFactor: 1}
+	waitingQueue := &api.Queue{Name: "waiting", PriorityFactor: 2}
+	capacity := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}
+
+	count := 0
+	for i := 0; i < trials; i++ {
+		waitingJob := createJobs(waitingQueue.Name, 1)[0]
+		waitingJob.Created = time.Now().Add(-48 * time.Hour)
+		freshJob := createJobs(freshQueue.Name, 1)[0]
+		freshJob.Created = time.Now()
+
+		repository := &fakeJobQueueRepository{
+			jobsByQueue: map[string][]*api.Job{
+				freshQueue.Name:   {freshJob},
+				waitingQueue.Name: {waitingJob},
+			},
+		}
+		jobs, e := LeaseJobs(
+			context.Background(),
+			config,
+			repository,
+			func(jobs []*api.Job) {},
+			func(denials []*LeaseDenial) {},
+			nil,
+			nil,
+			&api.LeaseRequest{ClusterId: "c1", Resources: common.ComputeResources{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")}},
+			map[string]*api.ClusterUsageReport{"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity}},
+			map[string]*api.ClusterLeasedReport{},
+			nil,
+			map[string]map[string]float64{"c1": {freshQueue.Name: 1, waitingQueue.Name: 1}},
+			[]*api.Queue{freshQueue, waitingQueue})
+
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(jobs))
+		if len(jobs) == 1 && jobs[0] == waitingJob {
+			count++
+		}
+	}
+	return count
+}
+
+func Test_LeaseJobs_ClusterFairnessWindowPreventsClusterFromStarving(t *testing.T) {
+	leasedWithoutWindow := leaseAlternatingClusters(t, false)
+	assert.Equal(t, 0, leasedWithoutWindow["c2"])
+
+	leasedWithWindow := leaseAlternatingClusters(t, true)
+	assert.True(t, leasedWithWindow["c1"] > 0)
+	assert.Equal(t, leasedWithWindow["c1"], leasedWithWindow["c2"])
+}
+
+// leaseAlternatingClusters lets two clusters of the same size lease jobs from one queue limited to half of total capacity
+func leaseAlternatingClusters(t *testing.T, useWindow bool) map[string]int {
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{"queue1": createJobs("queue1", 100)},
+	}
+	config := leaseTestConfig()
+	config.MaximalResourceFractionPerQueue = map[string]float64{"cpu": 0.5, "memory": 1}
+
+	capacity := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+		"c2": {ClusterId: "c2", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+	}
+	leasedReports := map[string]*api.ClusterLeasedReport{}
+	var leasesInWindow map[string]*api.ClusterLeasedReport
+	if useWindow {
+		leasesInWindow = map[string]*api.ClusterLeasedReport{}
+	}
+
+	leasedJobCount := map[string]int{}
+	for i := 0; i < 4; i++ {
+		for _, clusterId := range []string{"c1", "c2"} {
+			jobs, e := LeaseJobs(
+				context.Background(),
+				config,
+				repository,
+				func(jobs []*api.Job) {},
+				func(denials []*LeaseDenial) {},
+				nil,
+				nil,
+				&api.LeaseRequest{ClusterId: clusterId, Resources: capacity},
+				clusterReports,
+				leasedReports,
+				leasesInWindow,
+				map[string]map[string]float64{},
+				[]*api.Queue{queue})
+			assert.Nil(t, e)
+
+			leasedJobCount[clusterId] += len(jobs)
+			currentReport, ok := leasedReports[clusterId]
+			if !ok {
+				currentReport = &api.ClusterLeasedReport{ClusterId: clusterId}
+			}
+			leasedReports[clusterId] = CreateClusterLeasedReport(clusterId, currentReport, jobs)
+			if useWindow {
+				leasesInWindow[clusterId] = leasedReports[clusterId]
+			}
+		}
+	}
+	return leasedJobCount
+}
+
+func Test_LeaseJobs_ClusterWeightsLeaveJobsForPreferredCluster(t *testing.T) {
+	leasedWithoutWeights := leaseFromWeightedClusters(t, nil)
+	assert.Equal(t, 10, leasedWithoutWeights["overflow"])
+	assert.Equal(t, 5, leasedWithoutWeights["preferred"])
+
+	leasedWithWeights := leaseFromWeightedClusters(t, map[string]float64{"preferred": 2})
+	assert.Equal(t, 5, leasedWithWeights["overflow"])
+	assert.Equal(t, 10, leasedWithWeights["preferred"])
+}
+
+// leaseFromWeightedClusters lets the overflow cluster lease jobs first, then the preferred cluster of the same size,
+// the queue has 15 jobs, more than one cluster can run
+func leaseFromWeightedClusters(t *testing.T, weights map[string]float64) map[string]int {
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{"queue1": createJobs("queue1", 15)},
+	}
+	config := leaseTestConfig()
+	config.ClusterWeights = weights
+
+	capacity := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"preferred": {ClusterId: "preferred", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+		"overflow":  {ClusterId: "overflow", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+	}
+	leasedReports := map[string]*api.ClusterLeasedReport{}
+
+	leasedJobCount := map[string]int{}
+	for _, clusterId := range []string{"overflow", "preferred"} {
+		jobs, e := LeaseJobs(
+			context.Background(),
+			config,
+			repository,
+			func(jobs []*api.Job) {},
+			func(denials []*LeaseDenial) {},
+			nil,
+			nil,
+			&api.LeaseRequest{ClusterId: clusterId, Resources: capacity},
+			clusterReports,
+			leasedReports,
+			nil,
+			map[string]map[string]float64{},
+			[]*api.Queue{queue})
+		assert.Nil(t, e)
+
+		leasedJobCount[clusterId] += len(jobs)
+		leasedReports[clusterId] = CreateClusterLeasedReport(clusterId, &api.ClusterLeasedReport{ClusterId: clusterId}, jobs)
+	}
+	return leasedJobCount
+}
+
+func Test_preferredClustersFreeCapacity(t *testing.T) {
+	capacity := common.ComputeResources{"cpu": resource.MustParse("10")}
+	reports := map[string]*api.ClusterUsageReport{
+		"a": {ClusterId: "a", ClusterAvailableCapacity: capacity},
+		"b": {ClusterId: "b", ClusterAvailableCapacity: capacity},
+		"c": {ClusterId: "c", ClusterAvailableCapacity: capacity},
+	}
+	leased := map[string]*api.ClusterLeasedReport{
+		"a": {ClusterId: "a", Queues: []*api.QueueLeasedReport{{Name: "q", ResourcesLeased: common.ComputeResources{"cpu": resource.MustParse("4")}}}},
+	}
+	weights := map[string]float64{"a": 3, "b": 2}
+
+	assert.Nil(t, preferredClustersFreeCapacity(nil, "c", reports, leased))
+	assert.Nil(t, preferredClustersFreeCapacity(weights, "a", reports, leased))
+	assert.Equal(t, common.ComputeResourcesFloat{"cpu": 6}, preferredClustersFreeCapacity(weights, "b", reports, leased))
+	assert.Equal(t, common.ComputeResourcesFloat{"cpu": 16}, preferredClustersFreeCapacity(weights, "c", reports, leased))
+}
+
+func Test_LeaseJobs_JobIsLeftForPreferredClusterWithCapacity(t *testing.T) {
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	sticky := &api.Job{Id: "sticky", Queue: "queue1", PodSpec: classicPodSpec, PreferredCluster: "preferred"}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{"queue1": append([]*api.Job{sticky}, createJobs("queue1", 2)...)},
+	}
+
+	capacity := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"preferred": {ClusterId: "preferred", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+		"other":     {ClusterId: "other", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+	}
+
+	leased := leaseJobsForCluster(t, repository, "other", clusterReports, map[string]*api.ClusterLeasedReport{}, queue)
+	assert.ElementsMatch(t, []string{"queue1-job0", "queue1-job1"}, jobIds(leased))
+
+	leased = leaseJobsForCluster(t, repository, "preferred", clusterReports, map[string]*api.ClusterLeasedReport{}, queue)
+	assert.Equal(t, []string{"sticky"}, jobIds(leased))
+}
+
+func Test_LeaseJobs_JobPreferringClusterWithoutCapacityIsLeasedElsewhere(t *testing.T) {
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	sticky := &api.Job{Id: "sticky", Queue: "queue1", PodSpec: classicPodSpec, PreferredCluster: "preferred"}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{"queue1": {sticky}},
+	}
+
+	capacity := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"preferred": {ClusterId: "preferred", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+		"other":     {ClusterId: "other", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+	}
+	leasedReports := map[string]*api.ClusterLeasedReport{
+		"preferred": {ClusterId: "preferred", Queues: []*api.QueueLeasedReport{{Name: "queue2", ResourcesLeased: capacity}}},
+	}
+
+	leased := leaseJobsForCluster(t, repository, "other", clusterReports, leasedReports, queue)
+	assert.Equal(t, []string{"sticky"}, jobIds(leased))
+}
+
+func leaseJobsForCluster(
+	t *testing.T,
+	repository *fakeJobQueueRepository,
+	clusterId string,
+	clusterReports map[string]*api.ClusterUsageReport,
+	leasedReports map[string]*api.ClusterLeasedReport,
+	queue *api.Queue) []*api.Job {
+
+	jobs, e := LeaseJobs(
+		context.Background(),
+		leaseTestConfig(),
+		repository,
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) {},
+		nil,
+		nil,
+		&api.LeaseRequest{ClusterId: clusterId, Resources: clusterReports[clusterId].ClusterAvailableCapacity},
+		clusterReports,
+		leasedReports,
+		nil,
+		map[string]map[string]float64{},
+		[]*api.Queue{queue})
+	assert.Nil(t, e)
+	return jobs
+}
+
+func Test_LeaseJobs_ReservedResourcesAreNotLeased(t *testing.T) {
+	assert.Equal(t, 10, leaseFromClusterWithReservedResources(t, nil))
+	assert.Equal(t, 7, leaseFromClusterWithReservedResources(t, common.ComputeResourcesFloat{"cpu": 3}))
+	assert.Equal(t, 0, leaseFromClusterWithReservedResources(t, common.ComputeResourcesFloat{"cpu": 20}))
+}
+
+// leaseFromClusterWithReservedResources leases from a cluster with 10 cpus available, the queue has more 1 cpu jobs
+// than the cluster can run
+func leaseFromClusterWithReservedResources(t *testing.T, reserved common.ComputeResourcesFloat) int {
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{"queue1": createJobs("queue1", 15)},
+	}
+	config := leaseTestConfig()
+	config.ReservedResources = reserved
+
+	capacity := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+	}
+
+	jobs, e := LeaseJobs(
+		context.Background(),
+		config,
+		repository,
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) {},
+		nil,
+		nil,
+		&api.LeaseRequest{ClusterId: "c1", Resources: capacity},
+		clusterReports,
+		map[string]*api.ClusterLeasedReport{},
+		nil,
+		map[string]map[string]float64{},
+		[]*api.Queue{queue})
+	assert.Nil(t, e)
+	availableCpu := clusterReports["c1"].ClusterAvailableCapacity["cpu"]
+	assert.Equal(t, "10", availableCpu.String(), "reports must not be modified")
+	return len(jobs)
+}
+
+func Test_withoutReservedResources(t *testing.T) {
+	capacity := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("1Gi")}
+	reports := map[string]*api.ClusterUsageReport{
+		"a": {ClusterId: "a", ClusterAvailableCapacity: capacity},
+	}
+
+	result := withoutReservedResources(reports, common.ComputeResourcesFloat{"cpu": 2.5, "memory": 2 * 1024 * 1024 * 1024})
+
+	cpu := result["a"].ClusterAvailableCapacity["cpu"]
+	memory := result["a"].ClusterAvailableCapacity["memory"]
+	assert.Equal(t, "7500m", cpu.String())
+	assert.Equal(t, "0", memory.String())
+	assert.Equal(t, "a", result["a"].ClusterId)
+}
+
+func Test_LeaseJobs_CapacityFractionLimitsTotalLeasedResourcesOfCluster(t *testing.T) {
+	assert.Equal(t, 10, leaseFromClusterWithCapacityFraction(t, nil, nil))
+	assert.Equal(t, 5, leaseFromClusterWithCapacityFraction(t, map[string]float64{"c1": 0.5}, nil))
+	assert.Equal(t, 10, leaseFromClusterWithCapacityFraction(t, map[string]float64{"c2": 0.5}, nil))
+
+	leased := common.ComputeResources{"cpu": resource.MustParse("3"), "memory": resource.MustParse("3Mi")}
+	assert.Equal(t, 2, leaseFromClusterWithCapacityFraction(t, map[string]float64{"c1": 0.5}, leased))
+
+	leased = common.ComputeResources{"cpu": resource.MustParse("6"), "memory": resource.MustParse("6Mi")}
+	assert.Equal(t, 0, leaseFromClusterWithCapacityFraction(t, map[string]float64{"c1": 0.5}, leased))
+}
+
+// leaseFromClusterWithCapacityFraction leases from a cluster with 10 cpus available which already runs jobs requesting
+// the leased resources, the queue has more 1 cpu jobs than the cluster can run
+func leaseFromClusterWithCapacityFraction(t *testing.T, fractions map[string]float64, leased common.ComputeResources) int {
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{"queue1": createJobs("queue1", 15)},
+	}
+	config := leaseTestConfig()
+	config.ClusterCapacityFractions = fractions
+
+	capacity := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+	}
+	leasedReport := api.ClusterLeasedReport{ClusterId: "c1"}
+	if leased != nil {
+		leasedReport.Queues = []*api.QueueLeasedReport{{Name: "queue1", ResourcesLeased: leased}}
+	}
+
+	jobs, e := LeaseJobs(
+		context.Background(),
+		config,
+		repository,
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) {},
+		nil,
+		nil,
+		&api.LeaseRequest{ClusterId: "c1", Resources: capacity, ClusterLeasedReport: leasedReport},
+		clusterReports,
+		map[string]*api.ClusterLeasedReport{"c1": &leasedReport},
+		nil,
+		map[string]map[string]float64{},
+		[]*api.Queue{queue})
+	assert.Nil(t, e)
+
+	total := common.ComputeResources{}
+	for _, job := range jobs {
+		total.Add(common.TotalResourceRequest(job.PodSpec))
+	}
+	total.Add(leased)
+	// a cluster already over its fraction leases nothing, so only clusters leasing jobs are checked
+	if fraction, ok := fractions["c1"]; ok && len(jobs) > 0 {
+		assert.True(t, total.AsFloat()["cpu"] <= fraction*10, "leased jobs must not use more than the fraction of capacity")
+	}
+	return len(jobs)
+}
+
+func Test_LeaseJobs_GlobalResourceCeilingStopsLeasingAcrossAllQueues(t *testing.T) {
+	for _, probabilistic := range []bool{false, true} {
+		assert.Equal(t, 20, leaseBelowGlobalResourceCeiling(t, nil, probabilistic))
+		// half of 40 cpus of both clusters, other cluster already leased 12 of them
+		assert.Equal(t, 8, leaseBelowGlobalResourceCeiling(t, map[string]float64{"cpu": 0.5}, probabilistic))
+		assert.Equal(t, 0, leaseBelowGlobalResourceCeiling(t, map[string]float64{"cpu": 0.25}, probabilistic))
+		assert.Equal(t, 20, leaseBelowGlobalResourceCeiling(t, map[string]float64{"nvidia.com/gpu": 0.5}, probabilistic))
+	}
+}
+
+// leaseBelowGlobalResourceCeiling leases for a cluster with 20 cpus available from two queues without limits, each
+// with more 1 cpu jobs than the cluster can run, while another cluster with 20 cpus already runs jobs requesting 12 cpus
+func leaseBelowGlobalResourceCeiling(t *testing.T, ceiling map[string]float64, probabilistic bool) int {
+	queues := []*api.Queue{{Name: "queue1", PriorityFactor: 1}, {Name: "queue2", PriorityFactor: 1}}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{"queue1": createJobs("queue1", 30), "queue2": createJobs("queue2", 30)},
+	}
+	config := leaseTestConfig()
+	config.GlobalResourceCeiling = ceiling
+	config.UseProbabilisticSchedulingForAllResources = probabilistic
+
+	capacity := common.ComputeResources{"cpu": resource.MustParse("20"), "memory": resource.MustParse("20Gi")}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+		"c2": {ClusterId: "c2", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+	}
+	leasedReport := api.ClusterLeasedReport{ClusterId: "c1"}
+	otherLeasedReport := api.ClusterLeasedReport{ClusterId: "c2", Queues: []*api.QueueLeasedReport{{
+		Name:            "queue1",
+		ResourcesLeased: common.ComputeResources{"cpu": resource.MustParse("12"), "memory": resource.MustParse("12Mi")},
+	}}}
+
+	jobs, e := LeaseJobs(
+		context.Background(),
+		config,
+		repository,
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) {},
+		nil,
+		nil,
+		&api.LeaseRequest{ClusterId: "c1", Resources: capacity, ClusterLeasedReport: leasedReport},
+		clusterReports,
+		map[string]*api.ClusterLeasedReport{"c1": &leasedReport, "c2": &otherLeasedReport},
+		nil,
+		map[string]map[string]float64{},
+		queues)
+	assert.Nil(t, e)
+	return len(jobs)
+}
+
+func Test_LeaseJobs_FractionalSharedGpuJobsShareSingleAdvertisedGpu(t *testing.T) {
+	assert.Equal(t, 2, leaseFractionalSharedGpuJobs(t, "500m", 1))
+	assert.Equal(t, 4, leaseFractionalSharedGpuJobs(t, "250m", 2))
+	assert.Equal(t, 10, leaseFractionalSharedGpuJobs(t, "100m", 3))
+	assert.Equal(t, 3, leaseFractionalSharedGpuJobs(t, "300m", 3))
+}
+
+// leaseFractionalSharedGpuJobs leases for a cluster advertising a single shared GPU from queues, each with more jobs
+// requesting the fraction of shared GPU than the cluster can run
+func leaseFractionalSharedGpuJobs(t *testing.T, gpu string, queueCount int) int {
+	queues := []*api.Queue{}
+	jobsByQueue := map[string][]*api.Job{}
+	for i := 1; i <= queueCount; i++ {
+		name := fmt.Sprintf("queue%d", i)
+		queues = append(queues, &api.Queue{Name: name, PriorityFactor: 1})
+		for j := 0; j < 20; j++ {
+			jobsByQueue[name] = append(jobsByQueue[name], createJobWithSharedGpu(name, fmt.Sprintf("%s-job%d", name, j), gpu))
+		}
+	}
+	repository := &fakeJobQueueRepository{jobsByQueue: jobsByQueue}
+
+	capacity := common.ComputeResources{
+		"cpu":                        resource.MustParse("100"),
+		"memory":                     resource.MustParse("100Gi"),
+		common.SharedGpuResourceName: resource.MustParse("1"),
+	}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+	}
+	leasedReport := api.ClusterLeasedReport{ClusterId: "c1"}
+
+	jobs, e := LeaseJobs(
+		context.Background(),
+		leaseTestConfig(),
+		repository,
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) {},
+		nil,
+		nil,
+		&api.LeaseRequest{ClusterId: "c1", Resources: capacity, ClusterLeasedReport: leasedReport},
+		clusterReports,
+		map[string]*api.ClusterLeasedReport{"c1": &leasedReport},
+		nil,
+		map[string]map[string]float64{},
+		queues)
+	assert.Nil(t, e)
+
+	leased := resource.MustParse("0")
+	for _, job := range jobs {
+		leased.Add(job.PodSpec.Containers[0].Resources.Requests[common.SharedGpuResourceName])
+	}
+	assert.True(t, leased.Cmp(resource.MustParse("1")) <= 0)
+	return len(jobs)
+}
+
+func Test_fits_ToleratesFloatingPointErrorsOfFractions(t *testing.T) {
+	available := common.ComputeResourcesFloat{common.SharedGpuResourceName: 0.3}
+	available.Sub(common.ComputeResourcesFloat{common.SharedGpuResourceName: 0.1})
+	available.Sub(common.ComputeResourcesFloat{common.SharedGpuResourceName: 0.1})
+
+	assert.True(t, fits(common.ComputeResourcesFloat{common.SharedGpuResourceName: 0.1}, available))
+	assert.False(t, fits(common.ComputeResourcesFloat{common.SharedGpuResourceName: 0.2}, available))
+}
+
+func Test_LeaseJobs_MaxConcurrentJobsLimitsLeasedJobsOfQueue(t *testing.T) {
+	leased, repository, _ := leaseFromQueueWithConcurrencyLimit(t, 0, 0)
+	assert.Equal(t, 20, len(leased))
+
+	leased, repository, _ = leaseFromQueueWithConcurrencyLimit(t, 5, 0)
+	assert.Equal(t, 5, len(leased))
+	assert.Equal(t, 15, len(repository.jobsByQueue["queue1"]), "jobs over the limit stay queued")
+
+	leased, repository, _ = leaseFromQueueWithConcurrencyLimit(t, 5, 3)
+	assert.Equal(t, 2, len(leased))
+	assert.Equal(t, 18, len(repository.jobsByQueue["queue1"]))
+
+	leased, repository, denied := leaseFromQueueWithConcurrencyLimit(t, 5, 5)
+	assert.Equal(t, 0, len(leased))
+	assert.Equal(t, 20, len(repository.jobsByQueue["queue1"]))
+	for _, denial := range <-denied {
+		assert.Equal(t, api.LeaseDeniedReason_QueueLimitReached, denial.Reason)
+	}
+}
+
+// leaseFromQueueWithConcurrencyLimit leases from a cluster with resources for all 20 jobs of the queue,
+// the queue already has the given number of jobs leased
+func leaseFromQueueWithConcurrencyLimit(t *testing.T, maxConcurrentJobs uint32, leasedJobs int64) ([]*api.Job, *fakeJobQueueRepository, chan []*LeaseDenial) {
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1, MaxConcurrentJobs: maxConcurrentJobs}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue:     map[string][]*api.Job{"queue1": createJobs("queue1", 20)},
+		leasedJobCounts: map[string]int64{"queue1": leasedJobs},
+	}
+
+	capacity := common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+	}
+	leasedReport := api.ClusterLeasedReport{ClusterId: "c1"}
+	denied := make(chan []*LeaseDenial, 1)
+
+	jobs, e := LeaseJobs(
+		context.Background(),
+		leaseTestConfig(),
+		repository,
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) { denied <- denials },
+		nil,
+		nil,
+		&api.LeaseRequest{ClusterId: "c1", Resources: capacity, ClusterLeasedReport: leasedReport},
+		clusterReports,
+		map[string]*api.ClusterLeasedReport{"c1": &leasedReport},
+		nil,
+		map[string]map[string]float64{},
+		[]*api.Queue{queue})
+	assert.Nil(t, e)
+	return jobs, repository, denied
+}
+
+func Test_LeaseJobs_BackfillLeasesSmallJobsLeftByFairShare(t *testing.T) {
+	assert.Equal(t, 1, len(leaseJobsOfMixedSizes(t, false)))
+
+	jobs := leaseJobsOfMixedSizes(t, true)
+	assert.Equal(t, 3, len(jobs))
+	assert.Equal(t, "small1", jobs[1].Id)
+	assert.Equal(t, "small2", jobs[2].Id)
+}
+
+func leaseJobsOfMixedSizes(t *testing.T, useBackfill bool) []*api.Job {
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{"queue1": {
+			createJobWithCpu("queue1", "big1", "4"),
+			createJobWithCpu("queue1", "big2", "4"),
+			createJobWithCpu("queue1", "small1", "0.5"),
+			createJobWithCpu("queue1", "small2", "0.5"),
+			createJobWithCpu("queue1", "small3", "0.5"),
+		}},
+	}
+	config := leaseTestConfig()
+	config.UseProbabilisticSchedulingForAllResources = true
+	config.MinimumResourceToSchedule = common.ComputeResourcesFloat{"cpu": 1.5}
+	config.UseBackfill = useBackfill
+
+	capacity := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}
+	jobs, e := LeaseJobs(
+		context.Background(),
+		config,
+		repository,
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) {},
+		nil,
+		nil,
+		&api.LeaseRequest{ClusterId: "c1", Resources: common.ComputeResources{"cpu": resource.MustParse("5"), "memory": resource.MustParse("10Gi")}},
+		map[string]*api.ClusterUsageReport{"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity}},
+		map[string]*api.ClusterLeasedReport{},
+		nil,
+		map[string]map[string]float64{},
+		[]*api.Queue{queue})
+
+	assert.Nil(t, e)
+	assert.Equal(t, "big1", jobs[0].Id)
+	return jobs
+}
+
+func Test_LeaseJobs_UsesQueueLeaseBatchSize(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1, LeaseBatchSize: 50}
+	queue2 := &api.Queue{Name: "queue2", PriorityFactor: 1}
+
+	repository := &peekRecordingJobQueueRepository{
+		fakeJobQueueRepository: fakeJobQueueRepository{jobsByQueue: map[string][]*api.Job{
+			"queue1": createJobs("queue1", 100),
+			"queue2": createJobs("queue2", 100),
+		}},
+		limitsByQueue: map[string][]int64{},
+	}
+
+	_, e := leaseTestJobs(leaseTestConfig(), repository, []*api.Queue{queue1, queue2})
+	assert.Nil(t, e)
+
+	assert.NotEmpty(t, repository.limitsByQueue["queue1"])
+	for _, limit := range repository.limitsByQueue["queue1"] {
+		assert.Equal(t, int64(50), limit)
+	}
+	assert.NotEmpty(t, repository.limitsByQueue["queue2"])
+	for _, limit := range repository.limitsByQueue["queue2"] {
+		assert.Equal(t, int64(10), limit)
+	}
+}
+
+func Test_LeaseJobs_LeasesJobsInOrderOfQueueJobOrdering(t *testing.T) {
+	assert.Equal(t, []string{"big", "medium", "small"}, leaseIdsWithJobOrdering(t, api.JobOrderingStrategy_Priority))
+	assert.Equal(t, []string{"medium", "small", "big"}, leaseIdsWithJobOrdering(t, api.JobOrderingStrategy_FIFO))
+	assert.Equal(t, []string{"small", "medium", "big"}, leaseIdsWithJobOrdering(t, api.JobOrderingStrategy_ShortestResourceFirst))
+}
+
+// leaseIdsWithJobOrdering leases the same jobs of different priority, age and size from a queue with the job ordering
+func leaseIdsWithJobOrdering(t *testing.T, ordering api.JobOrderingStrategy) []string {
+	now := time.Now()
+	big := createJobWithCpu("queue1", "big", "3")
+	big.Priority, big.Created = 1, now.Add(2*time.Minute)
+	medium := createJobWithCpu("queue1", "medium", "2")
+	medium.Priority, medium.Created = 2, now
+	small := createJobWithCpu("queue1", "small", "1")
+	small.Priority, small.Created = 3, now.Add(time.Minute)
+
+	queue := &api.Queue{Name: "queue1", PriorityFactor: 1, JobOrdering: ordering}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{"queue1": {small, big, medium}},
+	}
+
+	jobs, e := leaseTestJobs(leaseTestConfig(), repository, []*api.Queue{queue})
+	assert.Nil(t, e)
+
+	ids := []string{}
+	for _, job := range jobs {
+		ids = append(ids, job.Id)
+	}
+	return ids
+}
+
+func Test_LeaseJobs_FractionalGpuShareLeasesWholeGpuJob(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	gpuJobs := []*api.Job{createJobWithGpu("queue1", "gpu1", "1"), createJobWithGpu("queue1", "gpu2", "1")}
+	repository := &fakeJobQueueRepository{jobsByQueue: map[string][]*api.Job{"queue1": gpuJobs}}
+
+	config := leaseTestConfig()
+	all := map[string]float64{"cpu": 1, "memory": 1, common.GpuResourceName: 1}
+	config.MaximalClusterFractionToSchedule = all
+	config.MaximalResourceFractionPerQueue = all
+	// 1.7 GPU of 10
+	config.MaximalResourceFractionToSchedulePerQueue = map[string]float64{"cpu": 1, "memory": 1, common.GpuResourceName: 0.17}
+
+	capacity := common.ComputeResources{"cpu": resource.MustParse("1000"), "memory": resource.MustParse("1000Gi"), common.GpuResourceName: resource.MustParse("10")}
+	leased, e := LeaseJobs(
+		context.Background(),
+		config,
+		repository,
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) {},
+		nil,
+		nil,
+		&api.LeaseRequest{ClusterId: "c1", Resources: capacity},
+		map[string]*api.ClusterUsageReport{"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity}},
+		map[string]*api.ClusterLeasedReport{},
+		nil,
+		map[string]map[string]float64{},
+		[]*api.Queue{queue1})
+
+	assert.Nil(t, e)
+	assert.Equal(t, 1, len(leased))
+	assert.Equal(t, "gpu1", leased[0].Id)
+}
+
+func Test_LeaseJobs_TracesSchedulingStepsInLeaseRequestSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	repository := &fakeJobQueueRepository{jobsByQueue: map[string][]*api.Job{"queue1": createJobs("queue1", 3)}}
+	config := leaseTestConfig()
+	config.UseProbabilisticSchedulingForAllResources = false
+
+	requestCtx, requestSpan := tracing.StartSpan(context.Background(), "request")
+	leased, e := leaseTestJobsWithContext(requestCtx, config, repository, []*api.Queue{queue1})
+	requestSpan.End()
+	assert.Nil(t, e)
+	assert.Equal(t, 3, len(leased))
+
+	spans := recorder.Ended()
+	names := []string{}
+	attributes := map[string][]attribute.KeyValue{}
+	for _, span := range spans[:len(spans)-1] {
+		names = append(names, span.Name())
+		attributes[span.Name()] = span.Attributes()
+		assert.Equal(t, requestSpan.SpanContext().SpanID(), span.Parent().SpanID())
+	}
+	assert.Equal(t, []string{"leaseGuaranteedResources", "assignJobs", "distributeRemainder"}, names)
+	assert.Contains(t, attributes["assignJobs"], attribute.Int("jobCount", 3))
+	assert.Contains(t, attributes["assignJobs"], attribute.String("clusterId", "c1"))
+}
+
+type peekRecordingJobQueueRepository struct {
+	fakeJobQueueRepository
+	limitsByQueue map[string][]int64
+}
+
+func (r *peekRecordingJobQueueRepository) PeekQueue(queue string, limit int64) ([]*api.Job, error) {
+	r.limitsByQueue[queue] = append(r.limitsByQueue[queue], limit)
+	return r.fakeJobQueueRepository.PeekQueue(queue, limit)
+}
+
+func Test_LeaseJobs_ReportsReasonsOfDeniedJobs(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	queue2 := &api.Queue{Name: "queue2", PriorityFactor: 1, ResourceLimits: map[string]float64{"cpu": 0.005}}
+
+	gpuJob := createJobWithCpu("queue1", "gpu", "1")
+	gpuJob.RequiredNodeLabels = map[string]string{"gpu": "a100"}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{
+			"queue1": {createJobWithCpu("queue1", "small", "1"), gpuJob, createJobWithCpu("queue1", "big", "20")},
+			"queue2": {createJobWithCpu("queue2", "overLimit", "8")},
+		},
+	}
+
+	denied := make(chan []*LeaseDenial, 1)
+	capacity := common.ComputeResources{"cpu": resource.MustParse("1000"), "memory": resource.MustParse("1000Gi")}
+	jobs, e := LeaseJobs(
+		context.Background(),
+		leaseTestConfig(),
+		repository,
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) { denied <- denials },
+		nil,
+		nil,
+		&api.LeaseRequest{ClusterId: "c1", Resources: common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}},
+		map[string]*api.ClusterUsageReport{"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity}},
+		map[string]*api.ClusterLeasedReport{},
+		nil,
+		map[string]map[string]float64{},
+		[]*api.Queue{queue1, queue2})
+
+	assert.Nil(t, e)
+	assert.Equal(t, 1, len(jobs))
+	assert.Equal(t, "small", jobs[0].Id)
+
+	reasons := map[string]api.LeaseDeniedReason{}
+	for _, denial := range <-denied {
+		reasons[denial.Job.Id] = denial.Reason
+	}
+	assert.Equal(t, map[string]api.LeaseDeniedReason{
+		"gpu":       api.LeaseDeniedReason_NoMatchingNodeLabels,
+		"big":       api.LeaseDeniedReason_InsufficientCapacity,
+		"overLimit": api.LeaseDeniedReason_QueueLimitReached,
+	}, reasons)
+}
+
+func Test_LeaseJobs_TraceExplainsJobsWhichWereNotLeased(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	queue2 := &api.Queue{Name: "queue2", PriorityFactor: 1, ResourceLimits: map[string]float64{"cpu": 0.005}}
+
+	gpuJob := createJobWithCpu("queue1", "gpu", "1")
+	gpuJob.RequiredNodeLabels = map[string]string{"gpu": "a100"}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{
+			"queue1": {createJobWithCpu("queue1", "small", "1"), gpuJob},
+			"queue2": {createJobWithCpu("queue2", "overLimit", "8")},
+		},
+	}
+
+	trace := &LeaseTraceCollector{}
+	capacity := common.ComputeResources{"cpu": resource.MustParse("1000"), "memory": resource.MustParse("1000Gi")}
+	jobs, e := LeaseJobs(
+		context.Background(),
+		leaseTestConfig(),
+		repository,
+		func(jobs []*api.Job) {},
+		trace.RecordDenials,
+		nil,
+		trace.RecordShares,
+		&api.LeaseRequest{ClusterId: "c1", Pool: "cpu", Debug: true, Resources: common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}},
+		map[string]*api.ClusterUsageReport{"c1": {ClusterId: "c1", Pool: "cpu", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity}},
+		map[string]*api.ClusterLeasedReport{},
+		nil,
+		map[string]map[string]float64{},
+		[]*api.Queue{queue1, queue2})
+
+	assert.Nil(t, e)
+	assert.Equal(t, []string{"small"}, jobIds(jobs))
+
+	result := trace.Trace()
+	assert.Equal(t, "cpu", result.Pool)
+	assert.Equal(t, 2, len(result.Queues))
+	assert.Equal(t, "queue1", result.Queues[0].Queue)
+	assert.Equal(t, "queue2", result.Queues[1].Queue)
+	assert.Equal(t, []*api.JobDenialTrace{
+		{JobId: "gpu", Queue: "queue1", Reason: "NoMatchingNodeLabels"},
+		{JobId: "overLimit", Queue: "queue2", Reason: "QueueLimitReached"},
+	}, result.Denials)
+}
+
+func Test_LeaseJobs_DoesNotLeaseJobWithMatchingLabelsExceedingAvailableCapacity(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+
+	bigJob := createJobWithCpu("queue1", "big", "1")
+	bigJob.PodSpec.Containers[0].Resources.Requests["memory"] = resource.MustParse("64Gi")
+	bigJob.PodSpec.Containers[0].Resources.Limits["memory"] = resource.MustParse("64Gi")
+	bigJob.RequiredNodeLabels = map[string]string{"armada/region": "eu"}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{"queue1": {bigJob}},
+	}
+
+	denied := make(chan []*LeaseDenial, 1)
+	capacity := common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("1000Gi")}
+	jobs, e := LeaseJobs(
+		context.Background(),
+		leaseTestConfig(),
+		repository,
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) { denied <- denials },
+		nil,
+		nil,
+		&api.LeaseRequest{
+			ClusterId:       "c1",
+			Resources:       common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("8Gi")},
+			AvailableLabels: []*api.NodeLabeling{{Labels: map[string]string{"armada/region": "eu"}}},
+		},
+		map[string]*api.ClusterUsageReport{"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity}},
+		map[string]*api.ClusterLeasedReport{},
+		nil,
+		map[string]map[string]float64{},
+		[]*api.Queue{queue1})
+
+	assert.Nil(t, e)
+	assert.Empty(t, jobs)
+	denials := <-denied
+	assert.Equal(t, 1, len(denials))
+	assert.Equal(t, "big", denials[0].Job.Id)
+	assert.Equal(t, api.LeaseDeniedReason_InsufficientCapacity, denials[0].Reason)
+}
+
+func Test_leaseJobs_DoesNotLeaseJobExceedingAvailableCapacityOfCluster(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	job := createJobWithCpu("queue1", "job1", "4")
+
+	c := leaseContext{
+		ctx:              context.Background(),
+		schedulingConfig: &configuration.SchedulingConfig{QueueLeaseBatchSize: 10},
+		onJobsLeased:     func(a []*api.Job) {},
+		request:          &api.LeaseRequest{ClusterId: "c1", Resources: common.ComputeResources{"cpu": resource.MustParse("2"), "memory": resource.MustParse("1Gi")}},
+		repository:       &fakeJobQueueRepository{jobsByQueue: map[string][]*api.Job{"queue1": {job}}},
+		queueCache:       map[string][]*api.Job{},
+	}
+
+	// the slice would hold the job, the capacity reported by the cluster would not
+	slice := common.ComputeResourcesFloat{"cpu": 10, "memory": 1024 * 1024 * 1024}
+	jobs, remainder, e := c.leaseJobs(queue1, slice, 10)
+	assert.Nil(t, e)
+	assert.Empty(t, jobs)
+	assert.Equal(t, slice, remainder)
+	assert.Equal(t, api.LeaseDeniedReason_InsufficientCapacity, c.denials["job1"].Reason)
+}
+
+func Test_leaseJobs_SkipsJobTakingLongerThanEvaluationTimeout(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	slowJob := createJobWithCpu("queue1", "slow", "1")
+	jobs := append([]*api.Job{slowJob}, createJobs("queue1", 5)...)
+
+	c := leaseContext{
+		ctx:              context.Background(),
+		schedulingConfig: &configuration.SchedulingConfig{QueueLeaseBatchSize: 10, JobEvaluationTimeout: 50 * time.Millisecond},
+		onJobsLeased:     func(a []*api.Job) {},
+		request:          &api.LeaseRequest{ClusterId: "c1", Resources: common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("1Gi")}},
+		repository:       &fakeJobQueueRepository{jobsByQueue: map[string][]*api.Job{"queue1": jobs}},
+		queueCache:       map[string][]*api.Job{},
+		labelMatcher: func(ctx context.Context, deadline time.Time, job *api.Job, request *api.LeaseRequest) (*api.NodeLabeling, bool, bool) {
+			if job.Id == "slow" {
+				// matching the slow job would take a second, it checks the deadline like matchNodeLabelingBefore
+				for end := time.Now().Add(time.Second); time.Now().Before(end); time.Sleep(time.Millisecond) {
+					if time.Now().After(deadline) {
+						return nil, false, true
+					}
+				}
+			}
+			return matchNodeLabelingBefore(ctx, deadline, job, request)
+		},
+	}
+
+	start := time.Now()
+	leased, _, e := c.leaseJobs(queue1, common.ComputeResourcesFloat{"cpu": 10, "memory": 1024 * 1024 * 1024}, 10)
+	assert.Nil(t, e)
+	assert.True(t, time.Since(start) < time.Second)
+	assert.Equal(t, sortedJobIds(jobs[1:]), sortedJobIds(leased))
+	// the slow job stays queued and is not denied
+	assert.Equal(t, []*api.Job{slowJob}, c.queueCache["queue1"])
+	assert.Empty(t, c.denials)
+	matched, timedOut := c.matchJobLabeling(slowJob)
+	assert.False(t, matched)
+	assert.True(t, timedOut)
+}
+
+func Test_matchNodeLabelingBefore_StopsAfterDeadlineOrCancellation(t *testing.T) {
+	job := createJobWithCpu("queue1", "job1", "1")
+	job.RequiredNodeLabels = map[string]string{"gpu": "true"}
+	request := &api.LeaseRequest{AvailableLabels: []*api.NodeLabeling{
+		{Labels: map[string]string{"gpu": "false"}},
+		{Labels: map[string]string{"gpu": "true"}},
+	}}
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for _, test := range []struct {
+		ctx      context.Context
+		deadline time.Time
+		matched  bool
+		timedOut bool
+	}{
+		{ctx: context.Background(), deadline: time.Time{}, matched: true, timedOut: false},
+		{ctx: context.Background(), deadline: time.Now().Add(time.Minute), matched: true, timedOut: false},
+		{ctx: context.Background(), deadline: time.Now().Add(-time.Second), matched: false, timedOut: true},
+		{ctx: cancelled, deadline: time.Time{}, matched: false, timedOut: true},
+	} {
+		_, matched, timedOut := matchNodeLabelingBefore(test.ctx, test.deadline, job, request)
+		assert.Equal(t, test.matched, matched)
+		assert.Equal(t, test.timedOut, timedOut)
+	}
+}
+
+func Test_leaseJobs_ElasticJobIsGrantedCpuOfSliceBetweenMinAndDesired(t *testing.T) {
+	for _, test := range []struct {
+		sliceCpu   float64
+		grantedCpu string
+	}{
+		{sliceCpu: 20, grantedCpu: "16"},
+		{sliceCpu: 16, grantedCpu: "16"},
+		{sliceCpu: 10, grantedCpu: "10"},
+		{sliceCpu: 6.5, grantedCpu: "6500m"},
+		{sliceCpu: 4, grantedCpu: "4"},
+	} {
+		slice := common.ComputeResourcesFloat{"cpu": test.sliceCpu, "memory": 1024 * 1024 * 1024}
+		jobs, remainder, e := leaseElasticJob(slice, "32")
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(jobs))
+
+		expectedCpu := resource.MustParse(test.grantedCpu)
+		granted := jobs[0].PodSpec.Containers[0].Resources
+		assert.Equal(t, expectedCpu.MilliValue(), granted.Requests.Cpu().MilliValue())
+		assert.Equal(t, expectedCpu.MilliValue(), granted.Limits.Cpu().MilliValue())
+		assert.Equal(t, test.sliceCpu-common.QuantityAsFloat64(expectedCpu), remainder["cpu"])
+		if test.grantedCpu == "16" {
+			assert.Empty(t, jobs[0].GrantedResources)
+		} else {
+			grantedCpu := jobs[0].GrantedResources["cpu"]
+			assert.Equal(t, expectedCpu.MilliValue(), grantedCpu.MilliValue())
+		}
+	}
+}
+
+func Test_leaseJobs_ElasticJobStaysQueuedWhenMinDoesNotFit(t *testing.T) {
+	slice := common.ComputeResourcesFloat{"cpu": 3, "memory": 1024 * 1024 * 1024}
+	jobs, remainder, e := leaseElasticJob(slice, "32")
+	assert.Nil(t, e)
+	assert.Empty(t, jobs)
+	assert.Equal(t, slice, remainder)
+}
+
+func Test_leaseJobs_ElasticJobIsGrantedCpuAvailableInCluster(t *testing.T) {
+	slice := common.ComputeResourcesFloat{"cpu": 20, "memory": 1024 * 1024 * 1024}
+	jobs, _, e := leaseElasticJob(slice, "6")
+	assert.Nil(t, e)
+	assert.Equal(t, 1, len(jobs))
+	assert.Equal(t, int64(6000), jobs[0].PodSpec.Containers[0].Resources.Requests.Cpu().MilliValue())
+}
+
+// leaseElasticJob leases job requesting 16 cpu which can run with 4 cpu from the slice, the cluster has the given cpu available
+func leaseElasticJob(slice common.ComputeResourcesFloat, availableCpu string) ([]*api.Job, common.ComputeResourcesFloat, error) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	job := createJobWithCpu("queue1", "job1", "16")
+	job.MinResources = common.ComputeResources{"cpu": resource.MustParse("4")}
+
+	c := leaseContext{
+		ctx:              context.Background(),
+		schedulingConfig: &configuration.SchedulingConfig{QueueLeaseBatchSize: 10},
+		onJobsLeased:     func(a []*api.Job) {},
+		request:          &api.LeaseRequest{ClusterId: "c1", Resources: common.ComputeResources{"cpu": resource.MustParse(availableCpu), "memory": resource.MustParse("1Gi")}},
+		repository:       &fakeJobQueueRepository{jobsByQueue: map[string][]*api.Job{"queue1": {job}}},
+		queueCache:       map[string][]*api.Job{},
+	}
+	return c.leaseJobs(queue1, slice, 10)
+}
+
+func Test_LeaseJobs_GuaranteedQueueReclaimsUpToItsGuaranteeFirst(t *testing.T) {
+	guaranteed := &api.Queue{Name: "guaranteed", PriorityFactor: 1, GuaranteedResources: common.ComputeResources{"cpu": resource.MustParse("6")}}
+	queue2 := &api.Queue{Name: "queue2", PriorityFactor: 1}
+	queue3 := &api.Queue{Name: "queue3", PriorityFactor: 1}
+
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{
+			"guaranteed": createJobs("guaranteed", 20),
+			"queue2":     createJobs("queue2", 20),
+			"queue3":     createJobs("queue3", 20),
+		},
+	}
+	leasedReports := map[string]*api.ClusterLeasedReport{
+		"c1": {ClusterId: "c1", ReportTime: time.Now(), Queues: []*api.QueueLeasedReport{
+			{Name: "guaranteed", ResourcesLeased: common.ComputeResources{"cpu": resource.MustParse("2"), "memory": resource.MustParse("2Mi")}},
+		}},
+	}
+
+	capacity := common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")}
+	jobs, e := LeaseJobs(
+		context.Background(),
+		leaseTestConfig(),
+		repository,
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) {},
+		nil,
+		nil,
+		&api.LeaseRequest{ClusterId: "c1", Resources: common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}},
+		map[string]*api.ClusterUsageReport{"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity}},
+		leasedReports,
+		nil,
+		map[string]map[string]float64{},
+		[]*api.Queue{guaranteed, queue2, queue3})
+
+	assert.Nil(t, e)
+	assert.Equal(t, 10, len(jobs))
+	// guarantee minus current usage is leased before other queues get their share
+	for _, job := range jobs[:4] {
+		assert.Equal(t, "guaranteed", job.Queue)
+	}
+	otherJobs := 0
+	for _, job := range jobs[4:] {
+		if job.Queue != "guaranteed" {
+			otherJobs++
+		}
+	}
+	assert.True(t, otherJobs > 0)
+}
+
+func Test_LeaseJobs_GuaranteesExceedingRequestAreSharedProportionally(t *testing.T) {
+	large := &api.Queue{Name: "large", PriorityFactor: 1, GuaranteedResources: common.ComputeResources{"cpu": resource.MustParse("6")}}
+	small := &api.Queue{Name: "small", PriorityFactor: 1, GuaranteedResources: common.ComputeResources{"cpu": resource.MustParse("3")}}
+	other := &api.Queue{Name: "other", PriorityFactor: 1}
+
+	capacity := common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")}
+	var firstRun []string
+	for i := 0; i < 20; i++ {
+		repository := &fakeJobQueueRepository{
+			jobsByQueue: map[string][]*api.Job{
+				"large": createJobs("large", 20),
+				"small": createJobs("small", 20),
+				"other": createJobs("other", 20),
+			},
+		}
+		jobs, e := LeaseJobs(
+			context.Background(),
+			leaseTestConfig(),
+			repository,
+			func(jobs []*api.Job) {},
+			func(denials []*LeaseDenial) {},
+			nil,
+			nil,
+			&api.LeaseRequest{ClusterId: "c1", Resources: common.ComputeResources{"cpu": resource.MustParse("6"), "memory": resource.MustParse("10Gi")}},
+			map[string]*api.ClusterUsageReport{"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity}},
+			map[string]*api.ClusterLeasedReport{},
+			nil,
+			map[string]map[string]float64{},
+			[]*api.Queue{other, small, large})
+
+		assert.Nil(t, e)
+		// 6 cpu are shared between guarantees of 6 and 3 cpu
+		jobsByQueue := map[string]int{}
+		for _, job := range jobs {
+			jobsByQueue[job.Queue]++
+		}
+		assert.Equal(t, map[string]int{"large": 4, "small": 2}, jobsByQueue)
+
+		if firstRun == nil {
+			firstRun = jobIds(jobs)
+		} else {
+			assert.Equal(t, firstRun, jobIds(jobs))
+		}
+	}
+}
+
+func Test_shareGuarantees(t *testing.T) {
+	large := &api.Queue{Name: "large"}
+	small := &api.Queue{Name: "small"}
+	notSchedulable := &api.Queue{Name: "notSchedulable"}
+	unused := map[*api.Queue]common.ComputeResourcesFloat{
+		large:          {"cpu": 6, "memory": 2},
+		small:          {"cpu": 2, "memory": 2},
+		notSchedulable: {"cpu": 8},
+	}
+	schedulingInfo := map[*api.Queue]*QueueSchedulingInfo{
+		large: NewQueueSchedulingInfo(common.ComputeResourcesFloat{}, common.ComputeResourcesFloat{}, common.ComputeResourcesFloat{}),
+		small: NewQueueSchedulingInfo(common.ComputeResourcesFloat{}, common.ComputeResourcesFloat{}, common.ComputeResourcesFloat{}),
+	}
+
+	shared := shareGuarantees(unused, schedulingInfo, common.ComputeResourcesFloat{"cpu": 4, "memory": 10})
+
+	assert.Equal(t, map[*api.Queue]common.ComputeResourcesFloat{
+		large: {"cpu": 3, "memory": 2},
+		small: {"cpu": 1, "memory": 2},
+	}, shared)
+}
+
+func Test_unusedGuarantees(t *testing.T) {
+	guaranteed := &api.Queue{Name: "guaranteed", GuaranteedResources: common.ComputeResources{"cpu": resource.MustParse("6")}}
+	overGuarantee := &api.Queue{Name: "overGuarantee", GuaranteedResources: common.ComputeResources{"cpu": resource.MustParse("1")}}
+	notGuaranteed := &api.Queue{Name: "notGuaranteed"}
+	allocation := map[string]common.ComputeResources{
+		"guaranteed":    {"cpu": resource.MustParse("2")},
+		"overGuarantee": {"cpu": resource.MustParse("2")},
+	}
+
+	result := unusedGuarantees([]*api.Queue{guaranteed, overGuarantee, notGuaranteed}, allocation)
+
+	assert.Equal(t, map[*api.Queue]common.ComputeResourcesFloat{
+		guaranteed:    {"cpu": 4.0},
+		overGuarantee: {"cpu": 0.0},
+	}, result)
+}
+
+func createJobWithCpu(queue string, id string, cpu string) *api.Job {
+	podSpec := classicPodSpec.DeepCopy()
+	podSpec.Containers[0].Resources.Requests["cpu"] = resource.MustParse(cpu)
+	podSpec.Containers[0].Resources.Limits["cpu"] = resource.MustParse(cpu)
+	return &api.Job{Id: id, Queue: queue, PodSpec: podSpec}
+}
+
+func createJobWithGpu(queue string, id string, gpu string) *api.Job {
+	job := createJobWithCpu(queue, id, "1")
+	job.PodSpec.Containers[0].Resources.Requests[common.GpuResourceName] = resource.MustParse(gpu)
+	job.PodSpec.Containers[0].Resources.Limits[common.GpuResourceName] = resource.MustParse(gpu)
+	return job
+}
+
+func createJobWithSharedGpu(queue string, id string, gpu string) *api.Job {
+	job := createJobWithCpu(queue, id, "100m")
+	job.PodSpec.Containers[0].Resources.Requests[common.SharedGpuResourceName] = resource.MustParse(gpu)
+	job.PodSpec.Containers[0].Resources.Limits[common.SharedGpuResourceName] = resource.MustParse(gpu)
+	return job
+}
+
+func Test_LeaseJobs_ReportsQueueSharesOfSchedulingPass(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	queue2 := &api.Queue{Name: "queue2", PriorityFactor: 1}
+	capacity := common.ComputeResources{"cpu": resource.MustParse("10")}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"c1": {
+			ClusterId:                "c1",
+			Pool:                     "pool1",
+			ClusterCapacity:          capacity,
+			ClusterAvailableCapacity: capacity,
+			Queues: []*api.QueueReport{
+				{Name: "queue1", Resources: common.ComputeResources{"cpu": resource.MustParse("2")}},
+			},
+		},
+	}
+
+	var reportedPool string
+	var reportedShares []*QueueShare
+	_, e := LeaseJobs(
+		context.Background(),
+		leaseTestConfig(),
+		&fakeJobQueueRepository{jobsByQueue: map[string][]*api.Job{}},
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) {},
+		nil,
+		func(pool string, shares []*QueueShare) {
+			reportedPool = pool
+			reportedShares = shares
+		},
+		&api.LeaseRequest{ClusterId: "c1", Pool: "pool1", Resources: capacity},
+		clusterReports,
+		map[string]*api.ClusterLeasedReport{},
+		nil,
+		map[string]map[string]float64{},
+		[]*api.Queue{queue1, queue2})
+
+	assert.Nil(t, e)
+	assert.Equal(t, "pool1", reportedPool)
+	assert.Equal(t, 2, len(reportedShares))
+	assert.Equal(t, "queue1", reportedShares[0].Queue)
+	assert.Equal(t, "queue2", reportedShares[1].Queue)
+	// queues share usage of 12 cpu equally, queue1 already uses 2 of its 6
+	assert.InDelta(t, 4, reportedShares[0].AdjustedShare["cpu"], 0.001)
+	assert.InDelta(t, 6, reportedShares[1].AdjustedShare["cpu"], 0.001)
+	assert.Equal(t, 10.0, reportedShares[0].RemainingSchedulingLimit["cpu"])
+	assert.Equal(t, 10.0, reportedShares[1].RemainingSchedulingLimit["cpu"])
+	assert.Equal(t, 2.0, reportedShares[0].CurrentUsage["cpu"])
+	assert.Equal(t, 0.0, reportedShares[1].CurrentUsage["cpu"])
+}
+
+func Test_LeaseJobs_UsesCapacitySnapshotTakenAtStartOfCycle(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	capacity := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}
+	request := &api.LeaseRequest{ClusterId: "c1", Resources: capacity.DeepCopy()}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity.DeepCopy()},
+	}
+	leasedReports := map[string]*api.ClusterLeasedReport{}
+	config := leaseTestConfig()
+	config.UseProbabilisticSchedulingForAllResources = true
+
+	// usage reported while the cycle runs, the cluster is full and the queue uses all of it
+	reportUsageMidCycle := func(step string, duration time.Duration) {
+		if step != "leaseGuaranteedResources" {
+			return
+		}
+		request.Resources = common.ComputeResources{}
+		clusterReports["c1"].ClusterAvailableCapacity = common.ComputeResources{}
+		clusterReports["c1"].Queues = []*api.QueueReport{{Name: "queue1", Resources: capacity}}
+		leasedReports["c1"] = &api.ClusterLeasedReport{
+			ClusterId: "c1",
+			Queues:    []*api.QueueLeasedReport{{Name: "queue1", ResourcesLeased: capacity}},
+		}
+	}
+
+	jobs, e := LeaseJobs(
+		context.Background(),
+		config,
+		&fakeJobQueueRepository{jobsByQueue: map[string][]*api.Job{"queue1": createJobs("queue1", 5)}},
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) {},
+		reportUsageMidCycle,
+		nil,
+		request,
+		clusterReports,
+		leasedReports,
+		nil,
+		map[string]map[string]float64{},
+		[]*api.Queue{queue1})
+
+	assert.Nil(t, e)
+	assert.Equal(t, 5, len(jobs), "jobs are leased by capacity and usage at the start of the cycle")
+}
+
+func leaseTestConfig() *configuration.SchedulingConfig {
+	all := map[string]float64{"cpu": 1, "memory": 1}
+	return &configuration.SchedulingConfig{
+		QueueLeaseBatchSize:                       10,
+		MaximalClusterFractionToSchedule:          all,
+		MaximalResourceFractionToSchedulePerQueue: all,
+		MaximalResourceFractionPerQueue:           all,
+	}
+}
+
+func Test_LeaseJobs_ShardedQueuesLeaseSameJobsAsSerialScheduling(t *testing.T) {
+	for _, probabilistic := range []bool{false, true} {
+		queues, jobsByQueue := createQueuesWithJobs(50, 10)
+		config := leaseTestConfig()
+		config.UseProbabilisticSchedulingForAllResources = probabilistic
+		serialJobs, e := leaseTestJobs(config, &fakeJobQueueRepository{jobsByQueue: jobsByQueue}, queues)
+		assert.Nil(t, e)
+
+		queues, jobsByQueue = createQueuesWithJobs(50, 10)
+		config.QueueShards = 8
+		shardedJobs, e := leaseTestJobs(config, &fakeJobQueueRepository{jobsByQueue: jobsByQueue}, queues)
+		assert.Nil(t, e)
+
+		assert.Equal(t, 500, len(serialJobs))
+		assert.Equal(t, sortedJobIds(serialJobs), sortedJobIds(shardedJobs))
+	}
+}
+
+func Test_LeaseJobs_ShardedQueuesDoNotLeaseMoreThanRequested(t *testing.T) {
+	for _, probabilistic := range []bool{false, true} {
+		queues, jobsByQueue := createQueuesWithJobs(50, 10)
+		config := leaseTestConfig()
+		config.UseProbabilisticSchedulingForAllResources = probabilistic
+		config.QueueShards = 8
+
+		capacity := common.ComputeResources{"cpu": resource.MustParse("1000"), "memory": resource.MustParse("1000Gi")}
+		jobs, e := LeaseJobs(
+			context.Background(),
+			config,
+			&fakeJobQueueRepository{jobsByQueue: jobsByQueue},
+			func(jobs []*api.Job) {},
+			func(denials []*LeaseDenial) {},
+			nil,
+			nil,
+			&api.LeaseRequest{ClusterId: "c1", Resources: common.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")}},
+			map[string]*api.ClusterUsageReport{"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity}},
+			map[string]*api.ClusterLeasedReport{},
+			nil,
+			map[string]map[string]float64{},
+			queues)
+		assert.Nil(t, e)
+
+		leased := common.ComputeResourcesFloat{}
+		for _, job := range jobs {
+			leased.Add(common.TotalResourceRequest(job.PodSpec).AsFloat())
+		}
+		assert.True(t, leased["cpu"] <= 100)
+		assert.True(t, len(jobs) > 0)
+
+		queues, jobsByQueue = createQueuesWithJobs(50, 10)
+		config.MaxJobsPerLeaseRequest = 15
+		jobs, e = leaseTestJobs(config, &fakeJobQueueRepository{jobsByQueue: jobsByQueue}, queues)
+		assert.Nil(t, e)
+		assert.Equal(t, 15, len(jobs))
+	}
+}
+
+func Test_LeaseJobs_ShardedQueuesAreScheduledConcurrently(t *testing.T) {
+	config := leaseTestConfig()
+	config.UseProbabilisticSchedulingForAllResources = true
+
+	queues, jobsByQueue := createQueuesWithJobs(32, 2)
+	start := time.Now()
+	serialJobs, e := leaseTestJobs(config, newSlowJobQueueRepository(jobsByQueue, 2*time.Millisecond), queues)
+	serialDuration := time.Since(start)
+	assert.Nil(t, e)
+
+	config.QueueShards = 8
+	queues, jobsByQueue = createQueuesWithJobs(32, 2)
+	start = time.Now()
+	shardedJobs, e := leaseTestJobs(config, newSlowJobQueueRepository(jobsByQueue, 2*time.Millisecond), queues)
+	shardedDuration := time.Since(start)
+	assert.Nil(t, e)
+
+	assert.Equal(t, sortedJobIds(serialJobs), sortedJobIds(shardedJobs))
+	assert.True(t, shardedDuration < serialDuration/2, "sharded scheduling took %s, serial %s", shardedDuration, serialDuration)
+}
+
+func BenchmarkLeaseJobs_QueueShards(b *testing.B) {
+	for _, shards := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("shards=%d", shards), func(b *testing.B) {
+			config := leaseTestConfig()
+			config.UseProbabilisticSchedulingForAllResources = true
+			config.QueueShards = shards
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				queues, jobsByQueue := createQueuesWithJobs(100, 10)
+				repository := newSlowJobQueueRepository(jobsByQueue, 100*time.Microsecond)
+				b.StartTimer()
+
+				_, e := leaseTestJobs(config, repository, queues)
+				if e != nil {
+					b.Fatal(e)
+				}
+			}
+		})
+	}
+}
+
+func createQueuesWithJobs(queueCount int, jobsPerQueue int) ([]*api.Queue, map[string][]*api.Job) {
+	queues := make([]*api.Queue, 0, queueCount)
+	jobsByQueue := make(map[string][]*api.Job, queueCount)
+	for i := 0; i < queueCount; i++ {
+		name := fmt.Sprintf("queue%d", i)
+		queues = append(queues, &api.Queue{Name: name, PriorityFactor: 1})
+		jobsByQueue[name] = createJobs(name, jobsPerQueue)
+	}
+	return queues, jobsByQueue
+}
+
+func sortedJobIds(jobs []*api.Job) []string {
+	ids := jobIds(jobs)
+	sort.Strings(ids)
+	return ids
+}
+
+func Test_LeaseJobs_QueueOfGpuPoolIsNotLeasedToClusterOfCpuPool(t *testing.T) {
+	gpuQueue := &api.Queue{Name: "gpu-queue", PriorityFactor: 1, Pool: "gpu"}
+	anyQueue := &api.Queue{Name: "any-queue", PriorityFactor: 1}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{
+			"gpu-queue": createJobs("gpu-queue", 5),
+			"any-queue": createJobs("any-queue", 5),
+		},
+	}
+	clusterReports := poolTestClusterReports(map[string]string{"cpu-cluster": "cpu", "gpu-cluster": "gpu"})
+
+	jobs, e := leaseJobsInPool(repository, clusterReports, map[string]map[string]float64{}, "cpu-cluster", "cpu", gpuQueue, anyQueue)
+	assert.Nil(t, e)
+	assert.Equal(t, 5, len(jobs))
+	for _, job := range jobs {
+		assert.Equal(t, "any-queue", job.Queue)
+	}
+
+	jobs, e = leaseJobsInPool(repository, clusterReports, map[string]map[string]float64{}, "gpu-cluster", "gpu", gpuQueue, anyQueue)
+	assert.Nil(t, e)
+	assert.Equal(t, 5, len(jobs))
+	for _, job := range jobs {
+		assert.Equal(t, "gpu-queue", job.Queue)
+	}
+}
+
+func Test_LeaseJobs_SharesAreCalculatedWithinPool(t *testing.T) {
+	// queue1 has used a lot of resources on the other cluster, which lowers its share only when the clusters share the pool
+	clusterPriorities := map[string]map[string]float64{
+		"c1": {"queue1": 1, "queue2": 1},
+		"c2": {"queue1": 100},
+	}
+
+	leased := leaseFromTwoQueuesInPool(t, poolTestClusterReports(map[string]string{"c1": "cpu", "c2": "gpu"}), clusterPriorities)
+	assert.Equal(t, 5, leased["queue1"])
+	assert.Equal(t, 5, leased["queue2"])
+
+	leased = leaseFromTwoQueuesInPool(t, poolTestClusterReports(map[string]string{"c1": "cpu", "c2": "cpu"}), clusterPriorities)
+	assert.True(t, leased["queue1"] < leased["queue2"])
+}
+
+func leaseFromTwoQueuesInPool(t *testing.T, clusterReports map[string]*api.ClusterUsageReport, clusterPriorities map[string]map[string]float64) map[string]int {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	queue2 := &api.Queue{Name: "queue2", PriorityFactor: 1}
+	repository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{
+			"queue1": createJobs("queue1", 20),
+			"queue2": createJobs("queue2", 20),
+		},
+	}
+	jobs, e := leaseJobsInPool(repository, clusterReports, clusterPriorities, "c1", "cpu", queue1, queue2)
+	assert.Nil(t, e)
+
+	leased := map[string]int{}
+	for _, job := range jobs {
+		leased[job.Queue]++
+	}
+	return leased
+}
+
+func leaseJobsInPool(
+	repository repository.JobQueueRepository,
+	clusterReports map[string]*api.ClusterUsageReport,
+	clusterPriorities map[string]map[string]float64,
+	clusterId string,
+	pool string,
+	queues ...*api.Queue) ([]*api.Job, error) {
+
+	return LeaseJobs(
+		context.Background(),
+		leaseTestConfig(),
+		repository,
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) {},
+		nil,
+		nil,
+		&api.LeaseRequest{ClusterId: clusterId, Pool: pool, Resources: clusterReports[clusterId].ClusterAvailableCapacity},
+		clusterReports,
+		map[string]*api.ClusterLeasedReport{},
+		nil,
+		clusterPriorities,
+		queues)
+}
+
+func poolTestClusterReports(poolsByCluster map[string]string) map[string]*api.ClusterUsageReport {
+	capacity := common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")}
+	reports := map[string]*api.ClusterUsageReport{}
+	for clusterId, pool := range poolsByCluster {
+		reports[clusterId] = &api.ClusterUsageReport{ClusterId: clusterId, Pool: pool, ClusterCapacity: capacity, ClusterAvailableCapacity: capacity}
+	}
+	return reports
+}
+
+func Test_LeaseJobs_JobIsNotLeasedBeforeItsStartTime(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	jobs := createJobs("queue1", 2)
+	notBefore := time.Now().Add(time.Hour)
+	jobs[0].NotBefore = &notBefore
+	jobRepository := &fakeJobQueueRepository{jobsByQueue: map[string][]*api.Job{"queue1": jobs}}
+
+	leased, e := leaseTestJobs(leaseTestConfig(), jobRepository, []*api.Queue{queue1})
+	assert.Nil(t, e)
+	assert.Equal(t, []string{"queue1-job1"}, jobIds(leased))
+
+	leased, e = leaseTestJobs(leaseTestConfig(), jobRepository, []*api.Queue{queue1})
+	assert.Nil(t, e)
+	assert.Empty(t, leased)
+
+	// the start time passes
+	notBefore = time.Now().Add(-time.Second)
+	leased, e = leaseTestJobs(leaseTestConfig(), jobRepository, []*api.Queue{queue1})
+	assert.Nil(t, e)
+	assert.Equal(t, []string{"queue1-job0"}, jobIds(leased))
+}
+
+func Test_LeaseJobs_QueueIsSkippedDuringItsBlockedWindow(t *testing.T) {
+	now := time.Now().UTC()
+	window := &api.SchedulingWindow{Start: now.Add(-time.Hour).Format("15:04"), End: now.Add(time.Hour).Format("15:04"), Blocked: true}
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1, SchedulingWindows: []*api.SchedulingWindow{window}}
+	queue2 := &api.Queue{Name: "queue2", PriorityFactor: 1}
+	jobRepository := &fakeJobQueueRepository{jobsByQueue: map[string][]*api.Job{
+		"queue1": createJobs("queue1", 1),
+		"queue2": createJobs("queue2", 1),
+	}}
+
+	leased, e := leaseTestJobs(leaseTestConfig(), jobRepository, []*api.Queue{queue1, queue2})
+	assert.Nil(t, e)
+	assert.Equal(t, []string{"queue2-job0"}, jobIds(leased))
+
+	// the window is over
+	window.Start = now.Add(-2 * time.Hour).Format("15:04")
+	window.End = now.Add(-time.Hour).Format("15:04")
+	leased, e = leaseTestJobs(leaseTestConfig(), jobRepository, []*api.Queue{queue1, queue2})
+	assert.Nil(t, e)
+	assert.Equal(t, []string{"queue1-job0"}, jobIds(leased))
+}
+
+func Test_LeaseJobs_CancelledRequestLeavesJobsReleasable(t *testing.T) {
+	minidb, e := miniredis.Run()
+	assert.Nil(t, e)
+	defer minidb.Close()
+
+	jobRepository := repository.NewRedisJobRepository(redis.NewClient(&redis.Options{Addr: minidb.Addr()}), "", false, 0)
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	_, e = jobRepository.AddJobs(createJobs("queue1", 1))
+	assert.Nil(t, e)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	jobs, e := leaseTestJobsWithContext(ctx, leaseTestConfig(), &cancellingJobQueueRepository{jobRepository, cancel}, []*api.Queue{queue1})
+	assert.Error(t, e)
+	assert.Empty(t, jobs)
+
+	jobs, e = leaseTestJobs(leaseTestConfig(), jobRepository, []*api.Queue{queue1})
+	assert.Nil(t, e)
+	assert.Equal(t, 1, len(jobs))
+}
+
+// cancellingJobQueueRepository cancels the request right after jobs are leased, as a server shutdown would
+type cancellingJobQueueRepository struct {
+	repository.JobQueueRepository
+	cancel func()
+}
+
+func (r *cancellingJobQueueRepository) TryLeaseJobs(clusterId string, queue string, jobs []*api.Job) ([]*api.Job, error) {
+	leased, e := r.JobQueueRepository.TryLeaseJobs(clusterId, queue, jobs)
+	r.cancel()
+	return leased, e
+}
+
+func Test_LeaseJobs_ReportsWhyQueuesLeasedNoJobs(t *testing.T) {
+	atLimit := &api.Queue{Name: "atLimit", PriorityFactor: 1, ResourceLimits: map[string]float64{"cpu": 0, "memory": 0}}
+	empty := &api.Queue{Name: "empty", PriorityFactor: 1}
+	unmatched := &api.Queue{Name: "unmatched", PriorityFactor: 1}
+	leasing := &api.Queue{Name: "leasing", PriorityFactor: 1}
+	unmatchedJobs := createJobs("unmatched", 1)
+	unmatchedJobs[0].RequiredNodeLabels = map[string]string{"gpu": "true"}
+	jobRepository := &fakeJobQueueRepository{jobsByQueue: map[string][]*api.Job{
+		"atLimit":   createJobs("atLimit", 1),
+		"unmatched": unmatchedJobs,
+		"leasing":   createJobs("leasing", 1),
+	}}
+	capacity := common.ComputeResources{"cpu": resource.MustParse("1000"), "memory": resource.MustParse("1000Gi")}
+
+	var reportedShares []*QueueShare
+	leased, e := LeaseJobs(
+		context.Background(),
+		leaseTestConfig(),
+		jobRepository,
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) {},
+		nil,
+		func(pool string, shares []*QueueShare) {
+			reportedShares = shares
+		},
+		&api.LeaseRequest{ClusterId: "c1", Resources: capacity},
+		map[string]*api.ClusterUsageReport{"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity}},
+		map[string]*api.ClusterLeasedReport{},
+		nil,
+		map[string]map[string]float64{},
+		[]*api.Queue{atLimit, empty, unmatched, leasing})
+
+	assert.Nil(t, e)
+	assert.Equal(t, []string{"leasing-job0"}, jobIds(leased))
+	reasons := map[string]QueueIdleReason{}
+	for _, share := range reportedShares {
+		reasons[share.Queue] = share.IdleReason
+	}
+	assert.Equal(t, map[string]QueueIdleReason{
+		"atLimit":   LimitReached,
+		"empty":     NoQueuedJobs,
+		"unmatched": NoMatchingCluster,
+		"leasing":   "",
+	}, reasons)
+}
+
+func leaseTestJobs(config *configuration.SchedulingConfig, repository repository.JobQueueRepository, queues []*api.Queue) ([]*api.Job, error) {
+	return leaseTestJobsWithContext(context.Background(), config, repository, queues)
+}
+
+func leaseTestJobsWithContext(ctx context.Context, config *configuration.SchedulingConfig, repository repository.JobQueueRepository, queues []*api.Queue) ([]*api.Job, error) {
+	capacity := common.ComputeResources{"cpu": resource.MustParse("1000"), "memory": resource.MustParse("1000Gi")}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+	}
+	return LeaseJobs(
+		ctx,
+		config,
+		repository,
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) {},
+		nil,
+		nil,
+		&api.LeaseRequest{ClusterId: "c1", Resources: capacity},
+		clusterReports,
+		map[string]*api.ClusterLeasedReport{},
+		nil,
+		map[string]map[string]float64{},
+		queues)
+}
+
+func createJobs(queue string, count int) []*api.Job {
+	jobs := make([]*api.Job, 0, count)
+	for i := 0; i < count; i++ {
+		jobs = append(jobs, &api.Job{Id: fmt.Sprintf("%s-job%d", queue, i), Queue: queue, PodSpec: classicPodSpec})
+	}
+	return jobs
+}
+
+func jobIds(jobs []*api.Job) []string {
+	ids := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, job.Id)
+	}
+	return ids
+}
+
+var multiContainerPodSpec = &v1.PodSpec{
+	InitContainers: []v1.Container{{
+		Name:  "Init",
+		Image: "index.docker.io/library/ubuntu:latest",
+		Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{"cpu": resource.MustParse("3"), "memory": resource.MustParse("1Mi")},
+			Limits:   v1.ResourceList{"cpu": resource.MustParse("3"), "memory": resource.MustParse("1Mi")},
+		}}},
+	Containers: []v1.Container{{
+		Name:  "Container1",
+		Image: "index.docker.io/library/ubuntu:latest",
+		Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Mi")},
+			Limits:   v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Mi")},
+		}}, {
+		Name:  "Container2",
+		Image: "index.docker.io/library/ubuntu:latest",
+		Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Mi")},
+			Limits:   v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Mi")},
+		}}}}
+
+var classicPodSpec = &v1.PodSpec{
+	Containers: []v1.Container{{
+		Name:  "Container1",
+		Image: "index.docker.io/library/ubuntu:latest",
+		Args:  []string{"sleep", "10s"},
+		Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Mi")},
+			Limits:   v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Mi")},
+		}}}}
+
+type fakeJobQueueRepository struct {
+	jobsByQueue     map[string][]*api.Job
+	returnedJobIds  []string
+	leasedJobCounts map[string]int64
+	lock            sync.Mutex
+}
+
+func (r *fakeJobQueueRepository) PeekQueue(queue string, limit int64) ([]*api.Job, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	jobs, exists := r.jobsByQueue[queue]
+	if !exists {
+		return []*api.Job{}, nil
+	}
+	if int64(len(jobs)) > limit {
+		jobs = jobs[:limit]
+	}
+	return jobs, nil
+}
+
+func (r *fakeJobQueueRepository) TryLeaseJobs(clusterId string, queue string, jobs []*api.Job) ([]*api.Job, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	remainingJobs := []*api.Job{}
+outer:
+	for _, j := range r.jobsByQueue[queue] {
+		for _, l := range jobs {
+			if j == l {
+				continue outer
+			}
+		}
+		remainingJobs = append(remainingJobs, j)
+	}
+	r.jobsByQueue[queue] = remainingJobs
+	return jobs, nil
+}
+
+func (r *fakeJobQueueRepository) ReturnLease(clusterId string, jobId string) (*api.Job, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.returnedJobIds = append(r.returnedJobIds, jobId)
+	return nil, nil
+}
+
+func (r *fakeJobQueueRepository) GetLeasedJobCount(queue string) (int64, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.leasedJobCounts[queue], nil
+}
+
+func Test_calculateQueueSchedulingLimits(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	activeQueues := []*api.Queue{queue1}
+	schedulingLimitPerQueue := common.ComputeResourcesFloat{"cpu": 300.0}
+	resourceLimitPerQueue := common.ComputeResourcesFloat{"cpu": 400.0}
+	totalCapacity := &common.ComputeResources{"cpu": resource.MustParse("1000")}
+	currentQueueResourceAllocation := map[string]common.ComputeResources{queue1.Name: {"cpu": resource.MustParse("250")}}
+
+	result := calculateQueueSchedulingLimits(activeQueues, schedulingLimitPerQueue, resourceLimitPerQueue, totalCapacity, currentQueueResourceAllocation, nil, nil)
+
+	assert.Equal(t, len(result), 1)
+	assert.Equal(t, result[queue1].remainingSchedulingLimit, common.ComputeResourcesFloat{"cpu": 150.0})
+}
+
+func Test_calculateQueueSchedulingLimits_WithSmallSchedulingLimitPerQueue(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	activeQueues := []*api.Queue{queue1}
+	schedulingLimitPerQueue := common.ComputeResourcesFloat{"cpu": 100.0}
+	resourceLimitPerQueue := common.ComputeResourcesFloat{"cpu": 400.0}
+	totalCapacity := &common.ComputeResources{"cpu": resource.MustParse("1000")}
+	currentQueueResourceAllocation := map[string]common.ComputeResources{queue1.Name: {"cpu": resource.MustParse("250")}}
+
+	result := calculateQueueSchedulingLimits(activeQueues, schedulingLimitPerQueue, resourceLimitPerQueue, totalCapacity, currentQueueResourceAllocation, nil, nil)
+
+	assert.Equal(t, len(result), 1)
+	assert.Equal(t, result[queue1].remainingSchedulingLimit, common.ComputeResourcesFloat{"cpu": 100.0})
+}
+
+func Test_calculateQueueSchedulingLimits_WithCustomQueueLimitsLessThanGlobal(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1, ResourceLimits: map[string]float64{"cpu": 0.3}}
+	activeQueues := []*api.Queue{queue1}
+	schedulingLimitPerQueue := common.ComputeResourcesFloat{"cpu": 300.0}
+	resourceLimitPerQueue := common.ComputeResourcesFloat{"cpu": 400.0}
+	totalCapacity := &common.ComputeResources{"cpu": resource.MustParse("1000")}
+	currentQueueResourceAllocation := map[string]common.ComputeResources{queue1.Name: {"cpu": resource.MustParse("250")}}
+
+	result := calculateQueueSchedulingLimits(activeQueues, schedulingLimitPerQueue, resourceLimitPerQueue, totalCapacity, currentQueueResourceAllocation, nil, nil)
+
+	assert.Equal(t, len(result), 1)
+	assert.Equal(t, result[queue1].remainingSchedulingLimit, common.ComputeResourcesFloat{"cpu": 50.0})
+}
+
+func Test_calculateQueueSchedulingLimits_RoundsGpuLimitDown(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	activeQueues := []*api.Queue{queue1}
+	totalCapacity := &common.ComputeResources{"cpu": resource.MustParse("1000"), common.GpuResourceName: resource.MustParse("10")}
+	schedulingLimitPerQueue := totalCapacity.MulByResource(map[string]float64{"cpu": 0.17, common.GpuResourceName: 0.17})
+	resourceLimitPerQueue := common.ComputeResourcesFloat{"cpu": 1000.0, common.GpuResourceName: 10.0}
+
+	result := calculateQueueSchedulingLimits(activeQueues, schedulingLimitPerQueue, resourceLimitPerQueue, totalCapacity, map[string]common.ComputeResources{}, nil, nil)
+
+	assert.Equal(t, common.ComputeResourcesFloat{"cpu": 170.0, common.GpuResourceName: 1.0}, result[queue1].remainingSchedulingLimit)
+}
+
+func Test_calculateQueueSchedulingLimits_GuaranteedResourcesOverrideLimits(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1, ResourceLimits: map[string]float64{"cpu": 0.3}, GuaranteedResources: common.ComputeResources{"cpu": resource.MustParse("400")}}
+	activeQueues := []*api.Queue{queue1}
+	schedulingLimitPerQueue := common.ComputeResourcesFloat{"cpu": 100.0}
+	resourceLimitPerQueue := common.ComputeResourcesFloat{"cpu": 400.0}
+	totalCapacity := &common.ComputeResources{"cpu": resource.MustParse("1000")}
+	currentQueueResourceAllocation := map[string]common.ComputeResources{queue1.Name: {"cpu": resource.MustParse("250")}}
+
+	result := calculateQueueSchedulingLimits(activeQueues, schedulingLimitPerQueue, resourceLimitPerQueue, totalCapacity, currentQueueResourceAllocation, nil, nil)
+
+	assert.Equal(t, len(result), 1)
+	assert.Equal(t, result[queue1].remainingSchedulingLimit, common.ComputeResourcesFloat{"cpu": 150.0})
 }
 
 func Test_calculateQueueSchedulingLimits_WithCustomQueueLimitsGreaterThanGlobal(t *testing.T) {
@@ -234,7 +2337,7 @@ func Test_calculateQueueSchedulingLimits_WithCustomQueueLimitsGreaterThanGlobal(
 	totalCapacity := &common.ComputeResources{"cpu": resource.MustParse("1000")}
 	currentQueueResourceAllocation := map[string]common.ComputeResources{queue1.Name: {"cpu": resource.MustParse("250")}}
 
-	result := calculateQueueSchedulingLimits(activeQueues, schedulingLimitPerQueue, resourceLimitPerQueue, totalCapacity, currentQueueResourceAllocation)
+	result := calculateQueueSchedulingLimits(activeQueues, schedulingLimitPerQueue, resourceLimitPerQueue, totalCapacity, currentQueueResourceAllocation, nil, nil)
 
 	assert.Equal(t, len(result), 1)
 	assert.Equal(t, result[queue1].remainingSchedulingLimit, common.ComputeResourcesFloat{"cpu": 250.0})
@@ -0,0 +1,21 @@
+package scheduling
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// LogJobsBySubmission logs one line for each request which submitted some of the jobs, with the correlation id
+// of the submission as jobCorrelationId, so the jobs can be followed in the log from their submission.
+func LogJobsBySubmission(logger *log.Entry, jobs []*api.Job, message string) {
+	idsByCorrelationId := map[string][]string{}
+	for _, job := range jobs {
+		if job.CorrelationId != "" {
+			idsByCorrelationId[job.CorrelationId] = append(idsByCorrelationId[job.CorrelationId], job.Id)
+		}
+	}
+	for correlationId, ids := range idsByCorrelationId {
+		logger.WithFields(log.Fields{"jobCorrelationId": correlationId, "jobIds": ids}).Info(message)
+	}
+}
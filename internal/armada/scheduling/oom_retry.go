@@ -0,0 +1,51 @@
+package scheduling
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// IncreaseJobMemory multiplies memory requests and limits of containers of the job by the configured factor,
+// bounded by the configured maximum. It returns the description of the change,
+// or false when the memory could not be increased any more.
+func IncreaseJobMemory(job *api.Job, settings *configuration.OOMRetrySettings) (string, bool) {
+	if settings.MemoryFactor <= 1 || job.PodSpec == nil {
+		return "", false
+	}
+
+	changes := []string{}
+	for i := range job.PodSpec.Containers {
+		container := &job.PodSpec.Containers[i]
+		if change, ok := increaseMemory(container.Resources.Requests, settings); ok {
+			changes = append(changes, fmt.Sprintf("container %s request %s", container.Name, change))
+		}
+		if change, ok := increaseMemory(container.Resources.Limits, settings); ok {
+			changes = append(changes, fmt.Sprintf("container %s limit %s", container.Name, change))
+		}
+	}
+	if len(changes) == 0 {
+		return "", false
+	}
+	return "Memory increased after OOMKilled: " + strings.Join(changes, ", "), true
+}
+
+func increaseMemory(resources v1.ResourceList, settings *configuration.OOMRetrySettings) (string, bool) {
+	memory, ok := resources[v1.ResourceMemory]
+	if !ok {
+		return "", false
+	}
+	current := memory.Value()
+	increased := int64(math.Min(math.Ceil(float64(current)*settings.MemoryFactor), settings.MaxMemory))
+	if increased <= current {
+		return "", false
+	}
+	resources[v1.ResourceMemory] = *resource.NewQuantity(increased, memory.Format)
+	return fmt.Sprintf("%s -> %s", memory.String(), resources.Memory().String()), true
+}
@@ -0,0 +1,69 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_IncreaseJobMemory_IsBoundedByMaxMemory(t *testing.T) {
+	settings := &configuration.OOMRetrySettings{MemoryFactor: 2, MaxMemory: 3 * 1024 * 1024 * 1024}
+	job := jobWithMemory("1Gi")
+
+	for _, expected := range []string{"2Gi", "3Gi"} {
+		_, increased := IncreaseJobMemory(job, settings)
+		assert.True(t, increased)
+		assert.Equal(t, expected, containerMemory(job.PodSpec.Containers[0].Resources.Requests))
+		assert.Equal(t, expected, containerMemory(job.PodSpec.Containers[0].Resources.Limits))
+	}
+
+	_, increased := IncreaseJobMemory(job, settings)
+	assert.False(t, increased)
+	assert.Equal(t, "3Gi", containerMemory(job.PodSpec.Containers[0].Resources.Requests))
+}
+
+func Test_IncreaseJobMemory_DescribesChange(t *testing.T) {
+	settings := &configuration.OOMRetrySettings{MemoryFactor: 1.5, MaxMemory: 8 * 1024 * 1024 * 1024}
+	job := jobWithMemory("1Gi")
+
+	description, increased := IncreaseJobMemory(job, settings)
+	assert.True(t, increased)
+	assert.Equal(t, "Memory increased after OOMKilled: container main request 1Gi -> 1536Mi, container main limit 1Gi -> 1536Mi", description)
+}
+
+func Test_IncreaseJobMemory_IgnoresContainersWithoutMemory(t *testing.T) {
+	settings := &configuration.OOMRetrySettings{MemoryFactor: 2, MaxMemory: 8 * 1024 * 1024 * 1024}
+	job := &api.Job{PodSpec: &v1.PodSpec{Containers: []v1.Container{{
+		Name:      "main",
+		Resources: v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")}},
+	}}}}
+
+	_, increased := IncreaseJobMemory(job, settings)
+	assert.False(t, increased)
+	assert.Equal(t, 1, len(job.PodSpec.Containers[0].Resources.Requests))
+}
+
+func Test_IncreaseJobMemory_DisabledWithoutFactor(t *testing.T) {
+	job := jobWithMemory("1Gi")
+
+	_, increased := IncreaseJobMemory(job, &configuration.OOMRetrySettings{MaxMemory: 8 * 1024 * 1024 * 1024})
+	assert.False(t, increased)
+	assert.Equal(t, "1Gi", containerMemory(job.PodSpec.Containers[0].Resources.Requests))
+}
+
+func jobWithMemory(memory string) *api.Job {
+	resources := v1.ResourceList{v1.ResourceMemory: resource.MustParse(memory)}
+	return &api.Job{PodSpec: &v1.PodSpec{Containers: []v1.Container{{
+		Name:      "main",
+		Resources: v1.ResourceRequirements{Requests: resources, Limits: resources.DeepCopy()},
+	}}}}
+}
+
+func containerMemory(resources v1.ResourceList) string {
+	return resources.Memory().String()
+}
@@ -0,0 +1,31 @@
+package scheduling
+
+import (
+	"sort"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// orderJobs sorts jobs read from the queue according to the job ordering strategy of the queue,
+// jobs equal by the strategy keep their order in the queue.
+func orderJobs(strategy api.JobOrderingStrategy, resourceScarcity map[string]float64, jobs []*api.Job) {
+	switch strategy {
+	case api.JobOrderingStrategy_FIFO:
+		sort.SliceStable(jobs, func(i, j int) bool {
+			return jobs[i].Created.Before(jobs[j].Created)
+		})
+	case api.JobOrderingStrategy_ShortestResourceFirst:
+		sizes := make(map[*api.Job]float64, len(jobs))
+		for _, job := range jobs {
+			sizes[job] = ResourcesAsUsage(resourceScarcity, common.TotalResourceRequest(job.PodSpec))
+		}
+		sort.SliceStable(jobs, func(i, j int) bool {
+			return sizes[jobs[i]] < sizes[jobs[j]]
+		})
+	default:
+		sort.SliceStable(jobs, func(i, j int) bool {
+			return jobs[i].Priority < jobs[j].Priority
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package scheduling
+
+import (
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// PreemptionTier returns the preemption tier configured for the priority class of the job,
+// ok is false for jobs without priority class or with class which is not configured.
+func PreemptionTier(priorityClasses map[string]int, job *api.Job) (tier int, ok bool) {
+	if job.PriorityClass == "" {
+		return 0, false
+	}
+	tier, ok = priorityClasses[job.PriorityClass]
+	return tier, ok
+}
+
+// PreemptionCandidates returns running jobs which may be preempted to make room for the waiting job, these are jobs
+// of lower tier than the waiting job. Candidates of the lowest tier come first, among them the least important
+// (highest priority value) and most recently created ones, so the least work is lost.
+// Jobs without configured priority class never preempt and are never preempted.
+func PreemptionCandidates(priorityClasses map[string]int, waiting *api.Job, running []*api.Job) []*api.Job {
+	waitingTier, ok := PreemptionTier(priorityClasses, waiting)
+	if !ok {
+		return nil
+	}
+
+	candidates := []*api.Job{}
+	tiers := map[*api.Job]int{}
+	for _, job := range running {
+		tier, ok := PreemptionTier(priorityClasses, job)
+		if ok && tier < waitingTier {
+			candidates = append(candidates, job)
+			tiers[job] = tier
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if tiers[a] != tiers[b] {
+			return tiers[a] < tiers[b]
+		}
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		return a.Created.After(b.Created)
+	})
+	return candidates
+}
+
+// PreemptionBudget bounds jobs and resources preempted in a scheduling cycle, each cycle starts with a new budget.
+type PreemptionBudget struct {
+	config             configuration.PreemptionBudget
+	preemptedJobs      int
+	preemptedResources common.ComputeResourcesFloat
+}
+
+func NewPreemptionBudget(config configuration.PreemptionBudget) *PreemptionBudget {
+	return &PreemptionBudget{config: config, preemptedResources: common.ComputeResourcesFloat{}}
+}
+
+// SelectPreemptions picks running jobs to preempt for the waiting jobs in their order. Each waiting job preempts its
+// candidates in order of PreemptionCandidates until they free resources the job requests, jobs which can't free enough
+// resources preempt nothing. Selection stops at the first waiting job whose preemptions would exceed the budget,
+// the job and all following jobs are deferred to the next cycle even if later jobs would fit into the budget.
+func (b *PreemptionBudget) SelectPreemptions(priorityClasses map[string]int, waiting []*api.Job, running []*api.Job) (preempted []*api.Job, deferred []*api.Job) {
+	preempted = []*api.Job{}
+	for i, job := range waiting {
+		victims, freed := selectVictims(priorityClasses, job, running)
+		if victims == nil {
+			continue
+		}
+		if !b.allows(len(victims), freed) {
+			return preempted, waiting[i:]
+		}
+		b.preemptedJobs += len(victims)
+		b.preemptedResources.Add(freed)
+		preempted = append(preempted, victims...)
+		running = withoutJobs(running, victims)
+	}
+	return preempted, []*api.Job{}
+}
+
+// selectVictims returns the fewest first candidates freeing resources requested by the waiting job with resources
+// they free, victims are nil when all candidates together don't free enough.
+func selectVictims(priorityClasses map[string]int, waiting *api.Job, running []*api.Job) ([]*api.Job, common.ComputeResourcesFloat) {
+	requirement := common.TotalResourceRequest(waiting.PodSpec).AsFloat()
+	victims := []*api.Job{}
+	freed := common.ComputeResourcesFloat{}
+	for _, candidate := range PreemptionCandidates(priorityClasses, waiting, running) {
+		if fits(requirement, freed) {
+			break
+		}
+		victims = append(victims, candidate)
+		freed.Add(common.TotalResourceRequest(candidate.PodSpec).AsFloat())
+	}
+	if !fits(requirement, freed) {
+		return nil, nil
+	}
+	return victims, freed
+}
+
+func (b *PreemptionBudget) allows(jobs int, resources common.ComputeResourcesFloat) bool {
+	if b.config.MaxJobs > 0 && b.preemptedJobs+jobs > b.config.MaxJobs {
+		return false
+	}
+	for resource, limit := range b.config.MaxResources {
+		if b.preemptedResources[resource]+resources[resource] > limit {
+			return false
+		}
+	}
+	return true
+}
+
+func withoutJobs(jobs []*api.Job, removed []*api.Job) []*api.Job {
+	for _, job := range removed {
+		jobs = removeJob(jobs, job)
+	}
+	return jobs
+}
+
+// PreemptJobs leases to the requesting cluster waiting jobs the lease request had no room for, in place of running
+// jobs of lower tiers leased by the same cluster before this cycle. Waiting jobs are the top jobs of active queues with
+// a configured priority class, considered from the highest tier, regardless of queue shares. Jobs with required node labels don't
+// preempt, as resources freed by preemption may be on other nodes. Leases of preempted jobs are returned without
+// counting a lease attempt, the executor stops them when it next renews its leases and they wait in their queue again.
+// Preemptions stop within the preemption budget of the cycle. Without configured priority classes nothing is preempted.
+func PreemptJobs(
+	config *configuration.SchedulingConfig,
+	jobRepository repository.JobRepository,
+	clusterId string,
+	activeQueues []*api.Queue,
+	leasedInCycle []*api.Job) (preempting []*api.Job, preempted []*api.Job, e error) {
+
+	preempting, preempted = []*api.Job{}, []*api.Job{}
+	if len(config.PriorityClasses) == 0 {
+		return preempting, preempted, nil
+	}
+	waiting, e := waitingPreemptors(config, jobRepository, activeQueues)
+	if e != nil || len(waiting) == 0 {
+		return preempting, preempted, e
+	}
+	leased, e := jobRepository.GetLeasedJobs(clusterId)
+	if e != nil {
+		return preempting, preempted, e
+	}
+	leasedIds := map[string]bool{}
+	for _, job := range leasedInCycle {
+		leasedIds[job.Id] = true
+	}
+	running := []*api.Job{}
+	for _, job := range leased {
+		if !leasedIds[job.Id] {
+			running = append(running, job)
+		}
+	}
+
+	budget := NewPreemptionBudget(config.PreemptionBudget)
+	for _, job := range waiting {
+		victims, deferred := budget.SelectPreemptions(config.PriorityClasses, []*api.Job{job}, running)
+		if len(deferred) > 0 {
+			break
+		}
+		if len(victims) == 0 {
+			continue
+		}
+		// the job may have been cancelled or leased by another cluster meanwhile
+		leasedJobs, e := jobRepository.TryLeaseJobs(clusterId, job.Queue, []*api.Job{job})
+		if e != nil {
+			log.Error(e)
+			continue
+		}
+		if len(leasedJobs) == 0 {
+			continue
+		}
+		preempting = append(preempting, leasedJobs...)
+		running = withoutJobs(running, victims)
+		for _, victim := range victims {
+			returned, e := jobRepository.ReturnLease(clusterId, victim.Id)
+			if e != nil {
+				log.Error(e)
+			} else if returned != nil {
+				preempted = append(preempted, returned)
+			}
+		}
+	}
+	return preempting, preempted, nil
+}
+
+// waitingPreemptors returns top jobs of the queues which may preempt running jobs, the highest tier first.
+func waitingPreemptors(config *configuration.SchedulingConfig, jobRepository repository.JobQueueRepository, queues []*api.Queue) ([]*api.Job, error) {
+	sorted := make([]*api.Queue, len(queues))
+	copy(sorted, queues)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	waiting := []*api.Job{}
+	tiers := map[*api.Job]int{}
+	for _, queue := range sorted {
+		topJobs, e := jobRepository.PeekQueue(queue.Name, int64(config.QueueLeaseBatchSize))
+		if e != nil {
+			return nil, e
+		}
+		for _, job := range topJobs {
+			tier, ok := PreemptionTier(config.PriorityClasses, job)
+			if ok && len(requiredNodeLabels(job)) == 0 && !waitsForStartTime(job) {
+				waiting = append(waiting, job)
+				tiers[job] = tier
+			}
+		}
+	}
+	sort.SliceStable(waiting, func(i, j int) bool {
+		return tiers[waiting[i]] > tiers[waiting[j]]
+	})
+	return waiting, nil
+}
@@ -0,0 +1,124 @@
+package scheduling
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+var testPriorityClasses = map[string]int{"best-effort": 0, "normal": 1, "critical": 2}
+
+func Test_PreemptionTier_ResolvesConfiguredClasses(t *testing.T) {
+	tier, ok := PreemptionTier(testPriorityClasses, &api.Job{PriorityClass: "critical"})
+	assert.True(t, ok)
+	assert.Equal(t, 2, tier)
+
+	tier, ok = PreemptionTier(testPriorityClasses, &api.Job{PriorityClass: "best-effort"})
+	assert.True(t, ok)
+	assert.Equal(t, 0, tier)
+
+	_, ok = PreemptionTier(testPriorityClasses, &api.Job{PriorityClass: "unknown"})
+	assert.False(t, ok)
+
+	_, ok = PreemptionTier(testPriorityClasses, &api.Job{})
+	assert.False(t, ok)
+}
+
+func Test_PreemptionCandidates_BestEffortJobIsFirstCandidateForCriticalJob(t *testing.T) {
+	now := time.Now()
+	normal := &api.Job{Id: "normal", PriorityClass: "normal", Created: now}
+	bestEffort := &api.Job{Id: "best-effort", PriorityClass: "best-effort", Created: now.Add(-time.Hour)}
+	critical := &api.Job{Id: "critical", PriorityClass: "critical", Created: now}
+	unclassified := &api.Job{Id: "unclassified", Created: now}
+
+	waiting := &api.Job{Id: "waiting", PriorityClass: "critical"}
+	candidates := PreemptionCandidates(testPriorityClasses, waiting, []*api.Job{normal, critical, unclassified, bestEffort})
+	assert.Equal(t, []string{"best-effort", "normal"}, jobIds(candidates))
+}
+
+func Test_PreemptionCandidates_CriticalJobIsNeverCandidate(t *testing.T) {
+	critical := &api.Job{Id: "critical", PriorityClass: "critical"}
+
+	for _, class := range []string{"best-effort", "normal", "critical"} {
+		waiting := &api.Job{Id: "waiting", PriorityClass: class}
+		assert.Empty(t, PreemptionCandidates(testPriorityClasses, waiting, []*api.Job{critical}))
+	}
+}
+
+func Test_PreemptionCandidates_OrdersSameTierByPriorityAndAge(t *testing.T) {
+	now := time.Now()
+	older := &api.Job{Id: "older", PriorityClass: "best-effort", Created: now.Add(-time.Hour)}
+	newer := &api.Job{Id: "newer", PriorityClass: "best-effort", Created: now}
+	lessImportant := &api.Job{Id: "less-important", PriorityClass: "best-effort", Priority: 10, Created: now.Add(-2 * time.Hour)}
+
+	waiting := &api.Job{Id: "waiting", PriorityClass: "normal"}
+	candidates := PreemptionCandidates(testPriorityClasses, waiting, []*api.Job{older, newer, lessImportant})
+	assert.Equal(t, []string{"less-important", "newer", "older"}, jobIds(candidates))
+}
+
+func Test_PreemptionCandidates_UnclassifiedJobDoesNotPreempt(t *testing.T) {
+	bestEffort := &api.Job{Id: "best-effort", PriorityClass: "best-effort"}
+	assert.Empty(t, PreemptionCandidates(testPriorityClasses, &api.Job{Id: "waiting"}, []*api.Job{bestEffort}))
+}
+
+func Test_PreemptionBudget_MaxJobsCapsPreemptionsOfCycle(t *testing.T) {
+	running := classifiedJobsWithCpu("best-effort", "running", 5, "1")
+	waiting := classifiedJobsWithCpu("critical", "waiting", 3, "1")
+
+	budget := NewPreemptionBudget(configuration.PreemptionBudget{MaxJobs: 2})
+	preempted, deferred := budget.SelectPreemptions(testPriorityClasses, waiting, running)
+	assert.Equal(t, 2, len(preempted))
+	assert.Equal(t, []string{"waiting2"}, jobIds(deferred))
+
+	// deferred demand preempts in the next cycle
+	nextBudget := NewPreemptionBudget(configuration.PreemptionBudget{MaxJobs: 2})
+	preempted, deferred = nextBudget.SelectPreemptions(testPriorityClasses, deferred, withoutJobs(running, preempted))
+	assert.Equal(t, 1, len(preempted))
+	assert.Empty(t, deferred)
+}
+
+func Test_PreemptionBudget_MaxResourcesCapsPreemptionsOfCycle(t *testing.T) {
+	running := classifiedJobsWithCpu("best-effort", "running", 6, "1")
+	waiting := classifiedJobsWithCpu("critical", "waiting", 3, "2")
+
+	budget := NewPreemptionBudget(configuration.PreemptionBudget{MaxResources: common.ComputeResourcesFloat{"cpu": 3}})
+	preempted, deferred := budget.SelectPreemptions(testPriorityClasses, waiting, running)
+	assert.Equal(t, 2, len(preempted))
+	assert.Equal(t, []string{"waiting1", "waiting2"}, jobIds(deferred))
+}
+
+func Test_PreemptionBudget_EmptyBudgetDoesNotLimitPreemptions(t *testing.T) {
+	running := classifiedJobsWithCpu("best-effort", "running", 6, "1")
+	waiting := classifiedJobsWithCpu("critical", "waiting", 3, "2")
+
+	budget := NewPreemptionBudget(configuration.PreemptionBudget{})
+	preempted, deferred := budget.SelectPreemptions(testPriorityClasses, waiting, running)
+	assert.Equal(t, 6, len(preempted))
+	assert.Empty(t, deferred)
+}
+
+func Test_PreemptionBudget_JobWithoutEnoughCandidatesPreemptsNothing(t *testing.T) {
+	running := classifiedJobsWithCpu("best-effort", "running", 1, "1")
+	waiting := classifiedJobsWithCpu("critical", "waiting", 1, "2")
+
+	budget := NewPreemptionBudget(configuration.PreemptionBudget{MaxJobs: 1})
+	preempted, deferred := budget.SelectPreemptions(testPriorityClasses, waiting, running)
+	assert.Empty(t, preempted)
+	assert.Empty(t, deferred)
+}
+
+func classifiedJobsWithCpu(priorityClass string, idPrefix string, count int, cpu string) []*api.Job {
+	jobs := make([]*api.Job, 0, count)
+	for i := 0; i < count; i++ {
+		job := createJobWithCpu("queue1", fmt.Sprintf("%s%d", idPrefix, i), cpu)
+		job.PriorityClass = priorityClass
+		jobs = append(jobs, job)
+	}
+	return jobs
+}
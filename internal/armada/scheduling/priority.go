@@ -14,6 +14,16 @@ const minPriority = 0.5
 type QueuePriorityInfo struct {
 	Priority     float64
 	CurrentUsage common.ComputeResources
+	// Priority for resources with their own priority factor, Priority is used for other resources
+	ResourcePriorities map[string]float64
+}
+
+// priorityOf returns priority of the queue for the resource.
+func (info QueuePriorityInfo) priorityOf(resource string) float64 {
+	if priority, ok := info.ResourcePriorities[resource]; ok {
+		return priority
+	}
+	return info.Priority
 }
 
 func CalculateQueuesPriorityInfo(clusterPriorities map[string]map[string]float64, activeClusterReports map[string]*api.ClusterUsageReport, queues []*api.Queue) map[*api.Queue]QueuePriorityInfo {
@@ -24,24 +34,56 @@ func CalculateQueuesPriorityInfo(clusterPriorities map[string]map[string]float64
 		priority := minPriority
 		currentPriority, ok := queuePriority[queue.Name]
 		if ok {
-			priority = math.Max(currentPriority*queue.PriorityFactor, minPriority)
+			priority = validPriority(currentPriority * priorityFactor(queue))
+		}
+		var resourcePriorities map[string]float64
+		if len(queue.ResourcePriorityFactors) > 0 {
+			resourcePriorities = make(map[string]float64, len(queue.ResourcePriorityFactors))
+			for resource, factor := range queue.ResourcePriorityFactors {
+				resourcePriorities[resource] = minPriority
+				if ok {
+					resourcePriorities[resource] = validPriority(currentPriority * validFactor(factor))
+				}
+			}
 		}
 		resultPriorityMap[queue] = QueuePriorityInfo{
-			Priority:     priority,
-			CurrentUsage: queueUsage[queue.Name],
+			Priority:           priority,
+			CurrentUsage:       queueUsage[queue.Name],
+			ResourcePriorities: resourcePriorities,
 		}
 	}
 	return resultPriorityMap
 }
 
-func CalculatePriorityUpdateFromReports(reports map[string]*api.ClusterUsageReport, report *api.ClusterUsageReport, previousPriority map[string]float64, halfTime time.Duration) map[string]float64 {
+// priorityFactor returns the priority factor of the queue, invalid factors (zero, negative, NaN or infinite),
+// which could be stored before they were validated, are replaced by 1 so they can't break the scheduling.
+func priorityFactor(queue *api.Queue) float64 {
+	return validFactor(queue.PriorityFactor)
+}
+
+func validFactor(factor float64) float64 {
+	if !(factor > 0) || math.IsInf(factor, 1) {
+		return 1
+	}
+	return factor
+}
+
+// validPriority keeps the priority between minPriority and math.MaxFloat64, NaN priority is replaced by minPriority.
+func validPriority(priority float64) float64 {
+	if math.IsNaN(priority) {
+		return minPriority
+	}
+	return math.Min(math.Max(priority, minPriority), math.MaxFloat64)
+}
+
+func CalculatePriorityUpdateFromReports(reports map[string]*api.ClusterUsageReport, report *api.ClusterUsageReport, previousPriority map[string]float64, halfTime time.Duration, configuredScarcity map[string]float64) map[string]float64 {
 	previousReport := reports[report.ClusterId]
 	timeChange := time.Minute
 	if previousReport != nil {
 		timeChange = report.ReportTime.Sub(previousReport.ReportTime)
 	}
 	reports[report.ClusterId] = report
-	resourceScarcity := ResourceScarcityFromReports(reports)
+	resourceScarcity := ResourceScarcityFromReports(reports, configuredScarcity)
 	usage := usageFromQueueReports(resourceScarcity, report.Queues)
 	newPriority := calculatePriorityUpdate(usage, previousPriority, timeChange, halfTime)
 	return newPriority
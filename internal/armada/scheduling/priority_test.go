@@ -1,6 +1,7 @@
 package scheduling
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -46,14 +47,53 @@ func TestPriorityService_GetQueuePriorities(t *testing.T) {
 	cpuSum := cpu.DeepCopy()
 	cpuSum.Add(cpu)
 	assert.Equal(t, map[*api.Queue]QueuePriorityInfo{
-		q1: {5, map[string]resource.Quantity{"cpu": cpuSum}},
-		q2: {1.5, nil},
-		q3: {1, nil},
-		q4: {minPriority, nil},
-		q5: {minPriority, nil},
+		q1: {5, map[string]resource.Quantity{"cpu": cpuSum}, nil},
+		q2: {1.5, nil, nil},
+		q3: {1, nil, nil},
+		q4: {minPriority, nil, nil},
+		q5: {minPriority, nil, nil},
 	}, priorities)
 }
 
+func TestPriorityService_GetQueuePriorities_InvalidPriorityFactorIsIgnored(t *testing.T) {
+	zero := &api.Queue{Name: "zero", PriorityFactor: 0}
+	negative := &api.Queue{Name: "negative", PriorityFactor: -2}
+	notANumber := &api.Queue{Name: "notANumber", PriorityFactor: math.NaN()}
+	infinite := &api.Queue{Name: "infinite", PriorityFactor: math.Inf(1)}
+	clusterPriorities := map[string]map[string]float64{
+		"cluster1": {"zero": 3, "negative": 3, "notANumber": 3, "infinite": 3},
+	}
+
+	priorities := CalculateQueuesPriorityInfo(clusterPriorities, map[string]*api.ClusterUsageReport{}, []*api.Queue{zero, negative, notANumber, infinite})
+
+	for _, queue := range []*api.Queue{zero, negative, notANumber, infinite} {
+		assert.Equal(t, 3.0, priorities[queue].Priority, queue.Name)
+	}
+}
+
+func TestPriorityService_GetQueuePriorities_ResourcePriorityFactors(t *testing.T) {
+	withFactors := &api.Queue{Name: "withFactors", PriorityFactor: 2, ResourcePriorityFactors: map[string]float64{"nvidia.com/gpu": 3}}
+	withoutFactors := &api.Queue{Name: "withoutFactors", PriorityFactor: 2}
+	clusterPriorities := map[string]map[string]float64{
+		"cluster1": {"withFactors": 4, "withoutFactors": 4},
+	}
+
+	priorities := CalculateQueuesPriorityInfo(clusterPriorities, map[string]*api.ClusterUsageReport{}, []*api.Queue{withFactors, withoutFactors})
+
+	assert.Equal(t, 12.0, priorities[withFactors].priorityOf("nvidia.com/gpu"))
+	assert.Equal(t, 8.0, priorities[withFactors].priorityOf("cpu"))
+	assert.Equal(t, 8.0, priorities[withoutFactors].priorityOf("nvidia.com/gpu"))
+	assert.Nil(t, priorities[withoutFactors].ResourcePriorities)
+}
+
+func Test_validPriority(t *testing.T) {
+	assert.Equal(t, 2.0, validPriority(2))
+	assert.Equal(t, minPriority, validPriority(0))
+	assert.Equal(t, minPriority, validPriority(-1))
+	assert.Equal(t, minPriority, validPriority(math.NaN()))
+	assert.Equal(t, math.MaxFloat64, validPriority(math.Inf(1)))
+}
+
 func TestAggregateQueueUsageDoesNotChangeSourceData(t *testing.T) {
 	oneCpu := resource.MustParse("1")
 	reports := map[string]*api.ClusterUsageReport{
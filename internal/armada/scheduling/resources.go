@@ -49,10 +49,58 @@ func (info *QueueSchedulingInfo) UpdateLimits(resourceUsed common.ComputeResourc
 	info.adjustedShare.LimitToZero()
 }
 
+const (
+	RoundingNone  = "none"
+	RoundingFloor = "floor"
+	RoundingCeil  = "ceil"
+	RoundingRound = "round"
+)
+
+// roundingTolerance prevents floating point errors (e.g. 0.29 * 100 = 28.999999999999996)
+// from rounding a whole value to the next integer
+const roundingTolerance = 1e-9
+
+// roundResources rounds resources with the rounding policy configured for them, so resources which can be
+// requested only in whole units (like GPU) are not limited to fractions jobs can't use.
+// GPU is rounded down when no policy is configured for it, shared GPU is not rounded as jobs can use its fractions.
+func roundResources(resources common.ComputeResourcesFloat, resourceRounding map[string]string) common.ComputeResourcesFloat {
+	rounded := resources.DeepCopy()
+	for key, value := range resources {
+		policy, ok := resourceRounding[key]
+		if !ok && key == common.GpuResourceName {
+			policy = RoundingFloor
+		}
+		switch policy {
+		case RoundingFloor:
+			rounded[key] = math.Floor(value + roundingTolerance)
+		case RoundingCeil:
+			rounded[key] = math.Ceil(value - roundingTolerance)
+		case RoundingRound:
+			rounded[key] = math.Round(value)
+		}
+	}
+	return rounded
+}
+
+// nonNegative tells whether no resource is below zero. Resources below zero only by floating point errors of fractions
+// (e.g. 0.3 - 0.1 - 0.1 - 0.1 of shared GPU sliced between queues) count as zero, so jobs can use up fractions exactly.
+func nonNegative(resources common.ComputeResourcesFloat) bool {
+	for _, value := range resources {
+		if value < -roundingTolerance {
+			return false
+		}
+	}
+	return true
+}
+
 func SliceResourceWithLimits(resourceScarcity map[string]float64, queueSchedulingInfo map[*api.Queue]*QueueSchedulingInfo, queuePriorities map[*api.Queue]QueuePriorityInfo, quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]*QueueSchedulingInfo {
 	queuesWithCapacity := filterQueuesWithNoCapacity(queueSchedulingInfo, queuePriorities)
-	naiveSlicedResource := sliceResource(resourceScarcity, queuesWithCapacity, quantityToSlice)
+	return withSchedulingLimits(queueSchedulingInfo, sliceResource(resourceScarcity, queuesWithCapacity, quantityToSlice))
+}
 
+// withSchedulingLimits returns scheduling info of queues with their slice of resources and the slice limited
+// by their remaining scheduling limit.
+func withSchedulingLimits(queueSchedulingInfo map[*api.Queue]*QueueSchedulingInfo, naiveSlicedResource map[*api.Queue]common.ComputeResourcesFloat) map[*api.Queue]*QueueSchedulingInfo {
 	result := map[*api.Queue]*QueueSchedulingInfo{}
 	for queue, slice := range naiveSlicedResource {
 		schedulingInfo := queueSchedulingInfo[queue]
@@ -77,33 +125,103 @@ func filterQueuesWithNoCapacity(queueSchedulingInfo map[*api.Queue]*QueueSchedul
 	return queuesWithCapacity
 }
 
+// sliceResource divides resources in two levels, queue groups share the resource equally
+// and each group share is divided between queues of the group according to their priority.
+// Queues without a group form one group together.
 func sliceResource(resourceScarcity map[string]float64, queuePriorities map[*api.Queue]QueuePriorityInfo, quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]common.ComputeResourcesFloat {
+	if hasResourcePriorities(queuePriorities) {
+		return sliceEachResource(queuePriorities, quantityToSlice)
+	}
+	return sliceResourceByUsage(func(resources common.ComputeResourcesFloat) float64 {
+		return ResourcesFloatAsUsage(resourceScarcity, resources)
+	}, queuePriorities, quantityToSlice)
+}
 
-	inversePriorities := make(map[*api.Queue]float64)
-	inverseSum := 0.0
+// sliceEachResource divides every resource separately between queues weighted by their priority for the resource,
+// so a queue can get a large share of one resource and a small share of another.
+func sliceEachResource(queuePriorities map[*api.Queue]QueuePriorityInfo, quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]common.ComputeResourcesFloat {
+	result := make(map[*api.Queue]common.ComputeResourcesFloat, len(queuePriorities))
+	for queue := range queuePriorities {
+		result[queue] = common.ComputeResourcesFloat{}
+	}
+	for resource, quantity := range quantityToSlice {
+		if quantity <= 0 {
+			for queue := range result {
+				result[queue][resource] = 0
+			}
+			continue
+		}
+		usage := func(resources common.ComputeResourcesFloat) float64 {
+			return resources[resource]
+		}
+		slices := sliceResourceByUsage(usage, prioritiesOfResource(queuePriorities, resource), common.ComputeResourcesFloat{resource: quantity})
+		for queue, slice := range slices {
+			result[queue][resource] = slice[resource]
+		}
+	}
+	return result
+}
+
+func hasResourcePriorities(queuePriorities map[*api.Queue]QueuePriorityInfo) bool {
+	for _, info := range queuePriorities {
+		if len(info.ResourcePriorities) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// prioritiesOfResource returns priorities of queues for the resource.
+func prioritiesOfResource(queuePriorities map[*api.Queue]QueuePriorityInfo, resource string) map[*api.Queue]QueuePriorityInfo {
+	result := make(map[*api.Queue]QueuePriorityInfo, len(queuePriorities))
+	for queue, info := range queuePriorities {
+		result[queue] = QueuePriorityInfo{Priority: info.priorityOf(resource), CurrentUsage: info.CurrentUsage}
+	}
+	return result
+}
+
+// sliceResourceByUsage divides resources as sliceResource does, with usage of resources measured by the usage function.
+func sliceResourceByUsage(usage func(common.ComputeResourcesFloat) float64, queuePriorities map[*api.Queue]QueuePriorityInfo, quantityToSlice common.ComputeResourcesFloat) map[*api.Queue]common.ComputeResourcesFloat {
+
+	queuesByGroup := make(map[string]map[*api.Queue]QueuePriorityInfo)
 
 	usages := make(map[*api.Queue]float64)
 	allCurrentUsage := 0.0
 
 	for queue, info := range queuePriorities {
-		inverse := 1 / info.Priority
-		inversePriorities[queue] = inverse
-		inverseSum += inverse
+		if _, ok := queuesByGroup[queue.Group]; !ok {
+			queuesByGroup[queue.Group] = map[*api.Queue]QueuePriorityInfo{}
+		}
+		queuesByGroup[queue.Group][queue] = info
 
-		queueUsage := ResourcesAsUsage(resourceScarcity, info.CurrentUsage)
+		queueUsage := usage(info.CurrentUsage.AsFloat())
 		usages[queue] = queueUsage
 		allCurrentUsage += queueUsage
 	}
 
-	usageToSlice := ResourcesFloatAsUsage(resourceScarcity, quantityToSlice)
+	usageToSlice := usage(quantityToSlice)
 	allUsage := usageToSlice + allCurrentUsage
 
 	shares := make(map[*api.Queue]float64)
 	shareSum := 0.0
-	for queue, inverse := range inversePriorities {
-		share := math.Max(0, allUsage*(inverse/inverseSum)-usages[queue])
-		shareSum += share
-		shares[queue] = share
+	for _, groupPriorities := range queuesByGroup {
+		groupUsage := 0.0
+		for queue := range groupPriorities {
+			groupUsage += usages[queue]
+		}
+		groupAllUsage := allUsage
+		if len(queuesByGroup) > 1 {
+			groupAllUsage = math.Max(allUsage/float64(len(queuesByGroup)), groupUsage)
+		}
+
+		groupShares := shareUsage(groupPriorities, usages, groupAllUsage)
+		if len(queuesByGroup) > 1 {
+			scaleToGroupShare(groupShares, groupAllUsage-groupUsage)
+		}
+		for queue, share := range groupShares {
+			shareSum += share
+			shares[queue] = share
+		}
 	}
 
 	shareResources := make(map[*api.Queue]common.ComputeResourcesFloat)
@@ -113,6 +231,34 @@ func sliceResource(resourceScarcity map[string]float64, queuePriorities map[*api
 	return shareResources
 }
 
+func shareUsage(queuePriorities map[*api.Queue]QueuePriorityInfo, usages map[*api.Queue]float64, allUsage float64) map[*api.Queue]float64 {
+	inversePriorities := make(map[*api.Queue]float64)
+	inverseSum := 0.0
+	for queue, info := range queuePriorities {
+		inverse := 1 / validPriority(info.Priority)
+		inversePriorities[queue] = inverse
+		inverseSum += inverse
+	}
+
+	shares := make(map[*api.Queue]float64)
+	for queue, inverse := range inversePriorities {
+		shares[queue] = math.Max(0, allUsage*(inverse/inverseSum)-usages[queue])
+	}
+	return shares
+}
+
+func scaleToGroupShare(shares map[*api.Queue]float64, groupShare float64) {
+	sum := 0.0
+	for _, share := range shares {
+		sum += share
+	}
+	for queue, share := range shares {
+		if sum > 0 {
+			shares[queue] = share * groupShare / sum
+		}
+	}
+}
+
 func ResourcesAsUsage(resourceScarcity map[string]float64, resources common.ComputeResources) float64 {
 	usage := 0.0
 	for resourceName, quantity := range resources {
@@ -147,9 +293,15 @@ func SumRemainingResource(schedulingInfo map[*api.Queue]*QueueSchedulingInfo) co
 	return sum
 }
 
-func ResourceScarcityFromReports(reports map[string]*api.ClusterUsageReport) map[string]float64 {
+// ResourceScarcityFromReports derives scarcity from capacity of the clusters,
+// scarcity configured for a resource takes precedence over the derived one.
+func ResourceScarcityFromReports(reports map[string]*api.ClusterUsageReport, configuredScarcity map[string]float64) map[string]float64 {
 	availableResources := sumReportResources(reports)
-	return calculateResourceScarcity(availableResources.AsFloat())
+	scarcity := calculateResourceScarcity(availableResources.AsFloat())
+	for resource, value := range configuredScarcity {
+		scarcity[resource] = value
+	}
+	return scarcity
 }
 
 // Calculates inverse of resources per cpu unit
@@ -192,7 +344,7 @@ func CombineLeasedReportResourceByQueue(reports map[string]*api.ClusterLeasedRep
 	for _, clusterReport := range reports {
 		for _, queueReport := range clusterReport.Queues {
 			if _, ok := resourceLeasedByQueue[queueReport.Name]; !ok {
-				resourceLeasedByQueue[queueReport.Name] = queueReport.ResourcesLeased
+				resourceLeasedByQueue[queueReport.Name] = common.ComputeResources(queueReport.ResourcesLeased).DeepCopy()
 			} else {
 				resourceLeasedByQueue[queueReport.Name].Add(queueReport.ResourcesLeased)
 			}
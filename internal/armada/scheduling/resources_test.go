@@ -1,6 +1,7 @@
 package scheduling
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -36,6 +37,23 @@ func Test_sliceResources(t *testing.T) {
 	assert.Equal(t, slices, map[*api.Queue]common.ComputeResourcesFloat{q1: twoCpu, q2: twoCpu, q3: fourCpu})
 }
 
+func Test_sliceResources_QueuesSplitEachResourceByTheirResourcePriorities(t *testing.T) {
+	cpuQueue := &api.Queue{Name: "cpuQueue"}
+	gpuQueue := &api.Queue{Name: "gpuQueue"}
+
+	queuePriorities := map[*api.Queue]QueuePriorityInfo{
+		cpuQueue: {Priority: 1, ResourcePriorities: map[string]float64{"nvidia.com/gpu": 3}},
+		gpuQueue: {Priority: 3, ResourcePriorities: map[string]float64{"nvidia.com/gpu": 1}},
+	}
+
+	slices := sliceResource(scarcity, queuePriorities, common.ComputeResourcesFloat{"cpu": 8, "nvidia.com/gpu": 4, "memory": 0})
+
+	assert.Equal(t, map[*api.Queue]common.ComputeResourcesFloat{
+		cpuQueue: {"cpu": 6, "nvidia.com/gpu": 1, "memory": 0},
+		gpuQueue: {"cpu": 2, "nvidia.com/gpu": 3, "memory": 0},
+	}, slices)
+}
+
 func Test_sliceResources_highImbalance(t *testing.T) {
 
 	q1 := &api.Queue{Name: "q1"}
@@ -56,6 +74,69 @@ func Test_sliceResources_highImbalance(t *testing.T) {
 	assert.Equal(t, slices, map[*api.Queue]common.ComputeResourcesFloat{q1: noCpu, q2: allCpu})
 }
 
+func Test_sliceResources_invalidPriorityDoesNotBreakSlices(t *testing.T) {
+	q1 := &api.Queue{Name: "q1"}
+	q2 := &api.Queue{Name: "q2"}
+	q3 := &api.Queue{Name: "q3"}
+
+	queuePriorities := map[*api.Queue]QueuePriorityInfo{
+		q1: {Priority: 0},
+		q2: {Priority: -1},
+		q3: {Priority: math.NaN()},
+	}
+
+	slices := sliceResource(scarcity, queuePriorities, common.ComputeResources{"cpu": resource.MustParse("3")}.AsFloat())
+
+	oneCpu := common.ComputeResourcesFloat{"cpu": 1.0}
+	assert.Equal(t, map[*api.Queue]common.ComputeResourcesFloat{q1: oneCpu, q2: oneCpu, q3: oneCpu}, slices)
+}
+
+func Test_sliceResources_groupsGetEqualShareRegardlessOfQueueCount(t *testing.T) {
+
+	a1 := &api.Queue{Name: "a1", Group: "a"}
+	b1 := &api.Queue{Name: "b1", Group: "b"}
+	b2 := &api.Queue{Name: "b2", Group: "b"}
+	b3 := &api.Queue{Name: "b3", Group: "b"}
+
+	noResources := common.ComputeResources{}
+
+	queuePriorities := map[*api.Queue]QueuePriorityInfo{
+		a1: {Priority: 1, CurrentUsage: noResources},
+		b1: {Priority: 1, CurrentUsage: noResources},
+		b2: {Priority: 1, CurrentUsage: noResources},
+		b3: {Priority: 1, CurrentUsage: noResources},
+	}
+
+	slices := sliceResource(scarcity, queuePriorities, common.ComputeResources{"cpu": resource.MustParse("12")}.AsFloat())
+
+	assert.InDelta(t, 6.0, slices[a1]["cpu"], 1e-9)
+	assert.InDelta(t, 6.0, slices[b1]["cpu"]+slices[b2]["cpu"]+slices[b3]["cpu"], 1e-9)
+	assert.InDelta(t, 2.0, slices[b1]["cpu"], 1e-9)
+}
+
+func Test_sliceResources_groupUsageCompetesForCluster(t *testing.T) {
+
+	a1 := &api.Queue{Name: "a1", Group: "a"}
+	b1 := &api.Queue{Name: "b1", Group: "b"}
+	b2 := &api.Queue{Name: "b2", Group: "b"}
+
+	twoCpu := common.ComputeResources{"cpu": resource.MustParse("2")}
+	noResources := common.ComputeResources{}
+
+	queuePriorities := map[*api.Queue]QueuePriorityInfo{
+		a1: {Priority: 1, CurrentUsage: noResources},
+		b1: {Priority: 1, CurrentUsage: twoCpu},
+		b2: {Priority: 1, CurrentUsage: noResources},
+	}
+
+	slices := sliceResource(scarcity, queuePriorities, common.ComputeResources{"cpu": resource.MustParse("6")}.AsFloat())
+
+	// total usage 8 is split 4 : 4 between groups, group b already uses 2
+	assert.InDelta(t, 4.0, slices[a1]["cpu"], 1e-9)
+	assert.InDelta(t, 0.0, slices[b1]["cpu"], 1e-9)
+	assert.InDelta(t, 2.0, slices[b2]["cpu"], 1e-9)
+}
+
 func Test_SliceResourceWithLimits_SchedulingShareMatchesAdjusted_WhenNoQueuesAtLimit(t *testing.T) {
 
 	q1 := &api.Queue{Name: "q1"}
@@ -189,3 +270,28 @@ func TestQueueSchedulingInfo_UpdateLimits_ValuesLimitedAt0(t *testing.T) {
 	assert.Equal(t, data.schedulingShare, common.ComputeResourcesFloat{"cpu": 0.0})
 	assert.Equal(t, data.adjustedShare, common.ComputeResourcesFloat{"cpu": 0.0})
 }
+
+func Test_roundResources(t *testing.T) {
+	resources := common.ComputeResourcesFloat{"cpu": 1.5, "memory": 2.5, "disk": 3.5, "other": 4.5, common.GpuResourceName: 1.7}
+	rounding := map[string]string{"cpu": RoundingFloor, "memory": RoundingCeil, "disk": RoundingRound, "other": RoundingNone}
+
+	rounded := roundResources(resources, rounding)
+
+	assert.Equal(t, common.ComputeResourcesFloat{"cpu": 1, "memory": 3, "disk": 4, "other": 4.5, common.GpuResourceName: 1}, rounded)
+	assert.Equal(t, 1.7, resources[common.GpuResourceName])
+}
+
+func Test_roundResources_GpuRoundingCanBeOverridden(t *testing.T) {
+	resources := common.ComputeResourcesFloat{common.GpuResourceName: 1.7}
+
+	assert.Equal(t, common.ComputeResourcesFloat{common.GpuResourceName: 2}, roundResources(resources, map[string]string{common.GpuResourceName: RoundingCeil}))
+	assert.Equal(t, common.ComputeResourcesFloat{common.GpuResourceName: 1.7}, roundResources(resources, map[string]string{common.GpuResourceName: RoundingNone}))
+}
+
+func Test_roundResources_IgnoresFloatingPointErrors(t *testing.T) {
+	resources := common.ComputeResourcesFloat{common.GpuResourceName: 0.29 * 100, "cpu": 0.1 * 3}
+
+	rounded := roundResources(resources, map[string]string{"cpu": RoundingCeil})
+
+	assert.Equal(t, common.ComputeResourcesFloat{common.GpuResourceName: 29, "cpu": 1}, rounded)
+}
@@ -0,0 +1,185 @@
+package scheduling
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// shardQueues divides queues to schedule into the configured number of shards, queues are assigned to shards
+// in order of their names, so the same queues end up in the same shard every time.
+// Returns nil when queues are scheduled serially.
+func (c *leaseContext) shardQueues() [][]*api.Queue {
+	shardCount := c.schedulingConfig.QueueShards
+	if c.parent != nil || shardCount <= 1 || len(c.schedulingInfo) <= 1 {
+		return nil
+	}
+	if shardCount > len(c.schedulingInfo) {
+		shardCount = len(c.schedulingInfo)
+	}
+	shards := make([][]*api.Queue, shardCount)
+	for i, queue := range sortedQueues(c.schedulingInfo) {
+		shards[i%shardCount] = append(shards[i%shardCount], queue)
+	}
+	return shards
+}
+
+// scheduleInShards runs the scheduling step for shards of queues concurrently. All shards start from the same
+// snapshot of the resources, each shard scheduling only within shares of its own queues.
+// Jobs leased above the limit or the resources of the whole snapshot are returned to their queues.
+func (c *leaseContext) scheduleInShards(shards [][]*api.Queue, limit int, step func(shard *leaseContext, limit int) []*api.Job) []*api.Job {
+	available := SumRemainingResource(c.schedulingInfo)
+	queueCount := len(c.schedulingInfo)
+
+	contexts := make([]*leaseContext, 0, len(shards))
+	for _, queues := range shards {
+		contexts = append(contexts, c.newShard(queues))
+	}
+
+	leasedByShard := make([][]*api.Job, len(shards))
+	wg := sync.WaitGroup{}
+	for i, shard := range contexts {
+		wg.Add(1)
+		// shards get part of the limit proportional to their number of queues, rounded up not to starve small shards
+		shardLimit := (limit*len(shards[i]) + queueCount - 1) / queueCount
+		go func(i int, shard *leaseContext, shardLimit int) {
+			defer wg.Done()
+			leasedByShard[i] = step(shard, shardLimit)
+		}(i, shard, shardLimit)
+	}
+	wg.Wait()
+
+	for i, shard := range contexts {
+		c.mergeShard(shard, shards[i])
+	}
+	return c.reconcileShardLeases(leasedByShard, available, limit)
+}
+
+// newShard creates context scheduling only the given queues. Scheduling state of the queues is moved to the shard
+// and back by mergeShard, the rest of the context is only read by the shard, so shards can run concurrently.
+func (c *leaseContext) newShard(queues []*api.Queue) *leaseContext {
+	shard := &leaseContext{
+		schedulingConfig: c.schedulingConfig,
+		repository:       c.repository,
+
+		ctx:     c.ctx,
+		request: c.request,
+
+		resourcesToSchedule: c.resourcesToSchedule,
+		resourceScarcity:    c.resourceScarcity,
+		schedulingInfo:      make(map[*api.Queue]*QueueSchedulingInfo, len(queues)),
+		priorities:          make(map[*api.Queue]QueuePriorityInfo, len(queues)),
+		fairness:            c.fairness,
+
+		queueCache:      map[string][]*api.Job{},
+		denials:         map[string]*LeaseDenial{},
+		reservedJobs:    map[string]map[string]bool{},
+		leasedJobCounts: map[string]int64{},
+
+		parent:       c,
+		labelMatcher: c.labelMatcher,
+	}
+	// random numbers are drawn before shards start, so shards pick the same queues for the same random source
+	if c.random != nil {
+		shard.random = rand.New(rand.NewSource(c.random.Int63()))
+	}
+	for _, queue := range queues {
+		shard.schedulingInfo[queue] = c.schedulingInfo[queue]
+		if priority, ok := c.priorities[queue]; ok {
+			shard.priorities[queue] = priority
+		}
+		if jobs, ok := c.queueCache[queue.Name]; ok {
+			shard.queueCache[queue.Name] = jobs
+		}
+		if reserved, ok := c.reservedJobs[queue.Name]; ok {
+			shard.reservedJobs[queue.Name] = reserved
+		}
+		if count, ok := c.leasedJobCounts[queue.Name]; ok {
+			shard.leasedJobCounts[queue.Name] = count
+		}
+	}
+	return shard
+}
+
+// mergeShard takes back scheduling state of the queues from the shard, queues the shard eliminated from scheduling
+// are eliminated from this context too.
+func (c *leaseContext) mergeShard(shard *leaseContext, queues []*api.Queue) {
+	if c.denials == nil {
+		c.denials = map[string]*LeaseDenial{}
+	}
+	if c.leasedJobCounts == nil {
+		c.leasedJobCounts = map[string]int64{}
+	}
+	for _, queue := range queues {
+		if info, ok := shard.schedulingInfo[queue]; ok {
+			c.schedulingInfo[queue] = info
+		} else {
+			delete(c.schedulingInfo, queue)
+			delete(c.priorities, queue)
+		}
+		if jobs, ok := shard.queueCache[queue.Name]; ok {
+			c.queueCache[queue.Name] = jobs
+		}
+		if reserved, ok := shard.reservedJobs[queue.Name]; ok {
+			c.reservedJobs[queue.Name] = reserved
+		}
+		if count, ok := shard.leasedJobCounts[queue.Name]; ok {
+			c.leasedJobCounts[queue.Name] = count
+		}
+	}
+	for id, denial := range shard.denials {
+		c.denials[id] = denial
+	}
+}
+
+// reconcileShardLeases keeps jobs leased by shards, taken from each shard in turn, while they fit into the limit
+// and the resources available to all shards. Shards schedule only within shares of their queues, so normally all
+// jobs are kept, the rest is returned to their queues so the cluster is never leased more than it asked for.
+func (c *leaseContext) reconcileShardLeases(leasedByShard [][]*api.Job, available common.ComputeResourcesFloat, limit int) []*api.Job {
+	remaining := available.DeepCopy()
+	kept := []*api.Job{}
+	excess := []*api.Job{}
+	for i, taken := 0, true; taken; i++ {
+		taken = false
+		for _, leased := range leasedByShard {
+			if i >= len(leased) {
+				continue
+			}
+			taken = true
+			job := leased[i]
+			requirement := common.TotalResourceRequest(job.PodSpec).AsFloat()
+			if len(kept) < limit && fits(requirement, remaining) {
+				remaining.Sub(requirement)
+				kept = append(kept, job)
+			} else {
+				excess = append(excess, job)
+			}
+		}
+	}
+
+	if len(excess) > 0 {
+		c.logger().Warnf("Returning %d jobs leased by shards of queues above the lease request.", len(excess))
+		c.returnLeases(excess)
+		c.releaseGlobalHeadroom(excess)
+		for _, job := range excess {
+			if _, loaded := c.leasedJobCounts[job.Queue]; loaded {
+				c.leasedJobCounts[job.Queue]--
+			}
+		}
+	}
+	return kept
+}
+
+func sortedQueues(schedulingInfo map[*api.Queue]*QueueSchedulingInfo) []*api.Queue {
+	queues := make([]*api.Queue, 0, len(schedulingInfo))
+	for queue := range schedulingInfo {
+		queues = append(queues, queue)
+	}
+	sort.Slice(queues, func(i, j int) bool {
+		return queues[i].Name < queues[j].Name
+	})
+	return queues
+}
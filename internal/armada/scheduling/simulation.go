@@ -0,0 +1,65 @@
+package scheduling
+
+import (
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// SimulatedJobQueueRepository leases jobs only in memory, so the scheduling algorithm
+// can be run against the current queues without modifying them.
+type SimulatedJobQueueRepository struct {
+	repository repository.JobQueueRepository
+	leased     map[string]map[string]bool
+}
+
+func NewSimulatedJobQueueRepository(jobQueueRepository repository.JobQueueRepository) *SimulatedJobQueueRepository {
+	return &SimulatedJobQueueRepository{
+		repository: jobQueueRepository,
+		leased:     map[string]map[string]bool{},
+	}
+}
+
+func (r *SimulatedJobQueueRepository) PeekQueue(queue string, limit int64) ([]*api.Job, error) {
+	leased := r.leased[queue]
+	jobs, e := r.repository.PeekQueue(queue, limit+int64(len(leased)))
+	if e != nil {
+		return nil, e
+	}
+	result := make([]*api.Job, 0, limit)
+	for _, job := range jobs {
+		if int64(len(result)) >= limit {
+			break
+		}
+		if !leased[job.Id] {
+			result = append(result, job)
+		}
+	}
+	return result, nil
+}
+
+func (r *SimulatedJobQueueRepository) TryLeaseJobs(clusterId string, queue string, jobs []*api.Job) ([]*api.Job, error) {
+	leased, ok := r.leased[queue]
+	if !ok {
+		leased = map[string]bool{}
+		r.leased[queue] = leased
+	}
+	for _, job := range jobs {
+		leased[job.Id] = true
+	}
+	return jobs, nil
+}
+
+func (r *SimulatedJobQueueRepository) ReturnLease(clusterId string, jobId string) (*api.Job, error) {
+	for _, leased := range r.leased {
+		delete(leased, jobId)
+	}
+	return nil, nil
+}
+
+func (r *SimulatedJobQueueRepository) GetLeasedJobCount(queue string) (int64, error) {
+	count, e := r.repository.GetLeasedJobCount(queue)
+	if e != nil {
+		return 0, e
+	}
+	return count + int64(len(r.leased[queue])), nil
+}
@@ -0,0 +1,57 @@
+package scheduling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_LeaseJobs_WithSimulatedRepository_DoesNotModifyQueues(t *testing.T) {
+	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
+	queue1Jobs := []*api.Job{
+		{Id: "job1", Queue: "queue1", PodSpec: classicPodSpec},
+		{Id: "job2", Queue: "queue1", PodSpec: classicPodSpec},
+		{Id: "job3", Queue: "queue1", PodSpec: classicPodSpec},
+		{Id: "job4", Queue: "queue1", PodSpec: classicPodSpec},
+	}
+	fakeRepository := &fakeJobQueueRepository{
+		jobsByQueue: map[string][]*api.Job{"queue1": append([]*api.Job{}, queue1Jobs...)},
+	}
+
+	capacity := common.ComputeResources{"cpu": resource.MustParse("3"), "memory": resource.MustParse("1Gi")}
+	clusterReports := map[string]*api.ClusterUsageReport{
+		"c1": {ClusterId: "c1", ClusterCapacity: capacity, ClusterAvailableCapacity: capacity},
+	}
+	all := map[string]float64{"cpu": 1, "memory": 1}
+	config := &configuration.SchedulingConfig{
+		QueueLeaseBatchSize:                       2,
+		MaximalClusterFractionToSchedule:          all,
+		MaximalResourceFractionToSchedulePerQueue: all,
+		MaximalResourceFractionPerQueue:           all,
+	}
+
+	jobs, e := LeaseJobs(
+		context.Background(),
+		config,
+		NewSimulatedJobQueueRepository(fakeRepository),
+		func(jobs []*api.Job) {},
+		func(denials []*LeaseDenial) {},
+		nil,
+		nil,
+		&api.LeaseRequest{ClusterId: "c1", Resources: capacity},
+		clusterReports,
+		map[string]*api.ClusterLeasedReport{},
+		nil,
+		map[string]map[string]float64{},
+		[]*api.Queue{queue1})
+
+	assert.Nil(t, e)
+	assert.Equal(t, queue1Jobs[:3], jobs)
+	assert.Equal(t, queue1Jobs, fakeRepository.jobsByQueue["queue1"])
+}
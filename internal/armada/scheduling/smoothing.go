@@ -0,0 +1,59 @@
+package scheduling
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+)
+
+// CapacitySmoother spreads capacity which newly became available in a cluster over several lease requests,
+// so queues waiting for it don't lease all of it in one cycle. It remembers resources released to each cluster
+// in its last lease request, each request releases these and a part of the resources freed since.
+type CapacitySmoother struct {
+	lock     sync.Mutex
+	random   *rand.Rand
+	released map[string]common.ComputeResourcesFloat
+}
+
+func NewCapacitySmoother() *CapacitySmoother {
+	return &CapacitySmoother{
+		random:   rand.New(rand.NewSource(time.Now().UnixNano())),
+		released: map[string]common.ComputeResourcesFloat{},
+	}
+}
+
+// Smooth returns resources of the cluster which may be leased in this cycle. Resources not freed since the last
+// lease request are released in full, of the newly freed ones only 1/Cycles is released, reduced by random jitter.
+// A cluster seen for the first time has all its resources newly freed.
+func (s *CapacitySmoother) Smooth(settings *configuration.CapacitySmoothingSettings, clusterId string, free common.ComputeResources) common.ComputeResources {
+	if settings.Cycles <= 1 {
+		return free
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	previous := s.released[clusterId]
+	released := common.ComputeResourcesFloat{}
+	smoothed := common.ComputeResources{}
+	for key, quantity := range free {
+		value := common.QuantityAsFloat64(quantity)
+		if value > previous[key] {
+			step := 1 / float64(settings.Cycles)
+			if settings.Jitter > 0 {
+				step *= 1 - s.random.Float64()*math.Min(settings.Jitter, 1)
+			}
+			value = previous[key] + (value-previous[key])*step
+		}
+		released[key] = value
+		smoothed[key] = *resource.NewMilliQuantity(int64(math.Round(value*1000)), resource.DecimalSI)
+	}
+	s.released[clusterId] = released
+	return smoothed
+}
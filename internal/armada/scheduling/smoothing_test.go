@@ -0,0 +1,57 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+)
+
+func TestCapacitySmoother_CapacityJumpIsLeasedOverSeveralCycles(t *testing.T) {
+	smoother := NewCapacitySmoother()
+	settings := &configuration.CapacitySmoothingSettings{Cycles: 4}
+
+	free := 100.0
+	leasedInCycles := []float64{}
+	for free > 0 && len(leasedInCycles) < 10 {
+		leasable := smoother.Smooth(settings, "cluster1", cpuResources(free))
+		// all leasable resources are taken by waiting queues
+		leased := common.QuantityAsFloat64(leasable["cpu"])
+		leasedInCycles = append(leasedInCycles, leased)
+		free -= leased
+	}
+
+	assert.Equal(t, []float64{25, 37.5, 37.5}, leasedInCycles)
+}
+
+func TestCapacitySmoother_StableCapacityIsReleasedInFull(t *testing.T) {
+	smoother := NewCapacitySmoother()
+	settings := &configuration.CapacitySmoothingSettings{Cycles: 2}
+
+	assert.Equal(t, 5.0, common.QuantityAsFloat64(smoother.Smooth(settings, "cluster1", cpuResources(10))["cpu"]))
+	assert.Equal(t, 7.5, common.QuantityAsFloat64(smoother.Smooth(settings, "cluster1", cpuResources(10))["cpu"]))
+	assert.Equal(t, 4.0, common.QuantityAsFloat64(smoother.Smooth(settings, "cluster1", cpuResources(4))["cpu"]))
+	assert.Equal(t, 5.0, common.QuantityAsFloat64(smoother.Smooth(settings, "cluster2", cpuResources(10))["cpu"]))
+}
+
+func TestCapacitySmoother_DisabledReturnsAllFreeResources(t *testing.T) {
+	smoother := NewCapacitySmoother()
+	free := cpuResources(100)
+
+	assert.Equal(t, free, smoother.Smooth(&configuration.CapacitySmoothingSettings{}, "cluster1", free))
+}
+
+func TestCapacitySmoother_JitterOnlyReducesReleasedResources(t *testing.T) {
+	smoother := NewCapacitySmoother()
+	settings := &configuration.CapacitySmoothingSettings{Cycles: 4, Jitter: 0.5}
+
+	leased := common.QuantityAsFloat64(smoother.Smooth(settings, "cluster1", cpuResources(100))["cpu"])
+	assert.True(t, leased >= 12.5 && leased <= 25, "released %v", leased)
+}
+
+func cpuResources(value float64) common.ComputeResources {
+	return common.ComputeResources{"cpu": *resource.NewMilliQuantity(int64(value*1000), resource.DecimalSI)}
+}
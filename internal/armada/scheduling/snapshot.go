@@ -0,0 +1,78 @@
+package scheduling
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// capacitySnapshot holds the lease request, capacity of clusters and current usage of queues a scheduling cycle works with.
+// It is taken at the start of the cycle, so usage reports arriving while the cycle runs do not change its decisions.
+type capacitySnapshot struct {
+	request               *api.LeaseRequest
+	clusterReports        map[string]*api.ClusterUsageReport
+	clusterLeasedReports  map[string]*api.ClusterLeasedReport
+	clusterLeasesInWindow map[string]*api.ClusterLeasedReport
+	clusterPriorities     map[string]map[string]float64
+}
+
+// takeCapacitySnapshot deep copies everything the cycle reads, the cycle works only with the copies.
+func takeCapacitySnapshot(
+	request *api.LeaseRequest,
+	clusterReports map[string]*api.ClusterUsageReport,
+	clusterLeasedReports map[string]*api.ClusterLeasedReport,
+	clusterLeasesInWindow map[string]*api.ClusterLeasedReport,
+	clusterPriorities map[string]map[string]float64) (*capacitySnapshot, error) {
+
+	snapshot := &capacitySnapshot{
+		request:              &api.LeaseRequest{},
+		clusterReports:       make(map[string]*api.ClusterUsageReport, len(clusterReports)),
+		clusterLeasedReports: make(map[string]*api.ClusterLeasedReport, len(clusterLeasedReports)),
+		clusterPriorities:    make(map[string]map[string]float64, len(clusterPriorities)),
+	}
+	if e := copyMessage(request, snapshot.request); e != nil {
+		return nil, e
+	}
+	for id, report := range clusterReports {
+		reportCopy := &api.ClusterUsageReport{}
+		if e := copyMessage(report, reportCopy); e != nil {
+			return nil, e
+		}
+		snapshot.clusterReports[id] = reportCopy
+	}
+	for id, report := range clusterLeasedReports {
+		reportCopy := &api.ClusterLeasedReport{}
+		if e := copyMessage(report, reportCopy); e != nil {
+			return nil, e
+		}
+		snapshot.clusterLeasedReports[id] = reportCopy
+	}
+	// nil leases in window mean the cluster fairness window is not configured
+	if clusterLeasesInWindow != nil {
+		snapshot.clusterLeasesInWindow = make(map[string]*api.ClusterLeasedReport, len(clusterLeasesInWindow))
+		for id, report := range clusterLeasesInWindow {
+			reportCopy := &api.ClusterLeasedReport{}
+			if e := copyMessage(report, reportCopy); e != nil {
+				return nil, e
+			}
+			snapshot.clusterLeasesInWindow[id] = reportCopy
+		}
+	}
+	for id, priorities := range clusterPriorities {
+		prioritiesCopy := make(map[string]float64, len(priorities))
+		for queue, priority := range priorities {
+			prioritiesCopy[queue] = priority
+		}
+		snapshot.clusterPriorities[id] = prioritiesCopy
+	}
+	return snapshot, nil
+}
+
+// copyMessage copies the message through its wire format, which keeps quantities and timestamps exact.
+func copyMessage(source proto.Message, target proto.Message) error {
+	data, e := proto.Marshal(source)
+	if e != nil {
+		return e
+	}
+	return proto.Unmarshal(data, target)
+}
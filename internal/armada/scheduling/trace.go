@@ -0,0 +1,65 @@
+package scheduling
+
+import (
+	"math"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// LeaseTraceCollector collects scheduling decisions of a lease request through the callbacks of LeaseJobs,
+// for executors asking for them with LeaseRequest.Debug.
+type LeaseTraceCollector struct {
+	pool    string
+	shares  []*QueueShare
+	denials []*LeaseDenial
+}
+
+func (c *LeaseTraceCollector) RecordShares(pool string, shares []*QueueShare) {
+	c.pool = pool
+	c.shares = shares
+}
+
+func (c *LeaseTraceCollector) RecordDenials(denials []*LeaseDenial) {
+	c.denials = append(c.denials, denials...)
+}
+
+// Trace returns the collected decisions, queues are sorted by name and denied jobs by id.
+func (c *LeaseTraceCollector) Trace() *api.SchedulingTrace {
+	trace := &api.SchedulingTrace{
+		Pool:    c.pool,
+		Queues:  make([]*api.QueueSchedulingTrace, 0, len(c.shares)),
+		Denials: make([]*api.JobDenialTrace, 0, len(c.denials)),
+	}
+	for _, share := range c.shares {
+		trace.Queues = append(trace.Queues, &api.QueueSchedulingTrace{
+			Queue:                    share.Queue,
+			Share:                    asQuantities(share.AdjustedShare),
+			RemainingSchedulingLimit: asQuantities(share.RemainingSchedulingLimit),
+			CurrentUsage:             asQuantities(share.CurrentUsage),
+			IdleReason:               string(share.IdleReason),
+		})
+	}
+	for _, denial := range c.denials {
+		trace.Denials = append(trace.Denials, &api.JobDenialTrace{
+			JobId:  denial.Job.Id,
+			Queue:  denial.Job.Queue,
+			Reason: denial.Reason.String(),
+		})
+	}
+	sort.Slice(trace.Denials, func(i, j int) bool {
+		return trace.Denials[i].JobId < trace.Denials[j].JobId
+	})
+	return trace
+}
+
+func asQuantities(resources common.ComputeResourcesFloat) map[string]resource.Quantity {
+	quantities := make(map[string]resource.Quantity, len(resources))
+	for key, value := range resources {
+		quantities[key] = *resource.NewMilliQuantity(int64(math.Round(value*1000)), resource.DecimalSI)
+	}
+	return quantities
+}
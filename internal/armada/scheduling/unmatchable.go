@@ -0,0 +1,56 @@
+package scheduling
+
+import (
+	"fmt"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const (
+	UnmatchableJobsLeave  = "leave"
+	UnmatchableJobsWarn   = "warn"
+	UnmatchableJobsCancel = "cancel"
+)
+
+// UnmatchableJobReason is the reason of cancellation of jobs cancelled by the cancel policy.
+const UnmatchableJobReason = "unschedulable: no matching cluster"
+
+// ValidateUnmatchableJobsPolicy returns an error when the policy is not known, empty policy leaves jobs queued.
+func ValidateUnmatchableJobsPolicy(policy string) error {
+	switch policy {
+	case "", UnmatchableJobsLeave, UnmatchableJobsWarn, UnmatchableJobsCancel:
+		return nil
+	default:
+		return fmt.Errorf("unknown unmatchable jobs policy %s, expected %s, %s or %s",
+			policy, UnmatchableJobsLeave, UnmatchableJobsWarn, UnmatchableJobsCancel)
+	}
+}
+
+// FilterUnmatchableJobs returns jobs denied because the requesting cluster has no nodes with their required labels
+// which no other active cluster has either. Transient denials, e.g. for insufficient capacity, are never included.
+func FilterUnmatchableJobs(denials []*LeaseDenial, activeClusterReports map[string]*api.ClusterUsageReport) []*api.Job {
+	result := []*api.Job{}
+	for _, denial := range denials {
+		if denial.Reason == api.LeaseDeniedReason_NoMatchingNodeLabels && !matchesAnyCluster(denial.Job, activeClusterReports) {
+			result = append(result, denial.Job)
+		}
+	}
+	return result
+}
+
+// matchesAnyCluster tells whether any of the clusters has nodes with labels required by the job regardless of resources
+// available on them. Clusters not reporting their node labelings may have any labels, so they match all jobs.
+func matchesAnyCluster(job *api.Job, clusterReports map[string]*api.ClusterUsageReport) bool {
+	requiredLabels := requiredNodeLabels(job)
+	for _, report := range clusterReports {
+		if len(report.NodeLabelings) == 0 {
+			return true
+		}
+		for _, labeling := range report.NodeLabelings {
+			if hasLabels(labeling, requiredLabels) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_FilterUnmatchableJobs_ReturnsJobsMatchingNoActiveCluster(t *testing.T) {
+	impossible := &api.Job{Id: "impossible", RequiredNodeLabels: map[string]string{"impossible": "label"}}
+	otherCluster := &api.Job{Id: "otherCluster", RequiredNodeLabels: map[string]string{"gpu": "a100"}}
+	noCapacity := &api.Job{Id: "noCapacity", RequiredNodeLabels: map[string]string{"gpu": "a100"}}
+
+	reports := map[string]*api.ClusterUsageReport{
+		"cluster1": {ClusterId: "cluster1", NodeLabelings: []*api.NodeLabeling{{Labels: map[string]string{"gpu": "none"}}}},
+		"cluster2": {ClusterId: "cluster2", NodeLabelings: []*api.NodeLabeling{{Labels: map[string]string{"gpu": "a100"}}}},
+	}
+	denials := []*LeaseDenial{
+		{Job: impossible, Reason: api.LeaseDeniedReason_NoMatchingNodeLabels},
+		{Job: otherCluster, Reason: api.LeaseDeniedReason_NoMatchingNodeLabels},
+		{Job: noCapacity, Reason: api.LeaseDeniedReason_InsufficientCapacity},
+	}
+
+	assert.Equal(t, []*api.Job{impossible}, FilterUnmatchableJobs(denials, reports))
+}
+
+func Test_FilterUnmatchableJobs_ClusterWithoutReportedLabelingsMatchesAllJobs(t *testing.T) {
+	impossible := &api.Job{Id: "impossible", RequiredNodeLabels: map[string]string{"impossible": "label"}}
+	reports := map[string]*api.ClusterUsageReport{
+		"cluster1": {ClusterId: "cluster1", NodeLabelings: []*api.NodeLabeling{{Labels: map[string]string{"gpu": "none"}}}},
+		"cluster2": {ClusterId: "cluster2"},
+	}
+	denials := []*LeaseDenial{{Job: impossible, Reason: api.LeaseDeniedReason_NoMatchingNodeLabels}}
+
+	assert.Empty(t, FilterUnmatchableJobs(denials, reports))
+}
+
+func Test_ValidateUnmatchableJobsPolicy(t *testing.T) {
+	for _, policy := range []string{"", UnmatchableJobsLeave, UnmatchableJobsWarn, UnmatchableJobsCancel} {
+		assert.Nil(t, ValidateUnmatchableJobsPolicy(policy))
+	}
+	assert.NotNil(t, ValidateUnmatchableJobsPolicy("delete"))
+}
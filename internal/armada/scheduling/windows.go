@@ -0,0 +1,109 @@
+package scheduling
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const minutesPerDay = 24 * 60
+
+// ValidateSchedulingWindows checks times and days of the windows can be parsed.
+func ValidateSchedulingWindows(windows []*api.SchedulingWindow) error {
+	for i, window := range windows {
+		if _, e := parseWindowTime(window.Start); e != nil {
+			return fmt.Errorf("window with index %d: invalid start: %v", i, e)
+		}
+		if _, e := parseWindowTime(window.End); e != nil {
+			return fmt.Errorf("window with index %d: invalid end: %v", i, e)
+		}
+		for _, day := range window.Days {
+			if _, ok := parseWeekday(day); !ok {
+				return fmt.Errorf("window with index %d: unknown day %s", i, day)
+			}
+		}
+	}
+	return nil
+}
+
+// queuesInSchedulingWindows returns the queues which may lease jobs at the time according to their scheduling windows.
+func queuesInSchedulingWindows(queues []*api.Queue, now time.Time) []*api.Queue {
+	result := make([]*api.Queue, 0, len(queues))
+	for _, queue := range queues {
+		if schedulesAt(queue, now) {
+			result = append(result, queue)
+		}
+	}
+	return result
+}
+
+// schedulesAt tells whether the queue may lease jobs at the time, which is when it is in one of its allowed windows,
+// or it has none, and in none of its blocked windows. Windows which can't be parsed are ignored.
+func schedulesAt(queue *api.Queue, t time.Time) bool {
+	hasAllowedWindows := false
+	inAllowedWindow := false
+	for _, window := range queue.SchedulingWindows {
+		if window.Blocked {
+			if windowContains(window, t) {
+				return false
+			}
+			continue
+		}
+		hasAllowedWindows = true
+		inAllowedWindow = inAllowedWindow || windowContains(window, t)
+	}
+	return !hasAllowedWindows || inAllowedWindow
+}
+
+// windowContains tells whether the time falls into the window, a window ending at or before its start continues
+// over midnight into the next day.
+func windowContains(window *api.SchedulingWindow, t time.Time) bool {
+	start, e := parseWindowTime(window.Start)
+	if e != nil {
+		return false
+	}
+	end, e := parseWindowTime(window.End)
+	if e != nil {
+		return false
+	}
+	t = t.UTC()
+	minute := t.Hour()*60 + t.Minute()
+	if start < end {
+		return start <= minute && minute < end && startsOn(window, t.Weekday())
+	}
+	previousDay := (t.Weekday() + 6) % 7
+	return (minute >= start && startsOn(window, t.Weekday())) || (minute < end && startsOn(window, previousDay))
+}
+
+func startsOn(window *api.SchedulingWindow, day time.Weekday) bool {
+	if len(window.Days) == 0 {
+		return true
+	}
+	for _, d := range window.Days {
+		if weekday, ok := parseWeekday(d); ok && weekday == day {
+			return true
+		}
+	}
+	return false
+}
+
+// parseWindowTime returns minutes since midnight of time in HH:MM format.
+func parseWindowTime(value string) (int, error) {
+	t, e := time.Parse("15:04", value)
+	if e != nil {
+		return 0, e
+	}
+	return (t.Hour()*60 + t.Minute()) % minutesPerDay, nil
+}
+
+func parseWeekday(day string) (time.Weekday, bool) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		name := weekday.String()
+		if strings.EqualFold(day, name) || strings.EqualFold(day, name[:3]) {
+			return weekday, true
+		}
+	}
+	return 0, false
+}
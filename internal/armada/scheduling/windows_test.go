@@ -0,0 +1,47 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_windowContains(t *testing.T) {
+	monday := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	businessHours := &api.SchedulingWindow{Start: "09:00", End: "17:00", Days: []string{"Mon", "Tue", "Wed", "Thu", "Fri"}}
+	overnight := &api.SchedulingWindow{Start: "22:00", End: "02:00", Days: []string{"Sunday"}}
+
+	assert.True(t, windowContains(businessHours, monday.Add(9*time.Hour)))
+	assert.True(t, windowContains(businessHours, monday.Add(16*time.Hour+59*time.Minute)))
+	assert.False(t, windowContains(businessHours, monday.Add(17*time.Hour)))
+	assert.False(t, windowContains(businessHours, monday.Add(-24*time.Hour+10*time.Hour)))
+
+	// the window started on Sunday continues into Monday
+	assert.True(t, windowContains(overnight, monday.Add(-time.Hour)))
+	assert.True(t, windowContains(overnight, monday.Add(time.Hour)))
+	assert.False(t, windowContains(overnight, monday.Add(2*time.Hour)))
+	assert.False(t, windowContains(overnight, monday.Add(23*time.Hour)))
+}
+
+func Test_schedulesAt(t *testing.T) {
+	monday := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	queue := &api.Queue{Name: "queue1", SchedulingWindows: []*api.SchedulingWindow{
+		{Start: "08:00", End: "20:00"},
+		{Start: "12:00", End: "13:00", Blocked: true},
+	}}
+
+	assert.False(t, schedulesAt(queue, monday.Add(7*time.Hour)))
+	assert.True(t, schedulesAt(queue, monday.Add(8*time.Hour)))
+	assert.False(t, schedulesAt(queue, monday.Add(12*time.Hour+30*time.Minute)))
+	assert.True(t, schedulesAt(queue, monday.Add(13*time.Hour)))
+	assert.True(t, schedulesAt(&api.Queue{Name: "queue2"}, monday))
+}
+
+func Test_ValidateSchedulingWindows(t *testing.T) {
+	assert.NoError(t, ValidateSchedulingWindows([]*api.SchedulingWindow{{Start: "22:00", End: "06:00", Days: []string{"sat", "Sunday"}}}))
+	assert.Error(t, ValidateSchedulingWindows([]*api.SchedulingWindow{{Start: "9am", End: "17:00"}}))
+	assert.Error(t, ValidateSchedulingWindows([]*api.SchedulingWindow{{Start: "09:00", End: "17:00", Days: []string{"Someday"}}}))
+}
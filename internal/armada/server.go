@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
+	"os"
 	"sync"
 	"time"
 
@@ -11,44 +13,94 @@ import (
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 
+	"github.com/G-Research/armada/internal/armada/audit"
 	"github.com/G-Research/armada/internal/armada/authorization"
 	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/armada/metrics"
 	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/internal/armada/scheduling"
 	"github.com/G-Research/armada/internal/armada/server"
+	"github.com/G-Research/armada/internal/armada/validation"
+	"github.com/G-Research/armada/internal/armada/webhook"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/health"
+	"github.com/G-Research/armada/internal/common/logging"
 	"github.com/G-Research/armada/internal/common/task"
+	"github.com/G-Research/armada/internal/common/tracing"
 	"github.com/G-Research/armada/pkg/api"
 )
 
-func Serve(config *configuration.ArmadaConfig) (func(), *sync.WaitGroup) {
+// Serve starts the server, reloadConfig is used to periodically read the current configuration
+// to apply changed scheduling settings without restart, nil disables reloading.
+func Serve(config *configuration.ArmadaConfig, reloadConfig func() (*configuration.ArmadaConfig, error)) (func(), *sync.WaitGroup) {
+	if _, e := scheduling.NewFairnessStrategy(config.Scheduling.FairnessStrategy); e != nil {
+		log.Fatalf("invalid scheduling configuration: %v", e)
+	}
+	if e := scheduling.ValidateUnmatchableJobsPolicy(config.Scheduling.UnmatchableJobs.Policy); e != nil {
+		log.Fatalf("invalid scheduling configuration: %v", e)
+	}
+
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
+	stopTracing := setUpTracing(&config.Tracing)
 	grpcServer := createServer(config)
 
 	db := createRedisClient(&config.Redis)
 	eventsDb := createRedisClient(&config.EventsRedis)
 
-	jobRepository := repository.NewRedisJobRepository(db)
-	usageRepository := repository.NewRedisUsageRepository(db)
-	queueRepository := repository.NewRedisQueueRepository(db)
+	redisLatency := repository.NewRedisLatencyMonitor(config.Backpressure.RedisLatencyThreshold)
+	if config.Backpressure.RedisLatencyThreshold > 0 {
+		redisLatency.Monitor(db)
+		redisLatency.Monitor(eventsDb)
+	}
+
+	jobRepository := repository.NewRedisJobRepository(db, config.RedisKeyPrefix, config.CompressJobs, config.JobRetention.RetentionDuration)
+	usageRepository := repository.NewRedisUsageRepository(db, config.RedisKeyPrefix)
+	queueRepository := repository.NewRedisQueueRepository(db, config.RedisKeyPrefix)
+	jobTemplateRepository := repository.NewRedisJobTemplateRepository(db, config.RedisKeyPrefix)
 
-	eventRepository := repository.NewRedisEventRepository(eventsDb, config.EventRetention)
+	eventRepository, stopWebhookNotifier := createEventRepository(config, eventsDb)
+	eventReader, statusReadsLatency := createEventReader(config, eventRepository, redisLatency)
 
 	permissions := authorization.NewPrincipalPermissionChecker(config.PermissionGroupMapping, config.PermissionScopeMapping)
+	auditSink, stopAuditSink := createAuditSink(&config.Audit, db)
 
-	submitServer := server.NewSubmitServer(permissions, jobRepository, queueRepository, eventRepository)
-	usageServer := server.NewUsageServer(permissions, config.PriorityHalfTime, usageRepository)
-	aggregatedQueueServer := server.NewAggregatedQueueServer(permissions, config.Scheduling, jobRepository, queueRepository, usageRepository, eventRepository)
-	eventServer := server.NewEventServer(permissions, eventRepository)
-	leaseManager := scheduling.NewLeaseManager(jobRepository, queueRepository, eventRepository, config.Scheduling.Lease.ExpireAfter)
+	jobNotifier := scheduling.NewJobNotifier()
+
+	submitServer := server.NewSubmitServer(permissions, &config.Scheduling, &config.Webhook, jobRepository, queueRepository, jobTemplateRepository, eventRepository, usageRepository, jobNotifier, auditSink,
+		validation.NewSubmissionValidator(config.SubmissionPolicy))
+	usageServer := server.NewUsageServer(permissions, config.PriorityHalfTime, config.Scheduling.ResourceScarcity, &config.Scheduling.ResourceOveruse,
+		usageRepository, jobRepository, eventRepository)
+	aggregatedQueueServer := server.NewAggregatedQueueServer(permissions, config.Scheduling, jobRepository, queueRepository, usageRepository, eventRepository, jobNotifier,
+		metrics.NewSchedulingMetrics(), config.LeaseConcurrency)
+	eventServer := server.NewEventServer(permissions, jobRepository, eventRepository, eventReader, jobNotifier, &config.Scheduling.OOMRetry,
+		&config.Scheduling.FailureRetry, config.EventWatchKeepaliveInterval, config.MaxEventWatchSubscribers, statusReadsLatency)
+	leaseManager := scheduling.NewLeaseManager(jobRepository, queueRepository, eventRepository, config.Scheduling.Lease.ExpireAfter, config.Scheduling.MaxLeaseAttempts)
 
 	taskManager := task.NewBackgroundTaskManager(metrics.MetricPrefix)
 	taskManager.Register(leaseManager.ExpireLeases, config.Scheduling.Lease.ExpiryLoopInterval, "lease_expiry")
+	if config.JobRetention.RetentionDuration > 0 && config.JobRetention.CleanupInterval > 0 {
+		taskManager.Register(func() { purgeFinishedJobStates(eventRepository, config.JobRetention.RetentionDuration) },
+			config.JobRetention.CleanupInterval, "job_retention")
+	}
+	if reloadConfig != nil && config.ConfigReloadInterval > 0 {
+		taskManager.Register(func() {
+			applySchedulingConfig(reloadConfig, aggregatedQueueServer, usageServer, submitServer, eventServer, leaseManager)
+		}, config.ConfigReloadInterval, "config_reload")
+	}
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", config.GrpcPort))
 	if err != nil {
@@ -56,6 +108,7 @@ func Serve(config *configuration.ArmadaConfig) (func(), *sync.WaitGroup) {
 	}
 
 	metrics.ExposeDataMetrics(queueRepository, jobRepository, usageRepository)
+	stopMetricsPush := metrics.StartMetricsPush(&config.MetricsPush, prometheus.DefaultGatherer)
 
 	api.RegisterSubmitServer(grpcServer, submitServer)
 	api.RegisterUsageServer(grpcServer, usageServer)
@@ -64,6 +117,11 @@ func Serve(config *configuration.ArmadaConfig) (func(), *sync.WaitGroup) {
 
 	grpc_prometheus.Register(grpcServer)
 
+	startupCompleteCheck := health.NewStartupCompleteChecker()
+	stopHealthServer := serveHealth(config, db, eventsDb, taskManager, startupCompleteCheck)
+
+	// the server is ready once it accepts connections
+	lis = &acceptNotifyingListener{Listener: lis, onAccept: startupCompleteCheck.MarkComplete}
 	go func() {
 		defer log.Println("Stopping server.")
 
@@ -76,19 +134,181 @@ func Serve(config *configuration.ArmadaConfig) (func(), *sync.WaitGroup) {
 	}()
 
 	return func() {
+		startupCompleteCheck.MarkIncomplete()
 		taskManager.StopAll(time.Second * 2)
-		grpcServer.GracefulStop()
+		stopGracefully(grpcServer, config.ShutdownTimeout)
+		stopAuditSink()
+		stopWebhookNotifier()
+		stopHealthServer()
+		stopMetricsPush()
+		stopTracing()
 	}, wg
 }
 
+// applySchedulingConfig reads the configuration and applies hot reloadable scheduling settings to all components
+// using them, they take effect from the next lease request, submission or reported failure.
+func applySchedulingConfig(
+	reloadConfig func() (*configuration.ArmadaConfig, error),
+	aggregatedQueueServer *server.AggregatedQueueServer,
+	usageServer *server.UsageServer,
+	submitServer *server.SubmitServer,
+	eventServer *server.EventServer,
+	leaseManager *scheduling.LeaseManager) {
+
+	config, e := reloadConfig()
+	if e != nil {
+		log.Errorf("Failed to reload configuration: %s", e)
+		return
+	}
+	if _, e := scheduling.NewFairnessStrategy(config.Scheduling.FairnessStrategy); e != nil {
+		log.Errorf("Failed to reload configuration: %s", e)
+		return
+	}
+	if e := scheduling.ValidateUnmatchableJobsPolicy(config.Scheduling.UnmatchableJobs.Policy); e != nil {
+		log.Errorf("Failed to reload configuration: %s", e)
+		return
+	}
+	usageServer.UpdateResourceScarcity(config.Scheduling.ResourceScarcity)
+	submitServer.UpdateSchedulingConfig(config.Scheduling)
+	eventServer.UpdateRetrySettings(config.Scheduling.OOMRetry, config.Scheduling.FailureRetry)
+	leaseManager.SetMaxLeaseAttempts(config.Scheduling.MaxLeaseAttempts)
+	if aggregatedQueueServer.UpdateSchedulingConfig(config.Scheduling) {
+		log.Infof("Applied reloaded scheduling configuration %+v", config.Scheduling)
+	}
+}
+
+// purgeFinishedJobStates removes states of jobs which finished longer than the retention ago,
+// status queries report these jobs as not found like their expired job objects.
+func purgeFinishedJobStates(eventRepository repository.EventRepository, retention time.Duration) {
+	purged, e := eventRepository.PurgeFinishedJobStates(time.Now().Add(-retention))
+	if e != nil {
+		log.Errorf("Failed to purge states of finished jobs: %s", e)
+		return
+	}
+	if purged > 0 {
+		log.Infof("Purged states of %d finished jobs", purged)
+	}
+}
+
+// serveHealth exposes /health, failing when a background task is hung, and /ready,
+// failing also when redis is not reachable or the server is not started.
+func serveHealth(
+	config *configuration.ArmadaConfig,
+	db redis.UniversalClient,
+	eventsDb redis.UniversalClient,
+	taskManager *task.BackgroundTaskManager,
+	startupCompleteCheck health.Checker) (shutdown func()) {
+
+	if config.HealthPort == 0 {
+		return func() {}
+	}
+
+	tasksCheck := health.CheckerFunc(func() error {
+		return taskManager.CheckNotHung(config.HungTaskTimeout)
+	})
+
+	liveness := health.NewMultiChecker()
+	liveness.Add("background tasks", tasksCheck)
+
+	readiness := health.NewMultiChecker()
+	readiness.Add("startup", startupCompleteCheck)
+	readiness.Add("redis", health.CheckerFunc(func() error { return db.Ping().Err() }))
+	readiness.Add("events redis", health.CheckerFunc(func() error { return eventsDb.Ping().Err() }))
+	readiness.Add("background tasks", tasksCheck)
+
+	mux := http.NewServeMux()
+	mux.Handle("/health", health.NewHandler(liveness))
+	mux.Handle("/ready", health.NewHandler(readiness))
+	return common.ServeHttp(config.HealthPort, mux)
+}
+
+// acceptNotifyingListener calls onAccept when the server starts accepting connections.
+type acceptNotifyingListener struct {
+	net.Listener
+	onAccept func()
+	once     sync.Once
+}
+
+func (l *acceptNotifyingListener) Accept() (net.Conn, error) {
+	l.once.Do(l.onAccept)
+	return l.Listener.Accept()
+}
+
+// stopGracefully stops accepting new connections and waits for in-flight requests to finish,
+// requests still running after the timeout are cancelled.
+func stopGracefully(grpcServer *grpc.Server, timeout time.Duration) {
+	if timeout <= 0 {
+		grpcServer.GracefulStop()
+		return
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		log.Warnf("Requests did not finish within %s, stopping server.", timeout)
+		grpcServer.Stop()
+	}
+}
+
 func createRedisClient(config *redis.UniversalOptions) redis.UniversalClient {
 	return redis.NewUniversalClient(config)
 }
 
+func createAuditSink(config *configuration.AuditConfig, db redis.UniversalClient) (audit.Sink, func()) {
+	if !config.Enabled {
+		return audit.NoopSink{}, func() {}
+	}
+	writers := []audit.Writer{audit.NewJsonWriter(os.Stdout)}
+	if config.RedisStream != "" {
+		writers = append(writers, audit.NewRedisStreamWriter(db, config.RedisStream))
+	}
+	sink := audit.NewAsyncSink(config.BufferSize, writers...)
+	return sink, sink.Stop
+}
+
+// createEventRepository returns the redis event repository, wrapped to notify webhooks of reported events when they are enabled.
+func createEventRepository(config *configuration.ArmadaConfig, eventsDb redis.UniversalClient) (repository.EventRepository, func()) {
+	eventRepository := repository.NewRedisEventRepository(eventsDb, config.RedisKeyPrefix, config.EventRetention, config.JsonEventStream)
+	if !config.Webhook.Enabled {
+		return eventRepository, func() {}
+	}
+	notifier := webhook.NewNotifier(&config.Webhook, eventRepository)
+	return webhook.NewNotifyingEventRepository(eventRepository, notifier), notifier.Stop
+}
+
+// createEventReader returns the reader serving event watches and status queries with the monitor of latency of its Redis.
+// It reads from the replica of the events Redis when one is configured and caches statuses when max staleness is set.
+func createEventReader(
+	config *configuration.ArmadaConfig,
+	eventRepository repository.EventRepository,
+	redisLatency *repository.RedisLatencyMonitor) (repository.EventReader, *repository.RedisLatencyMonitor) {
+
+	var eventReader repository.EventReader = eventRepository
+	if len(config.StatusReads.Replica.Addrs) > 0 {
+		replicaDb := createRedisClient(&config.StatusReads.Replica)
+		eventReader = repository.NewRedisEventRepository(replicaDb, config.RedisKeyPrefix, config.EventRetention, config.JsonEventStream)
+		redisLatency = repository.NewRedisLatencyMonitor(config.Backpressure.RedisLatencyThreshold)
+		if config.Backpressure.RedisLatencyThreshold > 0 {
+			redisLatency.Monitor(replicaDb)
+		}
+	}
+	if config.StatusReads.MaxStaleness > 0 {
+		eventReader = repository.NewCachingEventReader(eventReader, config.StatusReads.MaxStaleness)
+	}
+	return eventReader, redisLatency
+}
+
 func createServer(config *configuration.ArmadaConfig) *grpc.Server {
 
-	unaryInterceptors := []grpc.UnaryServerInterceptor{}
-	streamInterceptors := []grpc.StreamServerInterceptor{}
+	// correlation id is added first, so log lines of all following interceptors and handlers include it
+	unaryInterceptors := []grpc.UnaryServerInterceptor{logging.UnaryServerInterceptor()}
+	streamInterceptors := []grpc.StreamServerInterceptor{logging.StreamServerInterceptor()}
 
 	authServices := []authorization.AuthService{}
 
@@ -126,6 +346,8 @@ func createServer(config *configuration.ArmadaConfig) *grpc.Server {
 	unaryInterceptors = append(unaryInterceptors, grpc_prometheus.UnaryServerInterceptor)
 	streamInterceptors = append(streamInterceptors, grpc_prometheus.StreamServerInterceptor)
 
+	unaryInterceptors = append(unaryInterceptors, tracing.UnaryServerInterceptor(traceAttributes))
+
 	return grpc.NewServer(
 		grpc.KeepaliveParams(keepalive.ServerParameters{
 			MaxConnectionIdle: 5 * time.Minute,
@@ -133,3 +355,56 @@ func createServer(config *configuration.ArmadaConfig) *grpc.Server {
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)))
 }
+
+// setUpTracing sets the global tracer provider exporting spans to the OpenTelemetry collector over OTLP,
+// spans are not recorded when no collector endpoint is configured. The returned function flushes spans not exported yet.
+func setUpTracing(config *configuration.TracingConfig) func() {
+	if config.OtlpEndpoint == "" {
+		return func() {}
+	}
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(config.OtlpEndpoint)}
+	if config.Insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+	exporter, e := otlptrace.New(context.Background(), otlptracegrpc.NewClient(options...))
+	if e != nil {
+		log.Errorf("Failed to create tracing exporter, tracing is disabled: %v", e)
+		return func() {}
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", "armada-server"))))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if e := provider.Shutdown(ctx); e != nil {
+			log.Errorf("Failed to export remaining spans: %v", e)
+		}
+	}
+}
+
+// traceAttributes records queue, cluster and number of jobs of requests in their spans.
+func traceAttributes(span trace.Span, request interface{}, response interface{}) {
+	if r, ok := request.(interface{ GetQueue() string }); ok && r.GetQueue() != "" {
+		span.SetAttributes(attribute.String("queue", r.GetQueue()))
+	}
+	if r, ok := request.(interface{ GetClusterId() string }); ok && r.GetClusterId() != "" {
+		span.SetAttributes(attribute.String("clusterId", r.GetClusterId()))
+	}
+	switch r := request.(type) {
+	case *api.JobSubmitRequest:
+		span.SetAttributes(attribute.Int("jobCount", len(r.JobRequestItems)))
+	case *api.RenewLeaseRequest:
+		span.SetAttributes(attribute.Int("jobCount", len(r.Ids)))
+		if renewed, ok := response.(*api.RenewLeaseResponse); ok && renewed != nil {
+			span.SetAttributes(attribute.Int("renewedJobCount", len(renewed.Ids)))
+		}
+	case *api.LeaseRequest:
+		if lease, ok := response.(*api.JobLease); ok && lease != nil {
+			span.SetAttributes(attribute.Int("jobCount", len(lease.Job)))
+		}
+	}
+}
@@ -2,11 +2,21 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"sync"
 	"time"
 
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/G-Research/armada/internal/armada/authorization"
 	"github.com/G-Research/armada/internal/armada/authorization/permissions"
+	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/armada/scheduling"
+	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/pkg/api"
 
 	"github.com/gogo/protobuf/types"
@@ -14,25 +24,77 @@ import (
 
 type EventServer struct {
 	permissions     authorization.PermissionChecker
+	jobRepository   repository.JobRepository
 	eventRepository repository.EventRepository
+	// serves event watches and status queries, e.g. from a replica, so they don't compete with leasing and submitting
+	eventReader       repository.EventReader
+	jobNotifier       *scheduling.JobNotifier
+	retrySettingsLock sync.RWMutex
+	oomRetry          *configuration.OOMRetrySettings
+	failureRetry      *configuration.FailureRetrySettings
+	// idle watch streams get an empty message after this interval, 0 disables keepalives
+	keepaliveInterval time.Duration
+	watchSubscribers  *subscriberLimiter
+	// status queries are rejected while Redis is overloaded
+	redisLatency *repository.RedisLatencyMonitor
+}
+
+func NewEventServer(
+	permissions authorization.PermissionChecker,
+	jobRepository repository.JobRepository,
+	eventRepository repository.EventRepository,
+	eventReader repository.EventReader,
+	jobNotifier *scheduling.JobNotifier,
+	oomRetry *configuration.OOMRetrySettings,
+	failureRetry *configuration.FailureRetrySettings,
+	keepaliveInterval time.Duration,
+	maxWatchSubscribers int,
+	redisLatency *repository.RedisLatencyMonitor) *EventServer {
+
+	return &EventServer{
+		permissions:       permissions,
+		jobRepository:     jobRepository,
+		eventRepository:   eventRepository,
+		eventReader:       eventReader,
+		jobNotifier:       jobNotifier,
+		oomRetry:          oomRetry,
+		failureRetry:      failureRetry,
+		keepaliveInterval: keepaliveInterval,
+		watchSubscribers:  newSubscriberLimiter(maxWatchSubscribers),
+		redisLatency:      redisLatency}
+}
+
+// UpdateRetrySettings replaces the settings used to retry failed jobs, they apply to failures reported from now on.
+// Returns whether any of them changed.
+func (s *EventServer) UpdateRetrySettings(oomRetry configuration.OOMRetrySettings, failureRetry configuration.FailureRetrySettings) bool {
+	s.retrySettingsLock.Lock()
+	defer s.retrySettingsLock.Unlock()
+	if reflect.DeepEqual(oomRetry, *s.oomRetry) && reflect.DeepEqual(failureRetry, *s.failureRetry) {
+		return false
+	}
+	s.oomRetry = &oomRetry
+	s.failureRetry = &failureRetry
+	return true
 }
 
-func NewEventServer(permissions authorization.PermissionChecker, eventRepository repository.EventRepository) *EventServer {
-	return &EventServer{permissions: permissions, eventRepository: eventRepository}
+func (s *EventServer) getRetrySettings() (*configuration.OOMRetrySettings, *configuration.FailureRetrySettings) {
+	s.retrySettingsLock.RLock()
+	defer s.retrySettingsLock.RUnlock()
+	return s.oomRetry, s.failureRetry
 }
 
 func (s *EventServer) Report(ctx context.Context, message *api.EventMessage) (*types.Empty, error) {
 	if e := checkPermission(s.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
 	}
-	return &types.Empty{}, s.eventRepository.ReportEvent(message)
+	return &types.Empty{}, s.eventRepository.ReportEvents(s.handleFailures([]*api.EventMessage{message}))
 }
 
 func (s *EventServer) ReportMultiple(ctx context.Context, message *api.EventList) (*types.Empty, error) {
 	if e := checkPermission(s.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
 	}
-	return &types.Empty{}, s.eventRepository.ReportEvents(message.Events)
+	return &types.Empty{}, s.eventRepository.ReportEvents(s.handleFailures(message.Events))
 }
 
 func (s *EventServer) GetJobSetEvents(request *api.JobSetRequest, stream api.Event_GetJobSetEventsServer) error {
@@ -45,15 +107,24 @@ func (s *EventServer) GetJobSetEvents(request *api.JobSetRequest, stream api.Eve
 	var timeout time.Duration = -1
 	var stopAfter = ""
 	if request.Watch {
+		unsubscribe, e := s.watchSubscribers.subscribe()
+		if e != nil {
+			return e
+		}
+		defer unsubscribe()
 		timeout = 5 * time.Second
+		if s.keepaliveInterval > 0 && s.keepaliveInterval < timeout {
+			timeout = s.keepaliveInterval
+		}
 	} else {
-		lastId, e := s.eventRepository.GetLastMessageId(request.Queue, request.Id)
+		lastId, e := s.eventReader.GetLastMessageId(request.Queue, request.Id)
 		if e != nil {
 			return e
 		}
 		stopAfter = lastId
 	}
 
+	lastSent := time.Now()
 	for {
 		select {
 		case <-stream.Context().Done():
@@ -61,7 +132,7 @@ func (s *EventServer) GetJobSetEvents(request *api.JobSetRequest, stream api.Eve
 		default:
 		}
 
-		messages, e := s.eventRepository.ReadEvents(request.Queue, request.Id, fromId, 500, timeout)
+		messages, e := s.eventReader.ReadEvents(request.Queue, request.Id, fromId, 500, timeout)
 
 		if e != nil {
 			return e
@@ -77,10 +148,311 @@ func (s *EventServer) GetJobSetEvents(request *api.JobSetRequest, stream api.Eve
 			if e != nil {
 				return e
 			}
+			lastSent = time.Now()
 		}
 
 		if !request.Watch && stop {
 			return nil
 		}
+
+		// message without id and event keeps the idle stream open
+		if request.Watch && s.keepaliveInterval > 0 && time.Since(lastSent) >= s.keepaliveInterval {
+			e = stream.Send(&api.EventStreamMessage{})
+			if e != nil {
+				return e
+			}
+			lastSent = time.Now()
+		}
+	}
+}
+
+func (s *EventServer) GetJobSetStatus(ctx context.Context, request *api.JobSetStatusRequest) (*api.JobSetStatusResponse, error) {
+	if e := checkPermission(s.permissions, ctx, permissions.WatchAllEvents); e != nil {
+		return nil, e
+	}
+	if e := s.checkRedisLatency(); e != nil {
+		return nil, e
+	}
+	return s.eventReader.GetJobSetStatus(request.Queue, request.JobSetId)
+}
+
+// GetJobStatus returns the current state of the job, jobs finished longer than the job retention ago are not found.
+func (s *EventServer) GetJobStatus(ctx context.Context, request *api.JobStatusRequest) (*api.JobStatusResponse, error) {
+	if e := checkPermission(s.permissions, ctx, permissions.WatchAllEvents); e != nil {
+		return nil, e
+	}
+	if e := s.checkRedisLatency(); e != nil {
+		return nil, e
+	}
+	state, e := s.eventReader.GetJobState(request.Queue, request.JobSetId, request.JobId)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	if state == "" {
+		return nil, api.ErrorWithCode(codes.NotFound, api.ErrorCode_JobNotFound, "job %s not found, it does not exist or has expired", request.JobId)
+	}
+	return &api.JobStatusResponse{JobId: request.JobId, State: state}, nil
+}
+
+// checkRedisLatency fails status queries fast while Redis serving them is overloaded, so they don't slow down leasing and submitting further.
+func (s *EventServer) checkRedisLatency() error {
+	if s.redisLatency.Overloaded() {
+		return status.Errorf(codes.Unavailable, "Redis is overloaded, status queries are rejected until it recovers")
+	}
+	return nil
+}
+
+func (s *EventServer) handleFailures(messages []*api.EventMessage) []*api.EventMessage {
+	return s.handleDeadlineExceeded(s.handleCancelOnFailure(s.handleFailureRetry(s.handleOOMKilled(messages))))
+}
+
+// handleOOMKilled queues jobs which failed because they ran out of memory again with increased memory,
+// their JobFailedEvent is replaced by JobRequeuedEvent describing the change.
+func (s *EventServer) handleOOMKilled(messages []*api.EventMessage) []*api.EventMessage {
+	oomRetry, _ := s.getRetrySettings()
+	if oomRetry.MemoryFactor <= 1 {
+		return messages
+	}
+	jobIds := []string{}
+	for _, message := range messages {
+		if failed, ok := message.Events.(*api.EventMessage_Failed); ok && failed.Failed.OOMKilled {
+			jobIds = append(jobIds, failed.Failed.JobId)
+		}
+	}
+	if len(jobIds) == 0 {
+		return messages
+	}
+
+	jobs, e := s.jobRepository.GetExistingJobsByIds(jobIds)
+	if e != nil {
+		log.Errorf("Failed to load jobs killed for running out of memory: %v", e)
+		return messages
+	}
+	jobsById := make(map[string]*api.Job, len(jobs))
+	for _, job := range jobs {
+		jobsById[job.Id] = job
+	}
+
+	result := make([]*api.EventMessage, 0, len(messages))
+	requeued := false
+	for _, message := range messages {
+		failed, ok := message.Events.(*api.EventMessage_Failed)
+		if !ok || !failed.Failed.OOMKilled || jobsById[failed.Failed.JobId] == nil {
+			result = append(result, message)
+			continue
+		}
+		job := jobsById[failed.Failed.JobId]
+		reason, increased := scheduling.IncreaseJobMemory(job, oomRetry)
+		if !increased {
+			result = append(result, message)
+			continue
+		}
+		jobRequeued, e := s.jobRepository.RequeueJob(failed.Failed.ClusterId, job)
+		if e != nil {
+			log.Errorf("Failed to requeue job %s killed for running out of memory: %v", job.Id, e)
+		}
+		if !jobRequeued {
+			result = append(result, message)
+			continue
+		}
+		requeued = true
+		result = append(result, &api.EventMessage{
+			Events: &api.EventMessage_Requeued{
+				Requeued: &api.JobRequeuedEvent{
+					JobId:     job.Id,
+					JobSetId:  job.JobSetId,
+					Queue:     job.Queue,
+					Created:   failed.Failed.Created,
+					ClusterId: failed.Failed.ClusterId,
+					Reason:    reason,
+				},
+			},
+		})
+	}
+	if requeued {
+		s.jobNotifier.Notify()
+	}
+	return result
+}
+
+// handleFailureRetry queues jobs which failed for a retriable reason again while they have retries left,
+// their JobFailedEvent is replaced by JobRequeuedEvent.
+func (s *EventServer) handleFailureRetry(messages []*api.EventMessage) []*api.EventMessage {
+	_, failureRetry := s.getRetrySettings()
+	if failureRetry.MaxRetries == 0 {
+		return messages
+	}
+	jobIds := []string{}
+	for _, message := range messages {
+		if failed, ok := message.Events.(*api.EventMessage_Failed); ok && scheduling.FailureRetriable(failureRetry, failed.Failed.Category) {
+			jobIds = append(jobIds, failed.Failed.JobId)
+		}
+	}
+	if len(jobIds) == 0 {
+		return messages
+	}
+
+	jobs, e := s.jobRepository.GetExistingJobsByIds(jobIds)
+	if e != nil {
+		log.Errorf("Failed to load jobs to retry: %v", e)
+		return messages
+	}
+	jobsById := make(map[string]*api.Job, len(jobs))
+	for _, job := range jobs {
+		jobsById[job.Id] = job
+	}
+
+	result := make([]*api.EventMessage, 0, len(messages))
+	requeued := false
+	for _, message := range messages {
+		failed, ok := message.Events.(*api.EventMessage_Failed)
+		if !ok || jobsById[failed.Failed.JobId] == nil {
+			result = append(result, message)
+			continue
+		}
+		job := jobsById[failed.Failed.JobId]
+		reason, retried := scheduling.RetryFailedJob(job, failed.Failed, failureRetry)
+		if !retried {
+			result = append(result, message)
+			continue
+		}
+		jobRequeued, e := s.jobRepository.RequeueJob(failed.Failed.ClusterId, job)
+		if e != nil {
+			log.Errorf("Failed to requeue job %s for retry: %v", job.Id, e)
+		}
+		if !jobRequeued {
+			result = append(result, message)
+			continue
+		}
+		requeued = true
+		result = append(result, &api.EventMessage{
+			Events: &api.EventMessage_Requeued{
+				Requeued: &api.JobRequeuedEvent{
+					JobId:     job.Id,
+					JobSetId:  job.JobSetId,
+					Queue:     job.Queue,
+					Created:   failed.Failed.Created,
+					ClusterId: failed.Failed.ClusterId,
+					Reason:    reason,
+				},
+			},
+		})
+	}
+	if requeued {
+		s.jobNotifier.Notify()
+	}
+	return result
+}
+
+// handleCancelOnFailure cancels queued and leased jobs of job sets submitted with CancelOnFailure when any of their jobs fails,
+// and adds JobCancellingEvent and JobCancelledEvent with the reason for each of them.
+func (s *EventServer) handleCancelOnFailure(messages []*api.EventMessage) []*api.EventMessage {
+	failedJobIds := []string{}
+	for _, message := range messages {
+		if failed, ok := message.Events.(*api.EventMessage_Failed); ok {
+			failedJobIds = append(failedJobIds, failed.Failed.JobId)
+		}
+	}
+	if len(failedJobIds) == 0 {
+		return messages
+	}
+
+	failedJobs, e := s.jobRepository.GetExistingJobsByIds(failedJobIds)
+	if e != nil {
+		log.Errorf("Failed to load failed jobs: %v", e)
+		return messages
+	}
+	failed := util.StringListToSet(failedJobIds)
+
+	result := messages
+	cancelledJobSets := map[string]bool{}
+	for _, failedJob := range failedJobs {
+		jobSetKey := failedJob.Queue + ":" + failedJob.JobSetId
+		if !failedJob.CancelOnFailure || cancelledJobSets[jobSetKey] {
+			continue
+		}
+		cancelledJobSets[jobSetKey] = true
+
+		ids, e := s.jobRepository.GetActiveJobIds(failedJob.Queue, failedJob.JobSetId)
+		if e != nil {
+			log.Errorf("Failed to load jobs of job set %s: %v", failedJob.JobSetId, e)
+			continue
+		}
+		idsToCancel := []string{}
+		for _, id := range ids {
+			if !failed[id] {
+				idsToCancel = append(idsToCancel, id)
+			}
+		}
+		if len(idsToCancel) == 0 {
+			continue
+		}
+		jobs, e := s.jobRepository.GetExistingJobsByIds(idsToCancel)
+		if e != nil {
+			log.Errorf("Failed to load jobs of job set %s: %v", failedJob.JobSetId, e)
+			continue
+		}
+
+		reason := fmt.Sprintf("Job %s of the job set failed", failedJob.Id)
+		now := time.Now()
+		for job, e := range s.jobRepository.DeleteJobs(jobs) {
+			if e != nil {
+				log.Errorf("Failed to cancel job %s after failure of job %s: %v", job.Id, failedJob.Id, e)
+				continue
+			}
+			result = append(result,
+				&api.EventMessage{
+					Events: &api.EventMessage_Cancelling{
+						Cancelling: &api.JobCancellingEvent{JobId: job.Id, JobSetId: job.JobSetId, Queue: job.Queue, Created: now, Reason: reason},
+					},
+				},
+				&api.EventMessage{
+					Events: &api.EventMessage_Cancelled{
+						Cancelled: &api.JobCancelledEvent{JobId: job.Id, JobSetId: job.JobSetId, Queue: job.Queue, Created: now, Reason: reason},
+					},
+				})
+		}
+	}
+	return result
+}
+
+// handleDeadlineExceeded deletes jobs which failed because of exceeding their activeDeadlineSeconds,
+// so they are never retried, and adds JobDeadlineExceededEvent for each of them.
+func (s *EventServer) handleDeadlineExceeded(messages []*api.EventMessage) []*api.EventMessage {
+	result := make([]*api.EventMessage, 0, len(messages))
+	jobIds := []string{}
+	for _, message := range messages {
+		result = append(result, message)
+
+		failed, ok := message.Events.(*api.EventMessage_Failed)
+		if !ok || !failed.Failed.DeadlineExceeded {
+			continue
+		}
+		jobIds = append(jobIds, failed.Failed.JobId)
+		result = append(result, &api.EventMessage{
+			Events: &api.EventMessage_DeadlineExceeded{
+				DeadlineExceeded: &api.JobDeadlineExceededEvent{
+					JobId:     failed.Failed.JobId,
+					JobSetId:  failed.Failed.JobSetId,
+					Queue:     failed.Failed.Queue,
+					Created:   failed.Failed.Created,
+					ClusterId: failed.Failed.ClusterId,
+				},
+			},
+		})
+	}
+
+	if len(jobIds) > 0 {
+		jobs, e := s.jobRepository.GetExistingJobsByIds(jobIds)
+		if e != nil {
+			log.Errorf("Failed to load jobs which exceeded their deadline: %v", e)
+			return result
+		}
+		for job, e := range s.jobRepository.DeleteJobs(jobs) {
+			if e != nil {
+				log.Errorf("Failed to delete job %s which exceeded its deadline: %v", job.Id, e)
+			}
+		}
 	}
+	return result
 }
@@ -2,15 +2,24 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/go-redis/redis"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/G-Research/armada/internal/armada/authorization"
 	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/armada/scheduling"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -56,6 +65,40 @@ func TestEventServer_GetJobSetEvents_EmptyStreamShouldNotFail(t *testing.T) {
 	})
 }
 
+func TestEventServer_GetJobSetEvents_ReplaysWholeHistoryInOrder(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		// more events than read from redis at once
+		request := &api.JobSubmitRequest{Queue: "queue1", JobSetId: "set1", JobRequestItems: createJobRequestItems(200)}
+		jobs := []*api.Job{}
+		for _, item := range request.JobRequestItems {
+			job, e := s.jobRepository.CreateJob(request, item, authorization.NewStaticPrincipal("user", []string{}))
+			assert.Nil(t, e)
+			jobs = append(jobs, job)
+		}
+		assert.Nil(t, reportSubmitted(s.eventRepository, jobs))
+		_, e := s.jobRepository.AddJobs(jobs)
+		assert.Nil(t, e)
+		assert.Nil(t, reportQueued(s.eventRepository, jobs))
+		leased, e := s.jobRepository.TryLeaseJobs("cluster1", "queue1", jobs)
+		assert.Nil(t, e)
+		reportJobsLeased(s.eventRepository, leased, "cluster1")
+
+		stream := &eventStreamMock{}
+		e = s.GetJobSetEvents(&api.JobSetRequest{Id: "set1", Queue: "queue1", Watch: false}, stream)
+		assert.Nil(t, e)
+		assert.Equal(t, 3*len(jobs), len(stream.sendMessages))
+
+		assert.Equal(t, len(jobs), len(leased))
+		for i, job := range jobs {
+			assert.Equal(t, job.Id, stream.sendMessages[i].Message.GetSubmitted().JobId)
+			assert.Equal(t, job.Id, stream.sendMessages[len(jobs)+i].Message.GetQueued().JobId)
+		}
+		for i, job := range leased {
+			assert.Equal(t, job.Id, stream.sendMessages[2*len(jobs)+i].Message.GetLeased().JobId)
+		}
+	})
+}
+
 func TestEventServer_EventsShouldBeRemovedAfterEventRetentionTime(t *testing.T) {
 	eventRetention := configuration.EventRetentionPolicy{ExpiryEnabled: true, RetentionDuration: time.Second * 2}
 	withEventServer(eventRetention, func(s *EventServer) {
@@ -76,19 +119,476 @@ func TestEventServer_EventsShouldBeRemovedAfterEventRetentionTime(t *testing.T)
 	})
 }
 
+func TestEventServer_DeadlineExceededFailureDeletesJob(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		job := &api.Job{Id: "job1", JobSetId: "set1", Queue: "queue1", Priority: 1}
+		_, e := s.jobRepository.AddJobs([]*api.Job{job})
+		assert.Nil(t, e)
+
+		reportEvent(t, s, &api.JobFailedEvent{JobId: job.Id, JobSetId: job.JobSetId, Queue: job.Queue, DeadlineExceeded: true})
+
+		stream := &eventStreamMock{}
+		e = s.GetJobSetEvents(&api.JobSetRequest{Id: job.JobSetId, Queue: job.Queue, Watch: false}, stream)
+		assert.Nil(t, e)
+		assert.Equal(t, 2, len(stream.sendMessages))
+		assert.Equal(t, job.Id, stream.sendMessages[1].Message.GetDeadlineExceeded().JobId)
+
+		jobIds, e := s.jobRepository.GetActiveJobIds(job.Queue, job.JobSetId)
+		assert.Nil(t, e)
+		assert.Empty(t, jobIds)
+	})
+}
+
+func TestEventServer_FailureCancelsRemainingJobsOfJobSetWithCancelOnFailure(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		request := &api.JobSubmitRequest{Queue: "queue1", JobSetId: "set1", CancelOnFailure: true, JobRequestItems: createJobRequestItems(3)}
+		jobs := []*api.Job{}
+		for _, item := range request.JobRequestItems {
+			job, e := s.jobRepository.CreateJob(request, item, authorization.NewStaticPrincipal("user", []string{}))
+			assert.Nil(t, e)
+			jobs = append(jobs, job)
+		}
+		failedJob, queuedJob, leasedJob := jobs[0], jobs[1], jobs[2]
+		otherSetJob := &api.Job{Id: "other", JobSetId: "set2", Queue: "queue1", Priority: 1, CancelOnFailure: true}
+		_, e := s.jobRepository.AddJobs(append(jobs, otherSetJob))
+		assert.Nil(t, e)
+		leased, e := s.jobRepository.TryLeaseJobs("cluster1", "queue1", []*api.Job{failedJob, leasedJob})
+		assert.Nil(t, e)
+		assert.Equal(t, 2, len(leased))
+
+		reportEvent(t, s, &api.JobFailedEvent{JobId: failedJob.Id, JobSetId: failedJob.JobSetId, Queue: failedJob.Queue, ClusterId: "cluster1"})
+
+		jobIds, e := s.jobRepository.GetActiveJobIds("queue1", "set1")
+		assert.Nil(t, e)
+		assert.Equal(t, []string{failedJob.Id}, jobIds)
+		jobIds, e = s.jobRepository.GetActiveJobIds("queue1", "set2")
+		assert.Nil(t, e)
+		assert.Equal(t, []string{otherSetJob.Id}, jobIds)
+
+		stream := &eventStreamMock{}
+		e = s.GetJobSetEvents(&api.JobSetRequest{Id: "set1", Queue: "queue1", Watch: false}, stream)
+		assert.Nil(t, e)
+		assert.Equal(t, 5, len(stream.sendMessages))
+		reason := fmt.Sprintf("Job %s of the job set failed", failedJob.Id)
+		cancelled := []string{}
+		for _, m := range stream.sendMessages[1:] {
+			if c := m.Message.GetCancelled(); c != nil {
+				cancelled = append(cancelled, c.JobId)
+				assert.Equal(t, reason, c.Reason)
+			} else {
+				assert.Equal(t, reason, m.Message.GetCancelling().Reason)
+			}
+		}
+		assert.ElementsMatch(t, []string{queuedJob.Id, leasedJob.Id}, cancelled)
+	})
+}
+
+func TestEventServer_FailureDoesNotCancelJobSetWithoutCancelOnFailure(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		failedJob := &api.Job{Id: "job1", JobSetId: "set1", Queue: "queue1", Priority: 1}
+		queuedJob := &api.Job{Id: "job2", JobSetId: "set1", Queue: "queue1", Priority: 1}
+		_, e := s.jobRepository.AddJobs([]*api.Job{failedJob, queuedJob})
+		assert.Nil(t, e)
+
+		reportEvent(t, s, &api.JobFailedEvent{JobId: failedJob.Id, JobSetId: failedJob.JobSetId, Queue: failedJob.Queue})
+
+		jobIds, e := s.jobRepository.GetActiveJobIds("queue1", "set1")
+		assert.Nil(t, e)
+		assert.ElementsMatch(t, []string{failedJob.Id, queuedJob.Id}, jobIds)
+	})
+}
+
+func TestEventServer_OOMKilledFailureRequeuesJobWithIncreasedMemory(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		s.oomRetry = &configuration.OOMRetrySettings{MemoryFactor: 2, MaxMemory: 8 * 1024 * 1024 * 1024}
+		job := addLeasedJobWithMemory(t, s, "1Gi")
+
+		reportEvent(t, s, &api.JobFailedEvent{JobId: job.Id, JobSetId: job.JobSetId, Queue: job.Queue, ClusterId: "cluster1", OOMKilled: true})
+
+		queued, e := s.jobRepository.PeekQueue(job.Queue, 10)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(queued))
+		assert.Equal(t, job.Id, queued[0].Id)
+		assert.Equal(t, "2Gi", containerMemory(queued[0].PodSpec.Containers[0].Resources.Requests))
+		assert.Equal(t, "2Gi", containerMemory(queued[0].PodSpec.Containers[0].Resources.Limits))
+
+		stream := &eventStreamMock{}
+		e = s.GetJobSetEvents(&api.JobSetRequest{Id: job.JobSetId, Queue: job.Queue, Watch: false}, stream)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(stream.sendMessages))
+		requeued := stream.sendMessages[0].Message.GetRequeued()
+		assert.NotNil(t, requeued)
+		assert.Equal(t, job.Id, requeued.JobId)
+		assert.Contains(t, requeued.Reason, "1Gi -> 2Gi")
+	})
+}
+
+func TestEventServer_OOMKilledJobFailsWhenMemoryReachedMaximum(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		s.oomRetry = &configuration.OOMRetrySettings{MemoryFactor: 2, MaxMemory: 3 * 1024 * 1024 * 1024}
+		job := addLeasedJobWithMemory(t, s, "1Gi")
+
+		for _, expectedMemory := range []string{"2Gi", "3Gi"} {
+			reportEvent(t, s, &api.JobFailedEvent{JobId: job.Id, JobSetId: job.JobSetId, Queue: job.Queue, ClusterId: "cluster1", OOMKilled: true})
+
+			queued, e := s.jobRepository.PeekQueue(job.Queue, 10)
+			assert.Nil(t, e)
+			assert.Equal(t, 1, len(queued))
+			assert.Equal(t, expectedMemory, containerMemory(queued[0].PodSpec.Containers[0].Resources.Requests))
+
+			leased, e := s.jobRepository.TryLeaseJobs("cluster1", job.Queue, queued)
+			assert.Nil(t, e)
+			assert.Equal(t, 1, len(leased))
+		}
+
+		reportEvent(t, s, &api.JobFailedEvent{JobId: job.Id, JobSetId: job.JobSetId, Queue: job.Queue, ClusterId: "cluster1", OOMKilled: true})
+
+		queued, e := s.jobRepository.PeekQueue(job.Queue, 10)
+		assert.Nil(t, e)
+		assert.Empty(t, queued)
+
+		stream := &eventStreamMock{}
+		e = s.GetJobSetEvents(&api.JobSetRequest{Id: job.JobSetId, Queue: job.Queue, Watch: false}, stream)
+		assert.Nil(t, e)
+		assert.Equal(t, 3, len(stream.sendMessages))
+		assert.NotNil(t, stream.sendMessages[2].Message.GetFailed())
+	})
+}
+
+func TestEventServer_RetriableFailureRequeuesJobUntilMaxRetries(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		s.failureRetry = &configuration.FailureRetrySettings{MaxRetries: 1, Categories: map[string]bool{"NodeLost": true}}
+		job := addLeasedJobWithMemory(t, s, "1Gi")
+
+		reportEvent(t, s, &api.JobFailedEvent{JobId: job.Id, JobSetId: job.JobSetId, Queue: job.Queue, ClusterId: "cluster1", Category: "NodeLost"})
+
+		queued, e := s.jobRepository.PeekQueue(job.Queue, 10)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(queued))
+		assert.Equal(t, uint32(1), queued[0].FailedAttempts)
+
+		leased, e := s.jobRepository.TryLeaseJobs("cluster1", job.Queue, queued)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(leased))
+
+		reportEvent(t, s, &api.JobFailedEvent{JobId: job.Id, JobSetId: job.JobSetId, Queue: job.Queue, ClusterId: "cluster1", Category: "NodeLost"})
+
+		queued, e = s.jobRepository.PeekQueue(job.Queue, 10)
+		assert.Nil(t, e)
+		assert.Empty(t, queued)
+
+		stream := &eventStreamMock{}
+		e = s.GetJobSetEvents(&api.JobSetRequest{Id: job.JobSetId, Queue: job.Queue, Watch: false}, stream)
+		assert.Nil(t, e)
+		assert.Equal(t, 2, len(stream.sendMessages))
+		requeued := stream.sendMessages[0].Message.GetRequeued()
+		assert.NotNil(t, requeued)
+		assert.Equal(t, "Retry 1 of 1 after NodeLost failure", requeued.Reason)
+		assert.NotNil(t, stream.sendMessages[1].Message.GetFailed())
+	})
+}
+
+func TestEventServer_NonRetriableFailureSkipsRetriesLeft(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		s.failureRetry = &configuration.FailureRetrySettings{
+			MaxRetries: 3,
+			Categories: map[string]bool{"NodeLost": true, "ImagePullBackOff": false},
+		}
+		job := addLeasedJobWithMemory(t, s, "1Gi")
+
+		reportEvent(t, s, &api.JobFailedEvent{JobId: job.Id, JobSetId: job.JobSetId, Queue: job.Queue, ClusterId: "cluster1", Category: "ImagePullBackOff"})
+
+		queued, e := s.jobRepository.PeekQueue(job.Queue, 10)
+		assert.Nil(t, e)
+		assert.Empty(t, queued)
+
+		stream := &eventStreamMock{}
+		e = s.GetJobSetEvents(&api.JobSetRequest{Id: job.JobSetId, Queue: job.Queue, Watch: false}, stream)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(stream.sendMessages))
+		assert.NotNil(t, stream.sendMessages[0].Message.GetFailed())
+	})
+}
+
+func addLeasedJobWithMemory(t *testing.T, s *EventServer, memory string) *api.Job {
+	resources := v1.ResourceList{v1.ResourceMemory: resource.MustParse(memory)}
+	job := &api.Job{
+		Id:       "job1",
+		JobSetId: "set1",
+		Queue:    "queue1",
+		Priority: 1,
+		PodSpec: &v1.PodSpec{Containers: []v1.Container{{
+			Name:      "main",
+			Resources: v1.ResourceRequirements{Requests: resources, Limits: resources.DeepCopy()},
+		}}},
+	}
+	_, e := s.jobRepository.AddJobs([]*api.Job{job})
+	assert.Nil(t, e)
+	leased, e := s.jobRepository.TryLeaseJobs("cluster1", job.Queue, []*api.Job{job})
+	assert.Nil(t, e)
+	assert.Equal(t, 1, len(leased))
+	return job
+}
+
+func containerMemory(resources v1.ResourceList) string {
+	return resources.Memory().String()
+}
+
 func reportEvent(t *testing.T, s *EventServer, event api.Event) {
 	msg, _ := api.Wrap(event)
 	_, e := s.Report(context.Background(), msg)
 	assert.Nil(t, e)
 }
 
+func TestEventServer_SendsKeepalivesOnIdleWatchStream(t *testing.T) {
+	keepaliveInterval := 100 * time.Millisecond
+	withEventServerKeepalive(configuration.EventRetentionPolicy{ExpiryEnabled: false}, keepaliveInterval, func(s *EventServer) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		stream := &watchStreamMock{ctx: ctx, cancel: cancel, expectedMessages: 2}
+
+		start := time.Now()
+		e := s.GetJobSetEvents(&api.JobSetRequest{Id: "idle", Queue: "queue1", Watch: true}, stream)
+		assert.Nil(t, e)
+		assert.Equal(t, context.Canceled, ctx.Err())
+
+		assert.Equal(t, 2, len(stream.sendMessages))
+		for _, msg := range stream.sendMessages {
+			assert.Empty(t, msg.Id)
+			assert.Nil(t, msg.Message)
+		}
+		assert.True(t, time.Since(start) >= 2*keepaliveInterval)
+	})
+}
+
+func TestEventServer_GetJobSetEvents_RejectsWatchesOverSubscriberLimit(t *testing.T) {
+	withEventServerKeepalive(configuration.EventRetentionPolicy{ExpiryEnabled: false}, 50*time.Millisecond, func(s *EventServer) {
+		s.watchSubscribers = newSubscriberLimiter(2)
+		request := &api.JobSetRequest{Id: "set1", Queue: "queue1", Watch: true}
+		watch := func() (cancel func(), done chan error) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			stream := &watchStreamMock{ctx: ctx, cancel: cancel, expectedMessages: math.MaxInt32}
+			done = make(chan error, 1)
+			go func() { done <- s.GetJobSetEvents(request, stream) }()
+			return cancel, done
+		}
+
+		cancelFirst, firstDone := watch()
+		cancelSecond, secondDone := watch()
+		waitForWatchSubscribers(t, s, 2)
+
+		cancelRejected, rejectedDone := watch()
+		assert.Equal(t, codes.ResourceExhausted, status.Code(<-rejectedDone))
+		cancelRejected()
+
+		cancelFirst()
+		assert.Nil(t, <-firstDone)
+		waitForWatchSubscribers(t, s, 1)
+		cancelThird, thirdDone := watch()
+		waitForWatchSubscribers(t, s, 2)
+
+		cancelSecond()
+		cancelThird()
+		assert.Nil(t, <-secondDone)
+		assert.Nil(t, <-thirdDone, "closed watch frees a slot for a new one")
+		waitForWatchSubscribers(t, s, 0)
+	})
+}
+
+func waitForWatchSubscribers(t *testing.T, s *EventServer, expected int) {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s.watchSubscribers.lock.Lock()
+		active := s.watchSubscribers.active
+		s.watchSubscribers.lock.Unlock()
+		if active == expected {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d watch subscribers, got %d", expected, active)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestEventServer_GetJobSetStatus_CountsJobsInEachState(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		jobSetId := "set1"
+		queue := "queue1"
+		events := []api.Event{}
+		for _, jobId := range []string{"queued", "leased", "returned", "pending", "running", "succeeded", "failed", "cancelled"} {
+			events = append(events,
+				&api.JobSubmittedEvent{JobId: jobId, JobSetId: jobSetId, Queue: queue},
+				&api.JobQueuedEvent{JobId: jobId, JobSetId: jobSetId, Queue: queue})
+		}
+		events = append(events,
+			&api.JobLeasedEvent{JobId: "leased", JobSetId: jobSetId, Queue: queue},
+			&api.JobLeasedEvent{JobId: "returned", JobSetId: jobSetId, Queue: queue},
+			&api.JobLeaseReturnedEvent{JobId: "returned", JobSetId: jobSetId, Queue: queue},
+			&api.JobLeasedEvent{JobId: "pending", JobSetId: jobSetId, Queue: queue},
+			&api.JobPendingEvent{JobId: "pending", JobSetId: jobSetId, Queue: queue},
+			&api.JobPendingEvent{JobId: "running", JobSetId: jobSetId, Queue: queue},
+			&api.JobRunningEvent{JobId: "running", JobSetId: jobSetId, Queue: queue},
+			&api.JobUnableToScheduleEvent{JobId: "running", JobSetId: jobSetId, Queue: queue},
+			&api.JobRunningEvent{JobId: "succeeded", JobSetId: jobSetId, Queue: queue},
+			&api.JobSucceededEvent{JobId: "succeeded", JobSetId: jobSetId, Queue: queue},
+			&api.JobRunningEvent{JobId: "failed", JobSetId: jobSetId, Queue: queue},
+			&api.JobFailedEvent{JobId: "failed", JobSetId: jobSetId, Queue: queue},
+			&api.JobTerminatedEvent{JobId: "failed", JobSetId: jobSetId, Queue: queue},
+			&api.JobCancelledEvent{JobId: "cancelled", JobSetId: jobSetId, Queue: queue},
+			&api.JobLeaseExpiredEvent{JobId: "cancelled", JobSetId: jobSetId, Queue: queue},
+			&api.JobQueuedEvent{JobId: "other-set", JobSetId: "set2", Queue: queue})
+
+		messages := []*api.EventMessage{}
+		for _, event := range events {
+			message, e := api.Wrap(event)
+			assert.Nil(t, e)
+			messages = append(messages, message)
+		}
+		_, e := s.ReportMultiple(context.Background(), &api.EventList{Events: messages})
+		assert.Nil(t, e)
+
+		status, e := s.GetJobSetStatus(context.Background(), &api.JobSetStatusRequest{Queue: queue, JobSetId: jobSetId})
+		assert.Nil(t, e)
+		assert.Equal(t, &api.JobSetStatusResponse{
+			Queued:    2,
+			Leased:    1,
+			Pending:   1,
+			Running:   1,
+			Succeeded: 1,
+			Failed:    1,
+			Cancelled: 1,
+		}, status)
+
+		status, e = s.GetJobSetStatus(context.Background(), &api.JobSetStatusRequest{Queue: queue, JobSetId: "missing"})
+		assert.Nil(t, e)
+		assert.Equal(t, &api.JobSetStatusResponse{}, status)
+	})
+}
+
+func TestEventServer_GetJobStatus_NotFoundAfterStateIsPurged(t *testing.T) {
+	withEventServer(configuration.EventRetentionPolicy{ExpiryEnabled: false}, func(s *EventServer) {
+		reportEvent(t, s, &api.JobRunningEvent{JobId: "job1", JobSetId: "set1", Queue: "queue1"})
+		reportEvent(t, s, &api.JobSucceededEvent{JobId: "job1", JobSetId: "set1", Queue: "queue1"})
+
+		request := &api.JobStatusRequest{Queue: "queue1", JobSetId: "set1", JobId: "job1"}
+		jobStatus, e := s.GetJobStatus(context.Background(), request)
+		assert.Nil(t, e)
+		assert.Equal(t, &api.JobStatusResponse{JobId: "job1", State: "Succeeded"}, jobStatus)
+
+		_, e = s.eventRepository.PurgeFinishedJobStates(time.Now())
+		assert.Nil(t, e)
+
+		_, e = s.GetJobStatus(context.Background(), request)
+		assert.Equal(t, codes.NotFound, status.Code(e))
+	})
+}
+
+func TestEventServer_StatusQueriesFailFastWhileRedisIsSlow(t *testing.T) {
+	delay := 200 * time.Millisecond
+	var slow int32
+	client := slowRedisClient(delay, &slow)
+	client.FlushDB()
+	defer client.FlushDB()
+	monitor := repository.NewRedisLatencyMonitor(10 * time.Millisecond)
+	monitor.Monitor(client)
+
+	repo := repository.NewRedisEventRepository(client, "", configuration.EventRetentionPolicy{}, configuration.JsonEventStreamConfig{})
+	jobRepo := repository.NewRedisJobRepository(client, "", false, 0)
+	s := NewEventServer(&fakePermissionChecker{}, jobRepo, repo, repo, scheduling.NewJobNotifier(), &configuration.OOMRetrySettings{},
+		&configuration.FailureRetrySettings{}, 0, 0, monitor)
+
+	reportEvent(t, s, &api.JobRunningEvent{JobId: "job1", JobSetId: "set1", Queue: "queue1"})
+	request := &api.JobStatusRequest{Queue: "queue1", JobSetId: "set1", JobId: "job1"}
+	_, e := s.GetJobStatus(context.Background(), request)
+	assert.Nil(t, e)
+
+	atomic.StoreInt32(&slow, 1)
+	job := &api.Job{Id: "job2", JobSetId: "set1", Queue: "queue1", Priority: 1}
+	submitted, e := jobRepo.AddJobs([]*api.Job{job})
+	assert.Nil(t, e)
+	assert.Equal(t, 1, len(submitted))
+	assert.Nil(t, submitted[0].Error)
+
+	start := time.Now()
+	_, e = s.GetJobStatus(context.Background(), request)
+	assert.Equal(t, codes.Unavailable, status.Code(e))
+	_, e = s.GetJobSetStatus(context.Background(), &api.JobSetStatusRequest{Queue: "queue1", JobSetId: "set1"})
+	assert.Equal(t, codes.Unavailable, status.Code(e))
+	assert.True(t, time.Since(start) < delay)
+}
+
+func TestEventServer_StatusQueriesAreServedByReplicaWhilePrimaryIsSlow(t *testing.T) {
+	delay := 200 * time.Millisecond
+	var slow int32
+	primary := slowRedisClient(delay, &slow)
+	primary.FlushDB()
+	defer primary.FlushDB()
+	primaryMonitor := repository.NewRedisLatencyMonitor(10 * time.Millisecond)
+	primaryMonitor.Monitor(primary)
+	// the same database stands in for a replica which is up to date
+	replica := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer replica.Close()
+	replicaMonitor := repository.NewRedisLatencyMonitor(10 * time.Millisecond)
+	replicaMonitor.Monitor(replica)
+
+	repo := repository.NewRedisEventRepository(primary, "", configuration.EventRetentionPolicy{}, configuration.JsonEventStreamConfig{})
+	replicaRepo := repository.NewRedisEventRepository(replica, "", configuration.EventRetentionPolicy{}, configuration.JsonEventStreamConfig{})
+	jobRepo := repository.NewRedisJobRepository(primary, "", false, 0)
+	s := NewEventServer(&fakePermissionChecker{}, jobRepo, repo, replicaRepo, scheduling.NewJobNotifier(), &configuration.OOMRetrySettings{},
+		&configuration.FailureRetrySettings{}, 0, 0, replicaMonitor)
+
+	reportEvent(t, s, &api.JobRunningEvent{JobId: "job1", JobSetId: "set1", Queue: "queue1"})
+	atomic.StoreInt32(&slow, 1)
+	_, e := jobRepo.AddJobs([]*api.Job{{Id: "job2", JobSetId: "set1", Queue: "queue1", Priority: 1}})
+	assert.Nil(t, e)
+	assert.True(t, primaryMonitor.Overloaded())
+
+	start := time.Now()
+	jobStatus, e := s.GetJobStatus(context.Background(), &api.JobStatusRequest{Queue: "queue1", JobSetId: "set1", JobId: "job1"})
+	assert.Nil(t, e)
+	assert.Equal(t, &api.JobStatusResponse{JobId: "job1", State: "Running"}, jobStatus)
+	jobSetStatus, e := s.GetJobSetStatus(context.Background(), &api.JobSetStatusRequest{Queue: "queue1", JobSetId: "set1"})
+	assert.Nil(t, e)
+	assert.Equal(t, int32(1), jobSetStatus.Running)
+	assert.True(t, time.Since(start) < delay)
+}
+
+// slowRedisClient returns client whose operations are delayed while slow is set to 1
+func slowRedisClient(delay time.Duration, slow *int32) *redis.Client {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	client.WrapProcess(func(process func(cmd redis.Cmder) error) func(cmd redis.Cmder) error {
+		return func(cmd redis.Cmder) error {
+			if atomic.LoadInt32(slow) == 1 {
+				time.Sleep(delay)
+			}
+			return process(cmd)
+		}
+	})
+	client.WrapProcessPipeline(func(process func(cmds []redis.Cmder) error) func(cmds []redis.Cmder) error {
+		return func(cmds []redis.Cmder) error {
+			if atomic.LoadInt32(slow) == 1 {
+				time.Sleep(delay)
+			}
+			return process(cmds)
+		}
+	})
+	return client
+}
+
 func withEventServer(eventRetention configuration.EventRetentionPolicy, action func(s *EventServer)) {
+	withEventServerKeepalive(eventRetention, 0, action)
+}
+
+func withEventServerKeepalive(eventRetention configuration.EventRetentionPolicy, keepaliveInterval time.Duration, action func(s *EventServer)) {
 
 	// using real redis instance as miniredis does not support streams
 	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
 
-	repo := repository.NewRedisEventRepository(client, eventRetention)
-	server := NewEventServer(&fakePermissionChecker{}, repo)
+	repo := repository.NewRedisEventRepository(client, "", eventRetention, configuration.JsonEventStreamConfig{})
+	jobRepo := repository.NewRedisJobRepository(client, "", false, 0)
+	server := NewEventServer(&fakePermissionChecker{}, jobRepo, repo, repo, scheduling.NewJobNotifier(), &configuration.OOMRetrySettings{},
+		&configuration.FailureRetrySettings{}, keepaliveInterval, 0, repository.NewRedisLatencyMonitor(0))
 
 	client.FlushDB()
 
@@ -111,3 +611,23 @@ func (s *eventStreamMock) Context() context.Context {
 	return context.Background()
 
 }
+
+// watchStreamMock ends the watch by cancelling its context once the expected number of messages was sent
+type watchStreamMock struct {
+	eventStreamMock
+	ctx              context.Context
+	cancel           func()
+	expectedMessages int
+}
+
+func (s *watchStreamMock) Send(m *api.EventStreamMessage) error {
+	s.sendMessages = append(s.sendMessages, m)
+	if len(s.sendMessages) >= s.expectedMessages {
+		s.cancel()
+	}
+	return nil
+}
+
+func (s *watchStreamMock) Context() context.Context {
+	return s.ctx
+}
@@ -2,27 +2,45 @@ package server
 
 import (
 	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/G-Research/armada/internal/armada/authorization"
 	"github.com/G-Research/armada/internal/armada/authorization/permissions"
 	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/metrics"
 	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/internal/armada/scheduling"
 	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/logging"
+	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/pkg/api"
 )
 
 type AggregatedQueueServer struct {
-	permissions      authorization.PermissionChecker
-	schedulingConfig configuration.SchedulingConfig
-	jobRepository    repository.JobRepository
-	queueRepository  repository.QueueRepository
-	usageRepository  repository.UsageRepository
-	eventRepository  repository.EventRepository
+	permissions       authorization.PermissionChecker
+	jobRepository     repository.JobRepository
+	queueRepository   repository.QueueRepository
+	usageRepository   repository.UsageRepository
+	eventRepository   repository.EventRepository
+	jobNotifier       *scheduling.JobNotifier
+	schedulingMetrics *metrics.SchedulingMetrics
+	// bounds LeaseJobs and RenewLease requests handled at once
+	leaseLimiter *requestLimiter
+	// spreads capacity newly available in clusters over several lease requests
+	capacitySmoother *scheduling.CapacitySmoother
+
+	// schedulingConfig is replaced as a whole on reload and never modified,
+	// each request uses the config current when it started
+	schedulingConfigLock sync.RWMutex
+	schedulingConfig     *configuration.SchedulingConfig
 }
 
 func NewAggregatedQueueServer(
@@ -32,23 +50,85 @@ func NewAggregatedQueueServer(
 	queueRepository repository.QueueRepository,
 	usageRepository repository.UsageRepository,
 	eventRepository repository.EventRepository,
+	jobNotifier *scheduling.JobNotifier,
+	schedulingMetrics *metrics.SchedulingMetrics,
+	leaseConcurrency configuration.LeaseConcurrencyConfig,
 ) *AggregatedQueueServer {
 	return &AggregatedQueueServer{
-		permissions:      permissions,
-		schedulingConfig: schedulingConfig,
-		jobRepository:    jobRepository,
-		queueRepository:  queueRepository,
-		usageRepository:  usageRepository,
-		eventRepository:  eventRepository}
+		permissions:       permissions,
+		schedulingConfig:  &schedulingConfig,
+		jobRepository:     jobRepository,
+		queueRepository:   queueRepository,
+		usageRepository:   usageRepository,
+		eventRepository:   eventRepository,
+		jobNotifier:       jobNotifier,
+		schedulingMetrics: schedulingMetrics,
+		leaseLimiter:      newRequestLimiter(leaseConcurrency),
+		capacitySmoother:  scheduling.NewCapacitySmoother()}
+}
+
+// UpdateSchedulingConfig applies hot reloadable fields of the updated config to following lease requests,
+// returns false when none of them changed.
+func (q *AggregatedQueueServer) UpdateSchedulingConfig(updated configuration.SchedulingConfig) bool {
+	q.schedulingConfigLock.Lock()
+	defer q.schedulingConfigLock.Unlock()
+	config := configuration.WithHotReloadableFields(*q.schedulingConfig, updated)
+	if reflect.DeepEqual(config, *q.schedulingConfig) {
+		return false
+	}
+	q.schedulingConfig = &config
+	return true
+}
+
+func (q *AggregatedQueueServer) getSchedulingConfig() *configuration.SchedulingConfig {
+	q.schedulingConfigLock.RLock()
+	defer q.schedulingConfigLock.RUnlock()
+	return q.schedulingConfig
 }
 
-func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.LeaseRequest) (*api.JobLease, error) {
+// LeaseJobs leases jobs fitting the resources of the request. With long polling enabled a request which
+// would return no jobs waits until jobs are queued on this server or the long poll timeout passes.
+// Requests take a slot of the lease limiter only while they are scheduled, not while they wait for jobs.
+func (q *AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.LeaseRequest) (*api.JobLease, error) {
 	if e := checkPermission(q.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
 	}
+	config := q.getSchedulingConfig()
+	if config.Lease.LongPollTimeout <= 0 {
+		return q.limitedLeaseJobs(ctx, request, config)
+	}
 
+	timeout := time.NewTimer(config.Lease.LongPollTimeout)
+	defer timeout.Stop()
+	for {
+		queued := q.jobNotifier.Queued()
+		jobLease, e := q.limitedLeaseJobs(ctx, request, config)
+		if e != nil || len(jobLease.Job) > 0 {
+			return jobLease, e
+		}
+		select {
+		case <-queued:
+		case <-timeout.C:
+			return jobLease, nil
+		case <-ctx.Done():
+			return jobLease, nil
+		}
+	}
+}
+
+func (q *AggregatedQueueServer) limitedLeaseJobs(ctx context.Context, request *api.LeaseRequest, config *configuration.SchedulingConfig) (*api.JobLease, error) {
+	release, e := q.leaseLimiter.acquire(ctx)
+	if e != nil {
+		return nil, e
+	}
+	defer release()
+	return q.leaseJobs(ctx, request, config)
+}
+
+func (q *AggregatedQueueServer) leaseJobs(ctx context.Context, request *api.LeaseRequest, config *configuration.SchedulingConfig) (*api.JobLease, error) {
+	// a full cluster may still get jobs by preemption
 	var res common.ComputeResources = request.Resources
-	if res.AsFloat().IsLessThanOrEqual(q.schedulingConfig.MinimumResourceToSchedule) {
+	if res.AsFloat().IsLessThanOrEqual(config.MinimumResourceToSchedule) && len(config.PriorityClasses) == 0 {
 		return &api.JobLease{}, nil
 	}
 
@@ -67,11 +147,6 @@ func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.Lease
 		return nil, e
 	}
 
-	e = q.usageRepository.UpdateClusterLeased(&request.ClusterLeasedReport)
-	if e != nil {
-		return nil, e
-	}
-
 	activeClusterReports := scheduling.FilterActiveClusters(usageReports)
 	clusterPriorities, e := q.usageRepository.GetClusterPriorities(scheduling.GetClusterReportIds(activeClusterReports))
 	if e != nil {
@@ -82,16 +157,51 @@ func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.Lease
 	if e != nil {
 		return nil, e
 	}
+	// the report of the requesting cluster is stored only with leases of this cycle
+	if request.ClusterLeasedReport.ClusterId != "" {
+		clusterLeasedJobReports[request.ClusterLeasedReport.ClusterId] = &request.ClusterLeasedReport
+	}
 	clusterLeasedJobReports = scheduling.FilterActiveClusterLeasedReports(clusterLeasedJobReports)
 
+	clusterLeasesInWindow, e := q.getClusterLeasesInWindow(config)
+	if e != nil {
+		return nil, e
+	}
+
+	handleDenials := func(denials []*scheduling.LeaseDenial) {
+		go q.reportLeaseDenials(denials, request.ClusterId)
+		go q.handleUnmatchableJobs(denials, activeClusterReports, request.ClusterId, &config.UnmatchableJobs)
+	}
+	onJobsDenied := handleDenials
+	onSchedulingFinished := q.schedulingMetrics.RecordQueueShares
+	var trace *scheduling.LeaseTraceCollector
+	if request.Debug {
+		trace = &scheduling.LeaseTraceCollector{}
+		onJobsDenied = func(denials []*scheduling.LeaseDenial) {
+			trace.RecordDenials(denials)
+			handleDenials(denials)
+		}
+		onSchedulingFinished = func(pool string, shares []*scheduling.QueueShare) {
+			trace.RecordShares(pool, shares)
+			q.schedulingMetrics.RecordQueueShares(pool, shares)
+		}
+	}
+
+	smoothedRequest := *request
+	smoothedRequest.Resources = q.capacitySmoother.Smooth(&config.CapacitySmoothing, request.ClusterId, request.Resources)
+
 	jobs, e := scheduling.LeaseJobs(
 		ctx,
-		&q.schedulingConfig,
+		config,
 		q.jobRepository,
 		func(jobs []*api.Job) { reportJobsLeased(q.eventRepository, jobs, request.ClusterId) },
-		request,
+		onJobsDenied,
+		q.schedulingMetrics.RecordStepDuration,
+		onSchedulingFinished,
+		&smoothedRequest,
 		activeClusterReports,
 		clusterLeasedJobReports,
+		clusterLeasesInWindow,
 		clusterPriorities,
 		activeQueues)
 
@@ -99,33 +209,335 @@ func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.Lease
 		return nil, e
 	}
 
+	logger := logging.FromContext(ctx).WithField("clusterId", request.ClusterId)
+	preempting, preempted, e := scheduling.PreemptJobs(config, q.jobRepository, request.ClusterId, activeQueues, jobs)
+	if e != nil {
+		logger.Errorf("Failed to preempt jobs: %v", e)
+	}
+	if len(preempting) > 0 {
+		scheduling.LogJobsBySubmission(logger, preempting, "Leasing jobs by preemption")
+		scheduling.LogJobsBySubmission(logger, preempted, "Preempted jobs")
+		reportJobsLeased(q.eventRepository, preempting, request.ClusterId)
+		reportJobsPreempted(q.eventRepository, preempted, request.ClusterId)
+		jobs = append(jobs, preempting...)
+	}
+
+	q.recordSchedulingCycle(jobs, queues)
+
+	// usage changes of the cycle are applied together, so other lease requests see either none or all of them
+	var leases *api.ClusterLeasedReport
+	if clusterLeasesInWindow != nil && len(jobs) > 0 {
+		leases = scheduling.CreateClusterLeasedReport(request.ClusterId, &api.ClusterLeasedReport{}, jobs)
+	}
 	clusterLeasedReport := scheduling.CreateClusterLeasedReport(request.ClusterLeasedReport.ClusterId, &request.ClusterLeasedReport, jobs)
-	e = q.usageRepository.UpdateClusterLeased(clusterLeasedReport)
+	e = q.usageRepository.CommitClusterLeases(clusterLeasedReport, leases, config.ClusterFairnessWindow)
 	if e != nil {
 		return nil, e
 	}
 
 	jobLease := api.JobLease{
-		Job: jobs,
+		Job:             jobs,
+		SchedulingHints: scheduling.SchedulingHints(jobs, request),
+	}
+	if trace != nil {
+		jobLease.Trace = trace.Trace()
 	}
 	return &jobLease, nil
 }
 
-func (q *AggregatedQueueServer) RenewLease(ctx context.Context, request *api.RenewLeaseRequest) (*api.IdList, error) {
+func (q *AggregatedQueueServer) recordSchedulingCycle(jobs []*api.Job, queues []*api.Queue) {
+	sizes, e := q.jobRepository.GetQueueSizes(queues)
+	if e != nil {
+		log.Errorf("Failed to get queue sizes for scheduling metrics: %v", e)
+		return
+	}
+	queued := int64(0)
+	for _, size := range sizes {
+		queued += size
+	}
+	q.schedulingMetrics.RecordCycle(len(jobs), queued)
+}
+
+// SimulateSchedule runs the scheduling algorithm against the current queues as LeaseJobs would,
+// using queue configurations from the request in place of stored ones, without leasing any jobs.
+func (q *AggregatedQueueServer) SimulateSchedule(ctx context.Context, request *api.ScheduleSimulationRequest) (*api.ScheduleSimulationResult, error) {
+	if e := checkPermission(q.permissions, ctx, permissions.SimulateSchedule); e != nil {
+		return nil, e
+	}
+
+	config := q.getSchedulingConfig()
+	leaseRequest := request.LeaseRequest
+	var res common.ComputeResources = leaseRequest.Resources
+	if res.AsFloat().IsLessThanOrEqual(config.MinimumResourceToSchedule) {
+		return &api.ScheduleSimulationResult{}, nil
+	}
+
+	queues, e := q.queueRepository.GetAllQueues()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	queues = overrideQueues(queues, request.Queues)
+
+	activeQueues, e := q.jobRepository.FilterActiveQueues(queues)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+
+	usageReports, e := q.usageRepository.GetClusterUsageReports()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+
+	activeClusterReports := scheduling.FilterActiveClusters(usageReports)
+	clusterPriorities, e := q.usageRepository.GetClusterPriorities(scheduling.GetClusterReportIds(activeClusterReports))
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+
+	clusterLeasedJobReports, e := q.usageRepository.GetClusterLeasedReports()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	if leaseRequest.ClusterLeasedReport.ClusterId != "" {
+		clusterLeasedJobReports[leaseRequest.ClusterLeasedReport.ClusterId] = &leaseRequest.ClusterLeasedReport
+	}
+	clusterLeasedJobReports = scheduling.FilterActiveClusterLeasedReports(clusterLeasedJobReports)
+
+	clusterLeasesInWindow, e := q.getClusterLeasesInWindow(config)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+
+	jobs, e := scheduling.LeaseJobs(
+		ctx,
+		config,
+		scheduling.NewSimulatedJobQueueRepository(q.jobRepository),
+		func(jobs []*api.Job) {},
+		func(denials []*scheduling.LeaseDenial) {},
+		nil,
+		nil,
+		&leaseRequest,
+		activeClusterReports,
+		clusterLeasedJobReports,
+		clusterLeasesInWindow,
+		clusterPriorities,
+		activeQueues)
+
+	if e != nil {
+		return nil, status.Errorf(codes.Internal, e.Error())
+	}
+	return createScheduleSimulationResult(activeQueues, jobs), nil
+}
+
+// getClusterLeasesInWindow returns nil when cluster fairness window is not configured.
+func (q *AggregatedQueueServer) getClusterLeasesInWindow(config *configuration.SchedulingConfig) (map[string]*api.ClusterLeasedReport, error) {
+	if config.ClusterFairnessWindow <= 0 {
+		return nil, nil
+	}
+	return q.usageRepository.GetClusterLeasesSince(time.Now().Add(-config.ClusterFairnessWindow))
+}
+
+// reportLeaseDenials reports denied jobs, skipping jobs already reported within the lease denied event interval.
+func (q *AggregatedQueueServer) reportLeaseDenials(denials []*scheduling.LeaseDenial, clusterId string) {
+	interval := q.getSchedulingConfig().LeaseDeniedEventInterval
+	if interval <= 0 {
+		return
+	}
+	jobIds := make([]string, 0, len(denials))
+	for _, denial := range denials {
+		jobIds = append(jobIds, denial.Job.Id)
+	}
+	reserved, e := q.jobRepository.ReserveLeaseDeniedReports(jobIds, interval)
+	if e != nil {
+		log.Errorf("Failed to report denied leases: %s", e)
+		return
+	}
+	reservedIds := util.StringListToSet(reserved)
+	toReport := []*scheduling.LeaseDenial{}
+	for _, denial := range denials {
+		if reservedIds[denial.Job.Id] {
+			toReport = append(toReport, denial)
+		}
+	}
+	if len(toReport) > 0 {
+		reportJobsLeaseDenied(q.eventRepository, toReport, clusterId)
+	}
+}
+
+// handleUnmatchableJobs applies the configured policy to denied jobs no active cluster has matched
+// in the configured number of lease requests. Warning is reported once, cancelling is retried while the job stays queued.
+func (q *AggregatedQueueServer) handleUnmatchableJobs(
+	denials []*scheduling.LeaseDenial,
+	activeClusterReports map[string]*api.ClusterUsageReport,
+	clusterId string,
+	settings *configuration.UnmatchableJobsSettings) {
+
+	if settings.Policy != scheduling.UnmatchableJobsWarn && settings.Policy != scheduling.UnmatchableJobsCancel {
+		return
+	}
+	jobs := scheduling.FilterUnmatchableJobs(denials, activeClusterReports)
+	if len(jobs) == 0 {
+		return
+	}
+	cycles, e := q.jobRepository.CountUnmatchableCycles(jobIds(jobs))
+	if e != nil {
+		log.Errorf("Failed to count cycles of jobs matching no cluster: %v", e)
+		return
+	}
+
+	threshold := int64(settings.Cycles)
+	if threshold < 1 {
+		threshold = 1
+	}
+	warnings := []*scheduling.LeaseDenial{}
+	toCancel := []*api.Job{}
+	for _, job := range jobs {
+		switch {
+		case settings.Policy == scheduling.UnmatchableJobsCancel && cycles[job.Id] >= threshold:
+			toCancel = append(toCancel, job)
+		case settings.Policy == scheduling.UnmatchableJobsWarn && cycles[job.Id] == threshold:
+			warnings = append(warnings, &scheduling.LeaseDenial{Job: job, Reason: api.LeaseDeniedReason_NoMatchingCluster})
+		}
+	}
+	if len(warnings) > 0 {
+		reportJobsLeaseDenied(q.eventRepository, warnings, clusterId)
+	}
+	if len(toCancel) > 0 {
+		q.cancelUnmatchableJobs(toCancel)
+	}
+}
+
+func (q *AggregatedQueueServer) cancelUnmatchableJobs(jobs []*api.Job) {
+	now := time.Now()
+	events := []*api.EventMessage{}
+	for job, e := range q.jobRepository.DeleteJobs(jobs) {
+		if e != nil {
+			log.Errorf("Failed to cancel job %s matching no cluster: %v", job.Id, e)
+			continue
+		}
+		events = append(events,
+			&api.EventMessage{
+				Events: &api.EventMessage_Cancelling{
+					Cancelling: &api.JobCancellingEvent{
+						JobId: job.Id, JobSetId: job.JobSetId, Queue: job.Queue, Created: now, Reason: scheduling.UnmatchableJobReason},
+				},
+			},
+			&api.EventMessage{
+				Events: &api.EventMessage_Cancelled{
+					Cancelled: &api.JobCancelledEvent{
+						JobId: job.Id, JobSetId: job.JobSetId, Queue: job.Queue, Created: now, Reason: scheduling.UnmatchableJobReason},
+				},
+			})
+	}
+	e := q.eventRepository.ReportEvents(events)
+	if e != nil {
+		log.Error(e)
+	}
+}
+
+func (q *AggregatedQueueServer) RenewLease(ctx context.Context, request *api.RenewLeaseRequest) (*api.RenewLeaseResponse, error) {
 	if e := checkPermission(q.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
 	}
-	renewed, e := q.jobRepository.RenewLease(request.ClusterId, request.Ids)
-	return &api.IdList{renewed}, e
+	release, e := q.leaseLimiter.acquire(ctx)
+	if e != nil {
+		return nil, e
+	}
+	defer release()
+	statuses, e := q.jobRepository.RenewLease(request.ClusterId, request.Ids)
+	if e != nil {
+		return nil, e
+	}
+	return createRenewLeaseResponse(request.Ids, statuses), nil
+}
+
+// createRenewLeaseResponse lists renewed ids and status of each lease in the order of the request.
+func createRenewLeaseResponse(ids []string, statuses map[string]api.LeaseRenewalStatus) *api.RenewLeaseResponse {
+	response := &api.RenewLeaseResponse{Ids: []string{}, Renewals: []*api.LeaseRenewal{}}
+	seen := map[string]bool{}
+	for _, id := range ids {
+		status, ok := statuses[id]
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+		if status == api.LeaseRenewalStatus_Renewed {
+			response.Ids = append(response.Ids, id)
+		}
+		response.Renewals = append(response.Renewals, &api.LeaseRenewal{JobId: id, Status: status})
+	}
+	return response
+}
+
+// GetClusterLeases returns jobs currently leased by the cluster together with resources they request,
+// read from the same lease state RenewLease and lease expiry operate on.
+func (q *AggregatedQueueServer) GetClusterLeases(ctx context.Context, request *api.ClusterLeasesRequest) (*api.ClusterLeases, error) {
+	if e := checkPermission(q.permissions, ctx, permissions.GetClusterLeases); e != nil {
+		return nil, e
+	}
+	jobs, e := q.jobRepository.GetLeasedJobs(request.ClusterId)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	return createClusterLeases(request.ClusterId, jobs), nil
+}
+
+func createClusterLeases(clusterId string, jobs []*api.Job) *api.ClusterLeases {
+	leasedJobs := make([]*api.ClusterLeasedJob, 0, len(jobs))
+	total := common.ComputeResources{}
+	leasedResourceByQueue := map[string]common.ComputeResources{}
+	for _, job := range jobs {
+		leasedJobs = append(leasedJobs, &api.ClusterLeasedJob{JobId: job.Id, Queue: job.Queue, JobSetId: job.JobSetId})
+		jobResource := common.TotalResourceRequest(job.PodSpec)
+		total.Add(jobResource)
+		if _, ok := leasedResourceByQueue[job.Queue]; !ok {
+			leasedResourceByQueue[job.Queue] = common.ComputeResources{}
+		}
+		leasedResourceByQueue[job.Queue].Add(jobResource)
+	}
+
+	queueReports := make([]*api.QueueLeasedReport, 0, len(leasedResourceByQueue))
+	for queueName, leasedResource := range leasedResourceByQueue {
+		queueReports = append(queueReports, &api.QueueLeasedReport{Name: queueName, ResourcesLeased: leasedResource})
+	}
+	sort.Slice(queueReports, func(i, j int) bool { return queueReports[i].Name < queueReports[j].Name })
+
+	return &api.ClusterLeases{
+		ClusterId:       clusterId,
+		Jobs:            leasedJobs,
+		Queues:          queueReports,
+		ResourcesLeased: total,
+	}
 }
 
 func (q *AggregatedQueueServer) ReturnLease(ctx context.Context, request *api.ReturnLeaseRequest) (*types.Empty, error) {
 	if e := checkPermission(q.permissions, ctx, permissions.ExecuteJobs); e != nil {
 		return nil, e
 	}
-	_, err := q.jobRepository.ReturnLease(request.ClusterId, request.JobId)
-	if err != nil {
-		return nil, err
+	jobIds := request.JobIds
+	if request.JobId != "" {
+		jobIds = append([]string{request.JobId}, jobIds...)
+	}
+
+	returnedJobs := []*api.Job{}
+	var returnError error
+	for _, jobId := range jobIds {
+		returnedJob, err := q.jobRepository.ReturnLease(request.ClusterId, jobId)
+		if err != nil {
+			returnError = err
+			break
+		}
+		// jobs no longer leased by the cluster are not returned
+		if returnedJob != nil {
+			returnedJob.LeaseReturnReason = request.Reason
+			returnedJobs = append(returnedJobs, returnedJob)
+		}
+	}
+	scheduling.LogJobsBySubmission(logging.FromContext(ctx).WithField("clusterId", request.ClusterId), returnedJobs, "Returned leases of jobs")
+	// jobs returned before a failure are back in their queue, their attempts are counted anyway
+	scheduling.RecordReturnedLeases(q.jobRepository, q.eventRepository, returnedJobs, request.ClusterId, q.getSchedulingConfig().MaxLeaseAttempts)
+	if returnError != nil {
+		return nil, returnError
 	}
 	return &types.Empty{}, nil
 }
@@ -138,16 +550,59 @@ func (q *AggregatedQueueServer) ReportDone(ctx context.Context, idList *api.IdLi
 	if e != nil {
 		return nil, status.Errorf(codes.Internal, e.Error())
 	}
+	// jobs requeued after their failure are waiting in the queue again and are not done
+	jobs, e = q.jobRepository.FilterNotQueuedJobs(jobs)
+	if e != nil {
+		return nil, status.Errorf(codes.Internal, e.Error())
+	}
 	deletionResult := q.jobRepository.DeleteJobs(jobs)
 
 	cleanedIds := make([]string, 0, len(deletionResult))
+	cleanedJobs := make([]*api.Job, 0, len(deletionResult))
 	var returnedError error = nil
 	for job, err := range deletionResult {
 		if err != nil {
 			returnedError = err
 		} else {
 			cleanedIds = append(cleanedIds, job.Id)
+			cleanedJobs = append(cleanedJobs, job)
 		}
 	}
+	scheduling.LogJobsBySubmission(logging.FromContext(ctx), cleanedJobs, "Jobs done")
 	return &api.IdList{cleanedIds}, returnedError
 }
+
+func overrideQueues(queues []*api.Queue, overrides []*api.Queue) []*api.Queue {
+	overridesByName := make(map[string]*api.Queue, len(overrides))
+	for _, queue := range overrides {
+		overridesByName[queue.Name] = queue
+	}
+	result := make([]*api.Queue, 0, len(queues)+len(overrides))
+	for _, queue := range queues {
+		if override, ok := overridesByName[queue.Name]; ok {
+			queue = override
+			delete(overridesByName, queue.Name)
+		}
+		result = append(result, queue)
+	}
+	for _, queue := range overrides {
+		if _, ok := overridesByName[queue.Name]; ok {
+			result = append(result, queue)
+		}
+	}
+	return result
+}
+
+func createScheduleSimulationResult(queues []*api.Queue, jobs []*api.Job) *api.ScheduleSimulationResult {
+	jobIdsByQueue := map[string][]string{}
+	for _, job := range jobs {
+		jobIdsByQueue[job.Queue] = append(jobIdsByQueue[job.Queue], job.Id)
+	}
+	result := &api.ScheduleSimulationResult{}
+	for _, queue := range queues {
+		if ids, ok := jobIdsByQueue[queue.Name]; ok {
+			result.Queues = append(result.Queues, &api.QueueScheduleSimulation{Queue: queue.Name, JobIds: ids})
+		}
+	}
+	return result
+}
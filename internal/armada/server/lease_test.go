@@ -0,0 +1,307 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/authorization"
+	"github.com/G-Research/armada/internal/armada/authorization/permissions"
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/metrics"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/armada/scheduling"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestAggregatedQueueServer_ReturnLease_RequeuesReturnedJobWithReason(t *testing.T) {
+	withAggregatedQueueServer(func(s *AggregatedQueueServer) {
+		request := &api.JobSubmitRequest{Queue: "queue1", JobSetId: "set1", JobRequestItems: createJobRequestItems(3)}
+		jobs := []*api.Job{}
+		for _, item := range request.JobRequestItems {
+			job, e := s.jobRepository.CreateJob(request, item, authorization.NewStaticPrincipal("user", []string{}))
+			assert.Nil(t, e)
+			jobs = append(jobs, job)
+		}
+		_, e := s.jobRepository.AddJobs(jobs)
+		assert.Nil(t, e)
+		leased, e := s.jobRepository.TryLeaseJobs("cluster1", "queue1", jobs)
+		assert.Nil(t, e)
+		assert.Equal(t, len(jobs), len(leased))
+
+		returned := jobs[0]
+		_, e = s.ReturnLease(context.Background(), &api.ReturnLeaseRequest{
+			ClusterId: "cluster1",
+			JobIds:    []string{returned.Id},
+			Reason:    "rejected by admission webhook",
+		})
+		assert.Nil(t, e)
+
+		queued, e := s.jobRepository.PeekQueue("queue1", 10)
+		assert.Nil(t, e)
+		assert.Equal(t, []string{returned.Id}, jobIds(queued))
+		assert.Equal(t, "rejected by admission webhook", queued[0].LeaseReturnReason)
+		assert.Equal(t, uint32(1), queued[0].LeaseAttempts)
+
+		stillLeased, e := s.jobRepository.GetLeasedJobs("cluster1")
+		assert.Nil(t, e)
+		assert.ElementsMatch(t, []string{jobs[1].Id, jobs[2].Id}, jobIds(stillLeased))
+		for _, job := range stillLeased {
+			assert.Equal(t, "", job.LeaseReturnReason)
+			assert.Equal(t, uint32(0), job.LeaseAttempts)
+		}
+	})
+}
+
+func TestAggregatedQueueServer_ReturnLease_ReturnedJobFailsAtMaxLeaseAttempts(t *testing.T) {
+	withAggregatedQueueServer(func(s *AggregatedQueueServer) {
+		s.schedulingConfig.MaxLeaseAttempts = 1
+		request := &api.JobSubmitRequest{Queue: "queue1", JobSetId: "set1", JobRequestItems: createJobRequestItems(1)}
+		job, e := s.jobRepository.CreateJob(request, request.JobRequestItems[0], authorization.NewStaticPrincipal("user", []string{}))
+		assert.Nil(t, e)
+		_, e = s.jobRepository.AddJobs([]*api.Job{job})
+		assert.Nil(t, e)
+		_, e = s.jobRepository.TryLeaseJobs("cluster1", "queue1", []*api.Job{job})
+		assert.Nil(t, e)
+
+		_, e = s.ReturnLease(context.Background(), &api.ReturnLeaseRequest{ClusterId: "cluster1", JobId: job.Id, Reason: "quota exceeded"})
+		assert.Nil(t, e)
+
+		queued, e := s.jobRepository.PeekQueue("queue1", 10)
+		assert.Nil(t, e)
+		assert.Empty(t, queued)
+		active, e := s.jobRepository.GetQueueActiveJobIds("queue1")
+		assert.Nil(t, e)
+		assert.Empty(t, active)
+	})
+}
+
+func TestAggregatedQueueServer_HandleUnmatchableJobs_CancelsJobAfterConfiguredCycles(t *testing.T) {
+	withAggregatedQueueServer(func(s *AggregatedQueueServer) {
+		settings := &configuration.UnmatchableJobsSettings{Policy: scheduling.UnmatchableJobsCancel, Cycles: 2}
+		impossible, insufficientCapacity := addUnmatchableTestJobs(t, s)
+		denials := []*scheduling.LeaseDenial{
+			{Job: impossible, Reason: api.LeaseDeniedReason_NoMatchingNodeLabels},
+			{Job: insufficientCapacity, Reason: api.LeaseDeniedReason_InsufficientCapacity},
+		}
+
+		s.handleUnmatchableJobs(denials, unmatchableTestClusterReports(), "cluster1", settings)
+		active, e := s.jobRepository.GetQueueActiveJobIds("queue1")
+		assert.Nil(t, e)
+		assert.ElementsMatch(t, []string{impossible.Id, insufficientCapacity.Id}, active)
+
+		s.handleUnmatchableJobs(denials, unmatchableTestClusterReports(), "cluster1", settings)
+		active, e = s.jobRepository.GetQueueActiveJobIds("queue1")
+		assert.Nil(t, e)
+		assert.Equal(t, []string{insufficientCapacity.Id}, active)
+
+		events, e := s.eventRepository.ReadEvents("queue1", "set1", "", 100, 0)
+		assert.Nil(t, e)
+		assert.Equal(t, 2, len(events))
+		assert.Equal(t, impossible.Id, events[1].Message.GetCancelled().JobId)
+		assert.Equal(t, "unschedulable: no matching cluster", events[1].Message.GetCancelled().Reason)
+	})
+}
+
+func TestAggregatedQueueServer_HandleUnmatchableJobs_WarnsOnceAndLeavesJobQueued(t *testing.T) {
+	withAggregatedQueueServer(func(s *AggregatedQueueServer) {
+		settings := &configuration.UnmatchableJobsSettings{Policy: scheduling.UnmatchableJobsWarn, Cycles: 1}
+		impossible, _ := addUnmatchableTestJobs(t, s)
+		denials := []*scheduling.LeaseDenial{{Job: impossible, Reason: api.LeaseDeniedReason_NoMatchingNodeLabels}}
+
+		s.handleUnmatchableJobs(denials, unmatchableTestClusterReports(), "cluster1", settings)
+		s.handleUnmatchableJobs(denials, unmatchableTestClusterReports(), "cluster1", settings)
+
+		events, e := s.eventRepository.ReadEvents("queue1", "set1", "", 100, 0)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, api.LeaseDeniedReason_NoMatchingCluster, events[0].Message.GetLeaseDenied().Reason)
+		queued, e := s.jobRepository.PeekQueue("queue1", 10)
+		assert.Nil(t, e)
+		assert.Contains(t, jobIds(queued), impossible.Id)
+	})
+}
+
+func TestAggregatedQueueServer_SimulateSchedule_RequiresSimulateSchedulePermission(t *testing.T) {
+	withAggregatedQueueServer(func(s *AggregatedQueueServer) {
+		s.permissions = grantedPermissionChecker{granted: []permissions.Permission{permissions.WatchAllEvents}}
+
+		_, e := s.SimulateSchedule(context.Background(), &api.ScheduleSimulationRequest{LeaseRequest: api.LeaseRequest{ClusterId: "cluster1"}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(e))
+	})
+}
+
+func TestAggregatedQueueServer_GetClusterLeases_RequiresGetClusterLeasesPermission(t *testing.T) {
+	withAggregatedQueueServer(func(s *AggregatedQueueServer) {
+		s.permissions = grantedPermissionChecker{granted: []permissions.Permission{permissions.WatchAllEvents}}
+
+		_, e := s.GetClusterLeases(context.Background(), &api.ClusterLeasesRequest{ClusterId: "cluster1"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(e))
+
+		s.permissions = grantedPermissionChecker{granted: []permissions.Permission{permissions.GetClusterLeases}}
+		_, e = s.GetClusterLeases(context.Background(), &api.ClusterLeasesRequest{ClusterId: "cluster1"})
+		assert.Nil(t, e)
+	})
+}
+
+func TestAggregatedQueueServer_LeaseJobs_CriticalJobPreemptsRunningBestEffortJob(t *testing.T) {
+	withAggregatedQueueServer(func(s *AggregatedQueueServer) {
+		s.schedulingConfig = &configuration.SchedulingConfig{
+			QueueLeaseBatchSize: 10,
+			PriorityClasses:     map[string]int{"best-effort": 0, "critical": 2},
+		}
+		bestEffort := addPreemptionTestJobs(t, s, "queue1", "best-effort", 1)
+		leased, e := s.jobRepository.TryLeaseJobs("cluster1", "queue1", bestEffort)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(leased))
+		critical := addPreemptionTestJobs(t, s, "queue2", "critical", 1)
+		addPreemptionTestCluster(t, s)
+
+		// the cluster is full, the critical job fits only in place of the best-effort one
+		lease, e := s.LeaseJobs(context.Background(), &api.LeaseRequest{ClusterId: "cluster1", Resources: common.ComputeResources{}})
+		assert.Nil(t, e)
+		assert.Equal(t, jobIds(critical), jobIds(lease.Job))
+
+		running, e := s.jobRepository.GetLeasedJobs("cluster1")
+		assert.Nil(t, e)
+		assert.Equal(t, jobIds(critical), jobIds(running))
+		queued, e := s.jobRepository.PeekQueue("queue1", 10)
+		assert.Nil(t, e)
+		assert.Equal(t, jobIds(bestEffort), jobIds(queued))
+		assert.Equal(t, uint32(0), queued[0].LeaseAttempts)
+
+		events, e := s.eventRepository.ReadEvents("queue1", "set1", "", 100, 0)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, bestEffort[0].Id, events[0].Message.GetLeaseReturned().JobId)
+		assert.Equal(t, "preempted by a job of higher priority class", events[0].Message.GetLeaseReturned().Reason)
+	})
+}
+
+func TestAggregatedQueueServer_LeaseJobs_PreemptionBudgetDefersPreemptionsToNextCycle(t *testing.T) {
+	withAggregatedQueueServer(func(s *AggregatedQueueServer) {
+		s.schedulingConfig = &configuration.SchedulingConfig{
+			QueueLeaseBatchSize: 10,
+			PriorityClasses:     map[string]int{"best-effort": 0, "critical": 2},
+			PreemptionBudget:    configuration.PreemptionBudget{MaxJobs: 1},
+		}
+		bestEffort := addPreemptionTestJobs(t, s, "queue1", "best-effort", 2)
+		_, e := s.jobRepository.TryLeaseJobs("cluster1", "queue1", bestEffort)
+		assert.Nil(t, e)
+		critical := addPreemptionTestJobs(t, s, "queue2", "critical", 2)
+		addPreemptionTestCluster(t, s)
+
+		lease, e := s.LeaseJobs(context.Background(), &api.LeaseRequest{ClusterId: "cluster1", Resources: common.ComputeResources{}})
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(lease.Job))
+		queued, e := s.jobRepository.PeekQueue("queue1", 10)
+		assert.Nil(t, e)
+		assert.Equal(t, 1, len(queued))
+
+		// the other critical job waits for the budget of the next cycle
+		nextLease, e := s.LeaseJobs(context.Background(), &api.LeaseRequest{ClusterId: "cluster1", Resources: common.ComputeResources{}})
+		assert.Nil(t, e)
+		assert.ElementsMatch(t, jobIds(critical), append(jobIds(lease.Job), jobIds(nextLease.Job)...))
+		queued, e = s.jobRepository.PeekQueue("queue1", 10)
+		assert.Nil(t, e)
+		assert.ElementsMatch(t, jobIds(bestEffort), jobIds(queued))
+	})
+}
+
+func TestAggregatedQueueServer_LeaseJobs_JobOfSameTierDoesNotPreempt(t *testing.T) {
+	withAggregatedQueueServer(func(s *AggregatedQueueServer) {
+		s.schedulingConfig = &configuration.SchedulingConfig{
+			QueueLeaseBatchSize: 10,
+			PriorityClasses:     map[string]int{"best-effort": 0, "critical": 2},
+		}
+		running := addPreemptionTestJobs(t, s, "queue1", "critical", 1)
+		_, e := s.jobRepository.TryLeaseJobs("cluster1", "queue1", running)
+		assert.Nil(t, e)
+		addPreemptionTestJobs(t, s, "queue2", "critical", 1)
+		addPreemptionTestCluster(t, s)
+
+		lease, e := s.LeaseJobs(context.Background(), &api.LeaseRequest{ClusterId: "cluster1", Resources: common.ComputeResources{}})
+		assert.Nil(t, e)
+		assert.Empty(t, lease.Job)
+
+		stillRunning, e := s.jobRepository.GetLeasedJobs("cluster1")
+		assert.Nil(t, e)
+		assert.Equal(t, jobIds(running), jobIds(stillRunning))
+	})
+}
+
+func addPreemptionTestJobs(t *testing.T, s *AggregatedQueueServer, queue string, priorityClass string, count int) []*api.Job {
+	assert.Nil(t, s.queueRepository.CreateQueue(&api.Queue{Name: queue, PriorityFactor: 1}))
+	request := &api.JobSubmitRequest{Queue: queue, JobSetId: "set1", JobRequestItems: createJobRequestItems(count)}
+	jobs := []*api.Job{}
+	for _, item := range request.JobRequestItems {
+		item.PriorityClass = priorityClass
+		job, e := s.jobRepository.CreateJob(request, item, authorization.NewStaticPrincipal("user", []string{}))
+		assert.Nil(t, e)
+		jobs = append(jobs, job)
+	}
+	_, e := s.jobRepository.AddJobs(jobs)
+	assert.Nil(t, e)
+	return jobs
+}
+
+// addPreemptionTestCluster reports cluster1 with capacity of a single test job, all of it in use.
+func addPreemptionTestCluster(t *testing.T, s *AggregatedQueueServer) {
+	e := s.usageRepository.UpdateCluster(&api.ClusterUsageReport{
+		ClusterId:                "cluster1",
+		ReportTime:               time.Now(),
+		ClusterCapacity:          common.ComputeResources{"cpu": resource.MustParse("1"), "memory": resource.MustParse("512Mi")},
+		ClusterAvailableCapacity: common.ComputeResources{},
+	}, map[string]float64{})
+	assert.Nil(t, e)
+}
+
+func addUnmatchableTestJobs(t *testing.T, s *AggregatedQueueServer) (impossible *api.Job, insufficientCapacity *api.Job) {
+	request := &api.JobSubmitRequest{Queue: "queue1", JobSetId: "set1", JobRequestItems: createJobRequestItems(2)}
+	request.JobRequestItems[0].RequiredNodeLabels = map[string]string{"impossible": "label"}
+	request.JobRequestItems[1].RequiredNodeLabels = map[string]string{"gpu": "a100"}
+	jobs := []*api.Job{}
+	for _, item := range request.JobRequestItems {
+		job, e := s.jobRepository.CreateJob(request, item, authorization.NewStaticPrincipal("user", []string{}))
+		assert.Nil(t, e)
+		jobs = append(jobs, job)
+	}
+	_, e := s.jobRepository.AddJobs(jobs)
+	assert.Nil(t, e)
+	return jobs[0], jobs[1]
+}
+
+func unmatchableTestClusterReports() map[string]*api.ClusterUsageReport {
+	return map[string]*api.ClusterUsageReport{
+		"cluster1": {ClusterId: "cluster1", NodeLabelings: []*api.NodeLabeling{{Labels: map[string]string{"gpu": "none"}}}},
+		"cluster2": {ClusterId: "cluster2", NodeLabelings: []*api.NodeLabeling{{Labels: map[string]string{"gpu": "a100"}}}},
+	}
+}
+
+// metrics are registered in the default registry when created, so all test servers share them
+var testSchedulingMetrics = metrics.NewSchedulingMetrics()
+
+func withAggregatedQueueServer(action func(s *AggregatedQueueServer)) {
+	// using real redis instance as miniredis does not support streams
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+
+	jobRepo := repository.NewRedisJobRepository(client, "", false, 0)
+	queueRepo := repository.NewRedisQueueRepository(client, "")
+	usageRepo := repository.NewRedisUsageRepository(client, "")
+	eventRepo := repository.NewRedisEventRepository(client, "", configuration.EventRetentionPolicy{ExpiryEnabled: false}, configuration.JsonEventStreamConfig{})
+	server := NewAggregatedQueueServer(&fakePermissionChecker{}, configuration.SchedulingConfig{}, jobRepo, queueRepo, usageRepo, eventRepo,
+		scheduling.NewJobNotifier(), testSchedulingMetrics, configuration.LeaseConcurrencyConfig{})
+
+	client.FlushDB()
+
+	action(server)
+
+	client.FlushDB()
+}
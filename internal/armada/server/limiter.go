@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/metrics"
+)
+
+var eventWatchSubscribersGauge = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: metrics.MetricPrefix + "event_watch_subscribers",
+	Help: "Number of open event watch streams",
+})
+
+// requestLimiter bounds the number of requests handled at once, so many executors requesting together don't
+// overwhelm Redis and the scheduler. Excess requests wait for a free slot up to the configured time and then fail
+// with ResourceExhausted, executors retry them on their next loop.
+type requestLimiter struct {
+	// nil when requests are not limited
+	slots   chan struct{}
+	maxWait time.Duration
+}
+
+func newRequestLimiter(config configuration.LeaseConcurrencyConfig) *requestLimiter {
+	limiter := &requestLimiter{maxWait: config.MaxWait}
+	if config.MaxConcurrentRequests > 0 {
+		limiter.slots = make(chan struct{}, config.MaxConcurrentRequests)
+	}
+	return limiter
+}
+
+// acquire takes a slot for handling of a request, the returned function frees it.
+func (l *requestLimiter) acquire(ctx context.Context) (release func(), e error) {
+	if l.slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return l.release, nil
+	default:
+	}
+
+	if l.maxWait > 0 {
+		timeout := time.NewTimer(l.maxWait)
+		defer timeout.Stop()
+		select {
+		case l.slots <- struct{}{}:
+			return l.release, nil
+		case <-timeout.C:
+		case <-ctx.Done():
+		}
+	}
+	return nil, status.Errorf(codes.ResourceExhausted, "Too many concurrent lease requests, limit is %d", cap(l.slots))
+}
+
+func (l *requestLimiter) release() {
+	<-l.slots
+}
+
+// subscriberLimiter bounds the number of event watch streams open at once, each of them reads events from Redis
+// until its client disconnects, so a storm of subscribers could exhaust the server. Watches over the limit fail
+// with ResourceExhausted right away, clients retry them later.
+type subscriberLimiter struct {
+	// 0 when subscribers are not limited
+	max    int
+	lock   sync.Mutex
+	active int
+}
+
+func newSubscriberLimiter(max int) *subscriberLimiter {
+	return &subscriberLimiter{max: max}
+}
+
+// subscribe counts a new watch stream, the returned function must be called when the stream ends.
+func (l *subscriberLimiter) subscribe() (unsubscribe func(), e error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.max > 0 && l.active >= l.max {
+		return nil, status.Errorf(codes.ResourceExhausted, "Too many event watch subscribers, limit is %d", l.max)
+	}
+	l.active++
+	eventWatchSubscribersGauge.Inc()
+	return l.unsubscribe, nil
+}
+
+func (l *subscriberLimiter) unsubscribe() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.active--
+	eventWatchSubscribersGauge.Dec()
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+)
+
+func TestRequestLimiter_BoundsConcurrentLeaseRequests(t *testing.T) {
+	limiter := newRequestLimiter(configuration.LeaseConcurrencyConfig{MaxConcurrentRequests: 3, MaxWait: time.Minute})
+
+	var inFlight, maxInFlight, handled int32
+	lease := func() error {
+		release, e := limiter.acquire(context.Background())
+		if e != nil {
+			return e
+		}
+		defer release()
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		atomic.AddInt32(&handled, 1)
+		return nil
+	}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, lease())
+		}()
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("lease requests did not finish")
+	}
+
+	assert.Equal(t, int32(50), handled)
+	assert.True(t, maxInFlight <= 3)
+	assert.Equal(t, 0, len(limiter.slots))
+}
+
+func TestRequestLimiter_FailsFastWithResourceExhausted(t *testing.T) {
+	limiter := newRequestLimiter(configuration.LeaseConcurrencyConfig{MaxConcurrentRequests: 1})
+
+	release, e := limiter.acquire(context.Background())
+	assert.NoError(t, e)
+
+	_, e = limiter.acquire(context.Background())
+	assert.Equal(t, codes.ResourceExhausted, status.Code(e))
+
+	release()
+	release, e = limiter.acquire(context.Background())
+	assert.NoError(t, e)
+	release()
+}
+
+func TestRequestLimiter_UnlimitedWhenNotConfigured(t *testing.T) {
+	limiter := newRequestLimiter(configuration.LeaseConcurrencyConfig{})
+
+	for i := 0; i < 100; i++ {
+		_, e := limiter.acquire(context.Background())
+		assert.NoError(t, e)
+	}
+}
@@ -16,3 +16,21 @@ func (fakePermissionChecker) UserOwns(ctx context.Context, obj authorization.Own
 func (fakePermissionChecker) UserHasPermission(ctx context.Context, perm permissions.Permission) bool {
 	return true
 }
+
+// grantedPermissionChecker grants only the listed permissions, the user owns all queues.
+type grantedPermissionChecker struct {
+	granted []permissions.Permission
+}
+
+func (grantedPermissionChecker) UserOwns(ctx context.Context, obj authorization.Owned) bool {
+	return true
+}
+
+func (c grantedPermissionChecker) UserHasPermission(ctx context.Context, perm permissions.Permission) bool {
+	for _, granted := range c.granted {
+		if granted == perm {
+			return true
+		}
+	}
+	return false
+}
@@ -6,6 +6,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/armada/scheduling"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -18,6 +19,7 @@ func reportQueued(repository repository.EventRepository, jobs []*api.Job) error
 			Queue:    job.Queue,
 			JobSetId: job.JobSetId,
 			Created:  now,
+			Gated:    job.Gated,
 		})
 		if e != nil {
 			return e
@@ -72,6 +74,79 @@ func reportJobsLeased(repository repository.EventRepository, jobs []*api.Job, cl
 	}
 }
 
+// reportJobsPreempted reports leases of jobs preempted for jobs of higher priority classes as returned.
+func reportJobsPreempted(repository repository.EventRepository, jobs []*api.Job, clusterId string) {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, e := api.Wrap(&api.JobLeaseReturnedEvent{
+			JobId:     job.Id,
+			Queue:     job.Queue,
+			JobSetId:  job.JobSetId,
+			Created:   now,
+			ClusterId: clusterId,
+			Reason:    "preempted by a job of higher priority class",
+		})
+		if e != nil {
+			log.Error(e)
+		} else {
+			events = append(events, event)
+		}
+	}
+	e := repository.ReportEvents(events)
+	if e != nil {
+		log.Error(e)
+	}
+}
+
+func reportJobsLeaseDenied(repository repository.EventRepository, denials []*scheduling.LeaseDenial, clusterId string) {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, denial := range denials {
+		event, e := api.Wrap(&api.JobLeaseDeniedEvent{
+			JobId:     denial.Job.Id,
+			Queue:     denial.Job.Queue,
+			JobSetId:  denial.Job.JobSetId,
+			Created:   now,
+			ClusterId: clusterId,
+			Reason:    denial.Reason,
+		})
+		if e != nil {
+			log.Error(e)
+		} else {
+			events = append(events, event)
+		}
+	}
+	e := repository.ReportEvents(events)
+	if e != nil {
+		log.Error(e)
+	}
+}
+
+func reportJobsResourceOveruse(repository repository.EventRepository, jobs []*api.Job, clusterId string) {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, e := api.Wrap(&api.JobResourceOveruseEvent{
+			JobId:         job.Id,
+			Queue:         job.Queue,
+			JobSetId:      job.JobSetId,
+			Created:       now,
+			ClusterId:     clusterId,
+			ResourcesUsed: job.ResourcesUsed,
+		})
+		if e != nil {
+			log.Error(e)
+		} else {
+			events = append(events, event)
+		}
+	}
+	e := repository.ReportEvents(events)
+	if e != nil {
+		log.Error(e)
+	}
+}
+
 func reportJobsCancelling(repository repository.EventRepository, jobs []*api.Job) error {
 	events := []*api.EventMessage{}
 	now := time.Now()
@@ -110,6 +185,24 @@ func reportJobsCancelled(repository repository.EventRepository, jobs []*api.Job)
 	return e
 }
 
+func reportJobsReprioritized(repository repository.EventRepository, jobs []*api.Job) error {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, e := api.Wrap(&api.JobReprioritizedEvent{
+			JobId:    job.Id,
+			Queue:    job.Queue,
+			JobSetId: job.JobSetId,
+			Created:  now,
+		})
+		if e != nil {
+			return e
+		}
+		events = append(events, event)
+	}
+	return repository.ReportEvents(events)
+}
+
 func reportTerminated(repository repository.EventRepository, clusterId string, job *api.Job) error {
 	event, e := api.Wrap(&api.JobTerminatedEvent{
 		JobId:     job.Id,
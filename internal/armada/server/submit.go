@@ -2,36 +2,95 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"sync"
 
 	"github.com/gogo/protobuf/types"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
 
+	"github.com/G-Research/armada/internal/armada/audit"
 	"github.com/G-Research/armada/internal/armada/authorization"
 	"github.com/G-Research/armada/internal/armada/authorization/permissions"
+	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/armada/scheduling"
+	"github.com/G-Research/armada/internal/armada/validation"
+	"github.com/G-Research/armada/internal/armada/webhook"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/logging"
+	"github.com/G-Research/armada/internal/common/util"
+	commonValidation "github.com/G-Research/armada/internal/common/validation"
 	"github.com/G-Research/armada/pkg/api"
 )
 
+// cancelJobsByLabelBatchSize is the number of jobs loaded and cancelled at once by CancelJobsByLabel
+const cancelJobsByLabelBatchSize = 1000
+
 type SubmitServer struct {
-	permissions     authorization.PermissionChecker
-	jobRepository   repository.JobRepository
-	queueRepository repository.QueueRepository
-	eventRepository repository.EventRepository
+	permissions           authorization.PermissionChecker
+	schedulingConfigLock  sync.RWMutex
+	schedulingConfig      *configuration.SchedulingConfig
+	webhookConfig         *configuration.WebhookConfig
+	jobRepository         repository.JobRepository
+	queueRepository       repository.QueueRepository
+	jobTemplateRepository repository.JobTemplateRepository
+	eventRepository       repository.EventRepository
+	usageRepository       repository.UsageRepository
+	jobNotifier           *scheduling.JobNotifier
+	auditSink             audit.Sink
+	validator             *validation.SubmissionValidator
 }
 
 func NewSubmitServer(
 	permissions authorization.PermissionChecker,
+	schedulingConfig *configuration.SchedulingConfig,
+	webhookConfig *configuration.WebhookConfig,
 	jobRepository repository.JobRepository,
 	queueRepository repository.QueueRepository,
-	eventRepository repository.EventRepository) *SubmitServer {
+	jobTemplateRepository repository.JobTemplateRepository,
+	eventRepository repository.EventRepository,
+	usageRepository repository.UsageRepository,
+	jobNotifier *scheduling.JobNotifier,
+	auditSink audit.Sink,
+	validator *validation.SubmissionValidator) *SubmitServer {
 
 	return &SubmitServer{
-		permissions:     permissions,
-		jobRepository:   jobRepository,
-		queueRepository: queueRepository,
-		eventRepository: eventRepository}
+		permissions:           permissions,
+		schedulingConfig:      schedulingConfig,
+		webhookConfig:         webhookConfig,
+		jobRepository:         jobRepository,
+		queueRepository:       queueRepository,
+		jobTemplateRepository: jobTemplateRepository,
+		eventRepository:       eventRepository,
+		usageRepository:       usageRepository,
+		jobNotifier:           jobNotifier,
+		auditSink:             auditSink,
+		validator:             validator}
+}
+
+// UpdateSchedulingConfig applies hot reloadable fields of the updated config, they are used by submissions
+// from now on. Returns whether any of them changed.
+func (server *SubmitServer) UpdateSchedulingConfig(updated configuration.SchedulingConfig) bool {
+	server.schedulingConfigLock.Lock()
+	defer server.schedulingConfigLock.Unlock()
+	config := configuration.WithHotReloadableFields(*server.schedulingConfig, updated)
+	if reflect.DeepEqual(config, *server.schedulingConfig) {
+		return false
+	}
+	server.schedulingConfig = &config
+	return true
+}
+
+func (server *SubmitServer) getSchedulingConfig() *configuration.SchedulingConfig {
+	server.schedulingConfigLock.RLock()
+	defer server.schedulingConfigLock.RUnlock()
+	return server.schedulingConfig
 }
 
 func (server *SubmitServer) GetQueueInfo(ctx context.Context, req *api.QueueInfoRequest) (*api.QueueInfo, error) {
@@ -48,6 +107,20 @@ func (server *SubmitServer) GetQueueInfo(ctx context.Context, req *api.QueueInfo
 	}, nil
 }
 
+func (server *SubmitServer) SearchJobs(ctx context.Context, req *api.JobSearchRequest) (*api.JobSearchResult, error) {
+	if e := server.checkQueuePermission(ctx, req.Queue, permissions.SubmitJobs, permissions.WatchAllEvents); e != nil {
+		return nil, e
+	}
+	if len(req.Labels) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Specify at least one label to search by")
+	}
+	ids, e := server.jobRepository.GetQueuedJobIdsByLabels(req.Queue, req.Labels)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	return &api.JobSearchResult{JobIds: ids}, nil
+}
+
 func (server *SubmitServer) CreateQueue(ctx context.Context, queue *api.Queue) (*types.Empty, error) {
 	if e := checkPermission(server.permissions, ctx, permissions.CreateQueue); e != nil {
 		return nil, e
@@ -58,52 +131,449 @@ func (server *SubmitServer) CreateQueue(ctx context.Context, queue *api.Queue) (
 		queue.UserOwners = []string{principal.GetName()}
 	}
 
-	if queue.PriorityFactor < 1.0 {
-		return nil, status.Errorf(codes.InvalidArgument, "Minimum queue priority factor is 1.")
+	if e := validateQueue(queue); e != nil {
+		return nil, e
 	}
 
 	e := server.queueRepository.CreateQueue(queue)
+	if e == repository.ErrQueueAlreadyExists {
+		return nil, api.ErrorWithCode(codes.AlreadyExists, api.ErrorCode_QueueAlreadyExists, "Queue %s already exists", queue.Name)
+	}
+	if e != nil {
+		return nil, status.Errorf(codes.Aborted, e.Error())
+	}
+	server.auditSink.Record(audit.NewRecord(ctx, audit.CreateQueue, queue.Name, "", nil))
+	return &types.Empty{}, nil
+}
+
+func validateQueue(queue *api.Queue) error {
+	// written as negation so NaN is rejected as well
+	if !(queue.PriorityFactor >= 1.0) || math.IsInf(queue.PriorityFactor, 1) {
+		return status.Errorf(codes.InvalidArgument, "Minimum queue priority factor is 1.")
+	}
+	for resource, factor := range queue.ResourcePriorityFactors {
+		if !(factor >= 1.0) || math.IsInf(factor, 1) {
+			return status.Errorf(codes.InvalidArgument, "Minimum queue priority factor is 1, %s priority factor is %v.", resource, factor)
+		}
+	}
+
+	if queue.Namespace != "" {
+		if e := commonValidation.ValidateNamespace(queue.Namespace); e != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid queue namespace: %s", e.Error())
+		}
+	}
+
+	if _, ok := api.JobOrderingStrategy_name[int32(queue.JobOrdering)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "Unknown job ordering strategy %v.", queue.JobOrdering)
+	}
+
+	if e := validateQueueJobPriorities(queue); e != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid queue job priorities: %s", e.Error())
+	}
+
+	if e := scheduling.ValidateSchedulingWindows(queue.SchedulingWindows); e != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid queue scheduling windows: %s", e.Error())
+	}
+	return nil
+}
+
+// ExportQueues returns definitions of all queues ordered by name, for backup and restore by ImportQueues.
+func (server *SubmitServer) ExportQueues(ctx context.Context, request *api.QueueExportRequest) (*api.QueueExportResponse, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.CreateQueue); e != nil {
+		return nil, e
+	}
+	queues, e := server.queueRepository.GetAllQueues()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	sort.Slice(queues, func(i, j int) bool {
+		return queues[i].Name < queues[j].Name
+	})
+	return &api.QueueExportResponse{Queues: queues}, nil
+}
+
+// GetMyPermissions returns permissions granted to the calling user and queues it owns, so clients can tell
+// which operations the user may do without trying them. Any authenticated user may ask for its own permissions.
+func (server *SubmitServer) GetMyPermissions(ctx context.Context, request *api.PermissionsRequest) (*api.PermissionsResponse, error) {
+	granted := []string{}
+	for _, permission := range permissions.All {
+		if server.permissions.UserHasPermission(ctx, permission) {
+			granted = append(granted, string(permission))
+		}
+	}
+
+	queues, e := server.queueRepository.GetAllQueues()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	owned := []string{}
+	for _, queue := range queues {
+		if server.permissions.UserOwns(ctx, queue) {
+			owned = append(owned, queue.Name)
+		}
+	}
+	sort.Strings(owned)
+	return &api.PermissionsResponse{Permissions: granted, OwnedQueues: owned}, nil
+}
+
+// ImportQueues creates queues exported by ExportQueues exactly as they were exported. Existing queues of the same
+// name are skipped, or replaced when the request overwrites them. Nothing is imported when any of the queues is invalid.
+func (server *SubmitServer) ImportQueues(ctx context.Context, request *api.QueueImportRequest) (*api.QueueImportResponse, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.CreateQueue); e != nil {
+		return nil, e
+	}
+	names := map[string]bool{}
+	for _, queue := range request.Queues {
+		if queue.Name == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "Queue name is not specified")
+		}
+		if names[queue.Name] {
+			return nil, status.Errorf(codes.InvalidArgument, "Queue %s is specified more than once", queue.Name)
+		}
+		names[queue.Name] = true
+		if e := validateQueue(queue); e != nil {
+			return nil, status.Errorf(status.Code(e), "Invalid queue %s: %s", queue.Name, status.Convert(e).Message())
+		}
+	}
+
+	response := &api.QueueImportResponse{ImportedNames: []string{}, SkippedNames: []string{}}
+	for _, queue := range request.Queues {
+		var e error
+		if request.Overwrite {
+			e = server.queueRepository.UpdateQueue(queue)
+		} else {
+			e = server.queueRepository.CreateQueue(queue)
+		}
+		if e == repository.ErrQueueAlreadyExists {
+			response.SkippedNames = append(response.SkippedNames, queue.Name)
+			continue
+		}
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, "Failed to import queue %s, queues %v were imported: %s", queue.Name, response.ImportedNames, e.Error())
+		}
+		response.ImportedNames = append(response.ImportedNames, queue.Name)
+		server.auditSink.Record(audit.NewRecord(ctx, audit.ImportQueue, queue.Name, "", nil))
+	}
+	return response, nil
+}
+
+// DeleteQueue deletes the queue, queues with queued or leased jobs are not deleted unless the request forces
+// cancelling the jobs first. Deleting a queue which does not exist succeeds, so failed requests can be retried.
+func (server *SubmitServer) DeleteQueue(ctx context.Context, request *api.QueueDeleteRequest) (*api.QueueDeleteResponse, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.DeleteQueue); e != nil {
+		return nil, e
+	}
+	if _, e := server.queueRepository.GetQueue(request.Name); e == repository.ErrQueueNotFound {
+		return &api.QueueDeleteResponse{CancelledIds: []string{}}, nil
+	} else if e != nil {
+		return nil, queueLoadError(e)
+	}
+
+	ids, e := server.jobRepository.GetQueueActiveJobIds(request.Name)
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	cancelledIds := []string{}
+	if len(ids) > 0 && request.Force {
+		jobs, e := server.jobRepository.GetExistingJobsByIds(ids)
+		if e != nil {
+			return nil, status.Errorf(codes.Internal, e.Error())
+		}
+		result, e := server.cancelJobs(ctx, request.Name, "", jobs, false)
+		if e != nil {
+			return nil, e
+		}
+		cancelledIds = result.CancelledIds
+		// jobs which failed to cancel or were submitted meanwhile keep the queue
+		ids, e = server.jobRepository.GetQueueActiveJobIds(request.Name)
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, e.Error())
+		}
+	}
+	if len(ids) > 0 {
+		return nil, api.ErrorWithCode(codes.FailedPrecondition, api.ErrorCode_QueueNotEmpty,
+			"Queue %s has %d queued or leased jobs, cancel them or delete the queue with force", request.Name, len(ids))
+	}
+
+	if e := server.queueRepository.DeleteQueue(request.Name); e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	server.auditSink.Record(audit.NewRecord(ctx, audit.DeleteQueue, request.Name, "", cancelledIds))
+	return &api.QueueDeleteResponse{CancelledIds: cancelledIds}, nil
+}
+
+func (server *SubmitServer) CreateJobTemplate(ctx context.Context, template *api.JobTemplate) (*types.Empty, error) {
+	if e := server.checkQueuePermission(ctx, template.Queue, permissions.SubmitJobs, permissions.SubmitAnyJobs); e != nil {
+		return nil, e
+	}
+	if template.Name == "" {
+		return nil, api.ErrorWithCode(codes.InvalidArgument, api.ErrorCode_InvalidPodSpec, "Job template name is not specified")
+	}
+	if template.PodSpec == nil {
+		return nil, api.ErrorWithCode(codes.InvalidArgument, api.ErrorCode_InvalidPodSpec, "Job template pod spec is not specified")
+	}
+
+	e := server.jobTemplateRepository.CreateJobTemplate(template)
 	if e != nil {
 		return nil, status.Errorf(codes.Aborted, e.Error())
 	}
+	server.auditSink.Record(audit.NewRecord(ctx, audit.CreateJobTemplate, template.Queue, "", []string{template.Name}))
 	return &types.Empty{}, nil
 }
 
 func (server *SubmitServer) SubmitJobs(ctx context.Context, req *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
-	if e := server.checkQueuePermission(ctx, req.Queue, permissions.SubmitJobs, permissions.SubmitAnyJobs); e != nil {
+	if e := server.checkJobSubmission(ctx, req); e != nil {
 		return nil, e
 	}
 
+	queue, e := server.queueRepository.GetQueue(req.Queue)
+	if e != nil {
+		return nil, queueLoadError(e)
+	}
+
 	principal := authorization.GetPrincipal(ctx)
+	templates := map[string]*api.JobTemplate{}
 
-	jobs, e := server.jobRepository.CreateJobs(req, principal)
+	jobs := make([]*api.Job, 0, len(req.JobRequestItems))
+	itemErrors := make([]error, len(req.JobRequestItems))
+	for i, item := range req.JobRequestItems {
+		job, e := server.createJob(ctx, queue, templates, req, item, principal)
+		if e != nil {
+			e = fmt.Errorf("error validating job with index %v: %v", i, e)
+			if req.Strict {
+				return nil, api.ErrorWithCode(codes.InvalidArgument, api.ErrorCode_InvalidPodSpec, "%s", e)
+			}
+			itemErrors[i] = e
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	jobs, e = server.rejectJobsOverQueueLimit(queue, jobs, itemErrors, req.Strict)
 	if e != nil {
-		return nil, status.Errorf(codes.InvalidArgument, e.Error())
+		return nil, e
+	}
+	// nothing to submit, the request fails as in strict mode
+	if len(jobs) == 0 && len(req.JobRequestItems) > 0 {
+		if _, isStatus := status.FromError(itemErrors[0]); isStatus {
+			return nil, itemErrors[0]
+		}
+		return nil, api.ErrorWithCode(codes.InvalidArgument, api.ErrorCode_InvalidPodSpec, "%s", itemErrors[0])
+	}
+
+	duplicates, e := server.jobRepository.ReserveClientIds(jobs, server.getSchedulingConfig().JobDeduplicationTtl)
+	if e != nil {
+		return nil, status.Errorf(codes.Aborted, e.Error())
+	}
+	newJobs := make([]*api.Job, 0, len(jobs))
+	for _, job := range jobs {
+		if _, duplicate := duplicates[job.Id]; !duplicate {
+			newJobs = append(newJobs, job)
+		}
 	}
 
-	e = reportSubmitted(server.eventRepository, jobs)
+	// the callback URL is stored before the first event of the job set, so it is notified of all transitions
+	if req.CallbackUrl != "" {
+		if e := server.setJobSetCallbackUrl(req); e != nil {
+			server.releaseClientIds(ctx, newJobs)
+			return nil, e
+		}
+	}
+
+	e = reportSubmitted(server.eventRepository, newJobs)
 	if e != nil {
+		server.releaseClientIds(ctx, newJobs)
 		return nil, status.Errorf(codes.Aborted, e.Error())
 	}
 
-	submissionResults, e := server.jobRepository.AddJobs(jobs)
+	submissionResults, e := server.jobRepository.AddJobs(newJobs)
 	if e != nil {
+		server.releaseClientIds(ctx, newJobs)
 		return nil, status.Errorf(codes.Aborted, e.Error())
 	}
 
 	result := &api.JobSubmitResponse{
-		JobResponseItems: make([]*api.JobSubmitResponseItem, 0, len(submissionResults)),
+		JobResponseItems: make([]*api.JobSubmitResponseItem, 0, len(req.JobRequestItems)),
 	}
 
-	for _, submissionResult := range submissionResults {
+	submittedIds := make([]string, 0, len(submissionResults))
+	notStoredJobs := []*api.Job{}
+	for _, itemError := range itemErrors {
+		if itemError != nil {
+			result.JobResponseItems = append(result.JobResponseItems, &api.JobSubmitResponseItem{Error: itemError.Error()})
+			continue
+		}
+		job := jobs[0]
+		jobs = jobs[1:]
+		if originalId, duplicate := duplicates[job.Id]; duplicate {
+			result.JobResponseItems = append(result.JobResponseItems, &api.JobSubmitResponseItem{JobId: originalId})
+			continue
+		}
+		submissionResult := submissionResults[0]
+		submissionResults = submissionResults[1:]
+
 		jobResponse := &api.JobSubmitResponseItem{JobId: submissionResult.Job.Id}
 		if submissionResult.Error != nil {
 			jobResponse.Error = submissionResult.Error.Error()
+			notStoredJobs = append(notStoredJobs, submissionResult.Job)
+		} else {
+			submittedIds = append(submittedIds, submissionResult.Job.Id)
 		}
 		result.JobResponseItems = append(result.JobResponseItems, jobResponse)
 	}
+	server.releaseClientIds(ctx, notStoredJobs)
+	server.auditSink.Record(audit.NewRecord(ctx, audit.SubmitJobs, req.Queue, req.JobSetId, submittedIds))
+	logSubmittedJobs(ctx, req, submittedIds)
+	if len(submittedIds) > 0 {
+		server.jobNotifier.Notify()
+	}
+
+	e = reportQueued(server.eventRepository, newJobs)
+	if e != nil {
+		return result, status.Errorf(codes.Aborted, e.Error())
+	}
+
+	return result, nil
+}
+
+// releaseClientIds frees ClientIds reserved by jobs which were not stored, so a retry of the submission
+// is not answered with ids of jobs which don't exist.
+func (server *SubmitServer) releaseClientIds(ctx context.Context, jobs []*api.Job) {
+	if e := server.jobRepository.ReleaseClientIds(jobs); e != nil {
+		logging.FromContext(ctx).Errorf("Failed to release client ids of jobs which were not submitted: %v", e)
+	}
+}
+
+// setJobSetCallbackUrl stores the callback URL of the job set when it is created, a job set which already exists
+// keeps its URL and the request fails when it asks for a different one.
+func (server *SubmitServer) setJobSetCallbackUrl(req *api.JobSubmitRequest) error {
+	callbackUrl, e := server.eventRepository.SetJobSetCallbackUrl(req.Queue, req.JobSetId, req.CallbackUrl)
+	if e != nil {
+		return status.Errorf(codes.Aborted, e.Error())
+	}
+	if callbackUrl != req.CallbackUrl {
+		return status.Errorf(codes.InvalidArgument, "Callback URL of job set %s can be set only when the job set is created", req.JobSetId)
+	}
+	return nil
+}
+
+// checkJobSubmission checks the principal can submit the jobs of the request and the callback URL is valid.
+func (server *SubmitServer) checkJobSubmission(ctx context.Context, req *api.JobSubmitRequest) error {
+	if e := server.checkQueuePermission(ctx, req.Queue, permissions.SubmitJobs, permissions.SubmitAnyJobs); e != nil {
+		return e
+	}
+	// jobs of the set are cancelled on behalf of the submitter later
+	if req.CancelOnFailure {
+		if e := server.checkQueuePermission(ctx, req.Queue, permissions.CancelJobs, permissions.CancelAnyJobs); e != nil {
+			return e
+		}
+	}
+
+	if req.CallbackUrl != "" {
+		if e := webhook.ValidateCallbackUrl(server.webhookConfig, req.CallbackUrl); e != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid callback URL: %s", e.Error())
+		}
+	}
+	return nil
+}
+
+// SubmitJobSets submits jobs of all the job sets or none of them. All jobs are validated first and any invalid job
+// rejects the whole request as in strict mode, valid jobs are then added in a single Redis transaction
+// (see AddJobsAtomically for failures it doesn't roll back).
+func (server *SubmitServer) SubmitJobSets(ctx context.Context, request *api.JobSetsSubmitRequest) (*api.JobSetsSubmitResponse, error) {
+	principal := authorization.GetPrincipal(ctx)
+
+	queues := map[string]*api.Queue{}
+	jobsByQueue := map[string][]*api.Job{}
+	jobsBySet := make([][]*api.Job, 0, len(request.JobSets))
+	allJobs := []*api.Job{}
+	for i, req := range request.JobSets {
+		if e := server.checkJobSubmission(ctx, req); e != nil {
+			return nil, e
+		}
+		queue, loaded := queues[req.Queue]
+		if !loaded {
+			var e error
+			queue, e = server.queueRepository.GetQueue(req.Queue)
+			if e != nil {
+				return nil, queueLoadError(e)
+			}
+			queues[req.Queue] = queue
+		}
+
+		templates := map[string]*api.JobTemplate{}
+		jobs := make([]*api.Job, 0, len(req.JobRequestItems))
+		for j, item := range req.JobRequestItems {
+			job, e := server.createJob(ctx, queue, templates, req, item, principal)
+			if e != nil {
+				return nil, api.ErrorWithCode(codes.InvalidArgument, api.ErrorCode_InvalidPodSpec,
+					"error validating job with index %v of job set with index %v: %v", j, i, e)
+			}
+			jobs = append(jobs, job)
+		}
+		jobsBySet = append(jobsBySet, jobs)
+		jobsByQueue[req.Queue] = append(jobsByQueue[req.Queue], jobs...)
+		allJobs = append(allJobs, jobs...)
+	}
+	// job sets of the same queue count against its limit together
+	for name, jobs := range jobsByQueue {
+		if _, e := server.rejectJobsOverQueueLimit(queues[name], jobs, nil, true); e != nil {
+			return nil, e
+		}
+	}
+
+	duplicates, e := server.jobRepository.ReserveClientIds(allJobs, server.getSchedulingConfig().JobDeduplicationTtl)
+	if e != nil {
+		return nil, status.Errorf(codes.Aborted, e.Error())
+	}
+	newJobs := make([]*api.Job, 0, len(allJobs))
+	for _, job := range allJobs {
+		if _, duplicate := duplicates[job.Id]; !duplicate {
+			newJobs = append(newJobs, job)
+		}
+	}
+
+	for _, req := range request.JobSets {
+		if req.CallbackUrl != "" {
+			if e := server.setJobSetCallbackUrl(req); e != nil {
+				server.releaseClientIds(ctx, newJobs)
+				return nil, e
+			}
+		}
+	}
+
+	// submitted events are reported only once the jobs are stored, as none of the jobs exist when the transaction fails
+	e = server.jobRepository.AddJobsAtomically(newJobs)
+	if e != nil {
+		server.releaseClientIds(ctx, newJobs)
+		return nil, status.Errorf(codes.Aborted, e.Error())
+	}
+
+	e = reportSubmitted(server.eventRepository, newJobs)
+	if e != nil {
+		return nil, status.Errorf(codes.Aborted, e.Error())
+	}
 
-	e = reportQueued(server.eventRepository, jobs)
+	result := &api.JobSetsSubmitResponse{JobSets: make([]*api.JobSubmitResponse, 0, len(request.JobSets))}
+	for i, req := range request.JobSets {
+		jobSetResult := &api.JobSubmitResponse{JobResponseItems: make([]*api.JobSubmitResponseItem, 0, len(jobsBySet[i]))}
+		submittedIds := make([]string, 0, len(jobsBySet[i]))
+		for _, job := range jobsBySet[i] {
+			if originalId, duplicate := duplicates[job.Id]; duplicate {
+				jobSetResult.JobResponseItems = append(jobSetResult.JobResponseItems, &api.JobSubmitResponseItem{JobId: originalId})
+				continue
+			}
+			jobSetResult.JobResponseItems = append(jobSetResult.JobResponseItems, &api.JobSubmitResponseItem{JobId: job.Id})
+			submittedIds = append(submittedIds, job.Id)
+		}
+		server.auditSink.Record(audit.NewRecord(ctx, audit.SubmitJobs, req.Queue, req.JobSetId, submittedIds))
+		logSubmittedJobs(ctx, req, submittedIds)
+		result.JobSets = append(result.JobSets, jobSetResult)
+	}
+	if len(newJobs) > 0 {
+		server.jobNotifier.Notify()
+	}
+
+	e = reportQueued(server.eventRepository, newJobs)
 	if e != nil {
 		return result, status.Errorf(codes.Aborted, e.Error())
 	}
@@ -111,13 +581,54 @@ func (server *SubmitServer) SubmitJobs(ctx context.Context, req *api.JobSubmitRe
 	return result, nil
 }
 
+// rejectJobsOverQueueLimit returns the jobs which fit into the queue limited by MaxQueuedJobs, items of the rejected jobs
+// get ResourceExhausted errors. In strict mode the whole request is rejected instead.
+// The queue size is read from the cardinality of the queue sorted set, so the limit can be exceeded slightly by concurrent submissions.
+func (server *SubmitServer) rejectJobsOverQueueLimit(queue *api.Queue, jobs []*api.Job, itemErrors []error, strict bool) ([]*api.Job, error) {
+	if queue.MaxQueuedJobs == 0 || len(jobs) == 0 {
+		return jobs, nil
+	}
+	sizes, e := server.jobRepository.GetQueueSizes([]*api.Queue{queue})
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	free := int(queue.MaxQueuedJobs) - int(sizes[0])
+	if free < 0 {
+		free = 0
+	}
+	if len(jobs) <= free {
+		return jobs, nil
+	}
+
+	queueFull := api.ErrorWithCode(codes.ResourceExhausted, api.ErrorCode_QuotaExceeded,
+		"queue %s has reached its limit of %d queued jobs, %d of %d jobs can be submitted", queue.Name, queue.MaxQueuedJobs, free, len(jobs))
+	if strict {
+		return nil, queueFull
+	}
+	accepted := 0
+	for i, itemError := range itemErrors {
+		if itemError != nil {
+			continue
+		}
+		if accepted < free {
+			accepted++
+		} else {
+			itemErrors[i] = queueFull
+		}
+	}
+	return jobs[:free], nil
+}
+
 func (server *SubmitServer) CancelJobs(ctx context.Context, request *api.JobCancelRequest) (*api.CancellationResult, error) {
 	if request.JobId != "" {
 		jobs, e := server.jobRepository.GetExistingJobsByIds([]string{request.JobId})
 		if e != nil {
 			return nil, status.Errorf(codes.Internal, e.Error())
 		}
-		return server.cancelJobs(ctx, jobs[0].Queue, jobs)
+		if len(jobs) == 0 {
+			return nil, api.ErrorWithCode(codes.NotFound, api.ErrorCode_JobNotFound, "Job %s not found", request.JobId)
+		}
+		return server.cancelJobs(ctx, jobs[0].Queue, jobs[0].JobSetId, jobs, request.OnlyIfUnstarted)
 	}
 
 	if request.JobSetId != "" && request.Queue != "" {
@@ -129,34 +640,77 @@ func (server *SubmitServer) CancelJobs(ctx context.Context, request *api.JobCanc
 		if e != nil {
 			return nil, status.Errorf(codes.Internal, e.Error())
 		}
-		return server.cancelJobs(ctx, request.Queue, jobs)
+		return server.cancelJobs(ctx, request.Queue, request.JobSetId, jobs, request.OnlyIfUnstarted)
 	}
-	return nil, status.Errorf(codes.InvalidArgument, "Specify job id or queue with job set id")
+
+	if request.JobSetId != "" {
+		return server.cancelJobSetInAllQueues(ctx, request.JobSetId, request.OnlyIfUnstarted)
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "Specify job id or job set id")
 }
 
-func (server *SubmitServer) cancelJobs(ctx context.Context, queue string, jobs []*api.Job) (*api.CancellationResult, error) {
+// cancelJobSetInAllQueues cancels jobs of the job set wherever they are queued, which requires permission
+// to cancel jobs of any queue, as the user can't be checked to own queues not known upfront.
+func (server *SubmitServer) cancelJobSetInAllQueues(ctx context.Context, jobSetId string, onlyIfUnstarted bool) (*api.CancellationResult, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.CancelAnyJobs); e != nil {
+		return nil, e
+	}
+	idsByQueue, e := server.jobRepository.GetJobSetActiveJobIds(jobSetId)
+	if e != nil {
+		return nil, status.Errorf(codes.Aborted, e.Error())
+	}
+	cancelledIds := []string{}
+	for queue, ids := range idsByQueue {
+		jobs, e := server.jobRepository.GetExistingJobsByIds(ids)
+		if e != nil {
+			return nil, status.Errorf(codes.Internal, e.Error())
+		}
+		result, e := server.cancelJobs(ctx, queue, jobSetId, jobs, onlyIfUnstarted)
+		if e != nil {
+			return nil, e
+		}
+		cancelledIds = append(cancelledIds, result.CancelledIds...)
+	}
+	return &api.CancellationResult{CancelledIds: cancelledIds}, nil
+}
+
+func (server *SubmitServer) cancelJobs(ctx context.Context, queue string, jobSetId string, jobs []*api.Job, onlyIfUnstarted bool) (*api.CancellationResult, error) {
 	if e := server.checkQueuePermission(ctx, queue, permissions.CancelJobs, permissions.CancelAnyJobs); e != nil {
 		return nil, e
 	}
 
-	e := reportJobsCancelling(server.eventRepository, jobs)
-	if e != nil {
-		return nil, status.Errorf(codes.Unknown, e.Error())
+	var deletionResult map[*api.Job]error
+	if onlyIfUnstarted {
+		deletionResult = server.jobRepository.DeleteQueuedJobs(jobs)
+	} else {
+		e := reportJobsCancelling(server.eventRepository, jobs)
+		if e != nil {
+			return nil, status.Errorf(codes.Unknown, e.Error())
+		}
+		deletionResult = server.jobRepository.DeleteJobs(jobs)
 	}
 
-	deletionResult := server.jobRepository.DeleteJobs(jobs)
 	cancelled := []*api.Job{}
 	cancelledIds := []string{}
 	for job, err := range deletionResult {
 		if err != nil {
-			log.Errorf("Error when cancelling job id %s: %s", job.Id, err.Error())
+			logging.FromContext(ctx).Errorf("Error when cancelling job id %s: %s", job.Id, err.Error())
 		} else {
 			cancelled = append(cancelled, job)
 			cancelledIds = append(cancelledIds, job.Id)
 		}
 	}
 
-	e = reportJobsCancelled(server.eventRepository, cancelled)
+	server.auditSink.Record(audit.NewRecord(ctx, audit.CancelJobs, queue, jobSetId, cancelledIds))
+
+	if onlyIfUnstarted {
+		e := reportJobsCancelling(server.eventRepository, cancelled)
+		if e != nil {
+			return nil, status.Errorf(codes.Unknown, e.Error())
+		}
+	}
+
+	e := reportJobsCancelled(server.eventRepository, cancelled)
 	if e != nil {
 		return nil, status.Errorf(codes.Unknown, e.Error())
 	}
@@ -164,6 +718,340 @@ func (server *SubmitServer) cancelJobs(ctx context.Context, queue string, jobs [
 	return &api.CancellationResult{cancelledIds}, nil
 }
 
+// CancelJobsByLabel cancels queued and leased jobs which have all the specified labels. Without queue, jobs are cancelled
+// in all queues the user is allowed to cancel jobs in. Jobs are loaded and cancelled in batches to keep requests to Redis
+// bounded for large numbers of matching jobs.
+func (server *SubmitServer) CancelJobsByLabel(ctx context.Context, request *api.JobCancelByLabelRequest) (*api.CancellationResult, error) {
+	if len(request.Labels) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Specify at least one label to cancel jobs by")
+	}
+
+	queues := []string{request.Queue}
+	if request.Queue != "" {
+		if e := server.checkQueuePermission(ctx, request.Queue, permissions.CancelJobs, permissions.CancelAnyJobs); e != nil {
+			return nil, e
+		}
+	} else {
+		allQueues, e := server.queueRepository.GetAllQueues()
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, e.Error())
+		}
+		queues = []string{}
+		for _, queue := range allQueues {
+			if server.checkQueuePermission(ctx, queue.Name, permissions.CancelJobs, permissions.CancelAnyJobs) == nil {
+				queues = append(queues, queue.Name)
+			}
+		}
+	}
+
+	cancelledIds := []string{}
+	for _, queue := range queues {
+		ids, e := server.jobRepository.GetActiveJobIdsByLabels(queue, request.Labels)
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, e.Error())
+		}
+		for start := 0; start < len(ids); start += cancelJobsByLabelBatchSize {
+			end := start + cancelJobsByLabelBatchSize
+			if end > len(ids) {
+				end = len(ids)
+			}
+			jobs, e := server.jobRepository.GetExistingJobsByIds(ids[start:end])
+			if e != nil {
+				return nil, status.Errorf(codes.Internal, e.Error())
+			}
+			result, e := server.cancelJobs(ctx, queue, "", jobs, false)
+			if e != nil {
+				return nil, e
+			}
+			cancelledIds = append(cancelledIds, result.CancelledIds...)
+		}
+	}
+	return &api.CancellationResult{CancelledIds: cancelledIds}, nil
+}
+
+// MigrateJobs moves queued jobs of the source queue to the target queue, leased jobs finish in the source queue.
+// Events reported before the migration stay in the job set stream of the source queue.
+func (server *SubmitServer) MigrateJobs(ctx context.Context, request *api.JobMigrateRequest) (*api.JobMigrateResponse, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.MigrateJobs); e != nil {
+		return nil, e
+	}
+	if request.SourceQueue == request.TargetQueue {
+		return nil, status.Errorf(codes.InvalidArgument, "Source and target queue have to be different")
+	}
+	if _, e := server.queueRepository.GetQueue(request.SourceQueue); e != nil {
+		return nil, queueLoadError(e)
+	}
+	if _, e := server.queueRepository.GetQueue(request.TargetQueue); e != nil {
+		return nil, queueLoadError(e)
+	}
+
+	migrated, e := server.jobRepository.MigrateQueuedJobs(request.SourceQueue, request.TargetQueue)
+	if e != nil {
+		return nil, status.Errorf(codes.Aborted, e.Error())
+	}
+
+	migratedIds := make([]string, 0, len(migrated))
+	for _, job := range migrated {
+		migratedIds = append(migratedIds, job.Id)
+	}
+	server.auditSink.Record(audit.NewRecord(ctx, audit.MigrateJobs, request.TargetQueue, "", migratedIds))
+	if len(migratedIds) > 0 {
+		server.jobNotifier.Notify()
+	}
+
+	e = reportQueued(server.eventRepository, migrated)
+	if e != nil {
+		return nil, status.Errorf(codes.Unknown, e.Error())
+	}
+	return &api.JobMigrateResponse{MigratedIds: migratedIds}, nil
+}
+
+// ReconcileState repairs jobs left in inconsistent state, e.g. by a crash in the middle of updating them. Jobs leased
+// to clusters which are not reporting anymore are returned to their queue. Jobs added back to queues are reported as queued.
+func (server *SubmitServer) ReconcileState(ctx context.Context, request *api.StateReconcileRequest) (*api.StateReconcileResponse, error) {
+	if e := checkPermission(server.permissions, ctx, permissions.ReconcileState); e != nil {
+		return nil, e
+	}
+	queues, e := server.queueRepository.GetAllQueues()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	queueNames := make([]string, 0, len(queues))
+	for _, queue := range queues {
+		queueNames = append(queueNames, queue.Name)
+	}
+	usageReports, e := server.usageRepository.GetClusterUsageReports()
+	if e != nil {
+		return nil, status.Errorf(codes.Unavailable, e.Error())
+	}
+	activeClusterIds := util.StringListToSet(scheduling.GetClusterReportIds(scheduling.FilterActiveClusters(usageReports)))
+
+	reconciliation, e := server.jobRepository.ReconcileState(activeClusterIds, queueNames, request.DryRun)
+	if e != nil {
+		return nil, status.Errorf(codes.Aborted, e.Error())
+	}
+	response := &api.StateReconcileResponse{
+		ReturnedJobIds:             jobIds(reconciliation.ReturnedJobs),
+		RequeuedJobIds:             jobIds(reconciliation.RequeuedJobs),
+		RemovedJobSetEntries:       reconciliation.RemovedJobSetEntries,
+		RemovedQueueEntries:        reconciliation.RemovedQueueEntries,
+		RemovedClusterAssociations: reconciliation.RemovedClusterAssociations,
+	}
+	if request.DryRun {
+		return response, nil
+	}
+
+	repairedIds := []string{}
+	for _, ids := range [][]string{response.ReturnedJobIds, response.RequeuedJobIds, response.RemovedJobSetEntries,
+		response.RemovedQueueEntries, response.RemovedClusterAssociations} {
+		repairedIds = append(repairedIds, ids...)
+	}
+	server.auditSink.Record(audit.NewRecord(ctx, audit.ReconcileState, "", "", repairedIds))
+	logging.FromContext(ctx).Infof(
+		"Reconciled state: %d jobs returned, %d jobs requeued, %d job set entries, %d queue entries and %d cluster associations removed",
+		len(response.ReturnedJobIds), len(response.RequeuedJobIds), len(response.RemovedJobSetEntries),
+		len(response.RemovedQueueEntries), len(response.RemovedClusterAssociations))
+
+	queued := []*api.Job{}
+	queued = append(queued, reconciliation.ReturnedJobs...)
+	queued = append(queued, reconciliation.RequeuedJobs...)
+	if len(queued) > 0 {
+		server.jobNotifier.Notify()
+	}
+	e = reportQueued(server.eventRepository, queued)
+	if e != nil {
+		return nil, status.Errorf(codes.Unknown, e.Error())
+	}
+	return response, nil
+}
+
+// SuspendJobs holds queued jobs in their queue until they are resumed, leased jobs are not suspended.
+// Suspended jobs keep their position and priority in the queue and can still be cancelled.
+func (server *SubmitServer) SuspendJobs(ctx context.Context, request *api.JobSuspendRequest) (*api.JobSuspendResponse, error) {
+	jobsByQueue, e := server.loadJobsToHold(ctx, request.JobIds)
+	if e != nil {
+		return nil, e
+	}
+	suspendedIds := []string{}
+	for queue, jobs := range jobsByQueue {
+		suspended, e := server.jobRepository.SuspendJobs(jobs)
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, e.Error())
+		}
+		ids := jobIds(suspended)
+		server.auditSink.Record(audit.NewRecord(ctx, audit.SuspendJobs, queue, "", ids))
+		suspendedIds = append(suspendedIds, ids...)
+	}
+	return &api.JobSuspendResponse{SuspendedIds: suspendedIds}, nil
+}
+
+// ResumeJobs lets suspended jobs to be leased again.
+func (server *SubmitServer) ResumeJobs(ctx context.Context, request *api.JobResumeRequest) (*api.JobResumeResponse, error) {
+	jobsByQueue, e := server.loadJobsToHold(ctx, request.JobIds)
+	if e != nil {
+		return nil, e
+	}
+	resumedIds := []string{}
+	for queue, jobs := range jobsByQueue {
+		resumed, e := server.jobRepository.ResumeJobs(jobs)
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, e.Error())
+		}
+		ids := jobIds(resumed)
+		server.auditSink.Record(audit.NewRecord(ctx, audit.ResumeJobs, queue, "", ids))
+		resumedIds = append(resumedIds, ids...)
+	}
+	if len(resumedIds) > 0 {
+		server.jobNotifier.Notify()
+	}
+	return &api.JobResumeResponse{ResumedIds: resumedIds}, nil
+}
+
+// UngateJobs releases jobs submitted gated, so they can be leased in their position of the queue.
+// Ungated jobs are reported as queued again.
+func (server *SubmitServer) UngateJobs(ctx context.Context, request *api.JobUngateRequest) (*api.JobUngateResponse, error) {
+	jobsByQueue, e := server.loadJobsToHold(ctx, request.JobIds)
+	if e != nil {
+		return nil, e
+	}
+	ungatedIds := []string{}
+	for queue, jobs := range jobsByQueue {
+		ungated, e := server.jobRepository.UngateJobs(jobs)
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, e.Error())
+		}
+		for _, job := range ungated {
+			job.Gated = false
+		}
+		e = server.jobRepository.UpdateJobs(ungated)
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, e.Error())
+		}
+		e = reportQueued(server.eventRepository, ungated)
+		if e != nil {
+			return nil, status.Errorf(codes.Unknown, e.Error())
+		}
+		ids := jobIds(ungated)
+		server.auditSink.Record(audit.NewRecord(ctx, audit.UngateJobs, queue, "", ids))
+		ungatedIds = append(ungatedIds, ids...)
+	}
+	if len(ungatedIds) > 0 {
+		server.jobNotifier.Notify()
+	}
+	return &api.JobUngateResponse{UngatedIds: ungatedIds}, nil
+}
+
+// ReprioritizeJobs changes priority of the jobs, or of all queued and leased jobs of the job set. Queued jobs move
+// to their new position in the queue, leased jobs keep running. When the request doesn't name the queue of the job set,
+// the job set is looked up in every queue, so it requires permission to cancel jobs of any queue to not reach into
+// queues the user can't otherwise change.
+func (server *SubmitServer) ReprioritizeJobs(ctx context.Context, request *api.JobReprioritizeRequest) (*api.JobReprioritizeResponse, error) {
+	var jobsByQueue map[string][]*api.Job
+	var e error
+	if len(request.JobIds) > 0 {
+		jobsByQueue, e = server.loadJobsToHold(ctx, request.JobIds)
+	} else if request.JobSetId != "" {
+		jobsByQueue, e = server.loadActiveJobSetJobs(ctx, request.Queue, request.JobSetId)
+	} else {
+		return nil, status.Errorf(codes.InvalidArgument, "Specify job ids or job set id")
+	}
+	if e != nil {
+		return nil, e
+	}
+
+	reprioritizedIds := []string{}
+	for queue, jobs := range jobsByQueue {
+		reprioritized, e := server.jobRepository.ReprioritizeJobs(jobs, request.NewPriority)
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, e.Error())
+		}
+		ids := jobIds(reprioritized)
+		server.auditSink.Record(audit.NewRecord(ctx, audit.ReprioritizeJobs, queue, request.JobSetId, ids))
+		e = reportJobsReprioritized(server.eventRepository, reprioritized)
+		if e != nil {
+			return nil, status.Errorf(codes.Unknown, e.Error())
+		}
+		reprioritizedIds = append(reprioritizedIds, ids...)
+	}
+	if len(reprioritizedIds) > 0 {
+		server.jobNotifier.Notify()
+	}
+	return &api.JobReprioritizeResponse{ReprioritizedIds: reprioritizedIds}, nil
+}
+
+// loadActiveJobSetJobs loads queued and leased jobs of the job set grouped by queue, in all queues when queue is empty.
+func (server *SubmitServer) loadActiveJobSetJobs(ctx context.Context, queue string, jobSetId string) (map[string][]*api.Job, error) {
+	idsByQueue := map[string][]string{}
+	if queue != "" {
+		if e := server.checkQueuePermission(ctx, queue, permissions.CancelJobs, permissions.CancelAnyJobs); e != nil {
+			return nil, e
+		}
+		ids, e := server.jobRepository.GetActiveJobIds(queue, jobSetId)
+		if e != nil {
+			return nil, status.Errorf(codes.Aborted, e.Error())
+		}
+		idsByQueue[queue] = ids
+	} else {
+		if e := checkPermission(server.permissions, ctx, permissions.CancelAnyJobs); e != nil {
+			return nil, e
+		}
+		var e error
+		idsByQueue, e = server.jobRepository.GetJobSetActiveJobIds(jobSetId)
+		if e != nil {
+			return nil, status.Errorf(codes.Aborted, e.Error())
+		}
+	}
+
+	jobsByQueue := map[string][]*api.Job{}
+	for queue, ids := range idsByQueue {
+		jobs, e := server.jobRepository.GetExistingJobsByIds(ids)
+		if e != nil {
+			return nil, status.Errorf(codes.Internal, e.Error())
+		}
+		if len(jobs) > 0 {
+			jobsByQueue[queue] = jobs
+		}
+	}
+	return jobsByQueue, nil
+}
+
+// loadJobsToHold loads jobs grouped by queue, checking the user is allowed to cancel jobs in each of the queues.
+func (server *SubmitServer) loadJobsToHold(ctx context.Context, ids []string) (map[string][]*api.Job, error) {
+	if len(ids) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Specify at least one job id")
+	}
+	jobs, e := server.jobRepository.GetExistingJobsByIds(ids)
+	if e != nil {
+		return nil, status.Errorf(codes.Internal, e.Error())
+	}
+	jobsByQueue := map[string][]*api.Job{}
+	for _, job := range jobs {
+		jobsByQueue[job.Queue] = append(jobsByQueue[job.Queue], job)
+	}
+	for queue := range jobsByQueue {
+		if e := server.checkQueuePermission(ctx, queue, permissions.CancelJobs, permissions.CancelAnyJobs); e != nil {
+			return nil, e
+		}
+	}
+	return jobsByQueue, nil
+}
+
+func logSubmittedJobs(ctx context.Context, req *api.JobSubmitRequest, submittedIds []string) {
+	logging.FromContext(ctx).WithFields(log.Fields{
+		"queue":    req.Queue,
+		"jobSetId": req.JobSetId,
+		"jobIds":   submittedIds,
+	}).Infof("Submitted %d jobs", len(submittedIds))
+}
+
+func jobIds(jobs []*api.Job) []string {
+	ids := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, job.Id)
+	}
+	return ids
+}
+
 func (server *SubmitServer) checkQueuePermission(
 	ctx context.Context,
 	queueName string,
@@ -172,7 +1060,7 @@ func (server *SubmitServer) checkQueuePermission(
 
 	queue, e := server.queueRepository.GetQueue(queueName)
 	if e != nil {
-		return status.Errorf(codes.NotFound, "Could not load queue: %s", e.Error())
+		return queueLoadError(e)
 	}
 	permissionToCheck := basicPermission
 	if !server.permissions.UserOwns(ctx, queue) {
@@ -183,3 +1071,239 @@ func (server *SubmitServer) checkQueuePermission(
 	}
 	return nil
 }
+
+// queueLoadError reports missing queue as NotFound, other failures to load the queue as Unavailable.
+func queueLoadError(e error) error {
+	if e == repository.ErrQueueNotFound {
+		return api.ErrorWithCode(codes.NotFound, api.ErrorCode_QueueNotFound, "Could not load queue: %s", e)
+	}
+	return status.Errorf(codes.Unavailable, "Could not load queue: %s", e)
+}
+
+// createJob creates the job of a submitted item and validates it, the job is not stored yet.
+// Job templates loaded for the item are cached in templates for following items of the request.
+func (server *SubmitServer) createJob(
+	ctx context.Context,
+	queue *api.Queue,
+	templates map[string]*api.JobTemplate,
+	req *api.JobSubmitRequest,
+	item *api.JobSubmitRequestItem,
+	principal authorization.Principal) (*api.Job, error) {
+
+	applyJobSetDefaults(req.Defaults, item)
+	if item.TemplateName != "" {
+		template, e := server.getJobTemplate(queue.Name, item.TemplateName, templates)
+		if e != nil {
+			return nil, e
+		}
+		if e := applyJobTemplate(template, item); e != nil {
+			return nil, e
+		}
+	}
+	if e := applyQueueNamespace(queue, item); e != nil {
+		return nil, e
+	}
+	if e := applyQueueJobPriority(queue, item); e != nil {
+		return nil, e
+	}
+	job, e := server.jobRepository.CreateJob(req, item, principal)
+	if e != nil {
+		return nil, e
+	}
+	job.CorrelationId = logging.CorrelationId(ctx)
+	config := server.getSchedulingConfig()
+	if e := validateJobSize(job, config.MaxJobSize); e != nil {
+		return nil, e
+	}
+	if e := validatePriorityClass(job, config.PriorityClasses); e != nil {
+		return nil, e
+	}
+	if e := validateMinResources(job); e != nil {
+		return nil, e
+	}
+	if e := server.validator.Validate(job); e != nil {
+		return nil, e
+	}
+	return job, nil
+}
+
+func (server *SubmitServer) getJobTemplate(queue string, name string, templates map[string]*api.JobTemplate) (*api.JobTemplate, error) {
+	if template, ok := templates[name]; ok {
+		return template, nil
+	}
+	template, e := server.jobTemplateRepository.GetJobTemplate(queue, name)
+	if e != nil {
+		return nil, fmt.Errorf("could not load job template %s: %v", name, e)
+	}
+	if template == nil {
+		return nil, fmt.Errorf("job template %s does not exist in queue %s", name, queue)
+	}
+	templates[name] = template
+	return template, nil
+}
+
+// applyJobTemplate expands the job template into the item:
+// - the item gets a copy of the template pod spec, the item itself must not specify pod spec
+// - override args replace args of the first container
+// - override env variables are set in all containers, replacing variables of the same name
+// - template labels are added to the item, labels of the item take precedence
+func applyJobTemplate(template *api.JobTemplate, item *api.JobSubmitRequestItem) error {
+	if item.PodSpec != nil {
+		return fmt.Errorf("job specifies both pod spec and job template %s", template.Name)
+	}
+	podSpec := template.PodSpec.DeepCopy()
+
+	if overrides := item.TemplateOverrides; overrides != nil {
+		if len(overrides.Args) > 0 {
+			if len(podSpec.Containers) == 0 {
+				return fmt.Errorf("job template %s has no container to override args of", template.Name)
+			}
+			podSpec.Containers[0].Args = append([]string{}, overrides.Args...)
+		}
+		for i := range podSpec.Containers {
+			podSpec.Containers[i].Env = mergeEnv(podSpec.Containers[i].Env, overrides.Env)
+		}
+	}
+
+	item.Labels = mergeWithDefaults(template.Labels, item.Labels)
+	item.PodSpec = podSpec
+	return nil
+}
+
+// applyJobSetDefaults fills values the item does not set from defaults of its submit request, values set by the item
+// take precedence. Maps are merged by key, priority 0 means the item has no priority as for the queue default priority.
+func applyJobSetDefaults(defaults *api.JobSubmitDefaults, item *api.JobSubmitRequestItem) {
+	if defaults == nil {
+		return
+	}
+	if item.Priority == 0 {
+		item.Priority = defaults.Priority
+	}
+	if item.Namespace == "" {
+		item.Namespace = defaults.Namespace
+	}
+	if item.PriorityClass == "" {
+		item.PriorityClass = defaults.PriorityClass
+	}
+	item.Labels = mergeWithDefaults(defaults.Labels, item.Labels)
+	item.Annotations = mergeWithDefaults(defaults.Annotations, item.Annotations)
+	item.RequiredNodeLabels = mergeWithDefaults(defaults.RequiredNodeLabels, item.RequiredNodeLabels)
+}
+
+// mergeWithDefaults returns values with defaults added for keys values don't have, neither of the maps is modified.
+func mergeWithDefaults(defaults map[string]string, values map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return values
+	}
+	merged := make(map[string]string, len(defaults)+len(values))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range values {
+		merged[k] = v
+	}
+	return merged
+}
+
+func mergeEnv(env []v1.EnvVar, overrides []v1.EnvVar) []v1.EnvVar {
+	if len(overrides) == 0 {
+		return env
+	}
+	merged := make([]v1.EnvVar, 0, len(env)+len(overrides))
+	overridden := make(map[string]bool, len(overrides))
+	for _, variable := range overrides {
+		overridden[variable.Name] = true
+	}
+	for _, variable := range env {
+		if !overridden[variable.Name] {
+			merged = append(merged, variable)
+		}
+	}
+	return append(merged, overrides...)
+}
+
+// applyQueueNamespace places the job into the namespace of the queue, when the queue has one.
+func applyQueueNamespace(queue *api.Queue, item *api.JobSubmitRequestItem) error {
+	if queue.Namespace == "" {
+		return nil
+	}
+	if item.Namespace == "" {
+		item.Namespace = queue.Namespace
+	} else if item.Namespace != queue.Namespace {
+		return fmt.Errorf("job specifies namespace %s, but jobs of queue %s must use namespace %s", item.Namespace, queue.Name, queue.Namespace)
+	}
+	return nil
+}
+
+func hasJobPriorityRange(queue *api.Queue) bool {
+	return queue.MinJobPriority != 0 || queue.MaxJobPriority != 0
+}
+
+func validateQueueJobPriorities(queue *api.Queue) error {
+	if !hasJobPriorityRange(queue) {
+		return nil
+	}
+	if queue.MinJobPriority > queue.MaxJobPriority {
+		return fmt.Errorf("minimal job priority %v is greater than maximal job priority %v", queue.MinJobPriority, queue.MaxJobPriority)
+	}
+	if queue.DefaultJobPriority < queue.MinJobPriority || queue.DefaultJobPriority > queue.MaxJobPriority {
+		return fmt.Errorf("default job priority %v is outside of allowed range [%v, %v]", queue.DefaultJobPriority, queue.MinJobPriority, queue.MaxJobPriority)
+	}
+	return nil
+}
+
+// applyQueueJobPriority sets default priority of the queue to jobs without priority (priority 0),
+// and clamps or rejects priorities outside of the range allowed by the queue.
+func applyQueueJobPriority(queue *api.Queue, item *api.JobSubmitRequestItem) error {
+	if item.Priority == 0 {
+		item.Priority = queue.DefaultJobPriority
+	}
+	if !hasJobPriorityRange(queue) {
+		return nil
+	}
+	if item.Priority >= queue.MinJobPriority && item.Priority <= queue.MaxJobPriority {
+		return nil
+	}
+	if !queue.ClampJobPriority {
+		return fmt.Errorf("job priority %v is outside of range [%v, %v] allowed by queue %s", item.Priority, queue.MinJobPriority, queue.MaxJobPriority, queue.Name)
+	}
+	item.Priority = math.Max(queue.MinJobPriority, math.Min(item.Priority, queue.MaxJobPriority))
+	return nil
+}
+
+// validateJobSize limits size of the job as stored in Redis, 0 means no limit.
+func validateJobSize(job *api.Job, maxJobSize int) error {
+	if maxJobSize > 0 && job.Size() > maxJobSize {
+		return fmt.Errorf("serialized job size of %d bytes exceeds the limit of %d bytes", job.Size(), maxJobSize)
+	}
+	return nil
+}
+
+func validatePriorityClass(job *api.Job, priorityClasses map[string]int) error {
+	if job.PriorityClass == "" {
+		return nil
+	}
+	if _, ok := priorityClasses[job.PriorityClass]; !ok {
+		return fmt.Errorf("priority class %s is not configured", job.PriorityClass)
+	}
+	return nil
+}
+
+// validateMinResources checks every resource with a min is requested by the pod spec of the job, at least the min.
+func validateMinResources(job *api.Job) error {
+	if len(job.MinResources) == 0 {
+		return nil
+	}
+	requested := common.TotalResourceRequest(job.PodSpec).AsFloat()
+	for name, quantity := range job.MinResources {
+		minimum := common.QuantityAsFloat64(quantity)
+		request, ok := requested[name]
+		if !ok {
+			return fmt.Errorf("min resource %s is not requested by the pod spec", name)
+		}
+		if minimum <= 0 || minimum > request {
+			return fmt.Errorf("min resource %s of %s must be positive and at most the %v requested by the pod spec", name, quantity.String(), request)
+		}
+	}
+	return nil
+}
@@ -3,17 +3,26 @@ package server
 import (
 	"context"
 	"fmt"
+	"math"
 	"sort"
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis"
 	"github.com/go-redis/redis"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/G-Research/armada/internal/armada/audit"
+	"github.com/G-Research/armada/internal/armada/authorization"
+	"github.com/G-Research/armada/internal/armada/authorization/permissions"
 	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/armada/scheduling"
+	"github.com/G-Research/armada/internal/armada/validation"
 	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/pkg/api"
 )
@@ -91,6 +100,926 @@ func TestSubmitServer_SubmitJob_ReturnsJobItemsInTheSameOrderTheyWereSubmitted(t
 	})
 }
 
+func TestSubmitServer_SubmitJob_UsesQueueNamespace(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		err := s.queueRepository.CreateQueue(&api.Queue{Name: "namespaced", Namespace: "team-a"})
+		assert.Empty(t, err)
+
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.Queue = "namespaced"
+
+		response, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Empty(t, err)
+
+		jobs, err := s.jobRepository.GetExistingJobsByIds([]string{response.JobResponseItems[0].JobId})
+		assert.Empty(t, err)
+		assert.Equal(t, "team-a", jobs[0].Namespace)
+
+		jobRequest.JobRequestItems[0].Namespace = "team-b"
+		_, err = s.SubmitJobs(context.Background(), jobRequest)
+		assert.Error(t, err)
+	})
+}
+
+func TestSubmitServer_SubmitJob_ItemsInheritJobSetDefaultsUnlessTheyOverrideThem(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobRequest := createJobRequest(util.NewULID(), 2)
+		jobRequest.Defaults = &api.JobSubmitDefaults{
+			Priority:  5,
+			Namespace: "team-a",
+			Labels:    map[string]string{"team": "a", "project": "x"},
+		}
+		overriding := jobRequest.JobRequestItems[1]
+		overriding.Priority = 1
+		overriding.Namespace = "team-b"
+		overriding.Labels = map[string]string{"project": "y"}
+
+		response, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Empty(t, err)
+
+		jobs, err := s.jobRepository.GetExistingJobsByIds([]string{response.JobResponseItems[0].JobId, response.JobResponseItems[1].JobId})
+		assert.Empty(t, err)
+		assert.Equal(t, float64(5), jobs[0].Priority)
+		assert.Equal(t, "team-a", jobs[0].Namespace)
+		assert.Equal(t, map[string]string{"team": "a", "project": "x"}, jobs[0].Labels)
+
+		assert.Equal(t, float64(1), jobs[1].Priority)
+		assert.Equal(t, "team-b", jobs[1].Namespace)
+		assert.Equal(t, map[string]string{"team": "a", "project": "y"}, jobs[1].Labels)
+	})
+}
+
+func TestSubmitServer_SubmitJob_AcceptsValidJobsWhenSomeAreInvalid(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobRequest := createJobRequest(util.NewULID(), 3)
+		jobRequest.JobRequestItems[1].PodSpec = nil
+
+		response, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Empty(t, err)
+		assert.Equal(t, 3, len(response.JobResponseItems))
+
+		assert.NotEmpty(t, response.JobResponseItems[0].JobId)
+		assert.Empty(t, response.JobResponseItems[0].Error)
+		assert.Empty(t, response.JobResponseItems[1].JobId)
+		assert.Contains(t, response.JobResponseItems[1].Error, "error validating job with index 1")
+		assert.NotEmpty(t, response.JobResponseItems[2].JobId)
+		assert.Empty(t, response.JobResponseItems[2].Error)
+
+		jobIds, err := s.jobRepository.GetActiveJobIds("test", jobRequest.JobSetId)
+		assert.Empty(t, err)
+		assert.ElementsMatch(t, []string{response.JobResponseItems[0].JobId, response.JobResponseItems[2].JobId}, jobIds)
+	})
+}
+
+func TestSubmitServer_SubmitJob_StrictRejectsAllJobsWhenSomeAreInvalid(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobRequest := createJobRequest(util.NewULID(), 3)
+		jobRequest.JobRequestItems[1].PodSpec = nil
+		jobRequest.Strict = true
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "error validating job with index 1")
+
+		jobIds, err := s.jobRepository.GetActiveJobIds("test", jobRequest.JobSetId)
+		assert.Empty(t, err)
+		assert.Empty(t, jobIds)
+	})
+}
+
+func TestSubmitServer_SubmitJob_DeduplicatesJobsWithSameClientId(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.JobRequestItems[0].ClientId = "client-id"
+
+		firstResponse, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Empty(t, err)
+		secondResponse, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Empty(t, err)
+
+		jobId := firstResponse.JobResponseItems[0].JobId
+		assert.NotEmpty(t, jobId)
+		assert.Equal(t, jobId, secondResponse.JobResponseItems[0].JobId)
+		assert.Empty(t, secondResponse.JobResponseItems[0].Error)
+
+		jobIds, err := s.jobRepository.GetActiveJobIds("test", jobRequest.JobSetId)
+		assert.Empty(t, err)
+		assert.Equal(t, []string{jobId}, jobIds)
+	})
+}
+
+func TestSubmitServer_SubmitJob_FailedAddReleasesClientIds(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.JobRequestItems[0].ClientId = "client-id"
+
+		jobRepository := s.jobRepository
+		s.jobRepository = &failingAddJobRepository{jobRepository}
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Equal(t, codes.Aborted, status.Code(err))
+
+		s.jobRepository = jobRepository
+		response, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Empty(t, err)
+
+		jobIds, err := s.jobRepository.GetActiveJobIds("test", jobRequest.JobSetId)
+		assert.Empty(t, err)
+		assert.Equal(t, []string{response.JobResponseItems[0].JobId}, jobIds)
+	})
+}
+
+func TestSubmitServer_SubmitJob_FailedEventReportReleasesClientIds(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.JobRequestItems[0].ClientId = "client-id"
+
+		eventRepository := s.eventRepository
+		s.eventRepository = &failingEventRepository{eventRepository}
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Equal(t, codes.Aborted, status.Code(err))
+
+		s.eventRepository = eventRepository
+		response, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Empty(t, err)
+
+		jobIds, err := s.jobRepository.GetActiveJobIds("test", jobRequest.JobSetId)
+		assert.Empty(t, err)
+		assert.Equal(t, []string{response.JobResponseItems[0].JobId}, jobIds)
+	})
+}
+
+func TestSubmitServer_SubmitJobSets_FailedTransactionReleasesClientIdsAndReportsNothing(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobSet := createJobRequest(util.NewULID(), 1)
+		jobSet.JobRequestItems[0].ClientId = "client-id"
+		request := &api.JobSetsSubmitRequest{JobSets: []*api.JobSubmitRequest{jobSet}}
+
+		jobRepository := s.jobRepository
+		s.jobRepository = &failingAddJobRepository{jobRepository}
+		_, err := s.SubmitJobSets(context.Background(), request)
+		assert.Equal(t, codes.Aborted, status.Code(err))
+
+		events, err := s.eventRepository.ReadEvents("test", jobSet.JobSetId, "", 100, time.Millisecond)
+		assert.Empty(t, err)
+		assert.Empty(t, events)
+
+		s.jobRepository = jobRepository
+		response, err := s.SubmitJobSets(context.Background(), request)
+		assert.Empty(t, err)
+
+		jobIds, err := s.jobRepository.GetActiveJobIds("test", jobSet.JobSetId)
+		assert.Empty(t, err)
+		assert.Equal(t, []string{response.JobSets[0].JobResponseItems[0].JobId}, jobIds)
+	})
+}
+
+// failingAddJobRepository fails to store jobs as a failed Redis transaction does
+type failingAddJobRepository struct {
+	repository.JobRepository
+}
+
+func (r *failingAddJobRepository) AddJobs(jobs []*api.Job) ([]*repository.SubmitJobResult, error) {
+	return nil, fmt.Errorf("transaction failed")
+}
+
+func (r *failingAddJobRepository) AddJobsAtomically(jobs []*api.Job) error {
+	return fmt.Errorf("transaction failed")
+}
+
+// failingEventRepository fails to report events as Redis which is not available does
+type failingEventRepository struct {
+	repository.EventRepository
+}
+
+func (r *failingEventRepository) ReportEvents(messages []*api.EventMessage) error {
+	return fmt.Errorf("redis not available")
+}
+
+func TestSubmitServer_SubmitJobSets_SubmitsAllJobSets(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		request := &api.JobSetsSubmitRequest{JobSets: []*api.JobSubmitRequest{
+			createJobRequest(util.NewULID(), 2),
+			createJobRequest(util.NewULID(), 1),
+		}}
+
+		response, err := s.SubmitJobSets(context.Background(), request)
+		assert.Empty(t, err)
+		assert.Equal(t, 2, len(response.JobSets))
+
+		for i, jobSet := range request.JobSets {
+			submittedIds := []string{}
+			for _, item := range response.JobSets[i].JobResponseItems {
+				assert.Empty(t, item.Error)
+				submittedIds = append(submittedIds, item.JobId)
+			}
+			jobIds, err := s.jobRepository.GetActiveJobIds("test", jobSet.JobSetId)
+			assert.Empty(t, err)
+			assert.ElementsMatch(t, submittedIds, jobIds)
+		}
+	})
+}
+
+func TestSubmitServer_SubmitJobSets_InvalidJobSetSubmitsNothing(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		invalid := createJobRequest(util.NewULID(), 2)
+		invalid.JobRequestItems[1].PodSpec = nil
+		request := &api.JobSetsSubmitRequest{JobSets: []*api.JobSubmitRequest{
+			createJobRequest(util.NewULID(), 2),
+			invalid,
+			createJobRequest(util.NewULID(), 1),
+		}}
+
+		_, err := s.SubmitJobSets(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "error validating job with index 1 of job set with index 1")
+
+		for _, jobSet := range request.JobSets {
+			jobIds, err := s.jobRepository.GetActiveJobIds("test", jobSet.JobSetId)
+			assert.Empty(t, err)
+			assert.Empty(t, jobIds)
+
+			events, err := s.eventRepository.ReadEvents("test", jobSet.JobSetId, "", 100, time.Millisecond)
+			assert.Empty(t, err)
+			assert.Empty(t, events)
+		}
+	})
+}
+
+func TestSubmitServer_SubmitJob_ClampsJobPriorityToQueueRange(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		queue := &api.Queue{Name: util.NewULID(), PriorityFactor: 1, MinJobPriority: 1, MaxJobPriority: 10, DefaultJobPriority: 5, ClampJobPriority: true}
+		_, err := s.CreateQueue(context.Background(), queue)
+		assert.Empty(t, err)
+
+		jobRequest := createJobRequest(util.NewULID(), 3)
+		jobRequest.Queue = queue.Name
+		jobRequest.JobRequestItems[0].Priority = 0
+		jobRequest.JobRequestItems[1].Priority = 100
+		jobRequest.JobRequestItems[2].Priority = 0.5
+
+		response, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Empty(t, err)
+
+		jobs, err := s.jobRepository.GetExistingJobsByIds([]string{
+			response.JobResponseItems[0].JobId,
+			response.JobResponseItems[1].JobId,
+			response.JobResponseItems[2].JobId,
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, 5.0, jobs[0].Priority)
+		assert.Equal(t, 10.0, jobs[1].Priority)
+		assert.Equal(t, 1.0, jobs[2].Priority)
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsJobPriorityOutsideQueueRange(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		queue := &api.Queue{Name: util.NewULID(), PriorityFactor: 1, MinJobPriority: 1, MaxJobPriority: 10, DefaultJobPriority: 5}
+		_, err := s.CreateQueue(context.Background(), queue)
+		assert.Empty(t, err)
+
+		jobRequest := createJobRequest(util.NewULID(), 2)
+		jobRequest.Queue = queue.Name
+		jobRequest.JobRequestItems[0].Priority = 3
+		jobRequest.JobRequestItems[1].Priority = 100
+
+		response, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Empty(t, err)
+		assert.NotEmpty(t, response.JobResponseItems[0].JobId)
+		assert.Empty(t, response.JobResponseItems[1].JobId)
+		assert.Contains(t, response.JobResponseItems[1].Error, "job priority 100 is outside of range [1, 10]")
+	})
+}
+
+func TestSubmitServer_GetMyPermissions_ResolvesGroupsOfCaller(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		s.permissions = authorization.NewPrincipalPermissionChecker(map[permissions.Permission][]string{
+			permissions.SubmitJobs:  {"submitters"},
+			permissions.CancelJobs:  {"submitters", "admins"},
+			permissions.CreateQueue: {"admins"},
+		}, map[permissions.Permission][]string{})
+		for _, queue := range []*api.Queue{
+			{Name: "ownedByUser", PriorityFactor: 1, UserOwners: []string{"alice"}},
+			{Name: "ownedByGroup", PriorityFactor: 1, GroupOwners: []string{"submitters"}},
+			{Name: "ownedByOthers", PriorityFactor: 1, UserOwners: []string{"bob"}, GroupOwners: []string{"admins"}},
+		} {
+			assert.Nil(t, s.queueRepository.CreateQueue(queue))
+		}
+		ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("alice", []string{"submitters"}))
+
+		response, e := s.GetMyPermissions(ctx, &api.PermissionsRequest{})
+
+		assert.Nil(t, e)
+		assert.Equal(t, []string{"submit_jobs", "cancel_jobs"}, response.Permissions)
+		assert.Equal(t, []string{"ownedByGroup", "ownedByUser"}, response.OwnedQueues)
+	})
+}
+
+func TestSubmitServer_CreateQueue_RejectsDefaultJobPriorityOutsideRange(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		queue := &api.Queue{Name: util.NewULID(), PriorityFactor: 1, MinJobPriority: 1, MaxJobPriority: 10}
+		_, err := s.CreateQueue(context.Background(), queue)
+		assert.Error(t, err)
+	})
+}
+
+func TestSubmitServer_CreateQueue_RejectsInvalidNamespace(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "invalid", PriorityFactor: 1, Namespace: "Not_Valid"})
+		assert.Error(t, err)
+	})
+}
+
+func TestSubmitServer_CreateQueue_RejectsInvalidPriorityFactor(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		for _, factor := range []float64{0, -1, 0.5, math.NaN(), math.Inf(1)} {
+			_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "invalid", PriorityFactor: factor})
+			assert.Error(t, err, "priority factor %v", factor)
+		}
+
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "valid", PriorityFactor: 1})
+		assert.Nil(t, err)
+	})
+}
+
+func TestSubmitServer_CreateQueue_RejectsUnknownJobOrdering(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "invalid", PriorityFactor: 1, JobOrdering: 42})
+		assert.Error(t, err)
+	})
+}
+
+func TestSubmitServer_CreateQueue_DuplicateQueueReturnsAlreadyExists(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "test", PriorityFactor: 2})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		assert.Equal(t, api.ErrorCode_QueueAlreadyExists, api.ErrorCodeOf(err))
+
+		queue, err := s.queueRepository.GetQueue("test")
+		assert.Nil(t, err)
+		assert.Equal(t, 0.0, queue.PriorityFactor, "existing queue is kept")
+	})
+}
+
+func TestSubmitServer_DeleteQueue_RefusesQueueWithJobs(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		_, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 2))
+		assert.Nil(t, err)
+
+		_, err = s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "test"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Equal(t, api.ErrorCode_QueueNotEmpty, api.ErrorCodeOf(err))
+		assert.Contains(t, status.Convert(err).Message(), "2 queued or leased jobs")
+
+		_, err = s.queueRepository.GetQueue("test")
+		assert.Nil(t, err, "queue is kept")
+	})
+}
+
+func TestSubmitServer_DeleteQueue_ForceCancelsJobs(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		submitted, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 2))
+		assert.Nil(t, err)
+		jobs, err := s.jobRepository.GetExistingJobsByIds([]string{submitted.JobResponseItems[0].JobId})
+		assert.Nil(t, err)
+		leased, err := s.jobRepository.TryLeaseJobs("cluster1", "test", jobs)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(leased))
+
+		response, err := s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "test", Force: true})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(response.CancelledIds))
+
+		_, err = s.queueRepository.GetQueue("test")
+		assert.Equal(t, repository.ErrQueueNotFound, err)
+		ids, err := s.jobRepository.GetQueueActiveJobIds("test")
+		assert.Nil(t, err)
+		assert.Empty(t, ids)
+	})
+}
+
+func TestSubmitServer_DeleteQueue_MissingQueueSucceeds(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		_, err := s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "missing"})
+		assert.Nil(t, err)
+
+		_, err = s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "test"})
+		assert.Nil(t, err)
+		_, err = s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "test"})
+		assert.Nil(t, err, "deleting the queue again succeeds")
+	})
+}
+
+func TestSubmitServer_ImportQueues_RestoresExportedQueuesLosslessly(t *testing.T) {
+	withMiniredisSubmitServer(func(source *SubmitServer) {
+		err := source.queueRepository.DeleteQueue("test")
+		assert.Nil(t, err)
+
+		queue := &api.Queue{
+			Name:                "queue1",
+			PriorityFactor:      2.5,
+			UserOwners:          []string{"alice"},
+			GroupOwners:         []string{"team"},
+			ResourceLimits:      map[string]float64{"cpu": 0.5},
+			Namespace:           "namespace1",
+			Group:               "group1",
+			MinJobPriority:      1,
+			MaxJobPriority:      10,
+			DefaultJobPriority:  5,
+			ClampJobPriority:    true,
+			LeaseBatchSize:      20,
+			GuaranteedResources: map[string]resource.Quantity{"cpu": resource.MustParse("4"), "memory": resource.MustParse("8Gi")},
+			JobOrdering:         api.JobOrderingStrategy_FIFO,
+			MaxQueuedJobs:       100,
+			MaxConcurrentJobs:   10,
+			Pool:                "gpu",
+		}
+		_, err = source.CreateQueue(context.Background(), queue)
+		assert.Nil(t, err)
+		_, err = source.CreateQueue(context.Background(), &api.Queue{Name: "queue2", PriorityFactor: 1, UserOwners: []string{"bob"}})
+		assert.Nil(t, err)
+
+		exported, err := source.ExportQueues(context.Background(), &api.QueueExportRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"queue1", "queue2"}, queueNames(exported.Queues))
+
+		withMiniredisSubmitServer(func(target *SubmitServer) {
+			err := target.queueRepository.DeleteQueue("test")
+			assert.Nil(t, err)
+
+			response, err := target.ImportQueues(context.Background(), &api.QueueImportRequest{Queues: exported.Queues})
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"queue1", "queue2"}, response.ImportedNames)
+			assert.Empty(t, response.SkippedNames)
+
+			restored, err := target.ExportQueues(context.Background(), &api.QueueExportRequest{})
+			assert.Nil(t, err)
+			assert.Equal(t, exported.Queues, restored.Queues)
+		})
+	})
+}
+
+func TestSubmitServer_ImportQueues_SkipsOrOverwritesExistingQueues(t *testing.T) {
+	withMiniredisSubmitServer(func(s *SubmitServer) {
+		imported := []*api.Queue{{Name: "test", PriorityFactor: 3}, {Name: "new", PriorityFactor: 2}}
+
+		response, err := s.ImportQueues(context.Background(), &api.QueueImportRequest{Queues: imported})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"new"}, response.ImportedNames)
+		assert.Equal(t, []string{"test"}, response.SkippedNames)
+		queue, err := s.queueRepository.GetQueue("test")
+		assert.Nil(t, err)
+		assert.Equal(t, 0.0, queue.PriorityFactor, "existing queue is kept")
+
+		response, err = s.ImportQueues(context.Background(), &api.QueueImportRequest{Queues: imported, Overwrite: true})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"test", "new"}, response.ImportedNames)
+		assert.Empty(t, response.SkippedNames)
+		queue, err = s.queueRepository.GetQueue("test")
+		assert.Nil(t, err)
+		assert.Equal(t, 3.0, queue.PriorityFactor, "existing queue is replaced")
+	})
+}
+
+func TestSubmitServer_ImportQueues_InvalidQueueImportsNothing(t *testing.T) {
+	withMiniredisSubmitServer(func(s *SubmitServer) {
+		imported := []*api.Queue{{Name: "valid", PriorityFactor: 1}, {Name: "invalid", PriorityFactor: 0.5}}
+
+		_, err := s.ImportQueues(context.Background(), &api.QueueImportRequest{Queues: imported})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.queueRepository.GetQueue("valid")
+		assert.Equal(t, repository.ErrQueueNotFound, err)
+	})
+}
+
+func queueNames(queues []*api.Queue) []string {
+	names := []string{}
+	for _, queue := range queues {
+		names = append(names, queue.Name)
+	}
+	return names
+}
+
+func TestSubmitServer_CancelJobs_JobSetInQueue(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobSetId := util.NewULID()
+		testJobs := submitJobSetToQueue(t, s, "test", jobSetId, 2)
+		submitJobSetToQueue(t, s, "other", jobSetId, 1)
+
+		result, err := s.CancelJobs(context.Background(), &api.JobCancelRequest{Queue: "test", JobSetId: jobSetId})
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, testJobs, result.CancelledIds)
+
+		ids, err := s.jobRepository.GetQueueActiveJobIds("other")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(ids), "job set is cancelled only in the specified queue")
+	})
+}
+
+func TestSubmitServer_CancelJobs_JobSetInAllQueues(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobSetId := util.NewULID()
+		cancelledIds := append(submitJobSetToQueue(t, s, "test", jobSetId, 2), submitJobSetToQueue(t, s, "other", jobSetId, 1)...)
+		submitJobSetToQueue(t, s, "other", util.NewULID(), 1)
+
+		result, err := s.CancelJobs(context.Background(), &api.JobCancelRequest{JobSetId: jobSetId})
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, cancelledIds, result.CancelledIds)
+
+		ids, err := s.jobRepository.GetQueueActiveJobIds("other")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(ids), "jobs of other job sets are kept")
+	})
+}
+
+func TestSubmitServer_CancelJobs_JobSetInAllQueuesRequiresCancelAnyJobs(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobSetId := util.NewULID()
+		submitJobSetToQueue(t, s, "test", jobSetId, 1)
+		s.permissions = grantedPermissionChecker{granted: []permissions.Permission{permissions.CancelJobs}}
+
+		_, err := s.CancelJobs(context.Background(), &api.JobCancelRequest{JobSetId: jobSetId})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		result, err := s.CancelJobs(context.Background(), &api.JobCancelRequest{Queue: "test", JobSetId: jobSetId})
+		assert.Nil(t, err, "owner of the queue can cancel the job set in the queue")
+		assert.Equal(t, 1, len(result.CancelledIds))
+	})
+}
+
+func TestSubmitServer_UngateJobs_ReleasesGatedJob(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobSetId := util.NewULID()
+		request := createJobRequest(jobSetId, 2)
+		request.JobRequestItems[0].Gated = true
+		submitted, err := s.SubmitJobs(context.Background(), request)
+		assert.Nil(t, err)
+		gatedId := submitted.JobResponseItems[0].JobId
+
+		jobSetStatus, err := s.eventRepository.GetJobSetStatus("test", jobSetId)
+		assert.Nil(t, err)
+		assert.Equal(t, &api.JobSetStatusResponse{Queued: 1, Gated: 1}, jobSetStatus)
+
+		queued, err := s.jobRepository.PeekQueue("test", 2)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(queued))
+		assert.Equal(t, submitted.JobResponseItems[1].JobId, queued[0].Id)
+
+		response, err := s.UngateJobs(context.Background(), &api.JobUngateRequest{JobIds: []string{gatedId}})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{gatedId}, response.UngatedIds)
+
+		jobSetStatus, err = s.eventRepository.GetJobSetStatus("test", jobSetId)
+		assert.Nil(t, err)
+		assert.Equal(t, &api.JobSetStatusResponse{Queued: 2}, jobSetStatus)
+
+		queued, err = s.jobRepository.PeekQueue("test", 2)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(queued))
+		assert.False(t, queued[0].Gated)
+
+		response, err = s.UngateJobs(context.Background(), &api.JobUngateRequest{JobIds: []string{gatedId}})
+		assert.Nil(t, err)
+		assert.Empty(t, response.UngatedIds, "job is ungated only once")
+	})
+}
+
+func TestSubmitServer_ReprioritizeJobs_JobSetLeasesAheadOfOtherJobs(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		otherIds := submitJobSetWithPriority(t, s, util.NewULID(), 2, 5)
+		jobSetId := util.NewULID()
+		lowIds := submitJobSetWithPriority(t, s, jobSetId, 3, 10)
+
+		queued, err := s.jobRepository.PeekQueue("test", 5)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, lowIds, jobIds(queued)[2:])
+		leasedJob := queued[4]
+		_, err = s.jobRepository.TryLeaseJobs("cluster1", "test", []*api.Job{leasedJob})
+		assert.Nil(t, err)
+
+		response, err := s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{Queue: "test", JobSetId: jobSetId, NewPriority: 1})
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, lowIds, response.ReprioritizedIds)
+
+		queued, err = s.jobRepository.PeekQueue("test", 4)
+		assert.Nil(t, err)
+		assert.Equal(t, 4, len(queued))
+		assert.NotContains(t, jobIds(queued[:2]), otherIds[0], "queued jobs of the job set lease ahead of other jobs")
+		assert.NotContains(t, jobIds(queued[:2]), otherIds[1], "queued jobs of the job set lease ahead of other jobs")
+		assert.ElementsMatch(t, otherIds, jobIds(queued[2:]))
+
+		leased, err := s.jobRepository.GetLeasedJobs("cluster1")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{leasedJob.Id}, jobIds(leased), "leased job keeps running")
+		assert.Equal(t, float64(1), leased[0].Priority)
+	})
+}
+
+func submitJobSetWithPriority(t *testing.T, s *SubmitServer, jobSetId string, numberOfJobs int, priority float64) []string {
+	request := createJobRequest(jobSetId, numberOfJobs)
+	for _, item := range request.JobRequestItems {
+		item.Priority = priority
+	}
+	response, err := s.SubmitJobs(context.Background(), request)
+	assert.Nil(t, err)
+	ids := []string{}
+	for _, item := range response.JobResponseItems {
+		ids = append(ids, item.JobId)
+	}
+	return ids
+}
+
+func TestSubmitServer_ReprioritizeJobs_JobSetInAllQueuesRequiresCancelAnyJobs(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobSetId := util.NewULID()
+		submitJobSetToQueue(t, s, "test", jobSetId, 1)
+		s.permissions = grantedPermissionChecker{granted: []permissions.Permission{permissions.CancelJobs}}
+
+		_, err := s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{JobSetId: jobSetId, NewPriority: 2})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		response, err := s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{Queue: "test", JobSetId: jobSetId, NewPriority: 2})
+		assert.Nil(t, err, "owner of the queue can reprioritize the job set in the queue")
+		assert.Equal(t, 1, len(response.ReprioritizedIds))
+	})
+}
+
+func TestSubmitServer_ReconcileState_ReturnsJobsLeasedToInactiveClusters(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobSetId := util.NewULID()
+		_, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 2))
+		assert.Nil(t, err)
+		jobs, err := s.jobRepository.PeekQueue("test", 2)
+		assert.Nil(t, err)
+		_, err = s.jobRepository.TryLeaseJobs("activeCluster", "test", jobs[:1])
+		assert.Nil(t, err)
+		_, err = s.jobRepository.TryLeaseJobs("deadCluster", "test", jobs[1:])
+		assert.Nil(t, err)
+		err = s.usageRepository.UpdateCluster(&api.ClusterUsageReport{ClusterId: "activeCluster", ReportTime: time.Now()}, map[string]float64{})
+		assert.Nil(t, err)
+
+		response, err := s.ReconcileState(context.Background(), &api.StateReconcileRequest{DryRun: true})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{jobs[1].Id}, response.ReturnedJobIds)
+		queued, err := s.jobRepository.PeekQueue("test", 2)
+		assert.Nil(t, err)
+		assert.Empty(t, queued, "dry run does not return the lease")
+
+		response, err = s.ReconcileState(context.Background(), &api.StateReconcileRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{jobs[1].Id}, response.ReturnedJobIds)
+		assert.Empty(t, response.RequeuedJobIds)
+
+		queued, err = s.jobRepository.PeekQueue("test", 2)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{jobs[1].Id}, jobIds(queued))
+		leased, err := s.jobRepository.GetLeasedJobs("activeCluster")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{jobs[0].Id}, jobIds(leased))
+	})
+}
+
+func TestSubmitServer_ReconcileState_RequiresPermission(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		s.permissions = grantedPermissionChecker{granted: []permissions.Permission{permissions.MigrateJobs}}
+
+		_, err := s.ReconcileState(context.Background(), &api.StateReconcileRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestSubmitServer_SubmitJob_MissingQueueReturnsNotFound(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.Queue = "missing"
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, api.ErrorCode_QueueNotFound, api.ErrorCodeOf(err))
+	})
+}
+
+func TestSubmitServer_SubmitJob_InvalidJobReturnsInvalidArgument(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.JobRequestItems[0].PodSpec.Containers = nil
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, api.ErrorCode_InvalidPodSpec, api.ErrorCodeOf(err))
+	})
+}
+
+func TestSubmitServer_SubmitJob_StoresCallbackUrlOfJobSet(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.CallbackUrl = "https://example.com/armada"
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Nil(t, err)
+
+		url, err := s.eventRepository.GetJobSetCallbackUrl(jobRequest.Queue, jobRequest.JobSetId)
+		assert.Nil(t, err)
+		assert.Equal(t, "https://example.com/armada", url)
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsInvalidCallbackUrl(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.CallbackUrl = "ftp://example.com/armada"
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsCallbackUrlOutsideCallbackHosts(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.CallbackUrl = "http://169.254.169.254/latest/meta-data"
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_SubmitJob_KeepsCallbackUrlOfExistingJobSet(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobSetId := util.NewULID()
+		jobRequest := createJobRequest(jobSetId, 1)
+		jobRequest.CallbackUrl = "https://example.com/armada"
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Nil(t, err)
+
+		redirected := createJobRequest(jobSetId, 1)
+		redirected.JobRequestItems[0].ClientId = "client-id"
+		redirected.CallbackUrl = "https://example.com/other"
+		_, err = s.SubmitJobs(context.Background(), redirected)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		url, err := s.eventRepository.GetJobSetCallbackUrl(jobRequest.Queue, jobSetId)
+		assert.Nil(t, err)
+		assert.Equal(t, "https://example.com/armada", url)
+
+		// client id of the rejected job is released, so it is submitted when retried with the URL of the job set
+		redirected.CallbackUrl = "https://example.com/armada"
+		response, err := s.SubmitJobs(context.Background(), redirected)
+		assert.Nil(t, err)
+
+		jobIds, err := s.jobRepository.GetActiveJobIds("test", jobSetId)
+		assert.Nil(t, err)
+		assert.Contains(t, jobIds, response.JobResponseItems[0].JobId)
+		assert.Equal(t, 2, len(jobIds))
+	})
+}
+
+func TestSubmitServer_CancelJobs_MissingJobReturnsNotFound(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		_, err := s.CancelJobs(context.Background(), &api.JobCancelRequest{JobId: util.NewULID()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, api.ErrorCode_JobNotFound, api.ErrorCodeOf(err))
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsOversizedJob(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		s.schedulingConfig.MaxJobSize = 2000
+		queue := util.NewULID()
+		err := s.queueRepository.CreateQueue(&api.Queue{Name: queue})
+		assert.Empty(t, err)
+
+		jobRequest := createJobRequest(util.NewULID(), 1)
+		jobRequest.Queue = queue
+		for i := 0; i < 100; i++ {
+			jobRequest.JobRequestItems[0].PodSpec.Containers[0].Env = append(jobRequest.JobRequestItems[0].PodSpec.Containers[0].Env,
+				v1.EnvVar{Name: fmt.Sprintf("VARIABLE_%d", i), Value: "value"})
+		}
+
+		_, err = s.SubmitJobs(context.Background(), jobRequest)
+		assert.Error(t, err)
+		assert.Regexp(t, "error validating job with index 0: serialized job size of [0-9]+ bytes exceeds the limit of 2000 bytes", err.Error())
+
+		queued, err := s.jobRepository.PeekQueue(queue, 100)
+		assert.Empty(t, err)
+		assert.Empty(t, queued)
+	})
+}
+
+func TestSubmitServer_SubmitJob_TemplatedJobEqualsFullySpecifiedJob(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		template := &api.JobTemplate{
+			Queue:   "test",
+			Name:    "sleep",
+			Labels:  map[string]string{"team": "a", "kind": "template"},
+			PodSpec: createJobRequestItems(1)[0].PodSpec,
+		}
+		template.PodSpec.Containers[0].Env = []v1.EnvVar{{Name: "MODE", Value: "default"}, {Name: "LEVEL", Value: "1"}}
+		_, err := s.CreateJobTemplate(context.Background(), template)
+		assert.Empty(t, err)
+
+		fullRequest := createJobRequest(util.NewULID(), 1)
+		fullItem := fullRequest.JobRequestItems[0]
+		fullItem.Labels = map[string]string{"team": "a", "kind": "full"}
+		fullItem.PodSpec.Containers[0].Args = []string{"sleep", "20s"}
+		fullItem.PodSpec.Containers[0].Env = []v1.EnvVar{{Name: "LEVEL", Value: "1"}, {Name: "MODE", Value: "fast"}}
+
+		templatedRequest := &api.JobSubmitRequest{
+			Queue:    "test",
+			JobSetId: fullRequest.JobSetId,
+			JobRequestItems: []*api.JobSubmitRequestItem{{
+				Labels:       map[string]string{"kind": "full"},
+				TemplateName: "sleep",
+				TemplateOverrides: &api.JobTemplateOverrides{
+					Args: []string{"sleep", "20s"},
+					Env:  []v1.EnvVar{{Name: "MODE", Value: "fast"}},
+				},
+			}},
+		}
+
+		fullResponse, err := s.SubmitJobs(context.Background(), fullRequest)
+		assert.Empty(t, err)
+		templatedResponse, err := s.SubmitJobs(context.Background(), templatedRequest)
+		assert.Empty(t, err)
+		assert.Empty(t, templatedResponse.JobResponseItems[0].Error)
+
+		jobs, err := s.jobRepository.GetExistingJobsByIds([]string{fullResponse.JobResponseItems[0].JobId, templatedResponse.JobResponseItems[0].JobId})
+		assert.Empty(t, err)
+		fullJob, templatedJob := jobs[0], jobs[1]
+		templatedJob.Id = fullJob.Id
+		templatedJob.Created = fullJob.Created
+		assert.Equal(t, fullJob, templatedJob)
+	})
+}
+
+func TestSubmitServer_SubmitJob_RejectsUnknownJobTemplate(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer) {
+		jobRequest := &api.JobSubmitRequest{
+			Queue:           "test",
+			JobSetId:        util.NewULID(),
+			JobRequestItems: []*api.JobSubmitRequestItem{{TemplateName: "missing"}},
+		}
+
+		_, err := s.SubmitJobs(context.Background(), jobRequest)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "job template missing does not exist in queue test")
+	})
+}
+
+func Test_applyJobTemplate_RejectsJobWithPodSpec(t *testing.T) {
+	item := createJobRequestItems(1)[0]
+	template := &api.JobTemplate{Name: "sleep", PodSpec: createJobRequestItems(1)[0].PodSpec}
+
+	err := applyJobTemplate(template, item)
+	assert.Error(t, err)
+}
+
+func Test_applyJobTemplate_DoesNotModifyTemplate(t *testing.T) {
+	template := &api.JobTemplate{Name: "sleep", PodSpec: createJobRequestItems(1)[0].PodSpec}
+	item := &api.JobSubmitRequestItem{
+		TemplateName:      "sleep",
+		TemplateOverrides: &api.JobTemplateOverrides{Args: []string{"true"}, Env: []v1.EnvVar{{Name: "A", Value: "b"}}},
+	}
+
+	err := applyJobTemplate(template, item)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"true"}, item.PodSpec.Containers[0].Args)
+	assert.Equal(t, []v1.EnvVar{{Name: "A", Value: "b"}}, item.PodSpec.Containers[0].Env)
+	assert.Equal(t, []string{"sleep", "10s"}, template.PodSpec.Containers[0].Args)
+	assert.Empty(t, template.PodSpec.Containers[0].Env)
+}
+
+func Test_applyJobSetDefaults_DoesNotModifyDefaults(t *testing.T) {
+	defaults := &api.JobSubmitDefaults{Labels: map[string]string{"team": "a"}, Annotations: map[string]string{"cost-centre": "research"}}
+	first := &api.JobSubmitRequestItem{Labels: map[string]string{"team": "b"}}
+	second := &api.JobSubmitRequestItem{}
+
+	applyJobSetDefaults(defaults, first)
+	applyJobSetDefaults(defaults, second)
+	second.Labels["extra"] = "label"
+
+	assert.Equal(t, map[string]string{"team": "b"}, first.Labels)
+	assert.Equal(t, map[string]string{"cost-centre": "research"}, first.Annotations)
+	assert.Equal(t, map[string]string{"team": "a", "extra": "label"}, second.Labels)
+	assert.Equal(t, map[string]string{"team": "a"}, defaults.Labels)
+}
+
+func submitJobSetToQueue(t *testing.T, s *SubmitServer, queue string, jobSetId string, numberOfJobs int) []string {
+	if _, err := s.queueRepository.GetQueue(queue); err == repository.ErrQueueNotFound {
+		assert.Nil(t, s.queueRepository.CreateQueue(&api.Queue{Name: queue}))
+	}
+	request := createJobRequest(jobSetId, numberOfJobs)
+	request.Queue = queue
+	response, err := s.SubmitJobs(context.Background(), request)
+	assert.Nil(t, err)
+	ids := []string{}
+	for _, item := range response.JobResponseItems {
+		ids = append(ids, item.JobId)
+	}
+	return ids
+}
+
 func createJobRequest(jobSetId string, numberOfJobs int) *api.JobSubmitRequest {
 	return &api.JobSubmitRequest{
 		JobSetId:        jobSetId,
@@ -129,14 +1058,50 @@ func createJobRequestItems(numberOfJobs int) []*api.JobSubmitRequestItem {
 	return jobRequestItems
 }
 
+// callback URLs of job sets submitted in tests may point to example.com
+var testWebhookConfig = &configuration.WebhookConfig{CallbackHosts: []string{"example.com"}}
+
+// withMiniredisSubmitServer runs the action with a server on its own miniredis instance, for tests not using streams
+// which need more than one server.
+func withMiniredisSubmitServer(action func(s *SubmitServer)) {
+	minidb, err := miniredis.Run()
+	if err != nil {
+		panic(err)
+	}
+	defer minidb.Close()
+	client := redis.NewClient(&redis.Options{Addr: minidb.Addr()})
+
+	jobRepo := repository.NewRedisJobRepository(client, "", false, 0)
+	queueRepo := repository.NewRedisQueueRepository(client, "")
+	jobTemplateRepo := repository.NewRedisJobTemplateRepository(client, "")
+	eventRepo := repository.NewRedisEventRepository(client, "", configuration.EventRetentionPolicy{ExpiryEnabled: false}, configuration.JsonEventStreamConfig{})
+	usageRepo := repository.NewRedisUsageRepository(client, "")
+	server := NewSubmitServer(&fakePermissionChecker{}, &configuration.SchedulingConfig{}, testWebhookConfig, jobRepo, queueRepo, jobTemplateRepo, eventRepo, usageRepo,
+		scheduling.NewJobNotifier(), audit.NoopSink{},
+		validation.NewSubmissionValidator(configuration.SubmissionPolicyConfig{}))
+
+	err = queueRepo.CreateQueue(&api.Queue{Name: "test"})
+	if err != nil {
+		panic(err)
+	}
+
+	action(server)
+}
+
 func withSubmitServer(action func(s *SubmitServer)) {
 	// using real redis instance as miniredis does not support streams
 	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
 
-	jobRepo := repository.NewRedisJobRepository(client)
-	queueRepo := repository.NewRedisQueueRepository(client)
-	eventRepo := repository.NewRedisEventRepository(client, configuration.EventRetentionPolicy{ExpiryEnabled: false})
-	server := NewSubmitServer(&fakePermissionChecker{}, jobRepo, queueRepo, eventRepo)
+	jobRepo := repository.NewRedisJobRepository(client, "", false, 0)
+	queueRepo := repository.NewRedisQueueRepository(client, "")
+	jobTemplateRepo := repository.NewRedisJobTemplateRepository(client, "")
+	eventRepo := repository.NewRedisEventRepository(client, "", configuration.EventRetentionPolicy{ExpiryEnabled: false}, configuration.JsonEventStreamConfig{})
+	usageRepo := repository.NewRedisUsageRepository(client, "")
+	server := NewSubmitServer(&fakePermissionChecker{}, &configuration.SchedulingConfig{}, testWebhookConfig, jobRepo, queueRepo, jobTemplateRepo, eventRepo, usageRepo,
+		scheduling.NewJobNotifier(), audit.NoopSink{},
+		validation.NewSubmissionValidator(configuration.SubmissionPolicyConfig{}))
+
+	client.FlushDB()
 
 	err := queueRepo.CreateQueue(&api.Queue{Name: "test"})
 	if err != nil {
@@ -144,4 +1109,6 @@ func withSubmitServer(action func(s *SubmitServer)) {
 	}
 
 	action(server)
+
+	client.FlushDB()
 }
@@ -2,32 +2,51 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/G-Research/armada/internal/armada/authorization"
 	"github.com/G-Research/armada/internal/armada/authorization/permissions"
+	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/internal/armada/scheduling"
+	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/pkg/api"
 )
 
 type UsageServer struct {
 	permissions      authorization.PermissionChecker
 	priorityHalfTime time.Duration
+	overuseSettings  *configuration.ResourceOveruseSettings
 	usageRepository  repository.UsageRepository
+	jobRepository    repository.JobRepository
+	eventRepository  repository.EventRepository
+
+	resourceScarcityLock sync.RWMutex
+	resourceScarcity     map[string]float64
 }
 
 func NewUsageServer(
 	permissions authorization.PermissionChecker,
 	priorityHalfTime time.Duration,
-	usageRepository repository.UsageRepository) *UsageServer {
+	resourceScarcity map[string]float64,
+	overuseSettings *configuration.ResourceOveruseSettings,
+	usageRepository repository.UsageRepository,
+	jobRepository repository.JobRepository,
+	eventRepository repository.EventRepository) *UsageServer {
 
 	return &UsageServer{
 		permissions:      permissions,
 		priorityHalfTime: priorityHalfTime,
-		usageRepository:  usageRepository}
+		resourceScarcity: resourceScarcity,
+		overuseSettings:  overuseSettings,
+		usageRepository:  usageRepository,
+		jobRepository:    jobRepository,
+		eventRepository:  eventRepository}
 }
 
 func (s *UsageServer) ReportUsage(ctx context.Context, report *api.ClusterUsageReport) (*types.Empty, error) {
@@ -45,11 +64,100 @@ func (s *UsageServer) ReportUsage(ctx context.Context, report *api.ClusterUsageR
 		return nil, err
 	}
 
-	newPriority := scheduling.CalculatePriorityUpdateFromReports(reports, report, previousPriority, s.priorityHalfTime)
+	newPriority := scheduling.CalculatePriorityUpdateFromReports(reports, report, previousPriority, s.priorityHalfTime, s.getResourceScarcity())
 
 	err = s.usageRepository.UpdateCluster(report, newPriority)
 	if err != nil {
 		return nil, err
 	}
+
+	if len(report.Jobs) > 0 {
+		err = s.recordJobUsage(report)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &types.Empty{}, nil
 }
+
+// recordJobUsage updates rolling resource usage of the reported jobs,
+// jobs which keep using more resources than they requested are reported and optionally cancelled.
+func (s *UsageServer) recordJobUsage(report *api.ClusterUsageReport) error {
+	jobIds := make([]string, 0, len(report.Jobs))
+	usageByJobId := make(map[string]common.ComputeResources, len(report.Jobs))
+	for _, jobReport := range report.Jobs {
+		jobIds = append(jobIds, jobReport.JobId)
+		usageByJobId[jobReport.JobId] = jobReport.ResourcesUsed
+	}
+
+	jobs, e := s.jobRepository.GetExistingJobsByIds(jobIds)
+	if e != nil {
+		return e
+	}
+
+	updatedJobs := make([]*api.Job, 0, len(jobs))
+	overusingJobs := []*api.Job{}
+	for _, job := range jobs {
+		if job.Id == "" {
+			continue
+		}
+		scheduling.UpdateJobUsage(job, usageByJobId[job.Id])
+		if scheduling.UpdateJobOveruse(job, report.ReportTime, s.overuseSettings) {
+			overusingJobs = append(overusingJobs, job)
+		}
+		updatedJobs = append(updatedJobs, job)
+	}
+
+	e = s.jobRepository.UpdateJobs(updatedJobs)
+	if e != nil {
+		return e
+	}
+
+	if len(overusingJobs) > 0 {
+		reportJobsResourceOveruse(s.eventRepository, overusingJobs, report.ClusterId)
+		if s.overuseSettings.Preempt {
+			s.cancelOverusingJobs(overusingJobs)
+		}
+	}
+	return nil
+}
+
+func (s *UsageServer) cancelOverusingJobs(jobs []*api.Job) {
+	reason := fmt.Sprintf("Job kept using more than %g times its requested resources for %s", s.overuseSettings.Ratio, s.overuseSettings.Period)
+	now := time.Now()
+	events := []*api.EventMessage{}
+	for job, e := range s.jobRepository.DeleteJobs(jobs) {
+		if e != nil {
+			log.Errorf("Failed to cancel overusing job %s: %v", job.Id, e)
+			continue
+		}
+		events = append(events,
+			&api.EventMessage{
+				Events: &api.EventMessage_Cancelling{
+					Cancelling: &api.JobCancellingEvent{JobId: job.Id, JobSetId: job.JobSetId, Queue: job.Queue, Created: now, Reason: reason},
+				},
+			},
+			&api.EventMessage{
+				Events: &api.EventMessage_Cancelled{
+					Cancelled: &api.JobCancelledEvent{JobId: job.Id, JobSetId: job.JobSetId, Queue: job.Queue, Created: now, Reason: reason},
+				},
+			})
+	}
+	e := s.eventRepository.ReportEvents(events)
+	if e != nil {
+		log.Error(e)
+	}
+}
+
+// UpdateResourceScarcity replaces configured resource scarcity used for following usage reports.
+func (s *UsageServer) UpdateResourceScarcity(resourceScarcity map[string]float64) {
+	s.resourceScarcityLock.Lock()
+	defer s.resourceScarcityLock.Unlock()
+	s.resourceScarcity = resourceScarcity
+}
+
+func (s *UsageServer) getResourceScarcity() map[string]float64 {
+	s.resourceScarcityLock.RLock()
+	defer s.resourceScarcityLock.RUnlock()
+	return s.resourceScarcity
+}
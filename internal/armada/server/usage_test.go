@@ -5,11 +5,12 @@ import (
 	"testing"
 	"time"
 
-	"github.com/alicebob/miniredis"
 	"github.com/go-redis/redis"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/G-Research/armada/internal/armada/authorization"
+	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/armada/repository"
 	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/pkg/api"
@@ -37,6 +38,45 @@ func TestUsageServer_ReportUsage(t *testing.T) {
 	})
 }
 
+func TestUsageServer_ReportUsage_ReportsAndCancelsOverusingJob(t *testing.T) {
+	withUsageServerOveruseSettings(configuration.ResourceOveruseSettings{Ratio: 2, Period: time.Minute, Preempt: true}, func(s *UsageServer) {
+		request := createJobRequest("set1", 1)
+		job, e := s.jobRepository.CreateJob(request, request.JobRequestItems[0], authorization.NewStaticPrincipal("user", []string{}))
+		assert.Nil(t, e)
+		_, e = s.jobRepository.AddJobs([]*api.Job{job})
+		assert.Nil(t, e)
+
+		now := time.Now()
+		report := oneQueueReport(now, resource.MustParse("10"), resource.MustParse("360Gi"))
+		report.Jobs = []*api.JobUsageReport{{JobId: job.Id, ResourcesUsed: common.ComputeResources{"cpu": resource.MustParse("3")}}}
+		_, e = s.ReportUsage(context.Background(), report)
+		assert.Nil(t, e)
+
+		stored, e := s.jobRepository.GetExistingJobsByIds([]string{job.Id})
+		assert.Nil(t, e)
+		assert.Equal(t, resource.MustParse("3"), stored[0].ResourcesUsed["cpu"])
+		assert.NotNil(t, stored[0].OverusingSince)
+
+		jobIds, e := s.jobRepository.GetActiveJobIds(job.Queue, job.JobSetId)
+		assert.Nil(t, e)
+		assert.Equal(t, []string{job.Id}, jobIds, "overuse is tolerated for the configured period")
+
+		report.ReportTime = now.Add(time.Minute)
+		_, e = s.ReportUsage(context.Background(), report)
+		assert.Nil(t, e)
+
+		jobIds, e = s.jobRepository.GetActiveJobIds(job.Queue, job.JobSetId)
+		assert.Nil(t, e)
+		assert.Empty(t, jobIds)
+
+		events, e := s.eventRepository.ReadEvents(job.Queue, job.JobSetId, "", 100, 0)
+		assert.Nil(t, e)
+		assert.Equal(t, 3, len(events))
+		assert.Equal(t, job.Id, events[0].Message.GetResourceOveruse().JobId)
+		assert.Equal(t, job.Id, events[2].Message.GetCancelled().JobId)
+	})
+}
+
 func oneQueueReport(t time.Time, cpu resource.Quantity, memory resource.Quantity) *api.ClusterUsageReport {
 	return &api.ClusterUsageReport{
 		ClusterId:       "clusterA",
@@ -52,14 +92,21 @@ func oneQueueReport(t time.Time, cpu resource.Quantity, memory resource.Quantity
 }
 
 func withUsageServer(action func(s *UsageServer)) {
-	db, err := miniredis.Run()
-	if err != nil {
-		panic(err)
-	}
-	defer db.Close()
+	withUsageServerOveruseSettings(configuration.ResourceOveruseSettings{}, action)
+}
 
-	repo := repository.NewRedisUsageRepository(redis.NewClient(&redis.Options{Addr: db.Addr()}))
-	server := NewUsageServer(&fakePermissionChecker{}, time.Minute, repo)
+func withUsageServerOveruseSettings(overuseSettings configuration.ResourceOveruseSettings, action func(s *UsageServer)) {
+	// using real redis instance as miniredis does not support streams
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+
+	repo := repository.NewRedisUsageRepository(client, "")
+	jobRepo := repository.NewRedisJobRepository(client, "", false, 0)
+	eventRepo := repository.NewRedisEventRepository(client, "", configuration.EventRetentionPolicy{ExpiryEnabled: false}, configuration.JsonEventStreamConfig{})
+	server := NewUsageServer(&fakePermissionChecker{}, time.Minute, map[string]float64{}, &overuseSettings, repo, jobRepo, eventRepo)
+
+	client.FlushDB()
 
 	action(server)
+
+	client.FlushDB()
 }
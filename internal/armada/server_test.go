@@ -2,21 +2,32 @@ package armada
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/alicebob/miniredis"
 	"github.com/go-redis/redis"
 	"github.com/prometheus/client_golang/prometheus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/G-Research/armada/internal/armada/authorization/permissions"
 	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/logging"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -63,6 +74,77 @@ func TestSubmitJob(t *testing.T) {
 	})
 }
 
+func TestSubmitJob_AnnotationsAreReturnedInLease(t *testing.T) {
+	withRunningServer(func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context) {
+		_, err := client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+		assert.Empty(t, err)
+
+		cpu, _ := resource.ParseQuantity("1")
+		memory, _ := resource.ParseQuantity("512Mi")
+		annotations := map[string]string{"cost-centre": "research", "sidecar.example.com/inject": "true"}
+
+		item := jobRequestItem(cpu, memory)
+		item.Annotations = annotations
+		response, err := client.SubmitJobs(ctx, &api.JobSubmitRequest{JobRequestItems: []*api.JobSubmitRequestItem{item}, Queue: "test", JobSetId: "set"})
+		assert.Empty(t, err)
+
+		leasedResponse, err := leaseClient.LeaseJobs(ctx, &api.LeaseRequest{
+			ClusterId: "test-cluster",
+			Resources: common.ComputeResources{"cpu": cpu, "memory": memory},
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, 1, len(leasedResponse.Job))
+		assert.Equal(t, response.JobResponseItems[0].JobId, leasedResponse.Job[0].Id)
+		assert.Equal(t, annotations, leasedResponse.Job[0].Annotations)
+
+		item = jobRequestItem(cpu, memory)
+		item.Annotations = map[string]string{"armada/queue": "other"}
+		_, err = client.SubmitJobs(ctx, &api.JobSubmitRequest{JobRequestItems: []*api.JobSubmitRequestItem{item}, Queue: "test", JobSetId: "set"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "annotation armada/queue is reserved")
+	})
+}
+
+func TestSubmitJob_ServicesAreReturnedInLease(t *testing.T) {
+	withRunningServer(func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context) {
+		_, err := client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+		assert.Empty(t, err)
+
+		cpu, _ := resource.ParseQuantity("1")
+		memory, _ := resource.ParseQuantity("512Mi")
+		services := []*api.ServiceConfig{{Ports: []uint32{8888}}, {Type: "NodePort", Ports: []uint32{6006, 6007}}}
+
+		item := jobRequestItem(cpu, memory)
+		item.PodSpec.Containers[0].Ports = []v1.ContainerPort{{ContainerPort: 8888}, {ContainerPort: 6006}, {ContainerPort: 6007}}
+		item.Services = services
+		response, err := client.SubmitJobs(ctx, &api.JobSubmitRequest{JobRequestItems: []*api.JobSubmitRequestItem{item}, Queue: "test", JobSetId: "set"})
+		assert.Empty(t, err)
+
+		leasedResponse, err := leaseClient.LeaseJobs(ctx, &api.LeaseRequest{
+			ClusterId: "test-cluster",
+			Resources: common.ComputeResources{"cpu": cpu, "memory": memory},
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, 1, len(leasedResponse.Job))
+		assert.Equal(t, response.JobResponseItems[0].JobId, leasedResponse.Job[0].Id)
+		assert.Equal(t, services, leasedResponse.Job[0].Services)
+
+		for _, invalid := range [][]*api.ServiceConfig{
+			{{Ports: []uint32{70000}}},
+			{{Ports: []uint32{8080}}},
+			{{Ports: []uint32{8888}}, {Type: "NodePort", Ports: []uint32{8888}}},
+			{{Type: "LoadBalancer", Ports: []uint32{8888}}},
+		} {
+			item = jobRequestItem(cpu, memory)
+			item.PodSpec.Containers[0].Ports = []v1.ContainerPort{{ContainerPort: 8888}}
+			item.Services = invalid
+			_, err = client.SubmitJobs(ctx, &api.JobSubmitRequest{JobRequestItems: []*api.JobSubmitRequestItem{item}, Queue: "test", JobSetId: "set"})
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "error validating services")
+		}
+	})
+}
+
 func TestCancelJob(t *testing.T) {
 	withRunningServer(func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context) {
 
@@ -99,6 +181,643 @@ func TestCancelJob(t *testing.T) {
 	})
 }
 
+func TestRenewLease_ReportsStatusOfEachLease(t *testing.T) {
+	withRunningServer(func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context) {
+		_, err := client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+		assert.Empty(t, err)
+
+		cpu, _ := resource.ParseQuantity("1")
+		memory, _ := resource.ParseQuantity("512Mi")
+
+		SubmitJob(client, ctx, cpu, memory, t)
+		SubmitJob(client, ctx, cpu, memory, t)
+
+		leasedResponse, err := leaseClient.LeaseJobs(ctx, &api.LeaseRequest{
+			ClusterId: "test-cluster",
+			Resources: common.ComputeResources{"cpu": resource.MustParse("2"), "memory": resource.MustParse("1Gi")},
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, 2, len(leasedResponse.Job))
+		validId := leasedResponse.Job[0].Id
+		cancelledId := leasedResponse.Job[1].Id
+
+		cancelResult, err := client.CancelJobs(ctx, &api.JobCancelRequest{JobId: cancelledId})
+		assert.Empty(t, err)
+		assert.Equal(t, []string{cancelledId}, cancelResult.CancelledIds)
+
+		renewed, err := leaseClient.RenewLease(ctx, &api.RenewLeaseRequest{
+			ClusterId: "test-cluster",
+			Ids:       []string{validId, cancelledId, "unknownJobId"},
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, []string{validId}, renewed.Ids)
+		assert.Equal(t, []*api.LeaseRenewal{
+			{JobId: validId, Status: api.LeaseRenewalStatus_Renewed},
+			{JobId: cancelledId, Status: api.LeaseRenewalStatus_Cancelled},
+			{JobId: "unknownJobId", Status: api.LeaseRenewalStatus_UnknownJob},
+		}, renewed.Renewals)
+	})
+}
+
+func TestTraceAttributes_RecordsRenewedJobCountOfRenewLease(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	_, span := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test").Start(context.Background(), "RenewLease")
+
+	traceAttributes(span,
+		&api.RenewLeaseRequest{ClusterId: "test-cluster", Ids: []string{"job1", "job2", "job3"}},
+		&api.RenewLeaseResponse{Ids: []string{"job1", "job2"}})
+	span.End()
+
+	attributes := recorder.Ended()[0].Attributes()
+	assert.Contains(t, attributes, attribute.String("clusterId", "test-cluster"))
+	assert.Contains(t, attributes, attribute.Int("jobCount", 3))
+	assert.Contains(t, attributes, attribute.Int("renewedJobCount", 2))
+}
+
+func TestCancelJob_OnlyIfUnstartedLeavesLeasedJobRunning(t *testing.T) {
+	withRunningServerConfig(func(config *configuration.ArmadaConfig) {
+		config.Scheduling.Lease.ExpireAfter = time.Minute
+		config.Scheduling.Lease.ExpiryLoopInterval = time.Minute
+	}, func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context) {
+		_, err := client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+		assert.Empty(t, err)
+
+		cpu, _ := resource.ParseQuantity("1")
+		memory, _ := resource.ParseQuantity("512Mi")
+
+		jobIds := []string{}
+		for i := 0; i < 3; i++ {
+			jobIds = append(jobIds, SubmitJob(client, ctx, cpu, memory, t))
+		}
+
+		leasedResponse, err := leaseClient.LeaseJobs(ctx, &api.LeaseRequest{
+			ClusterId: "test-cluster",
+			Resources: common.ComputeResources{"cpu": cpu, "memory": memory},
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, 1, len(leasedResponse.Job))
+		leasedId := leasedResponse.Job[0].Id
+
+		cancelResult, err := client.CancelJobs(ctx, &api.JobCancelRequest{JobSetId: "set", Queue: "test", OnlyIfUnstarted: true})
+		assert.Empty(t, err)
+		assert.Equal(t, 2, len(cancelResult.CancelledIds))
+		assert.NotContains(t, cancelResult.CancelledIds, leasedId)
+		for _, id := range jobIds {
+			if id != leasedId {
+				assert.Contains(t, cancelResult.CancelledIds, id)
+			}
+		}
+
+		renewed, err := leaseClient.RenewLease(ctx, &api.RenewLeaseRequest{
+			ClusterId: "test-cluster",
+			Ids:       []string{leasedId},
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, []string{leasedId}, renewed.Ids)
+	})
+}
+
+func TestCancelJobsByLabel_CancelsMatchingJobsAcrossQueues(t *testing.T) {
+	withRunningServerConfig(func(config *configuration.ArmadaConfig) {
+		config.Scheduling.Lease.ExpireAfter = time.Minute
+		config.Scheduling.Lease.ExpiryLoopInterval = time.Minute
+	}, func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context) {
+		cpu, _ := resource.ParseQuantity("1")
+		memory, _ := resource.ParseQuantity("512Mi")
+
+		abandoned := map[string]string{"experiment": "abandoned"}
+		cancelledIds := []string{}
+		keptIds := []string{}
+		for _, queue := range []string{"queue1", "queue2"} {
+			_, err := client.CreateQueue(ctx, &api.Queue{Name: queue, PriorityFactor: 1})
+			assert.Empty(t, err)
+			cancelledIds = append(cancelledIds, submitLabeledJob(client, ctx, queue, abandoned, cpu, memory, t))
+			keptIds = append(keptIds, submitLabeledJob(client, ctx, queue, map[string]string{"experiment": "running"}, cpu, memory, t))
+		}
+
+		leasedResponse, err := leaseClient.LeaseJobs(ctx, &api.LeaseRequest{
+			ClusterId: "test-cluster",
+			Resources: common.ComputeResources{"cpu": cpu, "memory": memory},
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, 1, len(leasedResponse.Job))
+
+		cancelResult, err := client.CancelJobsByLabel(ctx, &api.JobCancelByLabelRequest{Labels: abandoned})
+		assert.Empty(t, err)
+		assert.ElementsMatch(t, cancelledIds, cancelResult.CancelledIds)
+
+		for _, queue := range []string{"queue1", "queue2"} {
+			remaining, err := client.SearchJobs(ctx, &api.JobSearchRequest{Queue: queue, Labels: abandoned})
+			assert.Empty(t, err)
+			assert.Empty(t, remaining.JobIds)
+		}
+
+		cancelResult, err = client.CancelJobsByLabel(ctx, &api.JobCancelByLabelRequest{Queue: "queue2", Labels: map[string]string{"experiment": "running"}})
+		assert.Empty(t, err)
+		assert.Equal(t, []string{keptIds[1]}, cancelResult.CancelledIds)
+
+		_, err = client.CancelJobsByLabel(ctx, &api.JobCancelByLabelRequest{Queue: "queue1"})
+		assert.Error(t, err)
+	})
+}
+
+func TestCreateQueue_DuplicateQueueReturnsAlreadyExists(t *testing.T) {
+	withRunningServer(func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context) {
+		_, err := client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+		assert.Empty(t, err)
+
+		_, err = client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		assert.Equal(t, api.ErrorCode_QueueAlreadyExists, api.ErrorCodeOf(err))
+
+		_, err = client.SubmitJobs(ctx, &api.JobSubmitRequest{
+			Queue:           "missing",
+			JobSetId:        "set",
+			JobRequestItems: []*api.JobSubmitRequestItem{jobRequestItem(resource.MustParse("1"), resource.MustParse("512Mi"))},
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, api.ErrorCode_QueueNotFound, api.ErrorCodeOf(err))
+	})
+}
+
+func TestSubmitJobs_RejectsJobsOverMaxQueuedJobs(t *testing.T) {
+	withRunningServerConfig(func(config *configuration.ArmadaConfig) {
+		config.Scheduling.Lease.ExpireAfter = time.Minute
+		config.Scheduling.Lease.ExpiryLoopInterval = time.Minute
+	}, func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context) {
+		_, err := client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1, MaxQueuedJobs: 3})
+		assert.Empty(t, err)
+
+		cpu, _ := resource.ParseQuantity("1")
+		memory, _ := resource.ParseQuantity("512Mi")
+
+		SubmitJob(client, ctx, cpu, memory, t)
+		SubmitJob(client, ctx, cpu, memory, t)
+
+		response, err := client.SubmitJobs(ctx, &api.JobSubmitRequest{
+			Queue:           "test",
+			JobSetId:        "set",
+			JobRequestItems: []*api.JobSubmitRequestItem{jobRequestItem(cpu, memory), jobRequestItem(cpu, memory), jobRequestItem(cpu, memory)},
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, 3, len(response.JobResponseItems))
+		assert.Empty(t, response.JobResponseItems[0].Error)
+		assert.NotEmpty(t, response.JobResponseItems[0].JobId)
+		for _, item := range response.JobResponseItems[1:] {
+			assert.Empty(t, item.JobId)
+			assert.Contains(t, item.Error, codes.ResourceExhausted.String())
+		}
+
+		_, err = client.SubmitJobs(ctx, &api.JobSubmitRequest{
+			Queue:           "test",
+			JobSetId:        "set",
+			JobRequestItems: []*api.JobSubmitRequestItem{jobRequestItem(cpu, memory)},
+		})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Equal(t, api.ErrorCode_QuotaExceeded, api.ErrorCodeOf(err))
+
+		leasedResponse, err := leaseClient.LeaseJobs(ctx, &api.LeaseRequest{
+			ClusterId: "test-cluster",
+			Resources: common.ComputeResources{"cpu": resource.MustParse("10"), "memory": resource.MustParse("10Gi")},
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, 3, len(leasedResponse.Job))
+
+		SubmitJob(client, ctx, cpu, memory, t)
+	})
+}
+
+func TestMigrateJobs_QueuedJobsAreLeasedFromTargetQueue(t *testing.T) {
+	withRunningServerConfig(func(config *configuration.ArmadaConfig) {
+		config.Scheduling.Lease.ExpireAfter = time.Minute
+		config.Scheduling.Lease.ExpiryLoopInterval = time.Minute
+	}, func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context) {
+		_, err := client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+		assert.Empty(t, err)
+		_, err = client.CreateQueue(ctx, &api.Queue{Name: "successor", PriorityFactor: 1})
+		assert.Empty(t, err)
+
+		cpu, _ := resource.ParseQuantity("1")
+		memory, _ := resource.ParseQuantity("512Mi")
+
+		jobIds := []string{}
+		for i := 0; i < 3; i++ {
+			jobIds = append(jobIds, SubmitJob(client, ctx, cpu, memory, t))
+		}
+
+		leasedResponse, err := leaseClient.LeaseJobs(ctx, &api.LeaseRequest{
+			ClusterId: "test-cluster",
+			Resources: common.ComputeResources{"cpu": cpu, "memory": memory},
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, 1, len(leasedResponse.Job))
+		leasedId := leasedResponse.Job[0].Id
+
+		migrateResult, err := client.MigrateJobs(ctx, &api.JobMigrateRequest{SourceQueue: "test", TargetQueue: "successor"})
+		assert.Empty(t, err)
+		assert.Equal(t, 2, len(migrateResult.MigratedIds))
+		assert.NotContains(t, migrateResult.MigratedIds, leasedId)
+
+		leasedResponse, err = leaseClient.LeaseJobs(ctx, &api.LeaseRequest{
+			ClusterId: "test-cluster",
+			Resources: common.ComputeResources{"cpu": cpu, "memory": memory},
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, 1, len(leasedResponse.Job))
+		assert.Equal(t, "successor", leasedResponse.Job[0].Queue)
+		assert.Contains(t, migrateResult.MigratedIds, leasedResponse.Job[0].Id)
+
+		renewed, err := leaseClient.RenewLease(ctx, &api.RenewLeaseRequest{
+			ClusterId: "test-cluster",
+			Ids:       []string{leasedId},
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, []string{leasedId}, renewed.Ids)
+	})
+}
+
+func TestMigrateJobs_RejectsSameSourceAndTargetQueue(t *testing.T) {
+	withRunningServer(func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context) {
+		_, err := client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+		assert.Empty(t, err)
+
+		_, err = client.MigrateJobs(ctx, &api.JobMigrateRequest{SourceQueue: "test", TargetQueue: "test"})
+		assert.Error(t, err)
+	})
+}
+
+func TestLeaseJobs_LongPollReturnsJobSubmittedWhileWaiting(t *testing.T) {
+	longPollTimeout := 10 * time.Second
+	withRunningServerConfig(func(config *configuration.ArmadaConfig) {
+		config.Scheduling.Lease.LongPollTimeout = longPollTimeout
+	}, func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context) {
+		_, err := client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+		assert.Empty(t, err)
+
+		cpu, _ := resource.ParseQuantity("1")
+		memory, _ := resource.ParseQuantity("512Mi")
+
+		start := time.Now()
+		leased := make(chan *api.JobLease)
+		go func() {
+			leasedResponse, err := leaseClient.LeaseJobs(ctx, &api.LeaseRequest{
+				ClusterId: "test-cluster",
+				Resources: common.ComputeResources{"cpu": cpu, "memory": memory},
+			})
+			assert.Empty(t, err)
+			leased <- leasedResponse
+		}()
+
+		time.Sleep(500 * time.Millisecond)
+		jobId := SubmitJob(client, ctx, cpu, memory, t)
+
+		leasedResponse := <-leased
+		assert.True(t, time.Since(start) < longPollTimeout)
+		assert.Equal(t, 1, len(leasedResponse.Job))
+		assert.Equal(t, jobId, leasedResponse.Job[0].Id)
+	})
+}
+
+func TestLeaseJobs_UpdatesSchedulingMetrics(t *testing.T) {
+	withRunningServer(func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context) {
+		_, err := client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+		assert.Empty(t, err)
+
+		cpu, _ := resource.ParseQuantity("1")
+		memory, _ := resource.ParseQuantity("512Mi")
+
+		SubmitJob(client, ctx, cpu, memory, t)
+		SubmitJob(client, ctx, cpu, memory, t)
+
+		leasedResponse, err := leaseClient.LeaseJobs(ctx, &api.LeaseRequest{
+			ClusterId: "test-cluster",
+			Resources: common.ComputeResources{"cpu": cpu, "memory": memory},
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, 1, len(leasedResponse.Job))
+
+		families, err := prometheus.DefaultRegisterer.(prometheus.Gatherer).Gather()
+		assert.Empty(t, err)
+
+		distributeRemainderObservations := uint64(0)
+		leasedJobs := 0.0
+		queuedJobs := 0.0
+		for _, family := range families {
+			for _, metric := range family.GetMetric() {
+				switch family.GetName() {
+				case "armada_scheduling_step_duration_seconds":
+					for _, label := range metric.GetLabel() {
+						if label.GetName() == "step" && label.GetValue() == "distributeRemainder" {
+							distributeRemainderObservations += metric.GetHistogram().GetSampleCount()
+						}
+					}
+				case "armada_scheduling_leased_jobs_total":
+					leasedJobs = metric.GetCounter().GetValue()
+				case "armada_queued_jobs":
+					queuedJobs = metric.GetGauge().GetValue()
+				}
+			}
+		}
+		assert.True(t, distributeRemainderObservations > 0)
+		assert.Equal(t, 1.0, leasedJobs)
+		assert.Equal(t, 1.0, queuedJobs)
+	})
+}
+
+func TestReturnLease_JobFailsAfterMaxLeaseAttempts(t *testing.T) {
+	withRunningServerConfig(func(config *configuration.ArmadaConfig) {
+		config.Scheduling.MaxLeaseAttempts = 2
+	}, func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context) {
+		_, err := client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+		assert.Empty(t, err)
+
+		cpu, _ := resource.ParseQuantity("1")
+		memory, _ := resource.ParseQuantity("512Mi")
+		jobId := SubmitJob(client, ctx, cpu, memory, t)
+
+		leaseRequest := &api.LeaseRequest{
+			ClusterId: "test-cluster",
+			Resources: common.ComputeResources{"cpu": cpu, "memory": memory},
+		}
+		for i := 0; i < 2; i++ {
+			leased, err := leaseClient.LeaseJobs(ctx, leaseRequest)
+			assert.Empty(t, err)
+			assert.Equal(t, 1, len(leased.Job))
+			assert.Equal(t, jobId, leased.Job[0].Id)
+			assert.Equal(t, uint32(i), leased.Job[0].LeaseAttempts)
+
+			_, err = leaseClient.ReturnLease(ctx, &api.ReturnLeaseRequest{ClusterId: "test-cluster", JobId: jobId})
+			assert.Empty(t, err)
+		}
+
+		leased, err := leaseClient.LeaseJobs(ctx, leaseRequest)
+		assert.Empty(t, err)
+		assert.Equal(t, 0, len(leased.Job))
+
+		queueInfo, err := client.GetQueueInfo(ctx, &api.QueueInfoRequest{Name: "test"})
+		assert.Empty(t, err)
+		assert.Equal(t, 0, len(queueInfo.ActiveJobSets))
+	})
+}
+
+func TestGetClusterLeases_ReflectsJobsLeasedByCluster(t *testing.T) {
+	withRunningServer(func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context) {
+		_, err := client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+		assert.Empty(t, err)
+		_, err = client.CreateQueue(ctx, &api.Queue{Name: "other", PriorityFactor: 1})
+		assert.Empty(t, err)
+
+		cpu, _ := resource.ParseQuantity("1")
+		memory, _ := resource.ParseQuantity("512Mi")
+		testJobId := SubmitJob(client, ctx, cpu, memory, t)
+		otherJobId := submitLabeledJob(client, ctx, "other", nil, cpu, memory, t)
+
+		leased, err := leaseClient.LeaseJobs(ctx, &api.LeaseRequest{
+			ClusterId: "test-cluster",
+			Resources: common.ComputeResources{"cpu": resource.MustParse("2"), "memory": resource.MustParse("1Gi")},
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, 2, len(leased.Job))
+
+		leases, err := leaseClient.GetClusterLeases(ctx, &api.ClusterLeasesRequest{ClusterId: "test-cluster"})
+		assert.Empty(t, err)
+		assert.Equal(t, "test-cluster", leases.ClusterId)
+		assert.ElementsMatch(t, []*api.ClusterLeasedJob{
+			{JobId: testJobId, Queue: "test", JobSetId: "set"},
+			{JobId: otherJobId, Queue: "other", JobSetId: "set"},
+		}, leases.Jobs)
+
+		assert.Equal(t, 2, len(leases.Queues))
+		assert.Equal(t, "other", leases.Queues[0].Name)
+		assert.Equal(t, "test", leases.Queues[1].Name)
+		for _, queueReport := range leases.Queues {
+			assert.True(t, cpu.Equal(queueReport.ResourcesLeased["cpu"]))
+			assert.True(t, memory.Equal(queueReport.ResourcesLeased["memory"]))
+		}
+		assert.True(t, resource.MustParse("2").Equal(leases.ResourcesLeased["cpu"]))
+		assert.True(t, resource.MustParse("1Gi").Equal(leases.ResourcesLeased["memory"]))
+
+		_, err = leaseClient.ReturnLease(ctx, &api.ReturnLeaseRequest{ClusterId: "test-cluster", JobId: testJobId})
+		assert.Empty(t, err)
+
+		leases, err = leaseClient.GetClusterLeases(ctx, &api.ClusterLeasesRequest{ClusterId: "test-cluster"})
+		assert.Empty(t, err)
+		assert.Equal(t, []*api.ClusterLeasedJob{{JobId: otherJobId, Queue: "other", JobSetId: "set"}}, leases.Jobs)
+
+		leases, err = leaseClient.GetClusterLeases(ctx, &api.ClusterLeasesRequest{ClusterId: "another-cluster"})
+		assert.Empty(t, err)
+		assert.Empty(t, leases.Jobs)
+		assert.Empty(t, leases.ResourcesLeased)
+	})
+}
+
+func TestConfigReload_AppliesQueueLeaseBatchSizeToNextLeaseRound(t *testing.T) {
+	minidb, err := miniredis.Run()
+	if err != nil {
+		panic(err)
+	}
+	defer minidb.Close()
+
+	config := testServerConfig(minidb.Addr(), 50055)
+	config.ConfigReloadInterval = 10 * time.Millisecond
+	config.Scheduling.QueueLeaseBatchSize = 1
+
+	reloadedLock := sync.Mutex{}
+	reloaded := *config
+	reloadConfig := func() (*configuration.ArmadaConfig, error) {
+		reloadedLock.Lock()
+		defer reloadedLock.Unlock()
+		c := reloaded
+		return &c, nil
+	}
+
+	conn, shutdown := serveTestServer(config, reloadConfig)
+	defer shutdown()
+	defer conn.Close()
+
+	setupServer(conn)
+	ctx := context.Background()
+	client := api.NewSubmitClient(conn)
+	leaseClient := api.NewAggregatedQueueClient(conn)
+
+	_, err = client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+	assert.Empty(t, err)
+
+	// the first job does not fit the lease request, so the second one is leased only when read in the same batch
+	memory, _ := resource.ParseQuantity("512Mi")
+	SubmitJob(client, ctx, resource.MustParse("2"), memory, t)
+	smallJobId := SubmitJob(client, ctx, resource.MustParse("1"), memory, t)
+
+	leaseRequest := &api.LeaseRequest{
+		ClusterId: "test-cluster",
+		Resources: common.ComputeResources{"cpu": resource.MustParse("1"), "memory": memory},
+	}
+	leased, err := leaseClient.LeaseJobs(ctx, leaseRequest)
+	assert.Empty(t, err)
+	assert.Equal(t, 0, len(leased.Job))
+
+	reloadedLock.Lock()
+	reloaded.Scheduling.QueueLeaseBatchSize = 2
+	reloadedLock.Unlock()
+
+	for i := 0; i < 100 && len(leased.Job) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+		leased, err = leaseClient.LeaseJobs(ctx, leaseRequest)
+		assert.Empty(t, err)
+	}
+	assert.Equal(t, 1, len(leased.Job))
+	assert.Equal(t, smallJobId, leased.Job[0].Id)
+}
+
+func TestConfigReload_AppliesMaxJobSizeToNextSubmission(t *testing.T) {
+	minidb, err := miniredis.Run()
+	if err != nil {
+		panic(err)
+	}
+	defer minidb.Close()
+
+	config := testServerConfig(minidb.Addr(), 50056)
+	config.ConfigReloadInterval = 10 * time.Millisecond
+
+	reloadedLock := sync.Mutex{}
+	reloaded := *config
+	reloadConfig := func() (*configuration.ArmadaConfig, error) {
+		reloadedLock.Lock()
+		defer reloadedLock.Unlock()
+		c := reloaded
+		return &c, nil
+	}
+
+	conn, shutdown := serveTestServer(config, reloadConfig)
+	defer shutdown()
+	defer conn.Close()
+
+	setupServer(conn)
+	ctx := context.Background()
+	client := api.NewSubmitClient(conn)
+
+	_, err = client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+	assert.Empty(t, err)
+
+	request := &api.JobSubmitRequest{
+		JobRequestItems: []*api.JobSubmitRequestItem{jobRequestItem(resource.MustParse("1"), resource.MustParse("512Mi"))},
+		Queue:           "test",
+		JobSetId:        "set",
+	}
+	_, err = client.SubmitJobs(ctx, request)
+	assert.Empty(t, err)
+
+	reloadedLock.Lock()
+	reloaded.Scheduling.MaxJobSize = 1
+	reloadedLock.Unlock()
+
+	for i := 0; i < 100 && err == nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+		_, err = client.SubmitJobs(ctx, request)
+	}
+	assert.Error(t, err)
+}
+
+func TestRedisKeyPrefix_IsolatesServersSharingRedis(t *testing.T) {
+	minidb, err := miniredis.Run()
+	if err != nil {
+		panic(err)
+	}
+	defer minidb.Close()
+
+	configA := testServerConfig(minidb.Addr(), 50053)
+	configA.RedisKeyPrefix = "A:"
+	connA, shutdownA := serveTestServer(configA, nil)
+	defer shutdownA()
+	defer connA.Close()
+	configB := testServerConfig(minidb.Addr(), 50054)
+	configB.RedisKeyPrefix = "B:"
+	connB, shutdownB := serveTestServer(configB, nil)
+	defer shutdownB()
+	defer connB.Close()
+
+	setupServer(connA)
+	setupServer(connB)
+	ctx := context.Background()
+	clientA := api.NewSubmitClient(connA)
+	clientB := api.NewSubmitClient(connB)
+
+	_, err = clientA.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+	assert.Empty(t, err)
+
+	_, err = clientB.SubmitJobs(ctx, &api.JobSubmitRequest{Queue: "test", JobSetId: "set"})
+	assert.Error(t, err)
+
+	cpu, _ := resource.ParseQuantity("1")
+	memory, _ := resource.ParseQuantity("512Mi")
+	jobId := SubmitJob(clientA, ctx, cpu, memory, t)
+
+	leaseRequest := &api.LeaseRequest{
+		ClusterId: "test-cluster",
+		Resources: common.ComputeResources{"cpu": cpu, "memory": memory},
+	}
+	leasedB, err := api.NewAggregatedQueueClient(connB).LeaseJobs(ctx, leaseRequest)
+	assert.Empty(t, err)
+	assert.Equal(t, 0, len(leasedB.Job))
+
+	leasedA, err := api.NewAggregatedQueueClient(connA).LeaseJobs(ctx, leaseRequest)
+	assert.Empty(t, err)
+	assert.Equal(t, 1, len(leasedA.Job))
+	assert.Equal(t, jobId, leasedA.Job[0].Id)
+
+	for _, key := range minidb.Keys() {
+		assert.True(t, strings.HasPrefix(key, "A:") || strings.HasPrefix(key, "B:"), key)
+	}
+}
+
+func TestCorrelationId_ClientProvidedIdIsLoggedForSubmitAndLeaseOfJob(t *testing.T) {
+	hook := logtest.NewGlobal()
+	withRunningServer(func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context) {
+		_, err := client.CreateQueue(ctx, &api.Queue{Name: "test", PriorityFactor: 1})
+		assert.Empty(t, err)
+
+		cpu, _ := resource.ParseQuantity("1")
+		memory, _ := resource.ParseQuantity("512Mi")
+		hook.Reset()
+
+		var header metadata.MD
+		submitCtx := metadata.AppendToOutgoingContext(ctx, logging.CorrelationIdHeader, "submit-correlation-id")
+		response, err := client.SubmitJobs(submitCtx, &api.JobSubmitRequest{
+			JobRequestItems: []*api.JobSubmitRequestItem{jobRequestItem(cpu, memory)},
+			Queue:           "test",
+			JobSetId:        "set",
+		}, grpc.Header(&header))
+		assert.Empty(t, err)
+		assert.Equal(t, []string{"submit-correlation-id"}, header.Get(logging.CorrelationIdHeader))
+		jobId := response.JobResponseItems[0].JobId
+
+		leaseCtx := metadata.AppendToOutgoingContext(ctx, logging.CorrelationIdHeader, "lease-correlation-id")
+		leased, err := leaseClient.LeaseJobs(leaseCtx, &api.LeaseRequest{
+			ClusterId: "test-cluster",
+			Resources: common.ComputeResources{"cpu": cpu, "memory": memory},
+		})
+		assert.Empty(t, err)
+		assert.Equal(t, 1, len(leased.Job))
+		assert.Equal(t, "submit-correlation-id", leased.Job[0].CorrelationId)
+
+		submitLogged, leaseLogged := false, false
+		for _, entry := range hook.AllEntries() {
+			ids, _ := entry.Data["jobIds"].([]string)
+			if len(ids) != 1 || ids[0] != jobId {
+				continue
+			}
+			if entry.Data["correlationId"] == "submit-correlation-id" {
+				submitLogged = true
+			}
+			if entry.Data["correlationId"] == "lease-correlation-id" && entry.Data["jobCorrelationId"] == "submit-correlation-id" {
+				leaseLogged = true
+			}
+		}
+		assert.True(t, submitLogged, "submission of the job must be logged with its correlation id")
+		assert.True(t, leaseLogged, "lease of the job must be logged with correlation id of its submission")
+	})
+}
+
 func SubmitJob(client api.SubmitClient, ctx context.Context, cpu resource.Quantity, memory resource.Quantity, t *testing.T) string {
 	request := &api.JobSubmitRequest{
 		JobRequestItems: []*api.JobSubmitRequestItem{
@@ -126,49 +845,111 @@ func SubmitJob(client api.SubmitClient, ctx context.Context, cpu resource.Quanti
 	return response.JobResponseItems[0].JobId
 }
 
+func jobRequestItem(cpu resource.Quantity, memory resource.Quantity) *api.JobSubmitRequestItem {
+	return &api.JobSubmitRequestItem{
+		PodSpec: &v1.PodSpec{
+			Containers: []v1.Container{{
+				Name:  "Container1",
+				Image: "index.docker.io/library/ubuntu:latest",
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{"cpu": cpu, "memory": memory},
+					Limits:   v1.ResourceList{"cpu": cpu, "memory": memory},
+				},
+			}},
+		},
+	}
+}
+
+func submitLabeledJob(client api.SubmitClient, ctx context.Context, queue string, labels map[string]string, cpu resource.Quantity, memory resource.Quantity, t *testing.T) string {
+	request := &api.JobSubmitRequest{
+		JobRequestItems: []*api.JobSubmitRequestItem{
+			{
+				Labels: labels,
+				PodSpec: &v1.PodSpec{
+					Containers: []v1.Container{{
+						Name:  "Container1",
+						Image: "index.docker.io/library/ubuntu:latest",
+						Resources: v1.ResourceRequirements{
+							Requests: v1.ResourceList{"cpu": cpu, "memory": memory},
+							Limits:   v1.ResourceList{"cpu": cpu, "memory": memory},
+						},
+					}},
+				},
+			},
+		},
+		Queue:    queue,
+		JobSetId: "set",
+	}
+	response, err := client.SubmitJobs(ctx, request)
+	assert.Empty(t, err)
+	return response.JobResponseItems[0].JobId
+}
+
 func withRunningServer(action func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context)) {
+	withRunningServerConfig(func(config *configuration.ArmadaConfig) {}, action)
+}
+
+func withRunningServerConfig(
+	configure func(config *configuration.ArmadaConfig),
+	action func(client api.SubmitClient, leaseClient api.AggregatedQueueClient, ctx context.Context)) {
+
 	minidb, err := miniredis.Run()
 	if err != nil {
 		panic(err)
 	}
 	defer minidb.Close()
 
-	// cleanup prometheus in case there are registered metrics already present
-	prometheus.DefaultRegisterer = prometheus.NewRegistry()
-	shutdown, _ := Serve(&configuration.ArmadaConfig{
+	config := testServerConfig(minidb.Addr(), 50052)
+	configure(config)
+	conn, shutdown := serveTestServer(config, nil)
+	defer shutdown()
+	defer conn.Close()
+
+	setupServer(conn)
+	client := api.NewSubmitClient(conn)
+	leaseClient := api.NewAggregatedQueueClient(conn)
+	ctx := context.Background()
+
+	action(client, leaseClient, ctx)
+}
+
+func testServerConfig(redisAddr string, grpcPort uint16) *configuration.ArmadaConfig {
+	return &configuration.ArmadaConfig{
 		AnonymousAuth: true,
-		GrpcPort:      50052,
+		GrpcPort:      grpcPort,
 		Redis: redis.UniversalOptions{
-			Addrs: []string{minidb.Addr()},
+			Addrs: []string{redisAddr},
 			DB:    0,
 		},
 		PermissionGroupMapping: map[permissions.Permission][]string{
-			permissions.ExecuteJobs:    {"everyone"},
-			permissions.SubmitJobs:     {"everyone"},
-			permissions.SubmitAnyJobs:  {"everyone"},
-			permissions.CreateQueue:    {"everyone"},
-			permissions.CancelJobs:     {"everyone"},
-			permissions.CancelAnyJobs:  {"everyone"},
-			permissions.WatchAllEvents: {"everyone"},
+			permissions.ExecuteJobs:      {"everyone"},
+			permissions.SubmitJobs:       {"everyone"},
+			permissions.SubmitAnyJobs:    {"everyone"},
+			permissions.CreateQueue:      {"everyone"},
+			permissions.CancelJobs:       {"everyone"},
+			permissions.CancelAnyJobs:    {"everyone"},
+			permissions.WatchAllEvents:   {"everyone"},
+			permissions.MigrateJobs:      {"everyone"},
+			permissions.GetClusterLeases: {"everyone"},
 		},
 		Scheduling: configuration.SchedulingConfig{
 			QueueLeaseBatchSize: 100,
+			// leases don't expire while a test checks them
+			Lease: configuration.LeaseSettings{ExpireAfter: time.Minute, ExpiryLoopInterval: time.Second},
 		},
-	})
-	defer shutdown()
+	}
+}
+
+func serveTestServer(config *configuration.ArmadaConfig, reloadConfig func() (*configuration.ArmadaConfig, error)) (*grpc.ClientConn, func()) {
+	// cleanup prometheus in case there are registered metrics already present
+	prometheus.DefaultRegisterer = prometheus.NewRegistry()
+	shutdown, _ := Serve(config, reloadConfig)
 
-	conn, err := grpc.Dial("localhost:50052", grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.WaitForReady(true)))
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", config.GrpcPort), grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.WaitForReady(true)))
 	if err != nil {
 		log.Fatalf("did not connect: %v", err)
 	}
-	defer conn.Close()
-
-	setupServer(conn)
-	client := api.NewSubmitClient(conn)
-	leaseClient := api.NewAggregatedQueueClient(conn)
-	ctx := context.Background()
-
-	action(client, leaseClient, ctx)
+	return conn, shutdown
 }
 
 func setupServer(conn *grpc.ClientConn) {
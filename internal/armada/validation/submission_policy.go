@@ -0,0 +1,151 @@
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/validation"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// Rule checks a job against a submission policy and returns description of every violation found.
+type Rule func(policy *configuration.SubmissionPolicy, job *api.Job) []string
+
+var DefaultRules = []Rule{
+	maxResourcesRule,
+	disallowedImagesRule,
+	requiredLabelsRule,
+	hostPathRule,
+	activeDeadlineRule,
+	secretPrefixesRule,
+}
+
+type SubmissionValidator struct {
+	config configuration.SubmissionPolicyConfig
+	rules  []Rule
+}
+
+func NewSubmissionValidator(config configuration.SubmissionPolicyConfig, rules ...Rule) *SubmissionValidator {
+	if len(rules) == 0 {
+		rules = DefaultRules
+	}
+	return &SubmissionValidator{config: config, rules: rules}
+}
+
+func (v *SubmissionValidator) Validate(job *api.Job) error {
+	policy := v.policyFor(job.Queue)
+	violations := []string{}
+	for _, rule := range v.rules {
+		violations = append(violations, rule(policy, job)...)
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("job violates submission policy of queue %s: %s", job.Queue, strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+func (v *SubmissionValidator) policyFor(queue string) *configuration.SubmissionPolicy {
+	if policy, ok := v.config.Queues[queue]; ok {
+		return &policy
+	}
+	return &v.config.Default
+}
+
+func maxResourcesRule(policy *configuration.SubmissionPolicy, job *api.Job) []string {
+	violations := []string{}
+	request := common.TotalResourceRequest(job.PodSpec).AsFloat()
+
+	resources := make([]string, 0, len(policy.MaxResourcesPerJob))
+	for resource := range policy.MaxResourcesPerJob {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	for _, resource := range resources {
+		limit := policy.MaxResourcesPerJob[resource]
+		if requested, ok := request[resource]; ok && requested > limit {
+			violations = append(violations, fmt.Sprintf("%s request of %v exceeds the limit of %v", resource, requested, limit))
+		}
+	}
+	return violations
+}
+
+func disallowedImagesRule(policy *configuration.SubmissionPolicy, job *api.Job) []string {
+	violations := []string{}
+	for _, container := range allContainers(job.PodSpec) {
+		for _, disallowed := range policy.DisallowedImages {
+			if strings.HasPrefix(container.Image, disallowed) {
+				violations = append(violations, fmt.Sprintf("image %s of container %s is not allowed", container.Image, container.Name))
+			}
+		}
+	}
+	return violations
+}
+
+func requiredLabelsRule(policy *configuration.SubmissionPolicy, job *api.Job) []string {
+	violations := []string{}
+	for _, label := range policy.RequiredLabels {
+		if _, ok := job.Labels[label]; !ok {
+			violations = append(violations, fmt.Sprintf("required label %s is missing", label))
+		}
+	}
+	return violations
+}
+
+func hostPathRule(policy *configuration.SubmissionPolicy, job *api.Job) []string {
+	violations := []string{}
+	if !policy.ForbidHostPath {
+		return violations
+	}
+	for _, volume := range job.PodSpec.Volumes {
+		if volume.HostPath != nil {
+			violations = append(violations, fmt.Sprintf("volume %s mounts host path %s", volume.Name, volume.HostPath.Path))
+		}
+	}
+	return violations
+}
+
+func activeDeadlineRule(policy *configuration.SubmissionPolicy, job *api.Job) []string {
+	violations := []string{}
+	deadline := job.PodSpec.ActiveDeadlineSeconds
+	if policy.MaxActiveDeadline <= 0 || deadline == nil {
+		return violations
+	}
+	if time.Duration(*deadline)*time.Second > policy.MaxActiveDeadline {
+		violations = append(violations, fmt.Sprintf("activeDeadlineSeconds of %d exceeds the limit of %d", *deadline, int64(policy.MaxActiveDeadline.Seconds())))
+	}
+	return violations
+}
+
+func secretPrefixesRule(policy *configuration.SubmissionPolicy, job *api.Job) []string {
+	violations := []string{}
+	if len(policy.AllowedSecretPrefixes) == 0 {
+		return violations
+	}
+	for _, secret := range validation.ReferencedSecrets(job.PodSpec) {
+		if !hasAnyPrefix(secret, policy.AllowedSecretPrefixes) {
+			violations = append(violations, fmt.Sprintf("secret %s is not allowed, secret names must start with one of %s",
+				secret, strings.Join(policy.AllowedSecretPrefixes, ", ")))
+		}
+	}
+	return violations
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func allContainers(spec *v1.PodSpec) []v1.Container {
+	return append(append([]v1.Container{}, spec.InitContainers...), spec.Containers...)
+}
@@ -0,0 +1,150 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_Validate_RejectsJobOverQueueResourceLimit(t *testing.T) {
+	validator := NewSubmissionValidator(configuration.SubmissionPolicyConfig{
+		Default: configuration.SubmissionPolicy{MaxResourcesPerJob: map[string]float64{"cpu": 64}},
+		Queues: map[string]configuration.SubmissionPolicy{
+			"small": {MaxResourcesPerJob: map[string]float64{"cpu": 32}},
+		},
+	})
+
+	e := validator.Validate(jobWithCpu("small", "128"))
+	assert.Error(t, e)
+	assert.Contains(t, e.Error(), "cpu request of 128 exceeds the limit of 32")
+
+	assert.NoError(t, validator.Validate(jobWithCpu("small", "32")))
+	assert.NoError(t, validator.Validate(jobWithCpu("other", "64")))
+	assert.Error(t, validator.Validate(jobWithCpu("other", "65")))
+}
+
+func Test_Validate_RejectsDisallowedImages(t *testing.T) {
+	validator := NewSubmissionValidator(configuration.SubmissionPolicyConfig{
+		Default: configuration.SubmissionPolicy{DisallowedImages: []string{"evil.io/"}},
+	})
+
+	job := jobWithCpu("test", "1")
+	assert.NoError(t, validator.Validate(job))
+
+	job.PodSpec.InitContainers = []v1.Container{{Name: "init", Image: "evil.io/miner:latest"}}
+	e := validator.Validate(job)
+	assert.Error(t, e)
+	assert.Contains(t, e.Error(), "image evil.io/miner:latest of container init is not allowed")
+}
+
+func Test_Validate_RequiresLabels(t *testing.T) {
+	validator := NewSubmissionValidator(configuration.SubmissionPolicyConfig{
+		Default: configuration.SubmissionPolicy{RequiredLabels: []string{"team"}},
+	})
+
+	job := jobWithCpu("test", "1")
+	e := validator.Validate(job)
+	assert.Error(t, e)
+	assert.Contains(t, e.Error(), "required label team is missing")
+
+	job.Labels = map[string]string{"team": "research"}
+	assert.NoError(t, validator.Validate(job))
+}
+
+func Test_Validate_ForbidsHostPath(t *testing.T) {
+	validator := NewSubmissionValidator(configuration.SubmissionPolicyConfig{
+		Default: configuration.SubmissionPolicy{ForbidHostPath: true},
+	})
+
+	job := jobWithCpu("test", "1")
+	job.PodSpec.Volumes = []v1.Volume{{
+		Name:         "host",
+		VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/etc"}},
+	}}
+	e := validator.Validate(job)
+	assert.Error(t, e)
+	assert.Contains(t, e.Error(), "volume host mounts host path /etc")
+}
+
+func Test_Validate_RejectsActiveDeadlineOverLimit(t *testing.T) {
+	validator := NewSubmissionValidator(configuration.SubmissionPolicyConfig{
+		Default: configuration.SubmissionPolicy{MaxActiveDeadline: time.Hour},
+	})
+
+	job := jobWithCpu("test", "1")
+	assert.NoError(t, validator.Validate(job))
+
+	deadline := int64(3600)
+	job.PodSpec.ActiveDeadlineSeconds = &deadline
+	assert.NoError(t, validator.Validate(job))
+
+	deadline = 3601
+	e := validator.Validate(job)
+	assert.Error(t, e)
+	assert.Contains(t, e.Error(), "activeDeadlineSeconds of 3601 exceeds the limit of 3600")
+}
+
+func Test_Validate_RejectsSecretsOutsideAllowedPrefixes(t *testing.T) {
+	validator := NewSubmissionValidator(configuration.SubmissionPolicyConfig{
+		Queues: map[string]configuration.SubmissionPolicy{
+			"research": {AllowedSecretPrefixes: []string{"research-", "shared-"}},
+		},
+	})
+
+	job := jobWithCpu("research", "1")
+	job.PodSpec.Volumes = []v1.Volume{{
+		Name:         "credentials",
+		VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "research-credentials"}},
+	}}
+	job.PodSpec.Containers[0].EnvFrom = []v1.EnvFromSource{{
+		SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "shared-config"}},
+	}}
+	assert.NoError(t, validator.Validate(job))
+
+	job.PodSpec.Containers[0].Env = []v1.EnvVar{{Name: "TOKEN", ValueFrom: &v1.EnvVarSource{
+		SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "finance-token"}, Key: "token"},
+	}}}
+	e := validator.Validate(job)
+	assert.Error(t, e)
+	assert.Contains(t, e.Error(), "secret finance-token is not allowed, secret names must start with one of research-, shared-")
+
+	job.Queue = "other"
+	assert.NoError(t, validator.Validate(job))
+}
+
+func Test_Validate_ReportsEveryViolation(t *testing.T) {
+	validator := NewSubmissionValidator(configuration.SubmissionPolicyConfig{
+		Default: configuration.SubmissionPolicy{
+			MaxResourcesPerJob: map[string]float64{"cpu": 1},
+			RequiredLabels:     []string{"team"},
+		},
+	})
+
+	e := validator.Validate(jobWithCpu("test", "2"))
+	assert.Error(t, e)
+	assert.Contains(t, e.Error(), "cpu request of 2 exceeds the limit of 1")
+	assert.Contains(t, e.Error(), "required label team is missing")
+}
+
+func jobWithCpu(queue string, cpu string) *api.Job {
+	resources := v1.ResourceList{"cpu": resource.MustParse(cpu)}
+	return &api.Job{
+		Queue: queue,
+		PodSpec: &v1.PodSpec{
+			Containers: []v1.Container{{
+				Name:  "container",
+				Image: "index.docker.io/library/ubuntu:latest",
+				Resources: v1.ResourceRequirements{
+					Requests: resources,
+					Limits:   resources,
+				},
+			}},
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+type NotificationType string
+
+const (
+	Submitted NotificationType = "submitted"
+	Leased    NotificationType = "leased"
+	Succeeded NotificationType = "succeeded"
+	Failed    NotificationType = "failed"
+	Cancelled NotificationType = "cancelled"
+)
+
+// Notification is the JSON payload posted to webhooks on state transitions of jobs.
+type Notification struct {
+	Type      NotificationType `json:"type"`
+	Time      time.Time        `json:"time"`
+	Queue     string           `json:"queue"`
+	JobSetId  string           `json:"jobSetId"`
+	JobId     string           `json:"jobId"`
+	ClusterId string           `json:"clusterId,omitempty"`
+	Reason    string           `json:"reason,omitempty"`
+}
+
+// notificationOf returns notification of the event, nil for events which are not notified.
+func notificationOf(event api.Event) *Notification {
+	notification := &Notification{
+		Time:     event.GetCreated(),
+		Queue:    event.GetQueue(),
+		JobSetId: event.GetJobSetId(),
+		JobId:    event.GetJobId(),
+	}
+	switch e := event.(type) {
+	case *api.JobSubmittedEvent:
+		notification.Type = Submitted
+	case *api.JobLeasedEvent:
+		notification.Type = Leased
+		notification.ClusterId = e.ClusterId
+	case *api.JobSucceededEvent:
+		notification.Type = Succeeded
+		notification.ClusterId = e.ClusterId
+	case *api.JobFailedEvent:
+		notification.Type = Failed
+		notification.ClusterId = e.ClusterId
+		notification.Reason = e.Reason
+	case *api.JobCancelledEvent:
+		notification.Type = Cancelled
+		notification.Reason = e.Reason
+	default:
+		return nil
+	}
+	return notification
+}
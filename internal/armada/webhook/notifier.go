@@ -0,0 +1,191 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+var droppedNotificationsCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "armada_webhook_notifications_dropped_total",
+	Help: "Number of webhook notifications dropped because the notification buffer was full",
+})
+
+var failedDeliveriesCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "armada_webhook_deliveries_failed_total",
+	Help: "Number of webhook notifications not delivered after all attempts",
+})
+
+// CallbackUrlSource provides URLs job sets registered to be notified of state transitions of their jobs.
+type CallbackUrlSource interface {
+	GetJobSetCallbackUrl(queue, jobSetId string) (string, error)
+}
+
+// Notifier posts notifications of state transitions of jobs to the configured URL and to callback URLs of their job sets.
+// Notifications are buffered and delivered by background workers, when the buffer is full notifications are dropped
+// and counted rather than blocking the caller. Failed deliveries are retried with exponential backoff.
+type Notifier struct {
+	config        *configuration.WebhookConfig
+	callbackUrls  CallbackUrlSource
+	client        *http.Client
+	notifications chan *Notification
+	stopping      chan struct{}
+	wg            *sync.WaitGroup
+}
+
+func NewNotifier(config *configuration.WebhookConfig, callbackUrls CallbackUrlSource) *Notifier {
+	client := &http.Client{
+		Timeout: config.Timeout,
+		// redirects are not followed, so a callback URL can't lead to a host which is not allowed
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	notifier := &Notifier{
+		config:        config,
+		callbackUrls:  callbackUrls,
+		client:        client,
+		notifications: make(chan *Notification, config.BufferSize),
+		stopping:      make(chan struct{}),
+		wg:            &sync.WaitGroup{},
+	}
+	workers := config.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	for i := 0; i < workers; i++ {
+		notifier.wg.Add(1)
+		go notifier.run()
+	}
+	return notifier
+}
+
+// Notify queues notifications of the events which change state of their jobs, other events are ignored.
+func (n *Notifier) Notify(messages []*api.EventMessage) {
+	for _, message := range messages {
+		event, e := api.UnwrapEvent(message)
+		if e != nil {
+			continue
+		}
+		notification := notificationOf(event)
+		if notification == nil {
+			continue
+		}
+		select {
+		case n.notifications <- notification:
+		default:
+			droppedNotificationsCounter.Inc()
+			log.Warnf("Webhook buffer is full, dropping %s notification of job %s", notification.Type, notification.JobId)
+		}
+	}
+}
+
+// Stop delivers buffered notifications without further retries, Notify must not be called after Stop.
+func (n *Notifier) Stop() {
+	close(n.stopping)
+	close(n.notifications)
+	n.wg.Wait()
+}
+
+func (n *Notifier) run() {
+	defer n.wg.Done()
+	for notification := range n.notifications {
+		n.deliver(notification)
+	}
+}
+
+func (n *Notifier) deliver(notification *Notification) {
+	body, e := json.Marshal(notification)
+	if e != nil {
+		log.Errorf("Failed to serialise webhook notification of job %s: %v", notification.JobId, e)
+		return
+	}
+	for _, url := range n.urlsOf(notification) {
+		e := n.post(url, body)
+		if e != nil {
+			failedDeliveriesCounter.Inc()
+			log.Errorf("Failed to deliver %s notification of job %s to %s: %v", notification.Type, notification.JobId, url, e)
+		}
+	}
+}
+
+func (n *Notifier) urlsOf(notification *Notification) []string {
+	urls := []string{}
+	if n.config.Url != "" {
+		urls = append(urls, n.config.Url)
+	}
+	callbackUrl, e := n.callbackUrls.GetJobSetCallbackUrl(notification.Queue, notification.JobSetId)
+	if e != nil {
+		log.Errorf("Failed to load callback URL of job set %s: %v", notification.JobSetId, e)
+	} else if callbackUrl != "" && callbackUrl != n.config.Url {
+		// callback hosts may have changed since the URL was stored
+		if e := ValidateCallbackUrl(n.config, callbackUrl); e != nil {
+			log.Warnf("Not notifying callback URL of job set %s: %v", notification.JobSetId, e)
+		} else {
+			urls = append(urls, callbackUrl)
+		}
+	}
+	return urls
+}
+
+// ValidateCallbackUrl checks the URL is an absolute http or https URL on one of the configured callback hosts.
+func ValidateCallbackUrl(config *configuration.WebhookConfig, callbackUrl string) error {
+	u, e := url.Parse(callbackUrl)
+	if e != nil {
+		return e
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme must be http or https, got %q", u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("host is not specified")
+	}
+	for _, host := range config.CallbackHosts {
+		if strings.EqualFold(host, u.Hostname()) {
+			return nil
+		}
+	}
+	return fmt.Errorf("host %s is not one of the callback hosts", u.Hostname())
+}
+
+// post sends the notification up to MaxAttempts times, doubling the wait between attempts.
+func (n *Notifier) post(url string, body []byte) error {
+	backoff := n.config.InitialBackoff
+	var e error
+	for attempt := 1; ; attempt++ {
+		e = n.postOnce(url, body)
+		if e == nil || attempt >= n.config.MaxAttempts {
+			return e
+		}
+		select {
+		case <-n.stopping:
+			return e
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (n *Notifier) postOnce(url string, body []byte) error {
+	response, e := n.client.Post(url, "application/json", bytes.NewReader(body))
+	if e != nil {
+		return e
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", response.Status)
+	}
+	return nil
+}
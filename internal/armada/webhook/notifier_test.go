@@ -0,0 +1,181 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestNotifier_PostsLeaseAndCompletionToCallbackUrlOfJobSet(t *testing.T) {
+	receiver := newReceiver(0)
+	defer receiver.server.Close()
+
+	notifier := NewNotifier(testConfig(""), fakeCallbackUrls{"queue1:set1": receiver.server.URL})
+	notifier.Notify([]*api.EventMessage{
+		{Events: &api.EventMessage_Leased{Leased: &api.JobLeasedEvent{JobId: "job1", JobSetId: "set1", Queue: "queue1", ClusterId: "cluster1"}}},
+		{Events: &api.EventMessage_Running{Running: &api.JobRunningEvent{JobId: "job1", JobSetId: "set1", Queue: "queue1"}}},
+		{Events: &api.EventMessage_Succeeded{Succeeded: &api.JobSucceededEvent{JobId: "job1", JobSetId: "set1", Queue: "queue1", ClusterId: "cluster1"}}},
+		{Events: &api.EventMessage_Leased{Leased: &api.JobLeasedEvent{JobId: "job2", JobSetId: "set2", Queue: "queue1"}}},
+	})
+	notifier.Stop()
+
+	notifications := receiver.received()
+	assert.Equal(t, 2, len(notifications))
+	assert.Equal(t, Leased, notifications[0].Type)
+	assert.Equal(t, Succeeded, notifications[1].Type)
+	for _, n := range notifications {
+		assert.Equal(t, "job1", n.JobId)
+		assert.Equal(t, "set1", n.JobSetId)
+		assert.Equal(t, "queue1", n.Queue)
+		assert.Equal(t, "cluster1", n.ClusterId)
+	}
+}
+
+func TestNotifier_RetriesFailedDelivery(t *testing.T) {
+	receiver := newReceiver(2)
+	defer receiver.server.Close()
+
+	before := testutil.ToFloat64(failedDeliveriesCounter)
+
+	notifier := NewNotifier(testConfig(receiver.server.URL), fakeCallbackUrls{})
+	notifier.Notify([]*api.EventMessage{
+		{Events: &api.EventMessage_Failed{Failed: &api.JobFailedEvent{JobId: "job1", JobSetId: "set1", Queue: "queue1", Reason: "OOMKilled"}}},
+	})
+	receiver.waitForAttempts(t, 3)
+	notifier.Stop()
+
+	notifications := receiver.received()
+	assert.Equal(t, 1, len(notifications))
+	assert.Equal(t, Failed, notifications[0].Type)
+	assert.Equal(t, "OOMKilled", notifications[0].Reason)
+	assert.Equal(t, 3, receiver.attemptCount())
+	assert.Equal(t, 0.0, testutil.ToFloat64(failedDeliveriesCounter)-before)
+}
+
+func TestNotifier_CountsDeliveryFailingAllAttempts(t *testing.T) {
+	receiver := newReceiver(10)
+	defer receiver.server.Close()
+
+	before := testutil.ToFloat64(failedDeliveriesCounter)
+
+	notifier := NewNotifier(testConfig(receiver.server.URL), fakeCallbackUrls{})
+	notifier.Notify([]*api.EventMessage{
+		{Events: &api.EventMessage_Cancelled{Cancelled: &api.JobCancelledEvent{JobId: "job1", JobSetId: "set1", Queue: "queue1"}}},
+	})
+	receiver.waitForAttempts(t, 3)
+	notifier.Stop()
+
+	assert.Equal(t, 0, len(receiver.received()))
+	assert.Equal(t, 3, receiver.attemptCount())
+	assert.Equal(t, 1.0, testutil.ToFloat64(failedDeliveriesCounter)-before)
+}
+
+func TestNotifier_SkipsCallbackUrlOutsideCallbackHosts(t *testing.T) {
+	receiver := newReceiver(0)
+	defer receiver.server.Close()
+
+	config := testConfig("")
+	config.CallbackHosts = []string{"example.com"}
+	notifier := NewNotifier(config, fakeCallbackUrls{"queue1:set1": receiver.server.URL})
+	notifier.Notify([]*api.EventMessage{
+		{Events: &api.EventMessage_Leased{Leased: &api.JobLeasedEvent{JobId: "job1", JobSetId: "set1", Queue: "queue1"}}},
+	})
+	notifier.Stop()
+
+	assert.Equal(t, 0, receiver.attemptCount())
+}
+
+func TestNotifier_DoesNotFollowRedirects(t *testing.T) {
+	receiver := newReceiver(0)
+	defer receiver.server.Close()
+	redirect := httptest.NewServer(http.RedirectHandler(receiver.server.URL, http.StatusTemporaryRedirect))
+	defer redirect.Close()
+
+	notifier := NewNotifier(testConfig(""), fakeCallbackUrls{"queue1:set1": redirect.URL})
+	notifier.Notify([]*api.EventMessage{
+		{Events: &api.EventMessage_Leased{Leased: &api.JobLeasedEvent{JobId: "job1", JobSetId: "set1", Queue: "queue1"}}},
+	})
+	notifier.Stop()
+
+	assert.Equal(t, 0, receiver.attemptCount())
+}
+
+func testConfig(url string) *configuration.WebhookConfig {
+	return &configuration.WebhookConfig{
+		Enabled:        true,
+		Url:            url,
+		CallbackHosts:  []string{"127.0.0.1"},
+		BufferSize:     10,
+		Workers:        1,
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		Timeout:        time.Second,
+	}
+}
+
+type fakeCallbackUrls map[string]string
+
+func (urls fakeCallbackUrls) GetJobSetCallbackUrl(queue, jobSetId string) (string, error) {
+	return urls[queue+":"+jobSetId], nil
+}
+
+// receiver records notifications posted to its server, failing the given number of first requests
+type receiver struct {
+	server        *httptest.Server
+	lock          sync.Mutex
+	failures      int
+	attempts      int
+	notifications []*Notification
+}
+
+func newReceiver(failures int) *receiver {
+	r := &receiver{failures: failures}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, request *http.Request) {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		r.attempts++
+		if r.attempts <= r.failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		notification := &Notification{}
+		if e := json.NewDecoder(request.Body).Decode(notification); e != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r.notifications = append(r.notifications, notification)
+	}))
+	return r
+}
+
+func (r *receiver) received() []*Notification {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.notifications
+}
+
+func (r *receiver) attemptCount() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.attempts
+}
+
+// waitForAttempts waits until the server received the number of requests, Stop abandons retries still waiting
+func (r *receiver) waitForAttempts(t *testing.T, attempts int) {
+	deadline := time.Now().Add(5 * time.Second)
+	for r.attemptCount() < attempts {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d requests, received %d", attempts, r.attemptCount())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// NotifyingEventRepository notifies webhooks of events reported to the wrapped repository,
+// the events are notified only once they are stored.
+type NotifyingEventRepository struct {
+	repository.EventRepository
+	notifier *Notifier
+}
+
+func NewNotifyingEventRepository(eventRepository repository.EventRepository, notifier *Notifier) *NotifyingEventRepository {
+	return &NotifyingEventRepository{EventRepository: eventRepository, notifier: notifier}
+}
+
+func (r *NotifyingEventRepository) ReportEvent(message *api.EventMessage) error {
+	return r.ReportEvents([]*api.EventMessage{message})
+}
+
+func (r *NotifyingEventRepository) ReportEvents(messages []*api.EventMessage) error {
+	e := r.EventRepository.ReportEvents(messages)
+	if e == nil {
+		r.notifier.Notify(messages)
+	}
+	return e
+}
@@ -1,3 +1,11 @@
 package common
 
 const PodNamePrefix string = "armada-"
+
+const GpuResourceName = "nvidia.com/gpu"
+
+// SharedGpuResourceName is GPU shared by several pods (MPS or time-slicing), jobs can request fractions of it
+const SharedGpuResourceName = "nvidia.com/gpu.shared"
+
+// GpuProductLabel is the node label with the model of node GPUs, as set by GPU feature discovery
+const GpuProductLabel = "nvidia.com/gpu.product"
@@ -0,0 +1,97 @@
+package health
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Checker reports an error when the component it checks is not healthy.
+type Checker interface {
+	Check() error
+}
+
+type CheckerFunc func() error
+
+func (f CheckerFunc) Check() error {
+	return f()
+}
+
+type namedChecker struct {
+	name    string
+	checker Checker
+}
+
+// MultiChecker is healthy only when all its checkers are healthy,
+// its error lists all failing checkers by name.
+type MultiChecker struct {
+	mutex    sync.Mutex
+	checkers []namedChecker
+}
+
+func NewMultiChecker() *MultiChecker {
+	return &MultiChecker{checkers: []namedChecker{}}
+}
+
+func (mc *MultiChecker) Add(name string, checker Checker) {
+	mc.mutex.Lock()
+	defer mc.mutex.Unlock()
+	mc.checkers = append(mc.checkers, namedChecker{name: name, checker: checker})
+}
+
+func (mc *MultiChecker) Check() error {
+	mc.mutex.Lock()
+	checkers := mc.checkers
+	mc.mutex.Unlock()
+
+	failures := []string{}
+	for _, c := range checkers {
+		if e := c.checker.Check(); e != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", c.name, e))
+		}
+	}
+	if len(failures) > 0 {
+		return errors.New(strings.Join(failures, "\n"))
+	}
+	return nil
+}
+
+// StartupCompleteChecker is unhealthy until MarkComplete is called.
+type StartupCompleteChecker struct {
+	complete int32
+}
+
+func NewStartupCompleteChecker() *StartupCompleteChecker {
+	return &StartupCompleteChecker{}
+}
+
+func (c *StartupCompleteChecker) MarkComplete() {
+	atomic.StoreInt32(&c.complete, 1)
+}
+
+func (c *StartupCompleteChecker) MarkIncomplete() {
+	atomic.StoreInt32(&c.complete, 0)
+}
+
+func (c *StartupCompleteChecker) Check() error {
+	if atomic.LoadInt32(&c.complete) == 0 {
+		return errors.New("startup is not complete")
+	}
+	return nil
+}
+
+// NewHandler responds with 503 and the error of the checker when it is not healthy.
+func NewHandler(checker Checker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if e := checker.Check(); e != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(e.Error()))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+}
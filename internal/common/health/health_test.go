@@ -0,0 +1,45 @@
+package health
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiChecker_NamesAllFailingCheckers(t *testing.T) {
+	checker := NewMultiChecker()
+	checker.Add("redis", CheckerFunc(func() error { return errors.New("connection refused") }))
+	checker.Add("tasks", CheckerFunc(func() error { return nil }))
+	checker.Add("startup", NewStartupCompleteChecker())
+
+	assert.EqualError(t, checker.Check(), "redis: connection refused\nstartup: startup is not complete")
+}
+
+func TestStartupCompleteChecker(t *testing.T) {
+	checker := NewStartupCompleteChecker()
+	assert.Error(t, checker.Check())
+
+	checker.MarkComplete()
+	assert.NoError(t, checker.Check())
+
+	checker.MarkIncomplete()
+	assert.Error(t, checker.Check())
+}
+
+func TestHandler(t *testing.T) {
+	var failure error
+	handler := NewHandler(CheckerFunc(func() error { return failure }))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/ready", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	failure = errors.New("redis: connection refused")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/ready", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Equal(t, "redis: connection refused", recorder.Body.String())
+}
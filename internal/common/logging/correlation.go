@@ -0,0 +1,65 @@
+package logging
+
+import (
+	"context"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/G-Research/armada/internal/common/util"
+)
+
+// CorrelationIdHeader is the metadata key of the correlation id, clients can set it to tie log lines
+// of their request together, the server generates an id for requests without it.
+const CorrelationIdHeader = "x-correlation-id"
+
+type correlationIdKey struct{}
+
+func WithCorrelationId(ctx context.Context, correlationId string) context.Context {
+	return context.WithValue(ctx, correlationIdKey{}, correlationId)
+}
+
+// CorrelationId returns the correlation id of the request, empty when the context has none.
+func CorrelationId(ctx context.Context) string {
+	correlationId, _ := ctx.Value(correlationIdKey{}).(string)
+	return correlationId
+}
+
+// FromContext returns log entry for lines logged while handling the request, with its correlation id when it has one.
+func FromContext(ctx context.Context) *log.Entry {
+	if correlationId := CorrelationId(ctx); correlationId != "" {
+		return log.WithField("correlationId", correlationId)
+	}
+	return log.NewEntry(log.StandardLogger())
+}
+
+// UnaryServerInterceptor adds correlation id to the context of each request and returns it in the response header.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		correlationId := incomingCorrelationId(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(CorrelationIdHeader, correlationId))
+		return handler(WithCorrelationId(ctx, correlationId), req)
+	}
+}
+
+// StreamServerInterceptor adds correlation id to the context of each stream and returns it in the stream header.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		correlationId := incomingCorrelationId(stream.Context())
+		_ = stream.SetHeader(metadata.Pairs(CorrelationIdHeader, correlationId))
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = WithCorrelationId(stream.Context(), correlationId)
+		return handler(srv, wrapped)
+	}
+}
+
+func incomingCorrelationId(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(CorrelationIdHeader); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return util.NewULID()
+}
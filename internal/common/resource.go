@@ -158,6 +158,17 @@ func (a ComputeResourcesFloat) LimitWith(limit ComputeResourcesFloat) ComputeRes
 	return targetComputeResource
 }
 
+func (a ComputeResourcesFloat) MaxWith(b ComputeResourcesFloat) ComputeResourcesFloat {
+	targetComputeResource := a.DeepCopy()
+	for key, value := range b {
+		existing, ok := targetComputeResource[key]
+		if !ok || value > existing {
+			targetComputeResource[key] = value
+		}
+	}
+	return targetComputeResource
+}
+
 //The merged in values take precedence and override existing values for the same key
 func (a ComputeResourcesFloat) MergeWith(merged ComputeResourcesFloat) ComputeResourcesFloat {
 	targetComputeResource := a.DeepCopy()
@@ -188,6 +199,7 @@ func (a ComputeResourcesFloat) Mul(factor float64) ComputeResourcesFloat {
 // - containers run in parallel (so need to sum resources)
 // - init containers run sequentially (so only their individual resource need be considered)
 //So pod resource usage is the max for each resource type (cpu/memory etc) that could be used at any given time
+//Pod overhead is added on top of that, the same way Kubernetes does when scheduling the pod
 func TotalResourceRequest(podSpec *v1.PodSpec) ComputeResources {
 	totalResources := make(ComputeResources)
 	for _, container := range podSpec.Containers {
@@ -199,6 +211,8 @@ func TotalResourceRequest(podSpec *v1.PodSpec) ComputeResources {
 		containerResource := FromResourceList(initContainer.Resources.Requests)
 		totalResources.Max(containerResource)
 	}
+
+	totalResources.Add(FromResourceList(podSpec.Overhead))
 	return totalResources
 }
 
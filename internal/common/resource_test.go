@@ -88,6 +88,22 @@ func TestTotalResourceRequest_ShouldCombineMaxInitContainerResourcesWithSummedCo
 	assert.Equal(t, result, FromResourceList(expectedResult))
 }
 
+func TestTotalResourceRequest_ShouldMatchKubernetesEffectivePodRequest(t *testing.T) {
+	containerResource := makeContainerResource(2, 4)
+	sidecarResource := makeContainerResource(1, 1)
+	initResource := makeContainerResource(4, 2)
+
+	pod := makePodWithResource([]*v1.ResourceList{&containerResource, &sidecarResource}, []*v1.ResourceList{&initResource})
+	pod.Spec.Overhead = makeContainerResource(1, 1)
+
+	//Cpu is max(2 + 1, 4) + 1 overhead = 5
+	//Memory is max(4 + 1, 2) + 1 overhead = 6
+	expectedResult := makeContainerResource(5, 6)
+
+	result := TotalResourceRequest(&pod.Spec)
+	assert.Equal(t, FromResourceList(expectedResult).AsFloat(), result.AsFloat())
+}
+
 func makeDefaultNodeResource() v1.ResourceList {
 	cpuResource := resource.NewQuantity(100, resource.DecimalSI)
 	memoryResource := resource.NewQuantity(50*1024*1024*1024, resource.DecimalSI)
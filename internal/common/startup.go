@@ -26,32 +26,40 @@ func BindCommandlineArguments() {
 }
 
 func LoadConfig(config interface{}, defaultPath string, overrideConfig string) {
-	viper.SetConfigName("config")
-	viper.AddConfigPath(defaultPath)
-	if err := viper.ReadInConfig(); err != nil {
+	err := readConfig(viper.GetViper(), config, defaultPath, overrideConfig)
+	if err != nil {
 		log.Error(err)
 		os.Exit(-1)
 	}
+}
+
+// ReloadConfig reads the configuration again from the same sources as LoadConfig,
+// returning an error instead of exiting when the configuration can't be read.
+func ReloadConfig(config interface{}, defaultPath string, overrideConfig string) error {
+	return readConfig(viper.New(), config, defaultPath, overrideConfig)
+}
+
+func readConfig(v *viper.Viper, config interface{}, defaultPath string, overrideConfig string) error {
+	v.SetConfigName("config")
+	v.AddConfigPath(defaultPath)
+	if err := v.ReadInConfig(); err != nil {
+		return err
+	}
 
 	if overrideConfig != "" {
-		viper.SetConfigFile(overrideConfig)
+		v.SetConfigFile(overrideConfig)
 
-		err := viper.MergeInConfig()
+		err := v.MergeInConfig()
 		if err != nil {
-			log.Error(err)
-			os.Exit(-1)
+			return err
 		}
 	}
 
-	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
-	viper.SetEnvPrefix("ARMADA")
-	viper.AutomaticEnv()
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.SetEnvPrefix("ARMADA")
+	v.AutomaticEnv()
 
-	err := viper.Unmarshal(config)
-	if err != nil {
-		log.Error(err)
-		os.Exit(-1)
-	}
+	return v.Unmarshal(config)
 }
 func ConfigureCommandLineLogging() {
 	commandLineFormatter := new(logging.CommandLineFormatter)
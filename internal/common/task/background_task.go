@@ -1,7 +1,9 @@
 package task
 
 import (
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -13,6 +15,8 @@ type task struct {
 	interval    time.Duration
 	metricName  string
 	stopChannel chan bool
+	// unix time in nanoseconds when the current run started, 0 when the task is not running, accessed atomically
+	runningSince int64
 }
 
 // BackgroundTaskManager is not threadsafe, it should only be accessed from a single thread.
@@ -56,10 +60,7 @@ func (m *BackgroundTaskManager) startBackgroundTask(task *task) {
 
 	m.wg.Add(1)
 	go func() {
-		start := time.Now()
-		task.function()
-		duration := time.Since(start)
-		taskDurationHistogram.Observe(duration.Seconds())
+		runTask(task, taskDurationHistogram)
 
 		for {
 			select {
@@ -68,14 +69,37 @@ func (m *BackgroundTaskManager) startBackgroundTask(task *task) {
 				m.wg.Done()
 				return
 			}
-			innerStart := time.Now()
-			task.function()
-			innerDuration := time.Since(innerStart)
-			taskDurationHistogram.Observe(innerDuration.Seconds())
+			runTask(task, taskDurationHistogram)
 		}
 	}()
 }
 
+func runTask(task *task, taskDurationHistogram prometheus.Histogram) {
+	start := time.Now()
+	atomic.StoreInt64(&task.runningSince, start.UnixNano())
+	task.function()
+	atomic.StoreInt64(&task.runningSince, 0)
+	taskDurationHistogram.Observe(time.Since(start).Seconds())
+}
+
+// CheckNotHung returns an error when any task has been running longer than hungTimeout, zero timeout disables the check.
+// It is safe to call while tasks are running, but not concurrently with Register.
+func (m *BackgroundTaskManager) CheckNotHung(hungTimeout time.Duration) error {
+	if hungTimeout <= 0 {
+		return nil
+	}
+	for _, task := range m.tasks {
+		runningSince := atomic.LoadInt64(&task.runningSince)
+		if runningSince == 0 {
+			continue
+		}
+		if running := time.Since(time.Unix(0, runningSince)); running > hungTimeout {
+			return fmt.Errorf("background task %s has been running for %s", task.metricName, running)
+		}
+	}
+	return nil
+}
+
 func (m *BackgroundTaskManager) waitForShutdownCompletion(timeout time.Duration) bool {
 	c := make(chan struct{})
 	go func() {
@@ -0,0 +1,27 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckNotHung_FailsForTaskRunningLongerThanTimeout(t *testing.T) {
+	manager := NewBackgroundTaskManager("test_hung_")
+	release := make(chan bool)
+	started := make(chan bool)
+	manager.Register(func() {
+		started <- true
+		<-release
+	}, time.Hour, "blocking_task")
+	<-started
+
+	time.Sleep(10 * time.Millisecond)
+	assert.Error(t, manager.CheckNotHung(time.Millisecond))
+	assert.Nil(t, manager.CheckNotHung(time.Hour))
+	assert.Nil(t, manager.CheckNotHung(0))
+
+	close(release)
+	manager.StopAll(time.Second)
+}
@@ -0,0 +1,16 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/G-Research/armada"
+
+// StartSpan starts a span as a child of the span (or remote parent) carried by the context, the returned context carries the new span.
+// Spans are created by the global OpenTelemetry TracerProvider, which is a no-op until a provider is set on startup.
+func StartSpan(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, options...)
+}
@@ -0,0 +1,61 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestStartSpan_IsNoopByDefault(t *testing.T) {
+	_, span := StartSpan(context.Background(), "span")
+	span.SetAttributes(attribute.String("key", "value"))
+	span.End()
+
+	assert.False(t, span.IsRecording())
+	assert.False(t, span.SpanContext().IsValid())
+}
+
+func TestUnaryServerInterceptor_ContinuesPropagatedTrace(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	defer otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+
+	traceParent := "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("traceparent", traceParent))
+
+	var handlerSpanContext trace.SpanContext
+	interceptor := UnaryServerInterceptor(func(span trace.Span, request interface{}, response interface{}) {
+		span.SetAttributes(attribute.String("request", request.(string)), attribute.String("response", response.(string)))
+	})
+	_, e := interceptor(ctx, "req", &grpc.UnaryServerInfo{FullMethod: "/api.Test/Method"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerSpanContext = trace.SpanContextFromContext(ctx)
+		return "resp", errors.New("failed")
+	})
+	assert.Error(t, e)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "/api.Test/Method", span.Name())
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+	assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", span.SpanContext().TraceID().String())
+	assert.Equal(t, "b7ad6b7169203331", span.Parent().SpanID().String())
+	assert.Equal(t, span.SpanContext().SpanID(), handlerSpanContext.SpanID())
+	assert.Contains(t, span.Attributes(), attribute.String("request", "req"))
+	assert.Contains(t, span.Attributes(), attribute.String("response", "resp"))
+	assert.Equal(t, codes.Error, span.Status().Code)
+	assert.Equal(t, "failed", span.Status().Description)
+}
@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	k8sValidation "k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/G-Research/armada/internal/common/util"
+)
+
+// Prefix of annotation keys reserved for Armada
+const reservedAnnotationPrefix = "armada/"
+
+// Annotations executors set on pods to track jobs and their reported states, users must not set them
+var reservedAnnotations = util.StringListToSet([]string{
+	"armada_jobset_id",
+	"reported_done",
+	string(v1.PodPending),
+	string(v1.PodRunning),
+	string(v1.PodSucceeded),
+	string(v1.PodFailed),
+	string(v1.PodUnknown),
+})
+
+// Kubernetes limit of the total size of pod annotations
+const maxAnnotationsSize = 256 * 1024
+
+// ValidateAnnotations checks annotations of the job can be set on its pod.
+func ValidateAnnotations(annotations map[string]string) error {
+	keys := make([]string, 0, len(annotations))
+	for key := range annotations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	size := 0
+	for _, key := range keys {
+		if strings.HasPrefix(key, reservedAnnotationPrefix) || reservedAnnotations[key] {
+			return fmt.Errorf("annotation %s is reserved", key)
+		}
+		if errs := k8sValidation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("annotation key %s is not valid: %s", key, strings.Join(errs, ", "))
+		}
+		size += len(key) + len(annotations[key])
+	}
+	if size > maxAnnotationsSize {
+		return fmt.Errorf("annotations have %d bytes, more than the limit of %d bytes", size, maxAnnotationsSize)
+	}
+	return nil
+}
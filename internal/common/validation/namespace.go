@@ -0,0 +1,15 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	k8sValidation "k8s.io/apimachinery/pkg/util/validation"
+)
+
+func ValidateNamespace(namespace string) error {
+	if errs := k8sValidation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return fmt.Errorf("namespace %s is not valid: %s", namespace, strings.Join(errs, ", "))
+	}
+	return nil
+}
@@ -15,6 +15,10 @@ func ValidatePodSpec(spec *v1.PodSpec) error {
 		return fmt.Errorf("pod spec have no containers")
 	}
 
+	if spec.ActiveDeadlineSeconds != nil && *spec.ActiveDeadlineSeconds <= 0 {
+		return fmt.Errorf("activeDeadlineSeconds must be positive, got %d", *spec.ActiveDeadlineSeconds)
+	}
+
 	for _, container := range spec.Containers {
 		if len(container.Resources.Limits) == 0 {
 			return fmt.Errorf("container %v have no resource limits specified", container.Name)
@@ -27,7 +31,7 @@ func ValidatePodSpec(spec *v1.PodSpec) error {
 			return fmt.Errorf("container %v does not havee resource request and limit equal (this is currently not supported)", container.Name)
 		}
 	}
-	return nil
+	return validateReferences(spec)
 }
 
 func resourceListEquals(a v1.ResourceList, b v1.ResourceList) bool {
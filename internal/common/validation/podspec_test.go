@@ -1,11 +1,14 @@
 package validation
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/pkg/api"
 )
 
 func Test_ValidatePodSpec_checkForMissingValues(t *testing.T) {
@@ -43,3 +46,112 @@ func Test_ValidatePodSpec_checkForResources(t *testing.T) {
 		}},
 	}))
 }
+
+func Test_ValidateNamespace(t *testing.T) {
+	assert.NoError(t, ValidateNamespace("default"))
+	assert.NoError(t, ValidateNamespace("team-a"))
+	assert.Error(t, ValidateNamespace(""))
+	assert.Error(t, ValidateNamespace("Team_A"))
+}
+
+func Test_ValidateAnnotations(t *testing.T) {
+	assert.NoError(t, ValidateAnnotations(nil))
+	assert.NoError(t, ValidateAnnotations(map[string]string{"cost-centre": "research", "sidecar.example.com/inject": "true"}))
+
+	assert.EqualError(t, ValidateAnnotations(map[string]string{"armada/queue": "other"}), "annotation armada/queue is reserved")
+	assert.EqualError(t, ValidateAnnotations(map[string]string{"armada_jobset_id": "other"}), "annotation armada_jobset_id is reserved")
+	assert.EqualError(t, ValidateAnnotations(map[string]string{"Running": "reported"}), "annotation Running is reserved")
+	assert.Error(t, ValidateAnnotations(map[string]string{"not valid": "true"}))
+	assert.Error(t, ValidateAnnotations(map[string]string{strings.Repeat("a", 64): "true"}))
+	assert.Error(t, ValidateAnnotations(map[string]string{"large": strings.Repeat("a", 256*1024)}))
+}
+
+func Test_ValidateServices(t *testing.T) {
+	spec := &v1.PodSpec{Containers: []v1.Container{
+		{Name: "notebook", Ports: []v1.ContainerPort{{ContainerPort: 8888}}},
+		{Name: "tensorboard", Ports: []v1.ContainerPort{{ContainerPort: 6006}, {ContainerPort: 6007}}},
+	}}
+	assert.NoError(t, ValidateServices(nil, spec))
+	assert.NoError(t, ValidateServices([]*api.ServiceConfig{{Ports: []uint32{8888}}, {Type: "NodePort", Ports: []uint32{6006, 6007}}}, spec))
+
+	assert.EqualError(t, ValidateServices([]*api.ServiceConfig{{Type: "LoadBalancer", Ports: []uint32{8888}}}, spec),
+		"service type LoadBalancer is not supported, expected ClusterIP or NodePort")
+	assert.EqualError(t, ValidateServices([]*api.ServiceConfig{{}}, spec), "service does not expose any ports")
+	assert.EqualError(t, ValidateServices([]*api.ServiceConfig{{Ports: []uint32{0}}}, spec), "service port 0 is outside of range [1, 65535]")
+	assert.EqualError(t, ValidateServices([]*api.ServiceConfig{{Ports: []uint32{70000}}}, spec), "service port 70000 is outside of range [1, 65535]")
+	assert.EqualError(t, ValidateServices([]*api.ServiceConfig{{Ports: []uint32{8888}}, {Type: "NodePort", Ports: []uint32{8888}}}, spec),
+		"port 8888 is exposed by more than one service")
+	assert.EqualError(t, ValidateServices([]*api.ServiceConfig{{Ports: []uint32{8080}}}, spec), "port 8080 is not a container port of the pod spec")
+}
+
+func Test_ValidatePodSpec_checkForActiveDeadline(t *testing.T) {
+	resources := v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("512Mi")}
+	spec := &v1.PodSpec{
+		Containers: []v1.Container{{
+			Resources: v1.ResourceRequirements{
+				Limits:   resources,
+				Requests: resources,
+			},
+		}},
+	}
+
+	deadline := int64(-1)
+	spec.ActiveDeadlineSeconds = &deadline
+	assert.Error(t, ValidatePodSpec(spec))
+
+	deadline = 0
+	assert.Error(t, ValidatePodSpec(spec))
+
+	deadline = 60
+	assert.NoError(t, ValidatePodSpec(spec))
+}
+
+func Test_ValidatePodSpec_checkForMalformedSecretReferences(t *testing.T) {
+	resources := v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("512Mi")}
+	specWith := func(env []v1.EnvVar, volumes []v1.Volume) *v1.PodSpec {
+		return &v1.PodSpec{
+			Containers: []v1.Container{{
+				Name:      "main",
+				Env:       env,
+				Resources: v1.ResourceRequirements{Limits: resources, Requests: resources},
+			}},
+			Volumes: volumes,
+		}
+	}
+	secretEnv := func(name string, key string) []v1.EnvVar {
+		return []v1.EnvVar{{Name: "TOKEN", ValueFrom: &v1.EnvVarSource{
+			SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: name}, Key: key},
+		}}}
+	}
+
+	assert.NoError(t, ValidatePodSpec(specWith(secretEnv("team-token", "token"), nil)))
+
+	e := ValidatePodSpec(specWith(secretEnv("", "token"), nil))
+	assert.EqualError(t, e, "env variable TOKEN of container main references secret without name")
+
+	e = ValidatePodSpec(specWith(secretEnv("team-token", ""), nil))
+	assert.EqualError(t, e, "env variable TOKEN of container main references secret team-token without key")
+
+	e = ValidatePodSpec(specWith(secretEnv("team-token", "to/ken"), nil))
+	assert.Error(t, e)
+	assert.Contains(t, e.Error(), "env variable TOKEN of container main references invalid key to/ken of secret team-token")
+
+	e = ValidatePodSpec(specWith(secretEnv("Team_Token", "token"), nil))
+	assert.Error(t, e)
+	assert.Contains(t, e.Error(), "references secret with invalid name Team_Token")
+
+	e = ValidatePodSpec(specWith(nil, []v1.Volume{{
+		Name:         "config",
+		VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{}},
+	}}))
+	assert.EqualError(t, e, "volume config references config map without name")
+
+	e = ValidatePodSpec(specWith(nil, []v1.Volume{{
+		Name: "credentials",
+		VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{
+			SecretName: "credentials",
+			Items:      []v1.KeyToPath{{Key: "", Path: "credentials"}},
+		}},
+	}}))
+	assert.EqualError(t, e, "volume credentials references secret credentials without key")
+}
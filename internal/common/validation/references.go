@@ -0,0 +1,167 @@
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	k8sValidation "k8s.io/apimachinery/pkg/util/validation"
+)
+
+// validateReferences checks secrets and config maps referenced by the pod spec are well formed,
+// whether they exist is known only on the cluster.
+func validateReferences(spec *v1.PodSpec) error {
+	for _, secret := range spec.ImagePullSecrets {
+		if e := validateReferenceName("image pull secrets", "secret", secret.Name); e != nil {
+			return e
+		}
+	}
+	for _, volume := range spec.Volumes {
+		if e := validateVolumeReferences(volume); e != nil {
+			return e
+		}
+	}
+	for _, container := range append(append([]v1.Container{}, spec.InitContainers...), spec.Containers...) {
+		if e := validateContainerReferences(container); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+func validateVolumeReferences(volume v1.Volume) error {
+	source := fmt.Sprintf("volume %s", volume.Name)
+	if volume.Secret != nil {
+		if e := validateItemsReference(source, "secret", volume.Secret.SecretName, volume.Secret.Items); e != nil {
+			return e
+		}
+	}
+	if volume.ConfigMap != nil {
+		if e := validateItemsReference(source, "config map", volume.ConfigMap.Name, volume.ConfigMap.Items); e != nil {
+			return e
+		}
+	}
+	if volume.Projected != nil {
+		for _, projection := range volume.Projected.Sources {
+			if projection.Secret != nil {
+				if e := validateItemsReference(source, "secret", projection.Secret.Name, projection.Secret.Items); e != nil {
+					return e
+				}
+			}
+			if projection.ConfigMap != nil {
+				if e := validateItemsReference(source, "config map", projection.ConfigMap.Name, projection.ConfigMap.Items); e != nil {
+					return e
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateContainerReferences(container v1.Container) error {
+	for _, env := range container.Env {
+		if env.ValueFrom == nil {
+			continue
+		}
+		source := fmt.Sprintf("env variable %s of container %s", env.Name, container.Name)
+		if ref := env.ValueFrom.SecretKeyRef; ref != nil {
+			if e := validateKeyReference(source, "secret", ref.Name, ref.Key); e != nil {
+				return e
+			}
+		}
+		if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil {
+			if e := validateKeyReference(source, "config map", ref.Name, ref.Key); e != nil {
+				return e
+			}
+		}
+	}
+	for _, envFrom := range container.EnvFrom {
+		source := fmt.Sprintf("envFrom of container %s", container.Name)
+		if envFrom.SecretRef != nil {
+			if e := validateReferenceName(source, "secret", envFrom.SecretRef.Name); e != nil {
+				return e
+			}
+		}
+		if envFrom.ConfigMapRef != nil {
+			if e := validateReferenceName(source, "config map", envFrom.ConfigMapRef.Name); e != nil {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+func validateItemsReference(source string, kind string, name string, items []v1.KeyToPath) error {
+	if e := validateReferenceName(source, kind, name); e != nil {
+		return e
+	}
+	for _, item := range items {
+		if e := validateKeyReference(source, kind, name, item.Key); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+func validateKeyReference(source string, kind string, name string, key string) error {
+	if e := validateReferenceName(source, kind, name); e != nil {
+		return e
+	}
+	if key == "" {
+		return fmt.Errorf("%s references %s %s without key", source, kind, name)
+	}
+	if errs := k8sValidation.IsConfigMapKey(key); len(errs) > 0 {
+		return fmt.Errorf("%s references invalid key %s of %s %s: %s", source, key, kind, name, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+func validateReferenceName(source string, kind string, name string) error {
+	if name == "" {
+		return fmt.Errorf("%s references %s without name", source, kind)
+	}
+	if errs := k8sValidation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return fmt.Errorf("%s references %s with invalid name %s: %s", source, kind, name, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// ReferencedSecrets returns sorted names of all secrets the pod spec references.
+func ReferencedSecrets(spec *v1.PodSpec) []string {
+	names := map[string]bool{}
+	for _, secret := range spec.ImagePullSecrets {
+		names[secret.Name] = true
+	}
+	for _, volume := range spec.Volumes {
+		if volume.Secret != nil {
+			names[volume.Secret.SecretName] = true
+		}
+		if volume.Projected != nil {
+			for _, projection := range volume.Projected.Sources {
+				if projection.Secret != nil {
+					names[projection.Secret.Name] = true
+				}
+			}
+		}
+	}
+	for _, container := range append(append([]v1.Container{}, spec.InitContainers...), spec.Containers...) {
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				names[env.ValueFrom.SecretKeyRef.Name] = true
+			}
+		}
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.SecretRef != nil {
+				names[envFrom.SecretRef.Name] = true
+			}
+		}
+	}
+
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,45 @@
+package validation
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// ValidateServices checks services of the job expose valid ports declared by containers of its pod spec.
+// Each port is reserved by the first service exposing it, so it can't be exposed by another service of the job.
+func ValidateServices(services []*api.ServiceConfig, spec *v1.PodSpec) error {
+	containerPorts := map[int32]bool{}
+	for _, container := range spec.Containers {
+		for _, port := range container.Ports {
+			containerPorts[port.ContainerPort] = true
+		}
+	}
+
+	reservedPorts := map[uint32]bool{}
+	for _, service := range services {
+		switch v1.ServiceType(service.Type) {
+		case "", v1.ServiceTypeClusterIP, v1.ServiceTypeNodePort:
+		default:
+			return fmt.Errorf("service type %s is not supported, expected %s or %s", service.Type, v1.ServiceTypeClusterIP, v1.ServiceTypeNodePort)
+		}
+		if len(service.Ports) == 0 {
+			return fmt.Errorf("service does not expose any ports")
+		}
+		for _, port := range service.Ports {
+			if port == 0 || port > 65535 {
+				return fmt.Errorf("service port %d is outside of range [1, 65535]", port)
+			}
+			if reservedPorts[port] {
+				return fmt.Errorf("port %d is exposed by more than one service", port)
+			}
+			if !containerPorts[int32(port)] {
+				return fmt.Errorf("port %d is not a container port of the pod spec", port)
+			}
+			reservedPorts[port] = true
+		}
+	}
+	return nil
+}
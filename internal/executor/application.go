@@ -74,7 +74,9 @@ func StartUpWithContext(config configuration.ExecutorConfiguration, clusterConte
 		clusterContext,
 		queueClient,
 		config.Kubernetes.MinimumPodAge,
-		config.Kubernetes.FailedPodExpiry)
+		config.Kubernetes.FailedPodExpiry,
+		config.Application.Pool,
+		config.Application.DebugLeaseRequests)
 
 	queueUtilisationService := service.NewMetricsServerQueueUtilisationService(
 		clusterContext,
@@ -84,7 +86,8 @@ func StartUpWithContext(config configuration.ExecutorConfiguration, clusterConte
 		clusterContext,
 		queueUtilisationService,
 		usageClient,
-		config.Kubernetes.TrackedNodeLabels)
+		config.Kubernetes.TrackedNodeLabels,
+		config.Application.Pool)
 
 	stuckPodDetector := service.NewPodProgressMonitorService(
 		clusterContext,
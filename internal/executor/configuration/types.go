@@ -8,6 +8,10 @@ import (
 
 type ApplicationConfiguration struct {
 	ClusterId string
+	// Pool the cluster belongs to, jobs of queues restricted to other pools are not leased to the cluster
+	Pool string
+	// Lease requests ask the server for a trace of its scheduling decisions, which is logged
+	DebugLeaseRequests bool
 }
 
 type KubernetesConfiguration struct {
@@ -32,6 +32,7 @@ type ClusterContext interface {
 	GetPodEvents(pod *v1.Pod) ([]*v1.Event, error)
 
 	SubmitPod(pod *v1.Pod, owner string) (*v1.Pod, error)
+	SubmitService(service *v1.Service, owner string) (*v1.Service, error)
 	AddAnnotation(pod *v1.Pod, annotations map[string]string) error
 	DeletePods(pods []*v1.Pod)
 
@@ -179,6 +180,14 @@ func (c *KubernetesClusterContext) SubmitPod(pod *v1.Pod, owner string) (*v1.Pod
 	return returnedPod, err
 }
 
+func (c *KubernetesClusterContext) SubmitService(service *v1.Service, owner string) (*v1.Service, error) {
+	ownerClient, err := c.kubernetesClientProvider.ClientForUser(owner)
+	if err != nil {
+		return nil, err
+	}
+	return ownerClient.CoreV1().Services(service.Namespace).Create(service)
+}
+
 func (c *KubernetesClusterContext) AddAnnotation(pod *v1.Pod, annotations map[string]string) error {
 	patch := &domain.Patch{
 		MetaData: metav1.ObjectMeta{
@@ -158,6 +158,10 @@ func extractSleepTime(pod *v1.Pod) float32 {
 	return 1
 }
 
+func (c *FakeClusterContext) SubmitService(service *v1.Service, owner string) (*v1.Service, error) {
+	return service, nil
+}
+
 func (c *FakeClusterContext) AddAnnotation(pod *v1.Pod, annotations map[string]string) error {
 	c.rwLock.Lock()
 	defer c.rwLock.Unlock()
@@ -78,5 +78,9 @@ func CreateJobFailedEvent(pod *v1.Pod, reason string, exitCodes map[string]int32
 		ClusterId: clusterId,
 		Reason:    reason,
 		ExitCodes: exitCodes,
+
+		DeadlineExceeded: util.IsDeadlineExceeded(pod),
+		OOMKilled:        util.IsOOMKilled(pod),
+		Category:         util.ExtractPodFailureCategory(pod),
 	}
 }
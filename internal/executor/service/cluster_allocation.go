@@ -8,6 +8,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/internal/executor/context"
@@ -71,7 +72,7 @@ func (allocationService *ClusterAllocationService) submitJobs(jobsToSubmit []*ap
 
 	for _, job := range jobsToSubmit {
 		pod := createPod(job)
-		_, err := allocationService.clusterContext.SubmitPod(pod, job.Owner)
+		submittedPod, err := allocationService.clusterContext.SubmitPod(pod, job.Owner)
 
 		if err != nil {
 			log.Errorf("Failed to submit job %s because %s", job.Id, err)
@@ -86,6 +87,8 @@ func (allocationService *ClusterAllocationService) submitJobs(jobsToSubmit []*ap
 			} else {
 				allocationService.returnLease(pod, fmt.Sprintf("Failed to submit pod because %s", err))
 			}
+		} else {
+			allocationService.submitServices(job, submittedPod)
 		}
 	}
 
@@ -95,6 +98,18 @@ func (allocationService *ClusterAllocationService) submitJobs(jobsToSubmit []*ap
 	}
 }
 
+// submitServices creates services exposing ports of the job, the pod of the job owns them so they are deleted with it.
+// The job keeps running when a service can't be created, its ports are just not reachable through the service.
+func (allocationService *ClusterAllocationService) submitServices(job *api.Job, pod *v1.Pod) {
+	for i, config := range job.Services {
+		service := createService(job, pod, config, i)
+		_, err := allocationService.clusterContext.SubmitService(service, job.Owner)
+		if err != nil {
+			log.Errorf("Failed to submit service %s of job %s because %s", service.Name, job.Id, err)
+		}
+	}
+}
+
 func isNotRecoverable(status metav1.Status) bool {
 	if status.Reason == metav1.StatusReasonInvalid ||
 		status.Reason == metav1.StatusReasonForbidden {
@@ -126,7 +141,7 @@ func (allocationService *ClusterAllocationService) failJobs(failedSubmissions []
 }
 
 func (allocationService *ClusterAllocationService) returnLease(pod *v1.Pod, reason string) {
-	err := allocationService.leaseService.ReturnLease(pod)
+	err := allocationService.leaseService.ReturnLease(pod, reason)
 
 	if err != nil {
 		log.Errorf("Failed to return lease for job %s because %s", util.ExtractJobId(pod), err)
@@ -164,6 +179,40 @@ func createPod(job *api.Job) *v1.Pod {
 	return &pod
 }
 
+func createService(job *api.Job, pod *v1.Pod, config *api.ServiceConfig, index int) *v1.Service {
+	serviceType := v1.ServiceType(config.Type)
+	if serviceType == "" {
+		serviceType = v1.ServiceTypeClusterIP
+	}
+	ports := make([]v1.ServicePort, 0, len(config.Ports))
+	for _, port := range config.Ports {
+		ports = append(ports, v1.ServicePort{
+			Name:       fmt.Sprintf("port-%d", port),
+			Port:       int32(port),
+			TargetPort: intstr.FromInt(int(port)),
+		})
+	}
+
+	service := v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("%s%s-%d", common.PodNamePrefix, job.Id, index),
+			Labels: map[string]string{
+				domain.JobId: job.Id,
+				domain.Queue: job.Queue,
+			},
+			Namespace:       job.Namespace,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(pod, v1.SchemeGroupVersion.WithKind("Pod"))},
+		},
+		Spec: v1.ServiceSpec{
+			Type:     serviceType,
+			Selector: map[string]string{domain.JobId: job.Id},
+			Ports:    ports,
+		},
+	}
+
+	return &service
+}
+
 func setRestartPolicyNever(podSpec *v1.PodSpec) {
 	podSpec.RestartPolicy = v1.RestartPolicyNever
 }
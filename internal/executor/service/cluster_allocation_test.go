@@ -10,6 +10,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestCreateLabels_CreatesExpectedLabels(t *testing.T) {
@@ -78,6 +80,33 @@ func TestCreatePod_CreatesExpectedPod(t *testing.T) {
 	assert.Equal(t, result, &expectedOutput)
 }
 
+func TestCreateService_ExposesPortsOfJobPod(t *testing.T) {
+	job := api.Job{
+		Id:        "Id",
+		Queue:     "Queue1",
+		Namespace: "Namespace1",
+		PodSpec:   makePodSpec(),
+	}
+	pod := createPod(&job)
+	pod.UID = "pod-uid"
+
+	result := createService(&job, pod, &api.ServiceConfig{Type: "NodePort", Ports: []uint32{8888, 6006}}, 1)
+
+	assert.Equal(t, common.PodNamePrefix+"Id-1", result.Name)
+	assert.Equal(t, "Namespace1", result.Namespace)
+	assert.Equal(t, v1.ServiceTypeNodePort, result.Spec.Type)
+	assert.Equal(t, map[string]string{domain.JobId: "Id"}, result.Spec.Selector)
+	assert.Equal(t, []v1.ServicePort{
+		{Name: "port-8888", Port: 8888, TargetPort: intstr.FromInt(8888)},
+		{Name: "port-6006", Port: 6006, TargetPort: intstr.FromInt(6006)},
+	}, result.Spec.Ports)
+	assert.Equal(t, 1, len(result.OwnerReferences))
+	assert.Equal(t, types.UID("pod-uid"), result.OwnerReferences[0].UID)
+
+	clusterIp := createService(&job, pod, &api.ServiceConfig{Ports: []uint32{8888}}, 0)
+	assert.Equal(t, v1.ServiceTypeClusterIP, clusterIp.Spec.Type)
+}
+
 func makePodSpec() *v1.PodSpec {
 	containers := make([]v1.Container, 1)
 	containers[0] = v1.Container{
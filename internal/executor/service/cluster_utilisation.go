@@ -15,7 +15,7 @@ import (
 )
 
 type UtilisationService interface {
-	GetAvailableClusterCapacity() (*common.ComputeResources, []map[string]string, error)
+	GetAvailableClusterCapacity() (*common.ComputeResources, []*api.NodeLabeling, error)
 	GetTotalAllocatableClusterCapacity() (*common.ComputeResources, error)
 	GetAllAvailableProcessingNodes() ([]*v1.Node, error)
 }
@@ -25,19 +25,32 @@ type ClusterUtilisationService struct {
 	queueUtilisationService PodUtilisationService
 	usageClient             api.UsageClient
 	trackedNodeLabels       []string
+	pool                    string
 }
 
 func NewClusterUtilisationService(
 	clusterContext context.ClusterContext,
 	queueUtilisationService PodUtilisationService,
 	usageClient api.UsageClient,
-	trackedNodeLabels []string) *ClusterUtilisationService {
+	trackedNodeLabels []string,
+	pool string) *ClusterUtilisationService {
 
 	return &ClusterUtilisationService{
 		clusterContext:          clusterContext,
 		queueUtilisationService: queueUtilisationService,
 		usageClient:             usageClient,
-		trackedNodeLabels:       trackedNodeLabels}
+		trackedNodeLabels:       withGpuProductLabel(trackedNodeLabels),
+		pool:                    pool}
+}
+
+// withGpuProductLabel makes sure GPU model is always reported, so jobs requesting specific GPUs are not leased to clusters without them
+func withGpuProductLabel(trackedNodeLabels []string) []string {
+	for _, label := range trackedNodeLabels {
+		if label == common.GpuProductLabel {
+			return trackedNodeLabels
+		}
+	}
+	return append(append([]string{}, trackedNodeLabels...), common.GpuProductLabel)
 }
 
 func (clusterUtilisationService *ClusterUtilisationService) ReportClusterUtilisation() {
@@ -69,6 +82,10 @@ func (clusterUtilisationService *ClusterUtilisationService) ReportClusterUtilisa
 		Queues:                   queueReports,
 		ClusterCapacity:          totalNodeResource,
 		ClusterAvailableCapacity: *allocatableClusterCapacity,
+		GpuCapacityByType:        getGpuCapacityByType(allAvailableProcessingNodes),
+		Jobs:                     clusterUtilisationService.createReportsOfJobUsages(allActiveManagedPods),
+		Pool:                     clusterUtilisationService.pool,
+		NodeLabelings:            getNodeLabelings(clusterUtilisationService.trackedNodeLabels, allAvailableProcessingNodes, nil),
 	}
 
 	err = clusterUtilisationService.reportUsage(&clusterUsage)
@@ -79,7 +96,7 @@ func (clusterUtilisationService *ClusterUtilisationService) ReportClusterUtilisa
 	}
 }
 
-func (clusterUtilisationService *ClusterUtilisationService) GetAvailableClusterCapacity() (*common.ComputeResources, []map[string]string, error) {
+func (clusterUtilisationService *ClusterUtilisationService) GetAvailableClusterCapacity() (*common.ComputeResources, []*api.NodeLabeling, error) {
 	processingNodes, err := clusterUtilisationService.GetAllAvailableProcessingNodes()
 	if err != nil {
 		return new(common.ComputeResources), nil, fmt.Errorf("Failed getting available cluster capacity due to: %s", err)
@@ -99,7 +116,7 @@ func (clusterUtilisationService *ClusterUtilisationService) GetAvailableClusterC
 	availableResource := totalNodeResource.DeepCopy()
 	availableResource.Sub(totalPodResource)
 
-	availableLabels := getDistinctNodesLabels(clusterUtilisationService.trackedNodeLabels, processingNodes)
+	availableLabels := getNodeLabelings(clusterUtilisationService.trackedNodeLabels, processingNodes, allNonCompletePodsRequiringResource)
 
 	return &availableResource, availableLabels, nil
 }
@@ -256,6 +273,26 @@ func (clusterUtilisationService *ClusterUtilisationService) createReportsOfQueue
 	return queueReports
 }
 
+// createReportsOfJobUsages reports resources used by each running job, jobs with unknown usage are skipped
+func (clusterUtilisationService *ClusterUtilisationService) createReportsOfJobUsages(pods []*v1.Pod) []*api.JobUsageReport {
+	jobReports := make([]*api.JobUsageReport, 0, len(pods))
+	for _, pod := range pods {
+		jobId, present := pod.Labels[domain.JobId]
+		if !present {
+			continue
+		}
+		podUsage := clusterUtilisationService.queueUtilisationService.GetPodUtilisation(pod)
+		if len(podUsage) == 0 {
+			continue
+		}
+		jobReports = append(jobReports, &api.JobUsageReport{
+			JobId:         jobId,
+			ResourcesUsed: podUsage,
+		})
+	}
+	return jobReports
+}
+
 func (clusterUtilisationService *ClusterUtilisationService) getUsageByQueue(pods []*v1.Pod) map[string]common.ComputeResources {
 	utilisationByQueue := make(map[string]common.ComputeResources)
 
@@ -300,9 +337,34 @@ func getAllocationByQueue(pods []*v1.Pod) map[string]common.ComputeResources {
 	return utilisationByQueue
 }
 
-func getDistinctNodesLabels(labels []string, nodes []*v1.Node) []map[string]string {
-	result := []map[string]string{}
-	existing := map[string]bool{}
+func getGpuCapacityByType(nodes []*v1.Node) common.ComputeResources {
+	result := common.ComputeResources{}
+	for _, n := range nodes {
+		gpuType, ok := n.Labels[common.GpuProductLabel]
+		if !ok {
+			continue
+		}
+		gpus, ok := n.Status.Allocatable[common.GpuResourceName]
+		if !ok {
+			continue
+		}
+		total := result[gpuType]
+		total.Add(gpus)
+		result[gpuType] = total
+	}
+	return result
+}
+
+// getNodeLabelings groups nodes by values of the tracked labels, each group carries resources of its nodes
+// not requested by the pods.
+func getNodeLabelings(labels []string, nodes []*v1.Node, pods []*v1.Pod) []*api.NodeLabeling {
+	podsByNode := map[string][]*v1.Pod{}
+	for _, pod := range pods {
+		podsByNode[pod.Spec.NodeName] = append(podsByNode[pod.Spec.NodeName], pod)
+	}
+
+	result := []*api.NodeLabeling{}
+	existing := map[string]*api.NodeLabeling{}
 	for _, n := range nodes {
 		selectedLabels := map[string]string{}
 		id := ""
@@ -313,10 +375,16 @@ func getDistinctNodesLabels(labels []string, nodes []*v1.Node) []map[string]stri
 			}
 			id += "|" + value
 		}
-		if !existing[id] {
-			result = append(result, selectedLabels)
-			existing[id] = true
+		available := common.FromResourceList(n.Status.Allocatable)
+		available.Sub(common.CalculateTotalResourceRequest(podsByNode[n.Name]))
+
+		labeling, ok := existing[id]
+		if !ok {
+			labeling = &api.NodeLabeling{Labels: selectedLabels, Resources: common.ComputeResources{}}
+			result = append(result, labeling)
+			existing[id] = labeling
 		}
+		common.ComputeResources(labeling.Resources).Add(available)
 	}
 	return result
 }
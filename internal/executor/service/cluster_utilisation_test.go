@@ -11,6 +11,7 @@ import (
 	"github.com/G-Research/armada/internal/common"
 	util2 "github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/internal/executor/domain"
+	"github.com/G-Research/armada/pkg/api"
 )
 
 func TestFilterAvailableProcessingNodes_ShouldReturnAvailableProcessingNodes(t *testing.T) {
@@ -216,29 +217,87 @@ func TestGetUsageByQueue_HandlesEmptyList(t *testing.T) {
 	assert.Equal(t, len(result), 0)
 }
 
-func Test_getDistinctNodesLabels(t *testing.T) {
+func Test_createReportsOfJobUsages_SkipsJobsWithUnknownUsage(t *testing.T) {
+	podResource := makeResourceList(2, 50)
+	usedPod := makePodWithResource("queue1", &podResource)
+	unknownPod := makePodWithResource("queue1", &podResource)
+	used := common.ComputeResources{"cpu": resource.MustParse("3")}
+
+	service := &ClusterUtilisationService{queueUtilisationService: &fakePodUtilisationService{
+		usageByPodName: map[string]common.ComputeResources{"used": used},
+	}}
+	usedPod.Name = "used"
+	unknownPod.Name = "unknown"
+
+	reports := service.createReportsOfJobUsages([]*v1.Pod{&usedPod, &unknownPod})
+	assert.Equal(t, []*api.JobUsageReport{{JobId: usedPod.Labels[domain.JobId], ResourcesUsed: used}}, reports)
+}
 
+type fakePodUtilisationService struct {
+	usageByPodName map[string]common.ComputeResources
+}
+
+func (f *fakePodUtilisationService) GetPodUtilisation(pod *v1.Pod) common.ComputeResources {
+	usage, ok := f.usageByPodName[pod.Name]
+	if !ok {
+		return common.ComputeResources{}
+	}
+	return usage
+}
+
+func Test_getNodeLabelings(t *testing.T) {
+	node := func(name string, labels map[string]string, cpu string) *v1.Node {
+		return &v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			Status:     v1.NodeStatus{Allocatable: v1.ResourceList{"cpu": resource.MustParse(cpu)}},
+		}
+	}
 	nodes := []*v1.Node{
-		{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
-			"A": "x",
-			"B": "x",
-		}}},
-		{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
-			"A": "x",
-			"B": "x",
-		}}},
-		{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
-			"B": "y",
-		}}},
+		node("node1", map[string]string{"A": "x", "B": "x"}, "4"),
+		node("node2", map[string]string{"A": "x", "B": "x"}, "2"),
+		node("node3", map[string]string{"B": "y"}, "8"),
+	}
+	pods := []*v1.Pod{
+		{Spec: v1.PodSpec{NodeName: "node1", Containers: []v1.Container{{Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{"cpu": resource.MustParse("3")}}}}}},
+		{Spec: v1.PodSpec{NodeName: "node3", Containers: []v1.Container{{Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{"cpu": resource.MustParse("8")}}}}}},
 	}
 	labels := []string{"A", "B"}
 
-	result := getDistinctNodesLabels(labels, nodes)
+	result := getNodeLabelings(labels, nodes, pods)
+
+	assert.Equal(t, 2, len(result))
+	assert.Equal(t, map[string]string{"A": "x", "B": "x"}, result[0].Labels)
+	assert.Equal(t, map[string]string{"B": "y"}, result[1].Labels)
+	assert.Equal(t, common.ComputeResources{"cpu": resource.MustParse("3")}.AsFloat(), common.ComputeResources(result[0].Resources).AsFloat())
+	assert.Equal(t, common.ComputeResources{"cpu": resource.MustParse("0")}.AsFloat(), common.ComputeResources(result[1].Resources).AsFloat())
+}
+
+func Test_getGpuCapacityByType(t *testing.T) {
+	gpuNode := func(gpuType string, gpus string) *v1.Node {
+		return &v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{common.GpuProductLabel: gpuType}},
+			Status:     v1.NodeStatus{Allocatable: v1.ResourceList{common.GpuResourceName: resource.MustParse(gpus)}},
+		}
+	}
+	nodes := []*v1.Node{
+		gpuNode("A100", "8"),
+		gpuNode("A100", "4"),
+		gpuNode("V100", "2"),
+		{Status: v1.NodeStatus{Allocatable: makeResourceList(4, 8)}},
+	}
+
+	result := getGpuCapacityByType(nodes)
+
+	assert.Equal(t, 2, len(result))
+	assert.Equal(t, 12.0, common.QuantityAsFloat64(result["A100"]))
+	assert.Equal(t, 2.0, common.QuantityAsFloat64(result["V100"]))
+}
 
-	assert.Equal(t, []map[string]string{
-		{"A": "x", "B": "x"},
-		{"B": "y"},
-	}, result)
+func Test_withGpuProductLabel(t *testing.T) {
+	assert.Equal(t, []string{"A", common.GpuProductLabel}, withGpuProductLabel([]string{"A"}))
+	assert.Equal(t, []string{common.GpuProductLabel, "A"}, withGpuProductLabel([]string{common.GpuProductLabel, "A"}))
 }
 
 func hasKey(value map[string]common.ComputeResources, key string) bool {
@@ -2,12 +2,15 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/G-Research/armada/internal/common"
 	commonUtil "github.com/G-Research/armada/internal/common/util"
@@ -21,8 +24,8 @@ const maxPodRequestSize = 10000
 const jobDoneAnnotation = "reported_done"
 
 type LeaseService interface {
-	ReturnLease(pod *v1.Pod) error
-	RequestJobLeases(availableResource *common.ComputeResources, availableLabels []map[string]string, leasedResourceByQueue map[string]common.ComputeResources) ([]*api.Job, error)
+	ReturnLease(pod *v1.Pod, reason string) error
+	RequestJobLeases(availableResource *common.ComputeResources, availableLabels []*api.NodeLabeling, leasedResourceByQueue map[string]common.ComputeResources) ([]*api.Job, error)
 	ReportDone(pods []*v1.Pod) error
 }
 
@@ -31,26 +34,28 @@ type JobLeaseService struct {
 	queueClient     api.AggregatedQueueClient
 	minimumPodAge   time.Duration
 	failedPodExpiry time.Duration
+	pool            string
+	debug           bool
 }
 
 func NewJobLeaseService(
 	clusterContext context2.ClusterContext,
 	queueClient api.AggregatedQueueClient,
 	minimumPodAge time.Duration,
-	failedPodExpiry time.Duration) *JobLeaseService {
+	failedPodExpiry time.Duration,
+	pool string,
+	debug bool) *JobLeaseService {
 
 	return &JobLeaseService{
 		clusterContext:  clusterContext,
 		queueClient:     queueClient,
 		minimumPodAge:   minimumPodAge,
-		failedPodExpiry: failedPodExpiry}
+		failedPodExpiry: failedPodExpiry,
+		pool:            pool,
+		debug:           debug}
 }
 
-func (jobLeaseService *JobLeaseService) RequestJobLeases(availableResource *common.ComputeResources, availableLabels []map[string]string, leasedResourceByQueue map[string]common.ComputeResources) ([]*api.Job, error) {
-	labeling := []*api.NodeLabeling{}
-	for _, l := range availableLabels {
-		labeling = append(labeling, &api.NodeLabeling{Labels: l})
-	}
+func (jobLeaseService *JobLeaseService) RequestJobLeases(availableResource *common.ComputeResources, availableLabels []*api.NodeLabeling, leasedResourceByQueue map[string]common.ComputeResources) ([]*api.Job, error) {
 	leasedQueueReports := make([]*api.QueueLeasedReport, 0, len(leasedResourceByQueue))
 	for queueName, leasedResource := range leasedResourceByQueue {
 		leasedQueueReport := &api.QueueLeasedReport{
@@ -68,8 +73,10 @@ func (jobLeaseService *JobLeaseService) RequestJobLeases(availableResource *comm
 	leaseRequest := api.LeaseRequest{
 		ClusterId:           jobLeaseService.clusterContext.GetClusterId(),
 		Resources:           *availableResource,
-		AvailableLabels:     labeling,
+		AvailableLabels:     availableLabels,
 		ClusterLeasedReport: clusterLeasedReport,
+		Pool:                jobLeaseService.pool,
+		Debug:               jobLeaseService.debug,
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -79,15 +86,66 @@ func (jobLeaseService *JobLeaseService) RequestJobLeases(availableResource *comm
 		return make([]*api.Job, 0), err
 	}
 
+	if response.Trace != nil {
+		logSchedulingTrace(response.Trace, len(response.Job))
+	}
+	applySchedulingHints(response.Job, response.SchedulingHints)
 	return response.Job, nil
 }
 
-func (jobLeaseService *JobLeaseService) ReturnLease(pod *v1.Pod) error {
+func logSchedulingTrace(trace *api.SchedulingTrace, leased int) {
+	log.Infof("Leased %d jobs in pool %q", leased, trace.Pool)
+	for _, queue := range trace.Queues {
+		log.Infof("Queue %s: share %s, remaining scheduling limit %s, current usage %s", queue.Queue,
+			formatResources(queue.Share), formatResources(queue.RemainingSchedulingLimit), formatResources(queue.CurrentUsage))
+		if queue.IdleReason != "" {
+			log.Infof("Queue %s leased no jobs: %s", queue.Queue, queue.IdleReason)
+		}
+	}
+	for _, denial := range trace.Denials {
+		log.Infof("Job %s of queue %s not leased: %s", denial.JobId, denial.Queue, denial.Reason)
+	}
+}
+
+func formatResources(resources map[string]resource.Quantity) string {
+	formatted := make([]string, 0, len(resources))
+	for key, quantity := range resources {
+		formatted = append(formatted, fmt.Sprintf("%s=%s", key, quantity.String()))
+	}
+	sort.Strings(formatted)
+	return "{" + strings.Join(formatted, ", ") + "}"
+}
+
+// applySchedulingHints adds node labels the job was matched to on the server to its pod node selector,
+// node selector values already set on the pod are kept.
+func applySchedulingHints(jobs []*api.Job, hints map[string]*api.SchedulingHint) {
+	for _, job := range jobs {
+		hint, ok := hints[job.Id]
+		if !ok || job.PodSpec == nil {
+			continue
+		}
+		if job.PodSpec.NodeSelector == nil {
+			job.PodSpec.NodeSelector = map[string]string{}
+		}
+		for k, v := range hint.NodeSelector {
+			if _, exists := job.PodSpec.NodeSelector[k]; !exists {
+				job.PodSpec.NodeSelector[k] = v
+			}
+		}
+	}
+}
+
+// ReturnLease returns the job of the pod to its queue, the server records the reason on the job.
+func (jobLeaseService *JobLeaseService) ReturnLease(pod *v1.Pod, reason string) error {
 	jobId := util.ExtractJobId(pod)
 	ctx, cancel := common.ContextWithDefaultTimeout()
 	defer cancel()
 	log.Infof("Returning lease for job %s", jobId)
-	_, err := jobLeaseService.queueClient.ReturnLease(ctx, &api.ReturnLeaseRequest{ClusterId: jobLeaseService.clusterContext.GetClusterId(), JobId: jobId})
+	_, err := jobLeaseService.queueClient.ReturnLease(ctx, &api.ReturnLeaseRequest{
+		ClusterId: jobLeaseService.clusterContext.GetClusterId(),
+		JobId:     jobId,
+		Reason:    reason,
+	})
 
 	return err
 }
@@ -143,7 +201,7 @@ func (jobLeaseService *JobLeaseService) renewJobLeases(pods []*v1.Pod) {
 
 	ctx, cancel := common.ContextWithDefaultTimeout()
 	defer cancel()
-	renewedJobIds, err := jobLeaseService.queueClient.RenewLease(ctx,
+	renewed, err := jobLeaseService.queueClient.RenewLease(ctx,
 		&api.RenewLeaseRequest{
 			ClusterId: jobLeaseService.clusterContext.GetClusterId(),
 			Ids:       jobIds})
@@ -152,14 +210,32 @@ func (jobLeaseService *JobLeaseService) renewJobLeases(pods []*v1.Pod) {
 		return
 	}
 
-	failedIds := commonUtil.SubtractStringList(jobIds, renewedJobIds.Ids)
+	failedIds := commonUtil.SubtractStringList(jobIds, renewed.Ids)
 	failedPods := filterPodsByJobId(pods, failedIds)
 	if len(failedIds) > 0 {
-		log.Warnf("Server has prevented renewing of job lease for jobs %s", strings.Join(failedIds, ","))
+		log.Warnf("Server has prevented renewing of job lease for jobs %s", strings.Join(describeFailedRenewals(failedIds, renewed.Renewals), ","))
 		jobLeaseService.clusterContext.DeletePods(failedPods)
 	}
 }
 
+// describeFailedRenewals appends the reason the server gave for each lease which was not renewed,
+// servers not reporting reasons leave the ids as they are.
+func describeFailedRenewals(failedIds []string, renewals []*api.LeaseRenewal) []string {
+	statuses := make(map[string]api.LeaseRenewalStatus, len(renewals))
+	for _, renewal := range renewals {
+		statuses[renewal.JobId] = renewal.Status
+	}
+	descriptions := make([]string, 0, len(failedIds))
+	for _, id := range failedIds {
+		if status, ok := statuses[id]; ok {
+			descriptions = append(descriptions, fmt.Sprintf("%s (%s)", id, status))
+		} else {
+			descriptions = append(descriptions, id)
+		}
+	}
+	return descriptions
+}
+
 func (jobLeaseService *JobLeaseService) markAsDone(pods []*v1.Pod) {
 	for _, pod := range pods {
 		err := jobLeaseService.clusterContext.AddAnnotation(pod, map[string]string{
@@ -175,8 +251,10 @@ func shouldBeRenewed(pod *v1.Pod) bool {
 	return !isReportedDone(pod)
 }
 
+// shouldBeReportedDone waits until the final state of the pod has been reported,
+// so the server handles the failure (e.g. requeues the job) before the job is done
 func shouldBeReportedDone(pod *v1.Pod) bool {
-	return util.IsInTerminalState(pod) && !isReportedDone(pod)
+	return util.IsInTerminalState(pod) && !isReportedDone(pod) && reporter.HasCurrentStateBeenReported(pod)
 }
 
 func (jobLeaseService *JobLeaseService) canBeRemoved(pod *v1.Pod) bool {
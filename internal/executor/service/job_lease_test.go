@@ -60,6 +60,21 @@ func TestChunkPods(t *testing.T) {
 	assert.Equal(t, [][]*v1.Pod{{p, p}, {p}}, chunks)
 }
 
+func TestApplySchedulingHints(t *testing.T) {
+	hinted := &api.Job{Id: "hinted", PodSpec: &v1.PodSpec{NodeSelector: map[string]string{"zone": "1"}}}
+	withoutSelector := &api.Job{Id: "withoutSelector", PodSpec: &v1.PodSpec{}}
+	notHinted := &api.Job{Id: "notHinted", PodSpec: &v1.PodSpec{}}
+
+	applySchedulingHints([]*api.Job{hinted, withoutSelector, notHinted}, map[string]*api.SchedulingHint{
+		"hinted":          {NodeSelector: map[string]string{"zone": "2", "region": "eu"}},
+		"withoutSelector": {NodeSelector: map[string]string{"region": "eu"}},
+	})
+
+	assert.Equal(t, map[string]string{"zone": "1", "region": "eu"}, hinted.PodSpec.NodeSelector)
+	assert.Equal(t, map[string]string{"region": "eu"}, withoutSelector.PodSpec.NodeSelector)
+	assert.Nil(t, notHinted.PodSpec.NodeSelector)
+}
+
 func makeFinishedPodWithTimestamp(state v1.PodPhase, timestamp time.Time) *v1.Pod {
 	pod := makePodWithCurrentStateReported(state, true)
 	pod.CreationTimestamp.Time = timestamp
@@ -87,7 +102,7 @@ func makePodWithCurrentStateReported(state v1.PodPhase, reportedDone bool) *v1.P
 
 func CreateLeaseService(minimumPodAge, failedPodExpiry time.Duration) *JobLeaseService {
 	fakeClusterContext := context2.NewFakeClusterContext("test")
-	return NewJobLeaseService(fakeClusterContext, &queueClientMock{}, minimumPodAge, failedPodExpiry)
+	return NewJobLeaseService(fakeClusterContext, &queueClientMock{}, minimumPodAge, failedPodExpiry, "", false)
 }
 
 type queueClientMock struct {
@@ -97,8 +112,8 @@ func (queueClientMock) LeaseJobs(ctx context.Context, in *api.LeaseRequest, opts
 	return &api.JobLease{}, nil
 }
 
-func (queueClientMock) RenewLease(ctx context.Context, in *api.RenewLeaseRequest, opts ...grpc.CallOption) (*api.IdList, error) {
-	return &api.IdList{}, nil
+func (queueClientMock) RenewLease(ctx context.Context, in *api.RenewLeaseRequest, opts ...grpc.CallOption) (*api.RenewLeaseResponse, error) {
+	return &api.RenewLeaseResponse{}, nil
 }
 
 func (queueClientMock) ReturnLease(ctx context.Context, in *api.ReturnLeaseRequest, opts ...grpc.CallOption) (*types.Empty, error) {
@@ -108,3 +123,11 @@ func (queueClientMock) ReturnLease(ctx context.Context, in *api.ReturnLeaseReque
 func (queueClientMock) ReportDone(ctx context.Context, in *api.IdList, opts ...grpc.CallOption) (*api.IdList, error) {
 	return &api.IdList{}, nil
 }
+
+func (queueClientMock) SimulateSchedule(ctx context.Context, in *api.ScheduleSimulationRequest, opts ...grpc.CallOption) (*api.ScheduleSimulationResult, error) {
+	return &api.ScheduleSimulationResult{}, nil
+}
+
+func (queueClientMock) GetClusterLeases(ctx context.Context, in *api.ClusterLeasesRequest, opts ...grpc.CallOption) (*api.ClusterLeases, error) {
+	return &api.ClusterLeases{}, nil
+}
@@ -66,7 +66,7 @@ func (d *StuckPodDetector) onStuckPodDetected(pod *v1.Pod) (err error, retryable
 
 func (d *StuckPodDetector) onStuckPodDeleted(jobId string, record *podRecord) (resolved bool) {
 	if record.retryable {
-		err := d.jobLeaseService.ReturnLease(record.pod)
+		err := d.jobLeaseService.ReturnLease(record.pod, record.message)
 		if err != nil {
 			log.Errorf("Failed to return lease for job %s because %s", jobId, err)
 			return false
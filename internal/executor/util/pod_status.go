@@ -16,6 +16,53 @@ var expectedWarningsEventReasons = util.StringListToSet([]string{
 var imagePullBackOffStatesSet = util.StringListToSet([]string{"ImagePullBackOff", "ErrImagePull"})
 var invalidImageNameStatesSet = util.StringListToSet([]string{"InvalidImageName"})
 
+// Reason of pods killed by kubernetes after running longer than their activeDeadlineSeconds
+const deadlineExceededReason = "DeadlineExceeded"
+
+func IsDeadlineExceeded(pod *v1.Pod) bool {
+	return pod.Status.Reason == deadlineExceededReason
+}
+
+// Reason of containers killed by kubernetes after exceeding their memory limit
+const oomKilledReason = "OOMKilled"
+
+func IsOOMKilled(pod *v1.Pod) bool {
+	containerStatuses := pod.Status.ContainerStatuses
+	containerStatuses = append(containerStatuses, pod.Status.InitContainerStatuses...)
+
+	for _, containerStatus := range containerStatuses {
+		if containerStatus.State.Terminated != nil && containerStatus.State.Terminated.Reason == oomKilledReason {
+			return true
+		}
+	}
+	return false
+}
+
+// ExtractPodFailureCategory returns the kubernetes reason best describing why the pod failed, e.g. NodeLost, Evicted,
+// ImagePullBackOff or OOMKilled, the server decides by it whether the job is retried. Reason of the pod comes first,
+// then reasons of containers waiting to start and of containers terminated with non zero exit code.
+func ExtractPodFailureCategory(pod *v1.Pod) string {
+	if pod.Status.Reason != "" {
+		return pod.Status.Reason
+	}
+
+	containerStatuses := pod.Status.ContainerStatuses
+	containerStatuses = append(containerStatuses, pod.Status.InitContainerStatuses...)
+
+	for _, containerStatus := range containerStatuses {
+		if containerStatus.State.Waiting != nil && containerStatus.State.Waiting.Reason != "" {
+			return containerStatus.State.Waiting.Reason
+		}
+	}
+	for _, containerStatus := range containerStatuses {
+		terminated := containerStatus.State.Terminated
+		if terminated != nil && terminated.ExitCode != 0 && terminated.Reason != "" {
+			return terminated.Reason
+		}
+	}
+	return ""
+}
+
 func ExtractPodStuckReason(pod *v1.Pod) string {
 	containerStatuses := pod.Status.ContainerStatuses
 	containerStatuses = append(containerStatuses, pod.Status.InitContainerStatuses...)
@@ -41,6 +88,9 @@ func ExtractPodFailedReason(pod *v1.Pod) string {
 	containerStatuses = append(containerStatuses, pod.Status.InitContainerStatuses...)
 
 	failedMessage := ""
+	if IsDeadlineExceeded(pod) {
+		failedMessage += fmt.Sprintf("%s: %s\n", pod.Status.Reason, pod.Status.Message)
+	}
 
 	for _, containerStatus := range containerStatuses {
 		if containerStatus.State.Terminated != nil && containerStatus.State.Terminated.ExitCode != 0 {
@@ -68,6 +118,10 @@ func ExtractPodExitCodes(pod *v1.Pod) map[string]int32 {
 }
 
 func DiagnoseStuckPod(pod *v1.Pod, podEvents []*v1.Event) (retryable bool, message string) {
+	if IsDeadlineExceeded(pod) {
+		return false, fmt.Sprintf("%s: %s", pod.Status.Reason, pod.Status.Message)
+	}
+
 	messages := []string{}
 	for _, event := range podEvents {
 		if event.Type == v1.EventTypeWarning && !expectedWarningsEventReasons[event.Reason] {
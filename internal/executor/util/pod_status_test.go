@@ -85,6 +85,62 @@ func TestDiagnoseStuckPod_ShouldIgnoreSchedulingFailures(t *testing.T) {
 	assert.True(t, retryable)
 }
 
+func TestDiagnoseStuckPod_ShouldNotRetryWhenDeadlineExceeded(t *testing.T) {
+	waitingContainer := v1.ContainerState{Waiting: &v1.ContainerStateWaiting{}}
+	pod := makePodWithContainerStatuses([]v1.ContainerState{waitingContainer}, []v1.ContainerState{})
+	pod.Status.Reason = "DeadlineExceeded"
+	pod.Status.Message = "Pod was active on the node longer than the specified deadline"
+
+	retryable, message := DiagnoseStuckPod(pod, []*v1.Event{})
+	assert.False(t, retryable)
+	assert.Contains(t, message, "DeadlineExceeded")
+}
+
+func TestIsOOMKilled_TrueWhenContainerTerminatedWithOOMKilled(t *testing.T) {
+	oomKilledContainer := v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}}
+	succeededContainer := v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"}}
+	pod := makePodWithContainerStatuses([]v1.ContainerState{succeededContainer, oomKilledContainer}, []v1.ContainerState{})
+
+	assert.True(t, IsOOMKilled(pod))
+}
+
+func TestIsOOMKilled_TrueWhenInitContainerTerminatedWithOOMKilled(t *testing.T) {
+	oomKilledContainer := v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}}
+	pod := makePodWithContainerStatuses([]v1.ContainerState{}, []v1.ContainerState{oomKilledContainer})
+
+	assert.True(t, IsOOMKilled(pod))
+}
+
+func TestIsOOMKilled_FalseWhenContainerFailedForOtherReason(t *testing.T) {
+	failedContainer := v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}}
+	pod := makePodWithContainerStatuses([]v1.ContainerState{failedContainer}, []v1.ContainerState{})
+
+	assert.False(t, IsOOMKilled(pod))
+}
+
+func TestExtractPodFailureCategory_PrefersPodReason(t *testing.T) {
+	failedContainer := v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 137, Reason: "Error"}}
+	pod := makePodWithContainerStatuses([]v1.ContainerState{failedContainer}, []v1.ContainerState{})
+	pod.Status.Reason = "NodeLost"
+
+	assert.Equal(t, "NodeLost", ExtractPodFailureCategory(pod))
+}
+
+func TestExtractPodFailureCategory_UsesWaitingThenTerminatedContainerReason(t *testing.T) {
+	imagePullBackoffState := v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ImagePullBackOff"}}
+	oomKilledContainer := v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}}
+	succeededContainer := v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"}}
+
+	pod := makePodWithContainerStatuses([]v1.ContainerState{oomKilledContainer}, []v1.ContainerState{imagePullBackoffState})
+	assert.Equal(t, "ImagePullBackOff", ExtractPodFailureCategory(pod))
+
+	pod = makePodWithContainerStatuses([]v1.ContainerState{succeededContainer, oomKilledContainer}, []v1.ContainerState{})
+	assert.Equal(t, "OOMKilled", ExtractPodFailureCategory(pod))
+
+	pod = makePodWithContainerStatuses([]v1.ContainerState{succeededContainer}, []v1.ContainerState{})
+	assert.Equal(t, "", ExtractPodFailureCategory(pod))
+}
+
 func makePodWithContainerStatuses(containerStates []v1.ContainerState, initContainerStates []v1.ContainerState) *v1.Pod {
 	containers := make([]v1.ContainerStatus, len(containerStates))
 	for i, state := range containerStates {
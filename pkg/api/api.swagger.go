@@ -22,6 +22,32 @@ func SwaggerJsonTemplate() string {
 		"    \"version\": \"version not set\"\n" +
 		"  },\n" +
 		"  \"paths\": {\n" +
+		"    \"/v1/job-set/status\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Event\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetJobSetStatus\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSetStatusRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSetStatusResponse\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/job-set/{Queue}/{Id}\": {\n" +
 		"      \"post\": {\n" +
 		"        \"produces\": [\n" +
@@ -89,6 +115,162 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/job/cancel-by-label\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"CancelJobsByLabel\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobCancelByLabelRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiCancellationResult\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/migrate\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"MigrateJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobMigrateRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobMigrateResponse\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/reprioritize\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"ReprioritizeJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobReprioritizeRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobReprioritizeResponse\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/resume\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"ResumeJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobResumeRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobResumeResponse\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/search\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"SearchJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSearchRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSearchResult\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/status\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Event\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetJobStatus\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobStatusRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobStatusResponse\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/job/submit\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -115,6 +297,84 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/job/suspend\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"SuspendJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSuspendRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSuspendResponse\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/ungate\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"UngateJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobUngateRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobUngateResponse\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/jobsets/submit\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"SubmitJobSets\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSetsSubmitRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSetsSubmitResponse\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/queue/{Name}\": {\n" +
 		"      \"get\": {\n" +
 		"        \"tags\": [\n" +
@@ -165,34 +425,209 @@ func SwaggerJsonTemplate() string {
 		"            \"schema\": {}\n" +
 		"          }\n" +
 		"        }\n" +
-		"      }\n" +
-		"    }\n" +
-		"  },\n" +
-		"  \"definitions\": {\n" +
-		"    \"apiCancellationResult\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
-		"      \"properties\": {\n" +
-		"        \"CancelledIds\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
-		"            \"type\": \"string\"\n" +
+		"      },\n" +
+		"      \"delete\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"DeleteQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"Name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"boolean\",\n" +
+		"            \"format\": \"boolean\",\n" +
+		"            \"description\": \"Cancel queued and leased jobs of the queue instead of refusing to delete it.\",\n" +
+		"            \"name\": \"Force\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueDeleteResponse\"\n" +
+		"            }\n" +
 		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiEventMessage\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"properties\": {\n" +
-		"        \"cancelled\": {\n" +
+		"    \"/v1/queue/{Queue}/job-template/{Name}\": {\n" +
+		"      \"put\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"CreateJobTemplate\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"Queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"Name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobTemplate\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queues/export\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"ExportQueues\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueExportRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueExportResponse\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queues/import\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"ImportQueues\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueImportRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueImportResponse\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/state/reconcile\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"ReconcileState\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiStateReconcileRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiStateReconcileResponse\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/user/permissions\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetMyPermissions\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiPermissionsRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiPermissionsResponse\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    }\n" +
+		"  },\n" +
+		"  \"definitions\": {\n" +
+		"    \"apiCancellationResult\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"CancelledIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiEventMessage\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cancelled\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobCancelledEvent\"\n" +
 		"        },\n" +
 		"        \"cancelling\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobCancellingEvent\"\n" +
 		"        },\n" +
+		"        \"deadlineExceeded\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobDeadlineExceededEvent\"\n" +
+		"        },\n" +
 		"        \"failed\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobFailedEvent\"\n" +
 		"        },\n" +
+		"        \"leaseDenied\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobLeaseDeniedEvent\"\n" +
+		"        },\n" +
 		"        \"leaseExpired\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobLeaseExpiredEvent\"\n" +
 		"        },\n" +
@@ -211,6 +646,12 @@ func SwaggerJsonTemplate() string {
 		"        \"reprioritized\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobReprioritizedEvent\"\n" +
 		"        },\n" +
+		"        \"requeued\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobRequeuedEvent\"\n" +
+		"        },\n" +
+		"        \"resourceOveruse\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobResourceOveruseEvent\"\n" +
+		"        },\n" +
 		"        \"running\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobRunningEvent\"\n" +
 		"        },\n" +
@@ -249,10 +690,38 @@ func SwaggerJsonTemplate() string {
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"CancelOnFailure\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"format\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"ClientId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"CorrelationId\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Correlation id of the request which submitted the job, log lines about the job include it\"\n" +
+		"        },\n" +
 		"        \"Created\": {\n" +
 		"          \"type\": \"string\",\n" +
 		"          \"format\": \"date-time\"\n" +
 		"        },\n" +
+		"        \"FailedAttempts\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\",\n" +
+		"          \"title\": \"Number of times the job was queued again after failing\"\n" +
+		"        },\n" +
+		"        \"Gated\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"format\": \"boolean\",\n" +
+		"          \"title\": \"Job is not leased until it is released by UngateJobs\"\n" +
+		"        },\n" +
+		"        \"GrantedResources\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Resources the job was leased with, the pod spec of the leased job is scaled to these, empty when the job is leased with resources of its pod spec\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"Id\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -265,19 +734,53 @@ func SwaggerJsonTemplate() string {
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"LeaseAttempts\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\",\n" +
+		"          \"title\": \"Number of times the job was returned to the queue after being leased\"\n" +
+		"        },\n" +
+		"        \"LeaseReturnReason\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Why an executor returned the job the last time it leased it, empty when the job was not returned\"\n" +
+		"        },\n" +
+		"        \"MinResources\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Smallest resources the job can run with, empty when the job runs only with resources of its pod spec\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"Namespace\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"NotBefore\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\",\n" +
+		"          \"title\": \"Job is not leased before this time, empty when it can be leased at any time\"\n" +
+		"        },\n" +
+		"        \"OverusingSince\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\",\n" +
+		"          \"title\": \"Since when the job uses more resources than it requested, empty when it does not\"\n" +
+		"        },\n" +
 		"        \"Owner\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"PodSpec\": {\n" +
 		"          \"$ref\": \"#/definitions/v1PodSpec\"\n" +
 		"        },\n" +
+		"        \"PreferredCluster\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Cluster preferred for the job, other clusters lease the job only when the preferred cluster has no capacity for it\"\n" +
+		"        },\n" +
 		"        \"Priority\": {\n" +
 		"          \"type\": \"number\",\n" +
 		"          \"format\": \"double\"\n" +
 		"        },\n" +
+		"        \"PriorityClass\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Priority class of the job, its preemption tier is configured by scheduling.priorityClasses\"\n" +
+		"        },\n" +
 		"        \"Queue\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -286,6 +789,35 @@ func SwaggerJsonTemplate() string {
 		"          \"additionalProperties\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
+		"        },\n" +
+		"        \"ResourcesUsed\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Rolling average of resources used by the running job, as reported by the executor\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"Services\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Services created by the executor together with the pod of the job\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiServiceConfig\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobCancelByLabelRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"Labels\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"Queue\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -299,14 +831,252 @@ func SwaggerJsonTemplate() string {
 		"        \"JobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"OnlyIfUnstarted\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"format\": \"boolean\",\n" +
+		"          \"title\": \"Only cancel jobs which are still queued, leased jobs are left untouched\"\n" +
+		"        },\n" +
+		"        \"Queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobCancelledEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"Created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"JobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"JobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobCancellingEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"Created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"JobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"JobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Reason\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Set when the job was not cancelled by a user, e.g. because of failure of another job of its job set\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobDeadlineExceededEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"ClusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"JobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"JobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobFailedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"Category\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Category of the failure, e.g. ImagePullBackOff or NodeLost, deciding whether the job is retried\"\n" +
+		"        },\n" +
+		"        \"ClusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"DeadlineExceeded\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"format\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"ExitCodes\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"integer\",\n" +
+		"            \"format\": \"int32\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"JobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"JobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"OOMKilled\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"format\": \"boolean\",\n" +
+		"          \"title\": \"Set when a container of the job was killed because it ran out of memory\"\n" +
+		"        },\n" +
 		"        \"Queue\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobLeaseDeniedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Job could not be leased to the cluster, reported at most once per scheduling.leaseDeniedEventInterval for each job\",\n" +
+		"      \"properties\": {\n" +
+		"        \"ClusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"JobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"JobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Reason\": {\n" +
+		"          \"$ref\": \"#/definitions/apiLeaseDeniedReason\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobLeaseExpiredEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"Created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"JobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"JobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobLeaseReturnedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"ClusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"JobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"JobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobLeasedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"ClusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"JobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"JobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobMigrateRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"SourceQueue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"TargetQueue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobMigrateResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"MigratedIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobCancelledEvent\": {\n" +
+		"    \"apiJobOrderingStrategy\": {\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"title\": \"- Priority: Jobs with higher priority (lower value) first, jobs of the same priority in submission order\\n - FIFO: Jobs in submission order regardless of their priority\\n - ShortestResourceFirst: Jobs requesting least resources first\",\n" +
+		"      \"default\": \"Priority\",\n" +
+		"      \"enum\": [\n" +
+		"        \"Priority\",\n" +
+		"        \"FIFO\",\n" +
+		"        \"ShortestResourceFirst\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"apiJobPendingEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
+		"        \"ClusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"Created\": {\n" +
 		"          \"type\": \"string\",\n" +
 		"          \"format\": \"date-time\"\n" +
@@ -322,13 +1092,18 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobCancellingEvent\": {\n" +
+		"    \"apiJobQueuedEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"Created\": {\n" +
 		"          \"type\": \"string\",\n" +
 		"          \"format\": \"date-time\"\n" +
 		"        },\n" +
+		"        \"Gated\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"format\": \"boolean\",\n" +
+		"          \"title\": \"Job waits in the queue gated, it is not leased until released by UngateJobs\"\n" +
+		"        },\n" +
 		"        \"JobId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -340,38 +1115,45 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobFailedEvent\": {\n" +
+		"    \"apiJobReprioritizeRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"ClusterId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"Created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"ExitCodes\": {\n" +
-		"          \"type\": \"object\",\n" +
-		"          \"additionalProperties\": {\n" +
-		"            \"type\": \"integer\",\n" +
-		"            \"format\": \"int32\"\n" +
+		"        \"JobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Jobs to reprioritize, all queued and leased jobs of the job set are reprioritized when empty\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"JobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
 		"        \"JobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Job set to reprioritize, in all queues unless queue is specified\"\n" +
 		"        },\n" +
-		"        \"Queue\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"NewPriority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\",\n" +
+		"          \"title\": \"New priority of the jobs, jobs with lower priority are leased first\"\n" +
 		"        },\n" +
-		"        \"Reason\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"Queue\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Queue of the job set\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobLeaseExpiredEvent\": {\n" +
+		"    \"apiJobReprioritizeResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"ReprioritizedIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobReprioritizedEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"Created\": {\n" +
@@ -389,8 +1171,9 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobLeaseReturnedEvent\": {\n" +
+		"    \"apiJobRequeuedEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Failed job was queued again with its spec changed as described by Reason\",\n" +
 		"      \"properties\": {\n" +
 		"        \"ClusterId\": {\n" +
 		"          \"type\": \"string\"\n" +
@@ -413,8 +1196,9 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobLeasedEvent\": {\n" +
+		"    \"apiJobResourceOveruseEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Job used more resources than it requested by the configured ratio for the configured period\",\n" +
 		"      \"properties\": {\n" +
 		"        \"ClusterId\": {\n" +
 		"          \"type\": \"string\"\n" +
@@ -431,10 +1215,40 @@ func SwaggerJsonTemplate() string {
 		"        },\n" +
 		"        \"Queue\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"ResourcesUsed\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobPendingEvent\": {\n" +
+		"    \"apiJobResumeRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"JobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobResumeResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"ResumedIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobRunningEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"ClusterId\": {\n" +
@@ -455,55 +1269,72 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobQueuedEvent\": {\n" +
+		"    \"apiJobSearchRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"Created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"JobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"JobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"Labels\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
 		"        },\n" +
 		"        \"Queue\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobReprioritizedEvent\": {\n" +
+		"    \"apiJobSearchResult\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"Created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"JobId\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"JobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSetInfo\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"LeasedJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        },\n" +
-		"        \"JobSetId\": {\n" +
+		"        \"Name\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"Queue\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"QueuedJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobRunningEvent\": {\n" +
+		"    \"apiJobSetRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"ClusterId\": {\n" +
+		"        \"FromMessageId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"Created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
+		"        \"Id\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"JobId\": {\n" +
+		"        \"Queue\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"Watch\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"format\": \"boolean\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSetStatusRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
 		"        \"JobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -512,38 +1343,134 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSetInfo\": {\n" +
+		"    \"apiJobSetStatusResponse\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"LeasedJobs\": {\n" +
+		"        \"Cancelled\": {\n" +
 		"          \"type\": \"integer\",\n" +
 		"          \"format\": \"int32\"\n" +
 		"        },\n" +
-		"        \"Name\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"Failed\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        },\n" +
-		"        \"QueuedJobs\": {\n" +
+		"        \"Gated\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"Leased\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"Pending\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"Queued\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"Running\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"Succeeded\": {\n" +
 		"          \"type\": \"integer\",\n" +
 		"          \"format\": \"int32\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSetRequest\": {\n" +
+		"    \"apiJobSetsSubmitRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"FromMessageId\": {\n" +
+		"        \"JobSets\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Job sets submitted together, either all of their jobs are submitted or none\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobSubmitRequest\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSetsSubmitResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"JobSets\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobSubmitResponse\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobStatusRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"JobId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"Id\": {\n" +
+		"        \"JobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"Queue\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobStatusResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"JobId\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"Watch\": {\n" +
-		"          \"type\": \"boolean\",\n" +
-		"          \"format\": \"boolean\"\n" +
+		"        \"State\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Queued, Leased, Pending, Running, Succeeded, Failed or Cancelled\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSubmitDefaults\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Values of jobs of a submit request used by items which do not set them\",\n" +
+		"      \"properties\": {\n" +
+		"        \"Annotations\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Annotations of all items, annotations set by the item take precedence\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"Labels\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Labels of all items, labels set by the item take precedence\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"Namespace\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Namespace of items without namespace\"\n" +
+		"        },\n" +
+		"        \"Priority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\",\n" +
+		"          \"title\": \"Priority of items with priority 0\"\n" +
+		"        },\n" +
+		"        \"PriorityClass\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Priority class of items without priority class\"\n" +
+		"        },\n" +
+		"        \"RequiredNodeLabels\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Required node labels of all items, required node labels set by the item take precedence\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -551,6 +1478,19 @@ func SwaggerJsonTemplate() string {
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
+		"        \"CallbackUrl\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"URL receiving POST notifications of state transitions of jobs of the job set\"\n" +
+		"        },\n" +
+		"        \"CancelOnFailure\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"format\": \"boolean\",\n" +
+		"          \"title\": \"Cancels all queued and leased jobs of the job set when any of its jobs fails\"\n" +
+		"        },\n" +
+		"        \"Defaults\": {\n" +
+		"          \"title\": \"Defaults of items of the request, values set by an item take precedence\",\n" +
+		"          \"$ref\": \"#/definitions/apiJobSubmitDefaults\"\n" +
+		"        },\n" +
 		"        \"JobRequestItems\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -562,6 +1502,11 @@ func SwaggerJsonTemplate() string {
 		"        },\n" +
 		"        \"Queue\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Strict\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"format\": \"boolean\",\n" +
+		"          \"title\": \"Rejects the whole request when any of the jobs is invalid, by default only the invalid jobs are rejected\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -574,27 +1519,71 @@ func SwaggerJsonTemplate() string {
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"ClientId\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Jobs submitted repeatedly with the same ClientId to the same queue and job set are created only once\"\n" +
+		"        },\n" +
+		"        \"Gated\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"format\": \"boolean\",\n" +
+		"          \"title\": \"Gated job is not leased until it is released by UngateJobs\"\n" +
+		"        },\n" +
 		"        \"Labels\": {\n" +
 		"          \"type\": \"object\",\n" +
 		"          \"additionalProperties\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"MinResources\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Smallest resources the job can run with, the job is leased with resources of its pod spec or less down to these when its queue has no share for all of them\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"Namespace\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"NotBefore\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\",\n" +
+		"          \"title\": \"Job is not leased before this time, it is scheduled as any other job afterwards\"\n" +
+		"        },\n" +
 		"        \"PodSpec\": {\n" +
 		"          \"$ref\": \"#/definitions/v1PodSpec\"\n" +
 		"        },\n" +
+		"        \"PreferredCluster\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Cluster the job is leased to when it has capacity for the job, e.g. because it holds cached inputs, other clusters lease the job otherwise\"\n" +
+		"        },\n" +
 		"        \"Priority\": {\n" +
 		"          \"type\": \"number\",\n" +
 		"          \"format\": \"double\"\n" +
 		"        },\n" +
+		"        \"PriorityClass\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Name of the priority class deciding which running jobs the job may preempt and which jobs may preempt it\"\n" +
+		"        },\n" +
 		"        \"RequiredNodeLabels\": {\n" +
 		"          \"type\": \"object\",\n" +
 		"          \"additionalProperties\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
+		"        },\n" +
+		"        \"Services\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Services exposing ports of the job, each port can be exposed by one service only\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiServiceConfig\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"TemplateName\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Name of the job template of the queue providing the pod spec, PodSpec must be empty when used\"\n" +
+		"        },\n" +
+		"        \"TemplateOverrides\": {\n" +
+		"          \"title\": \"Changes of the pod spec of the job template\",\n" +
+		"          \"$ref\": \"#/definitions/apiJobTemplateOverrides\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -663,6 +1652,71 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiJobSuspendRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"JobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSuspendResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"SuspendedIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobTemplate\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Reusable pod spec of jobs submitted to a queue, referenced by JobSubmitRequestItem.TemplateName\\nswagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"Labels\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Labels of jobs created from the template, labels of the submitted job take precedence\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"Name\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"PodSpec\": {\n" +
+		"          \"$ref\": \"#/definitions/v1PodSpec\"\n" +
+		"        },\n" +
+		"        \"Queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobTemplateOverrides\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"Args\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Replaces arguments of the first container when not empty\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"Env\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Environment variables set in all containers, replacing variables of the same name\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1EnvVar\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiJobTerminatedEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -708,19 +1762,134 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiJobUngateRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"JobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobUngateResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"UngatedIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiLeaseDeniedReason\": {\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"title\": \"- NoMatchingCluster: No cluster has nodes with the labels required by the job\",\n" +
+		"      \"default\": \"Unknown\",\n" +
+		"      \"enum\": [\n" +
+		"        \"Unknown\",\n" +
+		"        \"NoMatchingNodeLabels\",\n" +
+		"        \"QueueLimitReached\",\n" +
+		"        \"InsufficientCapacity\",\n" +
+		"        \"NoMatchingCluster\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"apiPermissionsRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\"\n" +
+		"    },\n" +
+		"    \"apiPermissionsResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"OwnedQueues\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Names of queues owned by the calling user or one of its groups\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"Permissions\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Permissions granted to the calling user by its groups and scopes\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiQueue\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
+		"        \"ClampJobPriority\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"format\": \"boolean\",\n" +
+		"          \"title\": \"Job priorities out of the allowed range are clamped to the range instead of rejecting the jobs\"\n" +
+		"        },\n" +
+		"        \"DefaultJobPriority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\",\n" +
+		"          \"title\": \"Priority of jobs submitted without priority\"\n" +
+		"        },\n" +
+		"        \"Group\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"GroupOwners\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"GuaranteedResources\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Resources reserved for the queue, while the queue uses less its jobs are leased ahead of other queues\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"JobOrdering\": {\n" +
+		"          \"title\": \"Order in which jobs read from the queue at once are leased\",\n" +
+		"          \"$ref\": \"#/definitions/apiJobOrderingStrategy\"\n" +
+		"        },\n" +
+		"        \"LeaseBatchSize\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\",\n" +
+		"          \"title\": \"Number of jobs read from the queue at once when leasing, overrides scheduling.queueLeaseBatchSize when not 0\"\n" +
+		"        },\n" +
+		"        \"MaxConcurrentJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\",\n" +
+		"          \"title\": \"Maximum number of leased jobs of the queue, further jobs stay queued regardless of resources, the number is not limited when 0\"\n" +
+		"        },\n" +
+		"        \"MaxJobPriority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"MaxQueuedJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\",\n" +
+		"          \"title\": \"Maximum number of jobs waiting in the queue, submitted jobs over the limit are rejected, the number is not limited when 0\"\n" +
+		"        },\n" +
+		"        \"MinJobPriority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\",\n" +
+		"          \"title\": \"Range of priorities allowed for jobs of the queue, the range is not limited when both bounds are 0\"\n" +
+		"        },\n" +
 		"        \"Name\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"Namespace\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"Pool\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Pool of clusters jobs of the queue are leased to, jobs are leased to clusters of any pool when empty\"\n" +
+		"        },\n" +
 		"        \"PriorityFactor\": {\n" +
 		"          \"type\": \"number\",\n" +
 		"          \"format\": \"double\"\n" +
@@ -732,6 +1901,21 @@ func SwaggerJsonTemplate() string {
 		"            \"format\": \"double\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"ResourcePriorityFactors\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Priority factors of the queue for individual resources, PriorityFactor is used for resources not listed\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"number\",\n" +
+		"            \"format\": \"double\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"SchedulingWindows\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Daily time windows the queue leases jobs in or is blocked from leasing jobs in, the queue leases jobs at any time when empty\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiSchedulingWindow\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"UserOwners\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -740,6 +1924,69 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiQueueDeleteResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"CancelledIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQueueExportRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\"\n" +
+		"    },\n" +
+		"    \"apiQueueExportResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"Queues\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQueueImportRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"Overwrite\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"format\": \"boolean\",\n" +
+		"          \"title\": \"Replace existing queues of the same name instead of skipping them\"\n" +
+		"        },\n" +
+		"        \"Queues\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQueueImportResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"ImportedNames\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"SkippedNames\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiQueueInfo\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
@@ -755,6 +2002,102 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiSchedulingWindow\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"Blocked\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"format\": \"boolean\",\n" +
+		"          \"title\": \"Queue does not lease jobs during blocked windows, otherwise it leases jobs only during its allowed windows\"\n" +
+		"        },\n" +
+		"        \"Days\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Days of week the window starts on (Mon, Tue, ...), every day when empty\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"End\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"End of the window in UTC in HH:MM format, the window continues over midnight when it is not after Start\"\n" +
+		"        },\n" +
+		"        \"Start\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Start of the window in UTC in HH:MM format\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiServiceConfig\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Service exposing ports of a job, e.g. of an interactive notebook, created by the executor together with the pod of the job\",\n" +
+		"      \"properties\": {\n" +
+		"        \"Ports\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Container ports of the job exposed by the service\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"integer\",\n" +
+		"            \"format\": \"int64\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"Type\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"Type of the service, ClusterIP when empty or NodePort\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiStateReconcileRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"DryRun\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"format\": \"boolean\",\n" +
+		"          \"title\": \"Report inconsistencies without repairing them\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiStateReconcileResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"RemovedClusterAssociations\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Jobs which were associated with a cluster without being leased\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"RemovedJobSetEntries\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Deleted jobs removed from job set indexes\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"RemovedQueueEntries\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Deleted jobs removed from queued or leased jobs of their queue\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"RequeuedJobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Jobs missing in both queued and leased jobs of their queue, added back to the queue\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"ReturnedJobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"title\": \"Jobs leased to clusters which are not active, returned to their queue\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"intstrIntOrString\": {\n" +
 		"      \"description\": \"+protobuf=true\\n+protobuf.options.(gogoproto.goproto_stringer)=false\\n+k8s:openapi-gen=true\",\n" +
 		"      \"type\": \"object\",\n" +
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const errorDetailTypeUrl = "type.googleapis.com/api.ErrorDetail"
+
+// ErrorWithCode returns gRPC status error with ErrorDetail telling clients the reason of the failure.
+func ErrorWithCode(code codes.Code, errorCode ErrorCode, format string, args ...interface{}) error {
+	message := fmt.Sprintf(format, args...)
+	s := status.New(code, message).Proto()
+	detail, e := (&ErrorDetail{Code: errorCode, Message: message}).Marshal()
+	if e == nil {
+		s.Details = append(s.Details, &any.Any{TypeUrl: errorDetailTypeUrl, Value: detail})
+	}
+	return status.ErrorProto(s)
+}
+
+// ErrorCodeOf returns the error code reported in details of gRPC status error, UnknownError when the error has none.
+func ErrorCodeOf(e error) ErrorCode {
+	s, ok := status.FromError(e)
+	if !ok || s == nil {
+		return ErrorCode_UnknownError
+	}
+	for _, d := range s.Proto().Details {
+		if d.TypeUrl != errorDetailTypeUrl {
+			continue
+		}
+		detail := &ErrorDetail{}
+		if detail.Unmarshal(d.Value) == nil {
+			return detail.Code
+		}
+	}
+	return ErrorCode_UnknownError
+}
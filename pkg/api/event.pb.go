@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	io "io"
 	math "math"
+	math_bits "math/bits"
 	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -16,6 +17,9 @@ import (
 	types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -28,7 +32,42 @@ var _ = time.Kitchen
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type LeaseDeniedReason int32
+
+const (
+	LeaseDeniedReason_Unknown              LeaseDeniedReason = 0
+	LeaseDeniedReason_NoMatchingNodeLabels LeaseDeniedReason = 1
+	LeaseDeniedReason_QueueLimitReached    LeaseDeniedReason = 2
+	LeaseDeniedReason_InsufficientCapacity LeaseDeniedReason = 3
+	// No cluster has nodes with the labels required by the job
+	LeaseDeniedReason_NoMatchingCluster LeaseDeniedReason = 4
+)
+
+var LeaseDeniedReason_name = map[int32]string{
+	0: "Unknown",
+	1: "NoMatchingNodeLabels",
+	2: "QueueLimitReached",
+	3: "InsufficientCapacity",
+	4: "NoMatchingCluster",
+}
+
+var LeaseDeniedReason_value = map[string]int32{
+	"Unknown":              0,
+	"NoMatchingNodeLabels": 1,
+	"QueueLimitReached":    2,
+	"InsufficientCapacity": 3,
+	"NoMatchingCluster":    4,
+}
+
+func (x LeaseDeniedReason) String() string {
+	return proto.EnumName(LeaseDeniedReason_name, int32(x))
+}
+
+func (LeaseDeniedReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{0}
+}
 
 type JobSubmittedEvent struct {
 	JobId    string    `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
@@ -52,7 +91,7 @@ func (m *JobSubmittedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_JobSubmittedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -111,6 +150,8 @@ type JobQueuedEvent struct {
 	JobSetId string    `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
 	Queue    string    `protobuf:"bytes,3,opt,name=Queue,proto3" json:"Queue,omitempty"`
 	Created  time.Time `protobuf:"bytes,4,opt,name=Created,proto3,stdtime" json:"Created"`
+	// Job waits in the queue gated, it is not leased until released by UngateJobs
+	Gated bool `protobuf:"varint,5,opt,name=Gated,proto3" json:"Gated,omitempty"`
 }
 
 func (m *JobQueuedEvent) Reset()         { *m = JobQueuedEvent{} }
@@ -127,7 +168,7 @@ func (m *JobQueuedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_JobQueuedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -174,6 +215,13 @@ func (m *JobQueuedEvent) GetCreated() time.Time {
 	return time.Time{}
 }
 
+func (m *JobQueuedEvent) GetGated() bool {
+	if m != nil {
+		return m.Gated
+	}
+	return false
+}
+
 type JobLeasedEvent struct {
 	JobId     string    `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
 	JobSetId  string    `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
@@ -196,7 +244,7 @@ func (m *JobLeasedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_JobLeasedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -273,7 +321,7 @@ func (m *JobLeaseReturnedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_JobLeaseReturnedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -355,7 +403,7 @@ func (m *JobLeaseExpiredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_JobLeaseExpiredEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -424,7 +472,7 @@ func (m *JobPendingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_JobPendingEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -500,7 +548,7 @@ func (m *JobRunningEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_JobRunningEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -577,7 +625,7 @@ func (m *JobUnableToScheduleEvent) XXX_Marshal(b []byte, deterministic bool) ([]
 		return xxx_messageInfo_JobUnableToScheduleEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -639,13 +687,18 @@ func (m *JobUnableToScheduleEvent) GetReason() string {
 }
 
 type JobFailedEvent struct {
-	JobId     string           `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
-	JobSetId  string           `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
-	Queue     string           `protobuf:"bytes,3,opt,name=Queue,proto3" json:"Queue,omitempty"`
-	Created   time.Time        `protobuf:"bytes,4,opt,name=Created,proto3,stdtime" json:"Created"`
-	ClusterId string           `protobuf:"bytes,5,opt,name=ClusterId,proto3" json:"ClusterId,omitempty"`
-	Reason    string           `protobuf:"bytes,6,opt,name=Reason,proto3" json:"Reason,omitempty"`
-	ExitCodes map[string]int32 `protobuf:"bytes,7,rep,name=ExitCodes,proto3" json:"ExitCodes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	JobId            string           `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
+	JobSetId         string           `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
+	Queue            string           `protobuf:"bytes,3,opt,name=Queue,proto3" json:"Queue,omitempty"`
+	Created          time.Time        `protobuf:"bytes,4,opt,name=Created,proto3,stdtime" json:"Created"`
+	ClusterId        string           `protobuf:"bytes,5,opt,name=ClusterId,proto3" json:"ClusterId,omitempty"`
+	Reason           string           `protobuf:"bytes,6,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	ExitCodes        map[string]int32 `protobuf:"bytes,7,rep,name=ExitCodes,proto3" json:"ExitCodes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	DeadlineExceeded bool             `protobuf:"varint,8,opt,name=DeadlineExceeded,proto3" json:"DeadlineExceeded,omitempty"`
+	// Set when a container of the job was killed because it ran out of memory
+	OOMKilled bool `protobuf:"varint,9,opt,name=OOMKilled,proto3" json:"OOMKilled,omitempty"`
+	// Category of the failure, e.g. ImagePullBackOff or NodeLost, deciding whether the job is retried
+	Category string `protobuf:"bytes,10,opt,name=Category,proto3" json:"Category,omitempty"`
 }
 
 func (m *JobFailedEvent) Reset()         { *m = JobFailedEvent{} }
@@ -662,7 +715,7 @@ func (m *JobFailedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_JobFailedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -730,6 +783,27 @@ func (m *JobFailedEvent) GetExitCodes() map[string]int32 {
 	return nil
 }
 
+func (m *JobFailedEvent) GetDeadlineExceeded() bool {
+	if m != nil {
+		return m.DeadlineExceeded
+	}
+	return false
+}
+
+func (m *JobFailedEvent) GetOOMKilled() bool {
+	if m != nil {
+		return m.OOMKilled
+	}
+	return false
+}
+
+func (m *JobFailedEvent) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
 type JobSucceededEvent struct {
 	JobId     string    `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
 	JobSetId  string    `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
@@ -752,7 +826,7 @@ func (m *JobSucceededEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_JobSucceededEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -827,7 +901,7 @@ func (m *JobReprioritizedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_JobReprioritizedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -879,6 +953,8 @@ type JobCancellingEvent struct {
 	JobSetId string    `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
 	Queue    string    `protobuf:"bytes,3,opt,name=Queue,proto3" json:"Queue,omitempty"`
 	Created  time.Time `protobuf:"bytes,4,opt,name=Created,proto3,stdtime" json:"Created"`
+	// Set when the job was not cancelled by a user, e.g. because of failure of another job of its job set
+	Reason string `protobuf:"bytes,5,opt,name=Reason,proto3" json:"Reason,omitempty"`
 }
 
 func (m *JobCancellingEvent) Reset()         { *m = JobCancellingEvent{} }
@@ -895,7 +971,7 @@ func (m *JobCancellingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_JobCancellingEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -942,11 +1018,19 @@ func (m *JobCancellingEvent) GetCreated() time.Time {
 	return time.Time{}
 }
 
+func (m *JobCancellingEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type JobCancelledEvent struct {
 	JobId    string    `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
 	JobSetId string    `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
 	Queue    string    `protobuf:"bytes,3,opt,name=Queue,proto3" json:"Queue,omitempty"`
 	Created  time.Time `protobuf:"bytes,4,opt,name=Created,proto3,stdtime" json:"Created"`
+	Reason   string    `protobuf:"bytes,5,opt,name=Reason,proto3" json:"Reason,omitempty"`
 }
 
 func (m *JobCancelledEvent) Reset()         { *m = JobCancelledEvent{} }
@@ -963,7 +1047,7 @@ func (m *JobCancelledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_JobCancelledEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1010,6 +1094,13 @@ func (m *JobCancelledEvent) GetCreated() time.Time {
 	return time.Time{}
 }
 
+func (m *JobCancelledEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type JobTerminatedEvent struct {
 	JobId     string    `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
 	JobSetId  string    `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
@@ -1032,7 +1123,7 @@ func (m *JobTerminatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_JobTerminatedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1086,2627 +1177,6417 @@ func (m *JobTerminatedEvent) GetClusterId() string {
 	return ""
 }
 
-type EventMessage struct {
-	// Types that are valid to be assigned to Events:
-	//	*EventMessage_Submitted
-	//	*EventMessage_Queued
-	//	*EventMessage_Leased
-	//	*EventMessage_LeaseReturned
-	//	*EventMessage_LeaseExpired
-	//	*EventMessage_Pending
-	//	*EventMessage_Running
-	//	*EventMessage_UnableToSchedule
-	//	*EventMessage_Failed
-	//	*EventMessage_Succeeded
-	//	*EventMessage_Reprioritized
-	//	*EventMessage_Cancelling
-	//	*EventMessage_Cancelled
-	//	*EventMessage_Terminated
-	Events isEventMessage_Events `protobuf_oneof:"events"`
+type JobDeadlineExceededEvent struct {
+	JobId     string    `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
+	JobSetId  string    `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
+	Queue     string    `protobuf:"bytes,3,opt,name=Queue,proto3" json:"Queue,omitempty"`
+	Created   time.Time `protobuf:"bytes,4,opt,name=Created,proto3,stdtime" json:"Created"`
+	ClusterId string    `protobuf:"bytes,5,opt,name=ClusterId,proto3" json:"ClusterId,omitempty"`
 }
 
-func (m *EventMessage) Reset()         { *m = EventMessage{} }
-func (m *EventMessage) String() string { return proto.CompactTextString(m) }
-func (*EventMessage) ProtoMessage()    {}
-func (*EventMessage) Descriptor() ([]byte, []int) {
+func (m *JobDeadlineExceededEvent) Reset()         { *m = JobDeadlineExceededEvent{} }
+func (m *JobDeadlineExceededEvent) String() string { return proto.CompactTextString(m) }
+func (*JobDeadlineExceededEvent) ProtoMessage()    {}
+func (*JobDeadlineExceededEvent) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7758595c3bb8cf56, []int{14}
 }
-func (m *EventMessage) XXX_Unmarshal(b []byte) error {
+func (m *JobDeadlineExceededEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobDeadlineExceededEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMessage.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobDeadlineExceededEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *EventMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMessage.Merge(m, src)
+func (m *JobDeadlineExceededEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobDeadlineExceededEvent.Merge(m, src)
 }
-func (m *EventMessage) XXX_Size() int {
+func (m *JobDeadlineExceededEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMessage.DiscardUnknown(m)
+func (m *JobDeadlineExceededEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobDeadlineExceededEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMessage proto.InternalMessageInfo
+var xxx_messageInfo_JobDeadlineExceededEvent proto.InternalMessageInfo
 
-type isEventMessage_Events interface {
-	isEventMessage_Events()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *JobDeadlineExceededEvent) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
 }
 
-type EventMessage_Submitted struct {
-	Submitted *JobSubmittedEvent `protobuf:"bytes,1,opt,name=submitted,proto3,oneof"`
-}
-type EventMessage_Queued struct {
-	Queued *JobQueuedEvent `protobuf:"bytes,2,opt,name=queued,proto3,oneof"`
-}
-type EventMessage_Leased struct {
-	Leased *JobLeasedEvent `protobuf:"bytes,3,opt,name=leased,proto3,oneof"`
-}
-type EventMessage_LeaseReturned struct {
-	LeaseReturned *JobLeaseReturnedEvent `protobuf:"bytes,4,opt,name=leaseReturned,proto3,oneof"`
+func (m *JobDeadlineExceededEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
 }
-type EventMessage_LeaseExpired struct {
-	LeaseExpired *JobLeaseExpiredEvent `protobuf:"bytes,5,opt,name=leaseExpired,proto3,oneof"`
+
+func (m *JobDeadlineExceededEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
 }
-type EventMessage_Pending struct {
-	Pending *JobPendingEvent `protobuf:"bytes,6,opt,name=pending,proto3,oneof"`
+
+func (m *JobDeadlineExceededEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
 }
-type EventMessage_Running struct {
-	Running *JobRunningEvent `protobuf:"bytes,7,opt,name=running,proto3,oneof"`
+
+func (m *JobDeadlineExceededEvent) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
 }
-type EventMessage_UnableToSchedule struct {
-	UnableToSchedule *JobUnableToScheduleEvent `protobuf:"bytes,8,opt,name=unableToSchedule,proto3,oneof"`
+
+// Job could not be leased to the cluster, reported at most once per scheduling.leaseDeniedEventInterval for each job
+type JobLeaseDeniedEvent struct {
+	JobId     string            `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
+	JobSetId  string            `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
+	Queue     string            `protobuf:"bytes,3,opt,name=Queue,proto3" json:"Queue,omitempty"`
+	Created   time.Time         `protobuf:"bytes,4,opt,name=Created,proto3,stdtime" json:"Created"`
+	ClusterId string            `protobuf:"bytes,5,opt,name=ClusterId,proto3" json:"ClusterId,omitempty"`
+	Reason    LeaseDeniedReason `protobuf:"varint,6,opt,name=Reason,proto3,enum=api.LeaseDeniedReason" json:"Reason,omitempty"`
 }
-type EventMessage_Failed struct {
-	Failed *JobFailedEvent `protobuf:"bytes,9,opt,name=failed,proto3,oneof"`
+
+func (m *JobLeaseDeniedEvent) Reset()         { *m = JobLeaseDeniedEvent{} }
+func (m *JobLeaseDeniedEvent) String() string { return proto.CompactTextString(m) }
+func (*JobLeaseDeniedEvent) ProtoMessage()    {}
+func (*JobLeaseDeniedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{15}
 }
-type EventMessage_Succeeded struct {
-	Succeeded *JobSucceededEvent `protobuf:"bytes,10,opt,name=succeeded,proto3,oneof"`
+func (m *JobLeaseDeniedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-type EventMessage_Reprioritized struct {
-	Reprioritized *JobReprioritizedEvent `protobuf:"bytes,11,opt,name=reprioritized,proto3,oneof"`
+func (m *JobLeaseDeniedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobLeaseDeniedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-type EventMessage_Cancelling struct {
-	Cancelling *JobCancellingEvent `protobuf:"bytes,12,opt,name=cancelling,proto3,oneof"`
+func (m *JobLeaseDeniedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobLeaseDeniedEvent.Merge(m, src)
 }
-type EventMessage_Cancelled struct {
-	Cancelled *JobCancelledEvent `protobuf:"bytes,13,opt,name=cancelled,proto3,oneof"`
+func (m *JobLeaseDeniedEvent) XXX_Size() int {
+	return m.Size()
 }
-type EventMessage_Terminated struct {
-	Terminated *JobTerminatedEvent `protobuf:"bytes,14,opt,name=terminated,proto3,oneof"`
+func (m *JobLeaseDeniedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobLeaseDeniedEvent.DiscardUnknown(m)
 }
 
-func (*EventMessage_Submitted) isEventMessage_Events()        {}
-func (*EventMessage_Queued) isEventMessage_Events()           {}
-func (*EventMessage_Leased) isEventMessage_Events()           {}
-func (*EventMessage_LeaseReturned) isEventMessage_Events()    {}
-func (*EventMessage_LeaseExpired) isEventMessage_Events()     {}
-func (*EventMessage_Pending) isEventMessage_Events()          {}
-func (*EventMessage_Running) isEventMessage_Events()          {}
-func (*EventMessage_UnableToSchedule) isEventMessage_Events() {}
-func (*EventMessage_Failed) isEventMessage_Events()           {}
-func (*EventMessage_Succeeded) isEventMessage_Events()        {}
-func (*EventMessage_Reprioritized) isEventMessage_Events()    {}
-func (*EventMessage_Cancelling) isEventMessage_Events()       {}
-func (*EventMessage_Cancelled) isEventMessage_Events()        {}
-func (*EventMessage_Terminated) isEventMessage_Events()       {}
+var xxx_messageInfo_JobLeaseDeniedEvent proto.InternalMessageInfo
 
-func (m *EventMessage) GetEvents() isEventMessage_Events {
+func (m *JobLeaseDeniedEvent) GetJobId() string {
 	if m != nil {
-		return m.Events
+		return m.JobId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetSubmitted() *JobSubmittedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Submitted); ok {
-		return x.Submitted
+func (m *JobLeaseDeniedEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetQueued() *JobQueuedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Queued); ok {
-		return x.Queued
+func (m *JobLeaseDeniedEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetLeased() *JobLeasedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Leased); ok {
-		return x.Leased
+func (m *JobLeaseDeniedEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
 	}
-	return nil
+	return time.Time{}
 }
 
-func (m *EventMessage) GetLeaseReturned() *JobLeaseReturnedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_LeaseReturned); ok {
-		return x.LeaseReturned
+func (m *JobLeaseDeniedEvent) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetLeaseExpired() *JobLeaseExpiredEvent {
-	if x, ok := m.GetEvents().(*EventMessage_LeaseExpired); ok {
-		return x.LeaseExpired
+func (m *JobLeaseDeniedEvent) GetReason() LeaseDeniedReason {
+	if m != nil {
+		return m.Reason
 	}
-	return nil
+	return LeaseDeniedReason_Unknown
 }
 
-func (m *EventMessage) GetPending() *JobPendingEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Pending); ok {
-		return x.Pending
-	}
-	return nil
+// Job used more resources than it requested by the configured ratio for the configured period
+type JobResourceOveruseEvent struct {
+	JobId         string                       `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
+	JobSetId      string                       `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
+	Queue         string                       `protobuf:"bytes,3,opt,name=Queue,proto3" json:"Queue,omitempty"`
+	Created       time.Time                    `protobuf:"bytes,4,opt,name=Created,proto3,stdtime" json:"Created"`
+	ClusterId     string                       `protobuf:"bytes,5,opt,name=ClusterId,proto3" json:"ClusterId,omitempty"`
+	ResourcesUsed map[string]resource.Quantity `protobuf:"bytes,6,rep,name=ResourcesUsed,proto3" json:"ResourcesUsed" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *EventMessage) GetRunning() *JobRunningEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Running); ok {
-		return x.Running
+func (m *JobResourceOveruseEvent) Reset()         { *m = JobResourceOveruseEvent{} }
+func (m *JobResourceOveruseEvent) String() string { return proto.CompactTextString(m) }
+func (*JobResourceOveruseEvent) ProtoMessage()    {}
+func (*JobResourceOveruseEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{16}
+}
+func (m *JobResourceOveruseEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobResourceOveruseEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobResourceOveruseEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *JobResourceOveruseEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobResourceOveruseEvent.Merge(m, src)
+}
+func (m *JobResourceOveruseEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobResourceOveruseEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobResourceOveruseEvent.DiscardUnknown(m)
 }
 
-func (m *EventMessage) GetUnableToSchedule() *JobUnableToScheduleEvent {
-	if x, ok := m.GetEvents().(*EventMessage_UnableToSchedule); ok {
-		return x.UnableToSchedule
+var xxx_messageInfo_JobResourceOveruseEvent proto.InternalMessageInfo
+
+func (m *JobResourceOveruseEvent) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetFailed() *JobFailedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Failed); ok {
-		return x.Failed
+func (m *JobResourceOveruseEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetSucceeded() *JobSucceededEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Succeeded); ok {
-		return x.Succeeded
+func (m *JobResourceOveruseEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetReprioritized() *JobReprioritizedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Reprioritized); ok {
-		return x.Reprioritized
+func (m *JobResourceOveruseEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
 	}
-	return nil
+	return time.Time{}
 }
 
-func (m *EventMessage) GetCancelling() *JobCancellingEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Cancelling); ok {
-		return x.Cancelling
+func (m *JobResourceOveruseEvent) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetCancelled() *JobCancelledEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Cancelled); ok {
-		return x.Cancelled
+func (m *JobResourceOveruseEvent) GetResourcesUsed() map[string]resource.Quantity {
+	if m != nil {
+		return m.ResourcesUsed
 	}
 	return nil
 }
 
-func (m *EventMessage) GetTerminated() *JobTerminatedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Terminated); ok {
-		return x.Terminated
-	}
-	return nil
-}
-
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*EventMessage) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _EventMessage_OneofMarshaler, _EventMessage_OneofUnmarshaler, _EventMessage_OneofSizer, []interface{}{
-		(*EventMessage_Submitted)(nil),
-		(*EventMessage_Queued)(nil),
-		(*EventMessage_Leased)(nil),
-		(*EventMessage_LeaseReturned)(nil),
-		(*EventMessage_LeaseExpired)(nil),
-		(*EventMessage_Pending)(nil),
-		(*EventMessage_Running)(nil),
-		(*EventMessage_UnableToSchedule)(nil),
-		(*EventMessage_Failed)(nil),
-		(*EventMessage_Succeeded)(nil),
-		(*EventMessage_Reprioritized)(nil),
-		(*EventMessage_Cancelling)(nil),
-		(*EventMessage_Cancelled)(nil),
-		(*EventMessage_Terminated)(nil),
-	}
-}
-
-func _EventMessage_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*EventMessage)
-	// events
-	switch x := m.Events.(type) {
-	case *EventMessage_Submitted:
-		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Submitted); err != nil {
-			return err
-		}
-	case *EventMessage_Queued:
-		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Queued); err != nil {
-			return err
-		}
-	case *EventMessage_Leased:
-		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Leased); err != nil {
-			return err
-		}
-	case *EventMessage_LeaseReturned:
-		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.LeaseReturned); err != nil {
-			return err
-		}
-	case *EventMessage_LeaseExpired:
-		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.LeaseExpired); err != nil {
-			return err
-		}
-	case *EventMessage_Pending:
-		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Pending); err != nil {
-			return err
-		}
-	case *EventMessage_Running:
-		_ = b.EncodeVarint(7<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Running); err != nil {
-			return err
-		}
-	case *EventMessage_UnableToSchedule:
-		_ = b.EncodeVarint(8<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.UnableToSchedule); err != nil {
-			return err
-		}
-	case *EventMessage_Failed:
-		_ = b.EncodeVarint(9<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Failed); err != nil {
-			return err
-		}
-	case *EventMessage_Succeeded:
-		_ = b.EncodeVarint(10<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Succeeded); err != nil {
-			return err
-		}
-	case *EventMessage_Reprioritized:
-		_ = b.EncodeVarint(11<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Reprioritized); err != nil {
-			return err
-		}
-	case *EventMessage_Cancelling:
-		_ = b.EncodeVarint(12<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Cancelling); err != nil {
-			return err
-		}
-	case *EventMessage_Cancelled:
-		_ = b.EncodeVarint(13<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Cancelled); err != nil {
-			return err
-		}
-	case *EventMessage_Terminated:
-		_ = b.EncodeVarint(14<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Terminated); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("EventMessage.Events has unexpected type %T", x)
-	}
-	return nil
-}
-
-func _EventMessage_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*EventMessage)
-	switch tag {
-	case 1: // events.submitted
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(JobSubmittedEvent)
-		err := b.DecodeMessage(msg)
-		m.Events = &EventMessage_Submitted{msg}
-		return true, err
-	case 2: // events.queued
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(JobQueuedEvent)
-		err := b.DecodeMessage(msg)
-		m.Events = &EventMessage_Queued{msg}
-		return true, err
-	case 3: // events.leased
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(JobLeasedEvent)
-		err := b.DecodeMessage(msg)
-		m.Events = &EventMessage_Leased{msg}
-		return true, err
-	case 4: // events.leaseReturned
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(JobLeaseReturnedEvent)
-		err := b.DecodeMessage(msg)
-		m.Events = &EventMessage_LeaseReturned{msg}
-		return true, err
-	case 5: // events.leaseExpired
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(JobLeaseExpiredEvent)
-		err := b.DecodeMessage(msg)
-		m.Events = &EventMessage_LeaseExpired{msg}
-		return true, err
-	case 6: // events.pending
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(JobPendingEvent)
-		err := b.DecodeMessage(msg)
-		m.Events = &EventMessage_Pending{msg}
-		return true, err
-	case 7: // events.running
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(JobRunningEvent)
-		err := b.DecodeMessage(msg)
-		m.Events = &EventMessage_Running{msg}
-		return true, err
-	case 8: // events.unableToSchedule
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(JobUnableToScheduleEvent)
-		err := b.DecodeMessage(msg)
-		m.Events = &EventMessage_UnableToSchedule{msg}
-		return true, err
-	case 9: // events.failed
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(JobFailedEvent)
-		err := b.DecodeMessage(msg)
-		m.Events = &EventMessage_Failed{msg}
-		return true, err
-	case 10: // events.succeeded
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(JobSucceededEvent)
-		err := b.DecodeMessage(msg)
-		m.Events = &EventMessage_Succeeded{msg}
-		return true, err
-	case 11: // events.reprioritized
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(JobReprioritizedEvent)
-		err := b.DecodeMessage(msg)
-		m.Events = &EventMessage_Reprioritized{msg}
-		return true, err
-	case 12: // events.cancelling
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(JobCancellingEvent)
-		err := b.DecodeMessage(msg)
-		m.Events = &EventMessage_Cancelling{msg}
-		return true, err
-	case 13: // events.cancelled
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(JobCancelledEvent)
-		err := b.DecodeMessage(msg)
-		m.Events = &EventMessage_Cancelled{msg}
-		return true, err
-	case 14: // events.terminated
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(JobTerminatedEvent)
-		err := b.DecodeMessage(msg)
-		m.Events = &EventMessage_Terminated{msg}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _EventMessage_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*EventMessage)
-	// events
-	switch x := m.Events.(type) {
-	case *EventMessage_Submitted:
-		s := proto.Size(x.Submitted)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *EventMessage_Queued:
-		s := proto.Size(x.Queued)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *EventMessage_Leased:
-		s := proto.Size(x.Leased)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *EventMessage_LeaseReturned:
-		s := proto.Size(x.LeaseReturned)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *EventMessage_LeaseExpired:
-		s := proto.Size(x.LeaseExpired)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *EventMessage_Pending:
-		s := proto.Size(x.Pending)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *EventMessage_Running:
-		s := proto.Size(x.Running)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *EventMessage_UnableToSchedule:
-		s := proto.Size(x.UnableToSchedule)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *EventMessage_Failed:
-		s := proto.Size(x.Failed)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *EventMessage_Succeeded:
-		s := proto.Size(x.Succeeded)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *EventMessage_Reprioritized:
-		s := proto.Size(x.Reprioritized)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *EventMessage_Cancelling:
-		s := proto.Size(x.Cancelling)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *EventMessage_Cancelled:
-		s := proto.Size(x.Cancelled)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *EventMessage_Terminated:
-		s := proto.Size(x.Terminated)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
-}
-
-type EventList struct {
-	Events []*EventMessage `protobuf:"bytes,1,rep,name=Events,proto3" json:"Events,omitempty"`
+// Failed job was queued again with its spec changed as described by Reason
+type JobRequeuedEvent struct {
+	JobId     string    `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
+	JobSetId  string    `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
+	Queue     string    `protobuf:"bytes,3,opt,name=Queue,proto3" json:"Queue,omitempty"`
+	Created   time.Time `protobuf:"bytes,4,opt,name=Created,proto3,stdtime" json:"Created"`
+	ClusterId string    `protobuf:"bytes,5,opt,name=ClusterId,proto3" json:"ClusterId,omitempty"`
+	Reason    string    `protobuf:"bytes,6,opt,name=Reason,proto3" json:"Reason,omitempty"`
 }
 
-func (m *EventList) Reset()         { *m = EventList{} }
-func (m *EventList) String() string { return proto.CompactTextString(m) }
-func (*EventList) ProtoMessage()    {}
-func (*EventList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{15}
+func (m *JobRequeuedEvent) Reset()         { *m = JobRequeuedEvent{} }
+func (m *JobRequeuedEvent) String() string { return proto.CompactTextString(m) }
+func (*JobRequeuedEvent) ProtoMessage()    {}
+func (*JobRequeuedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{17}
 }
-func (m *EventList) XXX_Unmarshal(b []byte) error {
+func (m *JobRequeuedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobRequeuedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobRequeuedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *EventList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventList.Merge(m, src)
+func (m *JobRequeuedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRequeuedEvent.Merge(m, src)
 }
-func (m *EventList) XXX_Size() int {
+func (m *JobRequeuedEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventList) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventList.DiscardUnknown(m)
+func (m *JobRequeuedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRequeuedEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventList proto.InternalMessageInfo
+var xxx_messageInfo_JobRequeuedEvent proto.InternalMessageInfo
 
-func (m *EventList) GetEvents() []*EventMessage {
+func (m *JobRequeuedEvent) GetJobId() string {
 	if m != nil {
-		return m.Events
+		return m.JobId
 	}
-	return nil
+	return ""
 }
 
-// swagger:model
-type EventStreamMessage struct {
-	Id      string        `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Message *EventMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (m *JobRequeuedEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
 }
 
-func (m *EventStreamMessage) Reset()         { *m = EventStreamMessage{} }
-func (m *EventStreamMessage) String() string { return proto.CompactTextString(m) }
-func (*EventStreamMessage) ProtoMessage()    {}
-func (*EventStreamMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{16}
-}
-func (m *EventStreamMessage) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *EventStreamMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EventStreamMessage.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *JobRequeuedEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-}
-func (m *EventStreamMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventStreamMessage.Merge(m, src)
-}
-func (m *EventStreamMessage) XXX_Size() int {
-	return m.Size()
-}
-func (m *EventStreamMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventStreamMessage.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_EventStreamMessage proto.InternalMessageInfo
+func (m *JobRequeuedEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
 
-func (m *EventStreamMessage) GetId() string {
+func (m *JobRequeuedEvent) GetClusterId() string {
 	if m != nil {
-		return m.Id
+		return m.ClusterId
 	}
 	return ""
 }
 
-func (m *EventStreamMessage) GetMessage() *EventMessage {
+func (m *JobRequeuedEvent) GetReason() string {
 	if m != nil {
-		return m.Message
+		return m.Reason
 	}
-	return nil
+	return ""
 }
 
-// swagger:model
-type JobSetRequest struct {
-	Id            string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Watch         bool   `protobuf:"varint,2,opt,name=Watch,proto3" json:"Watch,omitempty"`
-	FromMessageId string `protobuf:"bytes,3,opt,name=FromMessageId,proto3" json:"FromMessageId,omitempty"`
-	Queue         string `protobuf:"bytes,4,opt,name=Queue,proto3" json:"Queue,omitempty"`
+type EventMessage struct {
+	// Types that are valid to be assigned to Events:
+	//	*EventMessage_Submitted
+	//	*EventMessage_Queued
+	//	*EventMessage_Leased
+	//	*EventMessage_LeaseReturned
+	//	*EventMessage_LeaseExpired
+	//	*EventMessage_Pending
+	//	*EventMessage_Running
+	//	*EventMessage_UnableToSchedule
+	//	*EventMessage_Failed
+	//	*EventMessage_Succeeded
+	//	*EventMessage_Reprioritized
+	//	*EventMessage_Cancelling
+	//	*EventMessage_Cancelled
+	//	*EventMessage_Terminated
+	//	*EventMessage_DeadlineExceeded
+	//	*EventMessage_LeaseDenied
+	//	*EventMessage_ResourceOveruse
+	//	*EventMessage_Requeued
+	Events isEventMessage_Events `protobuf_oneof:"events"`
 }
 
-func (m *JobSetRequest) Reset()         { *m = JobSetRequest{} }
-func (m *JobSetRequest) String() string { return proto.CompactTextString(m) }
-func (*JobSetRequest) ProtoMessage()    {}
-func (*JobSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{17}
+func (m *EventMessage) Reset()         { *m = EventMessage{} }
+func (m *EventMessage) String() string { return proto.CompactTextString(m) }
+func (*EventMessage) ProtoMessage()    {}
+func (*EventMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{18}
 }
-func (m *JobSetRequest) XXX_Unmarshal(b []byte) error {
+func (m *EventMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobSetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMessage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *JobSetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobSetRequest.Merge(m, src)
+func (m *EventMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMessage.Merge(m, src)
 }
-func (m *JobSetRequest) XXX_Size() int {
+func (m *EventMessage) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobSetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobSetRequest.DiscardUnknown(m)
+func (m *EventMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMessage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobSetRequest proto.InternalMessageInfo
+var xxx_messageInfo_EventMessage proto.InternalMessageInfo
 
-func (m *JobSetRequest) GetId() string {
+type isEventMessage_Events interface {
+	isEventMessage_Events()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type EventMessage_Submitted struct {
+	Submitted *JobSubmittedEvent `protobuf:"bytes,1,opt,name=submitted,proto3,oneof" json:"submitted,omitempty"`
+}
+type EventMessage_Queued struct {
+	Queued *JobQueuedEvent `protobuf:"bytes,2,opt,name=queued,proto3,oneof" json:"queued,omitempty"`
+}
+type EventMessage_Leased struct {
+	Leased *JobLeasedEvent `protobuf:"bytes,3,opt,name=leased,proto3,oneof" json:"leased,omitempty"`
+}
+type EventMessage_LeaseReturned struct {
+	LeaseReturned *JobLeaseReturnedEvent `protobuf:"bytes,4,opt,name=leaseReturned,proto3,oneof" json:"leaseReturned,omitempty"`
+}
+type EventMessage_LeaseExpired struct {
+	LeaseExpired *JobLeaseExpiredEvent `protobuf:"bytes,5,opt,name=leaseExpired,proto3,oneof" json:"leaseExpired,omitempty"`
+}
+type EventMessage_Pending struct {
+	Pending *JobPendingEvent `protobuf:"bytes,6,opt,name=pending,proto3,oneof" json:"pending,omitempty"`
+}
+type EventMessage_Running struct {
+	Running *JobRunningEvent `protobuf:"bytes,7,opt,name=running,proto3,oneof" json:"running,omitempty"`
+}
+type EventMessage_UnableToSchedule struct {
+	UnableToSchedule *JobUnableToScheduleEvent `protobuf:"bytes,8,opt,name=unableToSchedule,proto3,oneof" json:"unableToSchedule,omitempty"`
+}
+type EventMessage_Failed struct {
+	Failed *JobFailedEvent `protobuf:"bytes,9,opt,name=failed,proto3,oneof" json:"failed,omitempty"`
+}
+type EventMessage_Succeeded struct {
+	Succeeded *JobSucceededEvent `protobuf:"bytes,10,opt,name=succeeded,proto3,oneof" json:"succeeded,omitempty"`
+}
+type EventMessage_Reprioritized struct {
+	Reprioritized *JobReprioritizedEvent `protobuf:"bytes,11,opt,name=reprioritized,proto3,oneof" json:"reprioritized,omitempty"`
+}
+type EventMessage_Cancelling struct {
+	Cancelling *JobCancellingEvent `protobuf:"bytes,12,opt,name=cancelling,proto3,oneof" json:"cancelling,omitempty"`
+}
+type EventMessage_Cancelled struct {
+	Cancelled *JobCancelledEvent `protobuf:"bytes,13,opt,name=cancelled,proto3,oneof" json:"cancelled,omitempty"`
+}
+type EventMessage_Terminated struct {
+	Terminated *JobTerminatedEvent `protobuf:"bytes,14,opt,name=terminated,proto3,oneof" json:"terminated,omitempty"`
+}
+type EventMessage_DeadlineExceeded struct {
+	DeadlineExceeded *JobDeadlineExceededEvent `protobuf:"bytes,15,opt,name=deadlineExceeded,proto3,oneof" json:"deadlineExceeded,omitempty"`
+}
+type EventMessage_LeaseDenied struct {
+	LeaseDenied *JobLeaseDeniedEvent `protobuf:"bytes,16,opt,name=leaseDenied,proto3,oneof" json:"leaseDenied,omitempty"`
+}
+type EventMessage_ResourceOveruse struct {
+	ResourceOveruse *JobResourceOveruseEvent `protobuf:"bytes,17,opt,name=resourceOveruse,proto3,oneof" json:"resourceOveruse,omitempty"`
+}
+type EventMessage_Requeued struct {
+	Requeued *JobRequeuedEvent `protobuf:"bytes,18,opt,name=requeued,proto3,oneof" json:"requeued,omitempty"`
+}
+
+func (*EventMessage_Submitted) isEventMessage_Events()        {}
+func (*EventMessage_Queued) isEventMessage_Events()           {}
+func (*EventMessage_Leased) isEventMessage_Events()           {}
+func (*EventMessage_LeaseReturned) isEventMessage_Events()    {}
+func (*EventMessage_LeaseExpired) isEventMessage_Events()     {}
+func (*EventMessage_Pending) isEventMessage_Events()          {}
+func (*EventMessage_Running) isEventMessage_Events()          {}
+func (*EventMessage_UnableToSchedule) isEventMessage_Events() {}
+func (*EventMessage_Failed) isEventMessage_Events()           {}
+func (*EventMessage_Succeeded) isEventMessage_Events()        {}
+func (*EventMessage_Reprioritized) isEventMessage_Events()    {}
+func (*EventMessage_Cancelling) isEventMessage_Events()       {}
+func (*EventMessage_Cancelled) isEventMessage_Events()        {}
+func (*EventMessage_Terminated) isEventMessage_Events()       {}
+func (*EventMessage_DeadlineExceeded) isEventMessage_Events() {}
+func (*EventMessage_LeaseDenied) isEventMessage_Events()      {}
+func (*EventMessage_ResourceOveruse) isEventMessage_Events()  {}
+func (*EventMessage_Requeued) isEventMessage_Events()         {}
+
+func (m *EventMessage) GetEvents() isEventMessage_Events {
 	if m != nil {
-		return m.Id
+		return m.Events
 	}
-	return ""
+	return nil
 }
 
-func (m *JobSetRequest) GetWatch() bool {
-	if m != nil {
-		return m.Watch
+func (m *EventMessage) GetSubmitted() *JobSubmittedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Submitted); ok {
+		return x.Submitted
 	}
-	return false
+	return nil
 }
 
-func (m *JobSetRequest) GetFromMessageId() string {
-	if m != nil {
-		return m.FromMessageId
+func (m *EventMessage) GetQueued() *JobQueuedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Queued); ok {
+		return x.Queued
 	}
-	return ""
+	return nil
 }
 
-func (m *JobSetRequest) GetQueue() string {
-	if m != nil {
-		return m.Queue
+func (m *EventMessage) GetLeased() *JobLeasedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Leased); ok {
+		return x.Leased
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*JobSubmittedEvent)(nil), "api.JobSubmittedEvent")
-	proto.RegisterType((*JobQueuedEvent)(nil), "api.JobQueuedEvent")
-	proto.RegisterType((*JobLeasedEvent)(nil), "api.JobLeasedEvent")
-	proto.RegisterType((*JobLeaseReturnedEvent)(nil), "api.JobLeaseReturnedEvent")
-	proto.RegisterType((*JobLeaseExpiredEvent)(nil), "api.JobLeaseExpiredEvent")
-	proto.RegisterType((*JobPendingEvent)(nil), "api.JobPendingEvent")
-	proto.RegisterType((*JobRunningEvent)(nil), "api.JobRunningEvent")
-	proto.RegisterType((*JobUnableToScheduleEvent)(nil), "api.JobUnableToScheduleEvent")
-	proto.RegisterType((*JobFailedEvent)(nil), "api.JobFailedEvent")
-	proto.RegisterMapType((map[string]int32)(nil), "api.JobFailedEvent.ExitCodesEntry")
-	proto.RegisterType((*JobSucceededEvent)(nil), "api.JobSucceededEvent")
-	proto.RegisterType((*JobReprioritizedEvent)(nil), "api.JobReprioritizedEvent")
-	proto.RegisterType((*JobCancellingEvent)(nil), "api.JobCancellingEvent")
-	proto.RegisterType((*JobCancelledEvent)(nil), "api.JobCancelledEvent")
-	proto.RegisterType((*JobTerminatedEvent)(nil), "api.JobTerminatedEvent")
-	proto.RegisterType((*EventMessage)(nil), "api.EventMessage")
-	proto.RegisterType((*EventList)(nil), "api.EventList")
-	proto.RegisterType((*EventStreamMessage)(nil), "api.EventStreamMessage")
-	proto.RegisterType((*JobSetRequest)(nil), "api.JobSetRequest")
+func (m *EventMessage) GetLeaseReturned() *JobLeaseReturnedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_LeaseReturned); ok {
+		return x.LeaseReturned
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("pkg/api/event.proto", fileDescriptor_7758595c3bb8cf56) }
-
-var fileDescriptor_7758595c3bb8cf56 = []byte{
-	// 1048 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0xb7, 0x93, 0xe6, 0xdf, 0xcb, 0x6e, 0xda, 0x4e, 0x97, 0xed, 0x10, 0xda, 0xec, 0xca, 0x70,
-	0x58, 0x40, 0x9b, 0x94, 0x54, 0xaa, 0x4a, 0x85, 0x00, 0x65, 0x95, 0x92, 0x84, 0x56, 0xa2, 0xb3,
-	0x8b, 0x38, 0xdb, 0xf1, 0x34, 0x6b, 0xea, 0x78, 0xbc, 0xf6, 0x78, 0xd5, 0xa5, 0xea, 0x85, 0x4f,
-	0x50, 0x89, 0x0b, 0x12, 0x08, 0xbe, 0x05, 0x08, 0xa4, 0xde, 0x7b, 0x42, 0x95, 0x10, 0x52, 0x2f,
-	0xfc, 0xd1, 0x2e, 0x1f, 0x04, 0xcd, 0x8c, 0xed, 0xd8, 0x49, 0xb9, 0x27, 0xbd, 0xf9, 0xcd, 0xfc,
-	0x7e, 0x6f, 0xde, 0x7b, 0x33, 0xf3, 0x9b, 0x67, 0xb8, 0xe4, 0x3f, 0x98, 0x74, 0x4c, 0xdf, 0xe9,
-	0xd0, 0x63, 0xea, 0xf1, 0xb6, 0x1f, 0x30, 0xce, 0x50, 0xd1, 0xf4, 0x9d, 0xe6, 0xd6, 0x84, 0xb1,
-	0x89, 0x4b, 0x3b, 0x72, 0xc8, 0x8a, 0xee, 0x77, 0xb8, 0x33, 0xa5, 0x21, 0x37, 0xa7, 0xbe, 0x42,
-	0x35, 0x53, 0xea, 0x51, 0x44, 0x23, 0x1a, 0x0f, 0xbe, 0x31, 0xcf, 0xa2, 0x53, 0x9f, 0x9f, 0xc4,
-	0x93, 0xbb, 0x13, 0x87, 0x1f, 0x46, 0x56, 0x7b, 0xcc, 0xa6, 0x9d, 0x09, 0x9b, 0xb0, 0x19, 0x4a,
-	0x58, 0xd2, 0x90, 0x5f, 0x31, 0xfc, 0x4a, 0xec, 0x4b, 0xac, 0x61, 0x7a, 0x1e, 0xe3, 0x26, 0x77,
-	0x98, 0x17, 0xaa, 0x59, 0xe3, 0xa9, 0x0e, 0x17, 0x47, 0xcc, 0xda, 0x8f, 0xac, 0xa9, 0xc3, 0x39,
-	0xb5, 0xfb, 0x22, 0x01, 0xb4, 0x01, 0xa5, 0x11, 0xb3, 0x86, 0x36, 0xd6, 0xb7, 0xf5, 0x9d, 0x1a,
-	0x51, 0x06, 0x6a, 0x42, 0x55, 0x40, 0x29, 0x1f, 0xda, 0xb8, 0x20, 0x27, 0x52, 0x5b, 0x30, 0xee,
-	0x89, 0x04, 0x70, 0x51, 0x31, 0xa4, 0x81, 0x3e, 0x84, 0xca, 0x5e, 0x40, 0x4d, 0x4e, 0x6d, 0x7c,
-	0x6e, 0x5b, 0xdf, 0xa9, 0x77, 0x9b, 0x6d, 0x15, 0x4d, 0x3b, 0x89, 0xb9, 0x7d, 0x90, 0xd4, 0xa3,
-	0x57, 0x7d, 0xf6, 0xd7, 0x96, 0xf6, 0xe4, 0xef, 0x2d, 0x9d, 0x24, 0x24, 0xb4, 0x0d, 0xc5, 0x11,
-	0xb3, 0x70, 0x49, 0x72, 0xab, 0x6d, 0xd3, 0x77, 0xda, 0x23, 0x66, 0xf5, 0xce, 0x09, 0x24, 0x11,
-	0x53, 0xc6, 0xb7, 0x3a, 0x34, 0x46, 0xcc, 0x92, 0xcb, 0x2d, 0x57, 0xf0, 0xc6, 0x4f, 0x2a, 0xb4,
-	0x3b, 0xd4, 0x0c, 0x97, 0xad, 0xae, 0x57, 0xa0, 0xb6, 0xe7, 0x46, 0x21, 0xa7, 0xc1, 0xd0, 0x96,
-	0xd5, 0xad, 0x91, 0xd9, 0x80, 0xf1, 0x87, 0x0e, 0xaf, 0x25, 0x81, 0x13, 0xca, 0xa3, 0xc0, 0x5b,
-	0xa9, 0xf8, 0xd1, 0x26, 0x94, 0x09, 0x35, 0x43, 0xe6, 0xe1, 0xb2, 0x9c, 0x8a, 0x2d, 0xe3, 0x07,
-	0x1d, 0x36, 0x92, 0xbc, 0xfa, 0x0f, 0x7d, 0x27, 0x58, 0xb6, 0x13, 0xf3, 0xb3, 0x0e, 0xe7, 0x47,
-	0xcc, 0xfa, 0x8c, 0x7a, 0xb6, 0xe3, 0x4d, 0x56, 0xe9, 0xc8, 0xc4, 0x91, 0x93, 0xc8, 0xf3, 0x56,
-	0x2c, 0xf2, 0x17, 0x3a, 0xe0, 0x11, 0xb3, 0x3e, 0xf7, 0x4c, 0xcb, 0xa5, 0x07, 0x6c, 0x7f, 0x7c,
-	0x48, 0xed, 0xc8, 0xa5, 0xaf, 0xc2, 0x79, 0xff, 0xad, 0x20, 0x05, 0xe8, 0xb6, 0xe9, 0xb8, 0xaf,
-	0xc4, 0x05, 0x46, 0x1f, 0x43, 0xad, 0xff, 0xd0, 0xe1, 0x7b, 0xcc, 0xa6, 0x21, 0xae, 0x6c, 0x17,
-	0x77, 0xea, 0x5d, 0x23, 0x79, 0x14, 0x32, 0x59, 0xb6, 0x53, 0x50, 0xdf, 0xe3, 0xc1, 0x09, 0x99,
-	0x91, 0x9a, 0x1f, 0x40, 0x23, 0x3f, 0x89, 0x2e, 0x40, 0xf1, 0x01, 0x3d, 0x89, 0xeb, 0x21, 0x3e,
-	0x45, 0xc6, 0xc7, 0xa6, 0x1b, 0x51, 0x59, 0x8a, 0x12, 0x51, 0xc6, 0xad, 0xc2, 0x4d, 0xdd, 0xf8,
-	0x25, 0x79, 0x2c, 0xc7, 0x63, 0x4a, 0xed, 0xd5, 0x12, 0xf5, 0x1f, 0x95, 0xa8, 0x13, 0xea, 0x07,
-	0x0e, 0x0b, 0x1c, 0xee, 0x7c, 0xb5, 0x6c, 0xea, 0xf7, 0xbd, 0x0e, 0x68, 0xc4, 0xac, 0x3d, 0xd3,
-	0x1b, 0x53, 0xd7, 0x5d, 0x36, 0x19, 0x31, 0xbe, 0x53, 0x9b, 0x1f, 0x87, 0xb7, 0x6c, 0xc5, 0xfb,
-	0x55, 0x15, 0xef, 0x80, 0x06, 0x53, 0xc7, 0x33, 0xf9, 0x6a, 0x9d, 0xcd, 0xa7, 0x65, 0x58, 0x93,
-	0xf1, 0xde, 0xa5, 0x61, 0x68, 0x4e, 0x28, 0xba, 0x01, 0xb5, 0x30, 0xe9, 0x48, 0x65, 0xe8, 0xf5,
-	0xee, 0x66, 0x72, 0xd1, 0xf3, 0xad, 0xea, 0x40, 0x23, 0x33, 0x28, 0xda, 0x85, 0xb2, 0x6c, 0xa3,
-	0x55, 0x5a, 0xf5, 0xee, 0xa5, 0x84, 0x94, 0xe9, 0x0f, 0x07, 0x1a, 0x89, 0x41, 0x02, 0xee, 0xca,
-	0xee, 0x4c, 0x26, 0x9b, 0x81, 0x67, 0x7a, 0x36, 0x01, 0x57, 0x20, 0xd4, 0x83, 0x75, 0x37, 0xdb,
-	0x13, 0xa5, 0xa5, 0xc8, 0xb2, 0x72, 0x0d, 0xd3, 0x40, 0x23, 0x79, 0x0a, 0xfa, 0x08, 0xd6, 0xdc,
-	0x4c, 0xff, 0x11, 0xb7, 0xb6, 0xaf, 0xe7, 0x5c, 0x64, 0x7b, 0x93, 0x81, 0x46, 0x72, 0x04, 0x74,
-	0x0d, 0x2a, 0xbe, 0xea, 0x0f, 0xa4, 0x38, 0xd6, 0xbb, 0x1b, 0x09, 0x37, 0xdb, 0x36, 0x0c, 0x34,
-	0x92, 0xc0, 0x04, 0x23, 0x50, 0xef, 0x32, 0xae, 0xe4, 0x19, 0xd9, 0xe7, 0x5a, 0x30, 0x62, 0x18,
-	0xfa, 0x14, 0x2e, 0x44, 0x73, 0xef, 0x21, 0xae, 0x4a, 0xea, 0xd5, 0x84, 0xfa, 0xd2, 0xf7, 0x72,
-	0xa0, 0x91, 0x05, 0xa2, 0x28, 0xf2, 0x7d, 0xa9, 0xcd, 0xb8, 0x96, 0x2f, 0x72, 0x46, 0xb1, 0x45,
-	0x91, 0x15, 0x48, 0x6d, 0x7d, 0xac, 0xaf, 0x18, 0xe6, 0xb7, 0x3e, 0x2b, 0xbc, 0x6a, 0xeb, 0xe3,
-	0x11, 0xb1, 0x39, 0x41, 0x56, 0xdb, 0x70, 0x3d, 0xbf, 0x39, 0x8b, 0xc2, 0x27, 0x36, 0x27, 0x47,
-	0x41, 0xef, 0x03, 0x8c, 0x53, 0xf5, 0xc1, 0x6b, 0xd2, 0xc1, 0xe5, 0xc4, 0xc1, 0x9c, 0x2e, 0x0d,
-	0x34, 0x92, 0x01, 0x8b, 0xb0, 0xc7, 0x89, 0x32, 0xe0, 0xf5, 0x7c, 0xd8, 0x79, 0xc9, 0x10, 0x61,
-	0xa7, 0x50, 0xb1, 0x24, 0x4f, 0xef, 0x2c, 0x6e, 0xe4, 0x97, 0x9c, 0xbb, 0xcd, 0x62, 0xc9, 0x19,
-	0xb8, 0x57, 0x85, 0xb2, 0xfc, 0xdd, 0x0c, 0x8d, 0x1b, 0x50, 0x93, 0x80, 0x3b, 0x4e, 0xc8, 0xd1,
-	0xdb, 0x50, 0x96, 0x46, 0x88, 0x75, 0xf9, 0x42, 0x5e, 0x94, 0xde, 0xb2, 0xd7, 0x8b, 0xc4, 0x00,
-	0xe3, 0x1e, 0x20, 0xf9, 0xb5, 0xcf, 0x03, 0x6a, 0x4e, 0x93, 0xcb, 0xd7, 0x80, 0x42, 0x2a, 0x18,
-	0x85, 0xa1, 0x8d, 0xde, 0x85, 0xca, 0x54, 0x4d, 0xc5, 0xb7, 0xea, 0x25, 0x1e, 0x13, 0x84, 0x71,
-	0x04, 0xeb, 0x4a, 0x4a, 0x08, 0x3d, 0x8a, 0x68, 0xc8, 0x17, 0xbc, 0x6d, 0x40, 0xe9, 0x0b, 0x93,
-	0x8f, 0x0f, 0xa5, 0xaf, 0x2a, 0x51, 0x06, 0x7a, 0x0b, 0xd6, 0x6f, 0x07, 0x2c, 0x09, 0x61, 0x68,
-	0xc7, 0xea, 0x93, 0x1f, 0x9c, 0x69, 0xd3, 0xb9, 0x8c, 0x36, 0x75, 0xff, 0xd4, 0xa1, 0xa4, 0xd4,
-	0xee, 0x26, 0x34, 0x08, 0xf5, 0x59, 0xc0, 0xef, 0x46, 0x2e, 0x77, 0x7c, 0x97, 0xa2, 0xc6, 0x2c,
-	0x54, 0x51, 0x9c, 0xe6, 0xe6, 0x82, 0x6c, 0xf5, 0xc5, 0x9f, 0x35, 0xba, 0x2e, 0x3a, 0x0e, 0xc1,
-	0x44, 0x8b, 0xc9, 0xfd, 0x2f, 0x89, 0xc2, 0xf9, 0x4f, 0x28, 0x57, 0xe9, 0xaa, 0x8a, 0x22, 0x94,
-	0x1e, 0xd5, 0xb4, 0x02, 0xcd, 0xcb, 0x33, 0x8f, 0xb9, 0x42, 0x1b, 0x6f, 0x7e, 0xfd, 0xfb, 0xbf,
-	0xdf, 0x14, 0xae, 0x1a, 0xb8, 0x73, 0xfc, 0x5e, 0xe7, 0x4b, 0x66, 0xed, 0x86, 0x94, 0x77, 0x1e,
-	0xc9, 0xa4, 0x1e, 0x77, 0x1e, 0x0d, 0xed, 0xc7, 0xb7, 0xf4, 0x77, 0xae, 0xe9, 0x3d, 0xfc, 0xec,
-	0xb4, 0xa5, 0x3f, 0x3f, 0x6d, 0xe9, 0xff, 0x9c, 0xb6, 0xf4, 0x27, 0x67, 0x2d, 0xed, 0xf9, 0x59,
-	0x4b, 0x7b, 0x71, 0xd6, 0xd2, 0xac, 0xb2, 0x0c, 0xe8, 0xfa, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xd8, 0x10, 0xf3, 0xa1, 0x7f, 0x10, 0x00, 0x00,
+func (m *EventMessage) GetLeaseExpired() *JobLeaseExpiredEvent {
+	if x, ok := m.GetEvents().(*EventMessage_LeaseExpired); ok {
+		return x.LeaseExpired
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// EventClient is the client API for Event service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type EventClient interface {
-	ReportMultiple(ctx context.Context, in *EventList, opts ...grpc.CallOption) (*types.Empty, error)
-	Report(ctx context.Context, in *EventMessage, opts ...grpc.CallOption) (*types.Empty, error)
-	GetJobSetEvents(ctx context.Context, in *JobSetRequest, opts ...grpc.CallOption) (Event_GetJobSetEventsClient, error)
+func (m *EventMessage) GetPending() *JobPendingEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Pending); ok {
+		return x.Pending
+	}
+	return nil
 }
 
-type eventClient struct {
-	cc *grpc.ClientConn
+func (m *EventMessage) GetRunning() *JobRunningEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Running); ok {
+		return x.Running
+	}
+	return nil
 }
 
-func NewEventClient(cc *grpc.ClientConn) EventClient {
-	return &eventClient{cc}
+func (m *EventMessage) GetUnableToSchedule() *JobUnableToScheduleEvent {
+	if x, ok := m.GetEvents().(*EventMessage_UnableToSchedule); ok {
+		return x.UnableToSchedule
+	}
+	return nil
 }
 
-func (c *eventClient) ReportMultiple(ctx context.Context, in *EventList, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Event/ReportMultiple", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventMessage) GetFailed() *JobFailedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Failed); ok {
+		return x.Failed
 	}
-	return out, nil
+	return nil
 }
 
-func (c *eventClient) Report(ctx context.Context, in *EventMessage, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Event/Report", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventMessage) GetSucceeded() *JobSucceededEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Succeeded); ok {
+		return x.Succeeded
 	}
-	return out, nil
+	return nil
 }
 
-func (c *eventClient) GetJobSetEvents(ctx context.Context, in *JobSetRequest, opts ...grpc.CallOption) (Event_GetJobSetEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Event_serviceDesc.Streams[0], "/api.Event/GetJobSetEvents", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &eventGetJobSetEventsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *EventMessage) GetReprioritized() *JobReprioritizedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Reprioritized); ok {
+		return x.Reprioritized
 	}
-	return x, nil
+	return nil
 }
 
-type Event_GetJobSetEventsClient interface {
-	Recv() (*EventStreamMessage, error)
-	grpc.ClientStream
+func (m *EventMessage) GetCancelling() *JobCancellingEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Cancelling); ok {
+		return x.Cancelling
+	}
+	return nil
 }
 
-type eventGetJobSetEventsClient struct {
-	grpc.ClientStream
+func (m *EventMessage) GetCancelled() *JobCancelledEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Cancelled); ok {
+		return x.Cancelled
+	}
+	return nil
 }
 
-func (x *eventGetJobSetEventsClient) Recv() (*EventStreamMessage, error) {
-	m := new(EventStreamMessage)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *EventMessage) GetTerminated() *JobTerminatedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Terminated); ok {
+		return x.Terminated
 	}
-	return m, nil
+	return nil
 }
 
-// EventServer is the server API for Event service.
-type EventServer interface {
-	ReportMultiple(context.Context, *EventList) (*types.Empty, error)
-	Report(context.Context, *EventMessage) (*types.Empty, error)
-	GetJobSetEvents(*JobSetRequest, Event_GetJobSetEventsServer) error
+func (m *EventMessage) GetDeadlineExceeded() *JobDeadlineExceededEvent {
+	if x, ok := m.GetEvents().(*EventMessage_DeadlineExceeded); ok {
+		return x.DeadlineExceeded
+	}
+	return nil
 }
 
-func RegisterEventServer(s *grpc.Server, srv EventServer) {
-	s.RegisterService(&_Event_serviceDesc, srv)
+func (m *EventMessage) GetLeaseDenied() *JobLeaseDeniedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_LeaseDenied); ok {
+		return x.LeaseDenied
+	}
+	return nil
 }
 
-func _Event_ReportMultiple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EventList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventServer).ReportMultiple(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Event/ReportMultiple",
+func (m *EventMessage) GetResourceOveruse() *JobResourceOveruseEvent {
+	if x, ok := m.GetEvents().(*EventMessage_ResourceOveruse); ok {
+		return x.ResourceOveruse
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServer).ReportMultiple(ctx, req.(*EventList))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Event_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EventMessage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventServer).Report(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Event/Report",
+func (m *EventMessage) GetRequeued() *JobRequeuedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Requeued); ok {
+		return x.Requeued
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServer).Report(ctx, req.(*EventMessage))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Event_GetJobSetEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(JobSetRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*EventMessage) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*EventMessage_Submitted)(nil),
+		(*EventMessage_Queued)(nil),
+		(*EventMessage_Leased)(nil),
+		(*EventMessage_LeaseReturned)(nil),
+		(*EventMessage_LeaseExpired)(nil),
+		(*EventMessage_Pending)(nil),
+		(*EventMessage_Running)(nil),
+		(*EventMessage_UnableToSchedule)(nil),
+		(*EventMessage_Failed)(nil),
+		(*EventMessage_Succeeded)(nil),
+		(*EventMessage_Reprioritized)(nil),
+		(*EventMessage_Cancelling)(nil),
+		(*EventMessage_Cancelled)(nil),
+		(*EventMessage_Terminated)(nil),
+		(*EventMessage_DeadlineExceeded)(nil),
+		(*EventMessage_LeaseDenied)(nil),
+		(*EventMessage_ResourceOveruse)(nil),
+		(*EventMessage_Requeued)(nil),
 	}
-	return srv.(EventServer).GetJobSetEvents(m, &eventGetJobSetEventsServer{stream})
 }
 
-type Event_GetJobSetEventsServer interface {
-	Send(*EventStreamMessage) error
-	grpc.ServerStream
+type EventList struct {
+	Events []*EventMessage `protobuf:"bytes,1,rep,name=Events,proto3" json:"Events,omitempty"`
 }
 
-type eventGetJobSetEventsServer struct {
-	grpc.ServerStream
+func (m *EventList) Reset()         { *m = EventList{} }
+func (m *EventList) String() string { return proto.CompactTextString(m) }
+func (*EventList) ProtoMessage()    {}
+func (*EventList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{19}
 }
-
-func (x *eventGetJobSetEventsServer) Send(m *EventStreamMessage) error {
-	return x.ServerStream.SendMsg(m)
+func (m *EventList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-var _Event_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.Event",
-	HandlerType: (*EventServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ReportMultiple",
-			Handler:    _Event_ReportMultiple_Handler,
-		},
-		{
-			MethodName: "Report",
-			Handler:    _Event_Report_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "GetJobSetEvents",
-			Handler:       _Event_GetJobSetEvents_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "pkg/api/event.proto",
+func (m *EventList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventList.Merge(m, src)
+}
+func (m *EventList) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventList) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventList.DiscardUnknown(m)
 }
 
-func (m *JobSubmittedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventList proto.InternalMessageInfo
+
+func (m *EventList) GetEvents() []*EventMessage {
+	if m != nil {
+		return m.Events
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobSubmittedEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
-	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
-	}
-	if len(m.Queue) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
-	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintEvent(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)))
-	n1, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n1
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintEvent(dAtA, i, uint64(m.Job.Size()))
-	n2, err := m.Job.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+// swagger:model
+type EventStreamMessage struct {
+	Id      string        `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Message *EventMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *EventStreamMessage) Reset()         { *m = EventStreamMessage{} }
+func (m *EventStreamMessage) String() string { return proto.CompactTextString(m) }
+func (*EventStreamMessage) ProtoMessage()    {}
+func (*EventStreamMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{20}
+}
+func (m *EventStreamMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStreamMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStreamMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i += n2
-	return i, nil
+}
+func (m *EventStreamMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStreamMessage.Merge(m, src)
+}
+func (m *EventStreamMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStreamMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStreamMessage.DiscardUnknown(m)
 }
 
-func (m *JobQueuedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventStreamMessage proto.InternalMessageInfo
+
+func (m *EventStreamMessage) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *JobQueuedEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
+func (m *EventStreamMessage) GetMessage() *EventMessage {
+	if m != nil {
+		return m.Message
 	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
+	return nil
+}
+
+// swagger:model
+type JobSetRequest struct {
+	Id            string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Watch         bool   `protobuf:"varint,2,opt,name=Watch,proto3" json:"Watch,omitempty"`
+	FromMessageId string `protobuf:"bytes,3,opt,name=FromMessageId,proto3" json:"FromMessageId,omitempty"`
+	Queue         string `protobuf:"bytes,4,opt,name=Queue,proto3" json:"Queue,omitempty"`
+}
+
+func (m *JobSetRequest) Reset()         { *m = JobSetRequest{} }
+func (m *JobSetRequest) String() string { return proto.CompactTextString(m) }
+func (*JobSetRequest) ProtoMessage()    {}
+func (*JobSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{21}
+}
+func (m *JobSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Queue) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
+}
+func (m *JobSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetRequest.Merge(m, src)
+}
+func (m *JobSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSetRequest proto.InternalMessageInfo
+
+func (m *JobSetRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintEvent(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)))
-	n3, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i:])
-	if err != nil {
-		return 0, err
+	return ""
+}
+
+func (m *JobSetRequest) GetWatch() bool {
+	if m != nil {
+		return m.Watch
 	}
-	i += n3
-	return i, nil
+	return false
 }
 
-func (m *JobLeasedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *JobSetRequest) GetFromMessageId() string {
+	if m != nil {
+		return m.FromMessageId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *JobLeasedEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
+func (m *JobSetRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
+	return ""
+}
+
+// swagger:model
+type JobSetStatusRequest struct {
+	Queue    string `protobuf:"bytes,1,opt,name=Queue,proto3" json:"Queue,omitempty"`
+	JobSetId string `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
+}
+
+func (m *JobSetStatusRequest) Reset()         { *m = JobSetStatusRequest{} }
+func (m *JobSetStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*JobSetStatusRequest) ProtoMessage()    {}
+func (*JobSetStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{22}
+}
+func (m *JobSetStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Queue) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
+}
+func (m *JobSetStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetStatusRequest.Merge(m, src)
+}
+func (m *JobSetStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSetStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSetStatusRequest proto.InternalMessageInfo
+
+func (m *JobSetStatusRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintEvent(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)))
-	n4, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i:])
-	if err != nil {
-		return 0, err
+	return ""
+}
+
+func (m *JobSetStatusRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
 	}
-	i += n4
-	if len(m.ClusterId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i += copy(dAtA[i:], m.ClusterId)
+	return ""
+}
+
+// swagger:model
+type JobSetStatusResponse struct {
+	Queued    int32 `protobuf:"varint,1,opt,name=Queued,proto3" json:"Queued,omitempty"`
+	Leased    int32 `protobuf:"varint,2,opt,name=Leased,proto3" json:"Leased,omitempty"`
+	Pending   int32 `protobuf:"varint,3,opt,name=Pending,proto3" json:"Pending,omitempty"`
+	Running   int32 `protobuf:"varint,4,opt,name=Running,proto3" json:"Running,omitempty"`
+	Succeeded int32 `protobuf:"varint,5,opt,name=Succeeded,proto3" json:"Succeeded,omitempty"`
+	Failed    int32 `protobuf:"varint,6,opt,name=Failed,proto3" json:"Failed,omitempty"`
+	Cancelled int32 `protobuf:"varint,7,opt,name=Cancelled,proto3" json:"Cancelled,omitempty"`
+	Gated     int32 `protobuf:"varint,8,opt,name=Gated,proto3" json:"Gated,omitempty"`
+}
+
+func (m *JobSetStatusResponse) Reset()         { *m = JobSetStatusResponse{} }
+func (m *JobSetStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*JobSetStatusResponse) ProtoMessage()    {}
+func (*JobSetStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{23}
+}
+func (m *JobSetStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *JobSetStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetStatusResponse.Merge(m, src)
+}
+func (m *JobSetStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSetStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetStatusResponse.DiscardUnknown(m)
 }
 
-func (m *JobLeaseReturnedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobSetStatusResponse proto.InternalMessageInfo
+
+func (m *JobSetStatusResponse) GetQueued() int32 {
+	if m != nil {
+		return m.Queued
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *JobLeaseReturnedEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
+func (m *JobSetStatusResponse) GetLeased() int32 {
+	if m != nil {
+		return m.Leased
 	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
+	return 0
+}
+
+func (m *JobSetStatusResponse) GetPending() int32 {
+	if m != nil {
+		return m.Pending
 	}
-	if len(m.Queue) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
+	return 0
+}
+
+func (m *JobSetStatusResponse) GetRunning() int32 {
+	if m != nil {
+		return m.Running
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintEvent(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)))
-	n5, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i:])
-	if err != nil {
-		return 0, err
+	return 0
+}
+
+func (m *JobSetStatusResponse) GetSucceeded() int32 {
+	if m != nil {
+		return m.Succeeded
 	}
-	i += n5
-	if len(m.ClusterId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i += copy(dAtA[i:], m.ClusterId)
+	return 0
+}
+
+func (m *JobSetStatusResponse) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
 	}
-	if len(m.Reason) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i += copy(dAtA[i:], m.Reason)
+	return 0
+}
+
+func (m *JobSetStatusResponse) GetCancelled() int32 {
+	if m != nil {
+		return m.Cancelled
 	}
-	return i, nil
+	return 0
 }
 
-func (m *JobLeaseExpiredEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *JobSetStatusResponse) GetGated() int32 {
+	if m != nil {
+		return m.Gated
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *JobLeaseExpiredEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
-	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
+// swagger:model
+type JobStatusRequest struct {
+	Queue    string `protobuf:"bytes,1,opt,name=Queue,proto3" json:"Queue,omitempty"`
+	JobSetId string `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
+	JobId    string `protobuf:"bytes,3,opt,name=JobId,proto3" json:"JobId,omitempty"`
+}
+
+func (m *JobStatusRequest) Reset()         { *m = JobStatusRequest{} }
+func (m *JobStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*JobStatusRequest) ProtoMessage()    {}
+func (*JobStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{24}
+}
+func (m *JobStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Queue) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
+}
+func (m *JobStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobStatusRequest.Merge(m, src)
+}
+func (m *JobStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobStatusRequest proto.InternalMessageInfo
+
+func (m *JobStatusRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintEvent(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)))
-	n6, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i:])
-	if err != nil {
-		return 0, err
+	return ""
+}
+
+func (m *JobStatusRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
 	}
-	i += n6
-	return i, nil
+	return ""
 }
 
-func (m *JobPendingEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *JobStatusRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *JobPendingEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
+// swagger:model
+type JobStatusResponse struct {
+	JobId string `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
+	// Queued, Leased, Pending, Running, Succeeded, Failed or Cancelled
+	State string `protobuf:"bytes,2,opt,name=State,proto3" json:"State,omitempty"`
+}
+
+func (m *JobStatusResponse) Reset()         { *m = JobStatusResponse{} }
+func (m *JobStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*JobStatusResponse) ProtoMessage()    {}
+func (*JobStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{25}
+}
+func (m *JobStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
+}
+func (m *JobStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobStatusResponse.Merge(m, src)
+}
+func (m *JobStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobStatusResponse proto.InternalMessageInfo
+
+func (m *JobStatusResponse) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	if len(m.Queue) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
+	return ""
+}
+
+func (m *JobStatusResponse) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintEvent(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)))
-	n7, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i:])
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("api.LeaseDeniedReason", LeaseDeniedReason_name, LeaseDeniedReason_value)
+	proto.RegisterType((*JobSubmittedEvent)(nil), "api.JobSubmittedEvent")
+	proto.RegisterType((*JobQueuedEvent)(nil), "api.JobQueuedEvent")
+	proto.RegisterType((*JobLeasedEvent)(nil), "api.JobLeasedEvent")
+	proto.RegisterType((*JobLeaseReturnedEvent)(nil), "api.JobLeaseReturnedEvent")
+	proto.RegisterType((*JobLeaseExpiredEvent)(nil), "api.JobLeaseExpiredEvent")
+	proto.RegisterType((*JobPendingEvent)(nil), "api.JobPendingEvent")
+	proto.RegisterType((*JobRunningEvent)(nil), "api.JobRunningEvent")
+	proto.RegisterType((*JobUnableToScheduleEvent)(nil), "api.JobUnableToScheduleEvent")
+	proto.RegisterType((*JobFailedEvent)(nil), "api.JobFailedEvent")
+	proto.RegisterMapType((map[string]int32)(nil), "api.JobFailedEvent.ExitCodesEntry")
+	proto.RegisterType((*JobSucceededEvent)(nil), "api.JobSucceededEvent")
+	proto.RegisterType((*JobReprioritizedEvent)(nil), "api.JobReprioritizedEvent")
+	proto.RegisterType((*JobCancellingEvent)(nil), "api.JobCancellingEvent")
+	proto.RegisterType((*JobCancelledEvent)(nil), "api.JobCancelledEvent")
+	proto.RegisterType((*JobTerminatedEvent)(nil), "api.JobTerminatedEvent")
+	proto.RegisterType((*JobDeadlineExceededEvent)(nil), "api.JobDeadlineExceededEvent")
+	proto.RegisterType((*JobLeaseDeniedEvent)(nil), "api.JobLeaseDeniedEvent")
+	proto.RegisterType((*JobResourceOveruseEvent)(nil), "api.JobResourceOveruseEvent")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.JobResourceOveruseEvent.ResourcesUsedEntry")
+	proto.RegisterType((*JobRequeuedEvent)(nil), "api.JobRequeuedEvent")
+	proto.RegisterType((*EventMessage)(nil), "api.EventMessage")
+	proto.RegisterType((*EventList)(nil), "api.EventList")
+	proto.RegisterType((*EventStreamMessage)(nil), "api.EventStreamMessage")
+	proto.RegisterType((*JobSetRequest)(nil), "api.JobSetRequest")
+	proto.RegisterType((*JobSetStatusRequest)(nil), "api.JobSetStatusRequest")
+	proto.RegisterType((*JobSetStatusResponse)(nil), "api.JobSetStatusResponse")
+	proto.RegisterType((*JobStatusRequest)(nil), "api.JobStatusRequest")
+	proto.RegisterType((*JobStatusResponse)(nil), "api.JobStatusResponse")
+}
+
+func init() { proto.RegisterFile("pkg/api/event.proto", fileDescriptor_7758595c3bb8cf56) }
+
+var fileDescriptor_7758595c3bb8cf56 = []byte{
+	// 1625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcb, 0x6f, 0x14, 0x47,
+	0x1a, 0x9f, 0xf6, 0x78, 0x1e, 0xfe, 0xc6, 0x1e, 0x8f, 0xcb, 0xc6, 0x6e, 0x66, 0xc1, 0x58, 0xbd,
+	0x7b, 0x60, 0xbd, 0xa2, 0x87, 0x35, 0x2b, 0xc4, 0x22, 0xb4, 0xac, 0x6c, 0x0c, 0xe3, 0xc1, 0x86,
+	0x75, 0x1b, 0xb4, 0x51, 0x72, 0xea, 0x9e, 0x2e, 0x8f, 0x3b, 0xee, 0xe9, 0x6a, 0x77, 0x57, 0x3b,
+	0x38, 0x88, 0x0b, 0x7f, 0x40, 0x84, 0x94, 0x3b, 0xf9, 0x0f, 0x72, 0x09, 0x4a, 0x94, 0x48, 0x91,
+	0x72, 0x0b, 0x47, 0xa4, 0x28, 0x12, 0xa7, 0x24, 0x02, 0x6e, 0xf9, 0x27, 0xa2, 0x7a, 0xf4, 0x6b,
+	0xc6, 0xe1, 0x90, 0x5c, 0x3c, 0xdc, 0xfa, 0xab, 0xfa, 0x7d, 0xcf, 0xaa, 0xfa, 0x1e, 0x0d, 0xb3,
+	0xfe, 0x7e, 0xaf, 0x65, 0xfa, 0x4e, 0x0b, 0x1f, 0x62, 0x8f, 0xea, 0x7e, 0x40, 0x28, 0x41, 0x45,
+	0xd3, 0x77, 0x9a, 0xe7, 0x7a, 0x84, 0xf4, 0x5c, 0xdc, 0xe2, 0x4b, 0x56, 0xb4, 0xdb, 0xa2, 0x4e,
+	0x1f, 0x87, 0xd4, 0xec, 0xfb, 0x02, 0xd5, 0x4c, 0x58, 0x0f, 0x22, 0x1c, 0x61, 0xb9, 0xf8, 0xaf,
+	0xfd, 0x2b, 0xa1, 0xee, 0x10, 0xb6, 0xde, 0x37, 0xbb, 0x7b, 0x8e, 0x87, 0x83, 0xa3, 0x56, 0x0c,
+	0x0c, 0x70, 0x48, 0xa2, 0xa0, 0x8b, 0x5b, 0x3d, 0xec, 0xe1, 0xc0, 0xa4, 0xd8, 0x96, 0x5c, 0x7f,
+	0x19, 0xd4, 0x85, 0xfb, 0x3e, 0x3d, 0x92, 0x9b, 0x17, 0x7a, 0x0e, 0xdd, 0x8b, 0x2c, 0xbd, 0x4b,
+	0xfa, 0xad, 0x1e, 0xe9, 0x91, 0x14, 0xc5, 0x28, 0x4e, 0xf0, 0x2f, 0x09, 0x3f, 0x23, 0x65, 0x31,
+	0x85, 0xa6, 0xe7, 0x11, 0x6a, 0x52, 0x87, 0x78, 0xa1, 0xd8, 0xd5, 0xbe, 0x55, 0x60, 0xa6, 0x43,
+	0xac, 0x9d, 0xc8, 0xea, 0x3b, 0x94, 0x62, 0x7b, 0x9d, 0xb9, 0x8d, 0xe6, 0xa0, 0xd4, 0x21, 0xd6,
+	0x86, 0xad, 0x2a, 0x4b, 0xca, 0xf9, 0x09, 0x43, 0x10, 0xa8, 0x09, 0x55, 0x06, 0xc5, 0x74, 0xc3,
+	0x56, 0xc7, 0xf8, 0x46, 0x42, 0x33, 0x8e, 0x6d, 0xe6, 0xb6, 0x5a, 0x14, 0x1c, 0x9c, 0x40, 0xff,
+	0x81, 0xca, 0x5a, 0x80, 0x99, 0x63, 0xea, 0xf8, 0x92, 0x72, 0xbe, 0xb6, 0xd2, 0xd4, 0x85, 0x35,
+	0x7a, 0x6c, 0xb3, 0x7e, 0x2f, 0x8e, 0xe2, 0x6a, 0xf5, 0xf9, 0x4f, 0xe7, 0x0a, 0x4f, 0x7e, 0x3e,
+	0xa7, 0x18, 0x31, 0x13, 0x5a, 0x82, 0x62, 0x87, 0x58, 0x6a, 0x89, 0xf3, 0x56, 0x75, 0xd3, 0x77,
+	0xf4, 0x0e, 0xb1, 0x56, 0xc7, 0x19, 0xd2, 0x60, 0x5b, 0xda, 0xe7, 0x0a, 0xd4, 0x3b, 0xc4, 0xe2,
+	0xea, 0x4e, 0x98, 0xf1, 0x73, 0x50, 0xba, 0xc5, 0xb9, 0x99, 0xf9, 0x55, 0x43, 0x10, 0xda, 0x97,
+	0xc2, 0xe0, 0x4d, 0x6c, 0x86, 0x27, 0xcd, 0xe0, 0x33, 0x30, 0xb1, 0xe6, 0x46, 0x21, 0xc5, 0xc1,
+	0x86, 0x30, 0x7a, 0xc2, 0x48, 0x17, 0xb4, 0x1f, 0x15, 0x38, 0x15, 0x1b, 0x6e, 0x60, 0x1a, 0x05,
+	0xde, 0x48, 0xd9, 0x8f, 0xe6, 0xa1, 0x6c, 0x60, 0x33, 0x24, 0x9e, 0x5a, 0xe6, 0x5b, 0x92, 0xd2,
+	0x9e, 0x2a, 0x30, 0x17, 0xfb, 0xb5, 0xfe, 0xc0, 0x77, 0x82, 0x13, 0xe6, 0x96, 0xf6, 0x95, 0x02,
+	0xd3, 0x1d, 0x62, 0xfd, 0x0f, 0x7b, 0xb6, 0xe3, 0xf5, 0x46, 0xe9, 0xca, 0x48, 0xcb, 0x8d, 0xc8,
+	0xf3, 0x46, 0xcc, 0xf2, 0x97, 0x0a, 0xa8, 0x1d, 0x62, 0xdd, 0xf7, 0x4c, 0xcb, 0xc5, 0xf7, 0xc8,
+	0x4e, 0x77, 0x0f, 0xdb, 0x91, 0x8b, 0xdf, 0x85, 0xfb, 0xfe, 0xac, 0xc8, 0x13, 0xd0, 0x4d, 0xd3,
+	0x71, 0xdf, 0x89, 0x07, 0x8c, 0xfe, 0x0b, 0x13, 0xeb, 0x0f, 0x1c, 0xba, 0x46, 0x6c, 0x1c, 0xaa,
+	0x95, 0xa5, 0xe2, 0xf9, 0xda, 0x8a, 0x16, 0x97, 0x8a, 0x8c, 0x97, 0x7a, 0x02, 0x5a, 0xf7, 0x68,
+	0x70, 0x64, 0xa4, 0x4c, 0x68, 0x19, 0x1a, 0x37, 0xb0, 0x69, 0xbb, 0x8e, 0x87, 0xd7, 0x1f, 0x74,
+	0x31, 0xb6, 0xb1, 0xad, 0x56, 0x79, 0xd2, 0x1e, 0x5a, 0x67, 0x36, 0xde, 0xbd, 0xbb, 0x75, 0xdb,
+	0x71, 0x5d, 0x6c, 0xab, 0x13, 0x1c, 0x94, 0x2e, 0xb0, 0x98, 0xad, 0x99, 0x14, 0xf7, 0x48, 0x70,
+	0xa4, 0x82, 0x88, 0x59, 0x4c, 0x37, 0xaf, 0x41, 0x3d, 0x6f, 0x02, 0x6a, 0x40, 0x71, 0x1f, 0x1f,
+	0xc9, 0xa8, 0xb3, 0x4f, 0x16, 0xd7, 0x43, 0xd3, 0x8d, 0x30, 0x0f, 0x78, 0xc9, 0x10, 0xc4, 0xd5,
+	0xb1, 0x2b, 0x8a, 0xf6, 0x75, 0x5c, 0xa8, 0xbb, 0xc2, 0x90, 0x51, 0x7a, 0x4d, 0x9f, 0x89, 0xd2,
+	0x61, 0x60, 0x3f, 0x70, 0x48, 0xe0, 0x50, 0xe7, 0xe3, 0x93, 0x96, 0x63, 0x9f, 0x29, 0x80, 0x3a,
+	0xc4, 0x5a, 0x33, 0xbd, 0x2e, 0x76, 0xdd, 0x13, 0x97, 0xac, 0xd2, 0xab, 0x5f, 0xca, 0xbd, 0xe5,
+	0x2f, 0xc4, 0xa5, 0x90, 0x66, 0x63, 0x7b, 0x34, 0xac, 0xfe, 0x46, 0x04, 0xfb, 0x1e, 0x0e, 0xfa,
+	0x8e, 0x67, 0xd2, 0xd1, 0xba, 0xcb, 0xdf, 0x89, 0xca, 0x30, 0x98, 0x17, 0x46, 0xc9, 0x85, 0x5f,
+	0x15, 0x98, 0x8d, 0x3b, 0x9e, 0x1b, 0xd8, 0x73, 0x46, 0xab, 0x0c, 0xe8, 0xb9, 0x32, 0x50, 0x5f,
+	0x99, 0xe7, 0xb9, 0x3e, 0xe3, 0x8c, 0xd8, 0x4d, 0x6e, 0xdb, 0x27, 0x45, 0x58, 0xe0, 0xc9, 0x47,
+	0xcc, 0x5a, 0x77, 0x0f, 0x71, 0x10, 0x85, 0x23, 0x55, 0xc9, 0x3f, 0x80, 0xa9, 0xd8, 0xfa, 0xf0,
+	0x7e, 0x88, 0x6d, 0xb5, 0xcc, 0x8b, 0x5c, 0x2b, 0x2e, 0x72, 0xc7, 0xb9, 0xa6, 0xe7, 0x38, 0x78,
+	0xb9, 0x91, 0x63, 0x53, 0x5e, 0x56, 0xd3, 0x07, 0x34, 0x0c, 0x3d, 0xa6, 0x32, 0xdd, 0xc8, 0x56,
+	0xa6, 0xda, 0x8a, 0xae, 0x8b, 0xc1, 0x56, 0xcf, 0x0e, 0xb6, 0xba, 0xbf, 0xdf, 0xe3, 0x46, 0xc5,
+	0x83, 0xad, 0xbe, 0x1d, 0x99, 0x1e, 0x75, 0xe8, 0x51, 0xb6, 0x92, 0xbd, 0x50, 0xa0, 0xc1, 0xad,
+	0x3e, 0x38, 0x81, 0x43, 0xdb, 0x1f, 0xeb, 0xa9, 0xbe, 0xaf, 0xc2, 0x24, 0xf7, 0x63, 0x0b, 0x87,
+	0xa1, 0xd9, 0xc3, 0xe8, 0x32, 0x4c, 0x84, 0xf1, 0x48, 0xcd, 0x5d, 0xaa, 0xc9, 0x7b, 0x3a, 0x34,
+	0x6b, 0xb7, 0x0b, 0x46, 0x0a, 0x45, 0x17, 0xa0, 0x2c, 0xa2, 0x22, 0xc3, 0x3c, 0x1b, 0x33, 0x65,
+	0x06, 0xdc, 0x76, 0xc1, 0x90, 0x20, 0x06, 0x77, 0xf9, 0x20, 0xa9, 0x16, 0xf3, 0xf0, 0xcc, 0x78,
+	0xc9, 0xe0, 0x02, 0x84, 0x56, 0x61, 0xca, 0xcd, 0x8e, 0x6f, 0x49, 0x88, 0xb2, 0x5c, 0xb9, 0xd9,
+	0xae, 0x5d, 0x30, 0xf2, 0x2c, 0xe8, 0x3a, 0x4c, 0xba, 0x99, 0x51, 0x49, 0xce, 0xe6, 0xa7, 0x73,
+	0x22, 0xb2, 0x63, 0x54, 0xbb, 0x60, 0xe4, 0x18, 0xd0, 0x45, 0xa8, 0xf8, 0x62, 0x94, 0xe1, 0x41,
+	0xac, 0xad, 0xcc, 0xc5, 0xbc, 0xd9, 0x09, 0xa7, 0x5d, 0x30, 0x62, 0x18, 0xe3, 0x08, 0xc4, 0x08,
+	0xa1, 0x56, 0xf2, 0x1c, 0xd9, 0xc9, 0x82, 0x71, 0x48, 0x18, 0xba, 0x0d, 0x8d, 0x68, 0xa0, 0x75,
+	0xe7, 0x0d, 0x5d, 0x6d, 0xe5, 0x6c, 0xcc, 0x7a, 0x6c, 0x6b, 0xdf, 0x2e, 0x18, 0x43, 0x8c, 0x2c,
+	0xc8, 0xbb, 0xa6, 0x13, 0xb7, 0x7b, 0x99, 0x20, 0x67, 0x9a, 0x4b, 0x16, 0x64, 0x01, 0x12, 0x47,
+	0x2f, 0x9b, 0x34, 0x15, 0x06, 0x8f, 0x3e, 0xdb, 0xbd, 0x89, 0xa3, 0x97, 0x2b, 0xec, 0x70, 0x82,
+	0x6c, 0x83, 0xa4, 0xd6, 0xf2, 0x87, 0x33, 0xdc, 0x3d, 0xb1, 0xc3, 0xc9, 0xb1, 0xa0, 0x7f, 0x03,
+	0x74, 0x93, 0x16, 0x46, 0x9d, 0xe4, 0x02, 0x16, 0x62, 0x01, 0x03, 0xcd, 0x4d, 0xbb, 0x60, 0x64,
+	0xc0, 0xcc, 0x6c, 0x49, 0x61, 0x5b, 0x9d, 0xca, 0x9b, 0x9d, 0xef, 0x2f, 0x98, 0xd9, 0x09, 0x94,
+	0xa9, 0xa4, 0x49, 0x21, 0x57, 0xeb, 0x79, 0x95, 0x03, 0x25, 0x9e, 0xa9, 0x4c, 0xc1, 0xec, 0x94,
+	0xec, 0xc1, 0xb6, 0x7b, 0x3a, 0x7f, 0x4a, 0xc7, 0x96, 0x59, 0x76, 0x4a, 0x83, 0x8c, 0xe8, 0x1a,
+	0xd4, 0xdc, 0xb4, 0x06, 0xa8, 0x0d, 0x2e, 0x47, 0xcd, 0x5d, 0xcb, 0x4c, 0xad, 0x6b, 0x17, 0x8c,
+	0x2c, 0x1c, 0xb5, 0x61, 0x3a, 0xc8, 0x67, 0x51, 0x75, 0x86, 0x4b, 0x38, 0xf3, 0xb6, 0x24, 0xdb,
+	0x2e, 0x18, 0x83, 0x6c, 0xe8, 0x12, 0x54, 0x03, 0x99, 0xd9, 0x54, 0xc4, 0x45, 0x9c, 0x4a, 0x45,
+	0x1c, 0xe4, 0x5e, 0x71, 0x02, 0x5c, 0xad, 0x42, 0x99, 0xff, 0x6f, 0x0c, 0xb5, 0xcb, 0x30, 0xc1,
+	0xb7, 0x37, 0x9d, 0x90, 0xa2, 0xbf, 0x43, 0x99, 0x13, 0xa1, 0xaa, 0xf0, 0x8c, 0x3f, 0xc3, 0x25,
+	0x65, 0x13, 0x8d, 0x21, 0x01, 0xda, 0x36, 0x20, 0xfe, 0xb5, 0x43, 0x03, 0x6c, 0xf6, 0xe5, 0x2e,
+	0xaa, 0xc3, 0x58, 0x92, 0x52, 0xc7, 0x36, 0x6c, 0xf4, 0x0f, 0xa8, 0xf4, 0xc5, 0x96, 0xcc, 0x2f,
+	0xc7, 0x48, 0x8c, 0x11, 0xda, 0x01, 0x4c, 0x89, 0x64, 0xcb, 0xed, 0x0e, 0xe9, 0x90, 0xb4, 0x39,
+	0x28, 0xfd, 0xdf, 0xa4, 0xdd, 0x3d, 0x2e, 0xab, 0x6a, 0x08, 0x02, 0xfd, 0x0d, 0xa6, 0x6e, 0x06,
+	0x24, 0x36, 0x61, 0xc3, 0x96, 0xf9, 0x39, 0xbf, 0x98, 0x66, 0xef, 0xf1, 0x4c, 0xf6, 0xd6, 0x6e,
+	0xf1, 0xc6, 0x64, 0x07, 0xd3, 0x1d, 0x6a, 0xd2, 0x28, 0x8c, 0x15, 0x27, 0x60, 0x25, 0x03, 0x7e,
+	0x5b, 0x71, 0xd0, 0xde, 0x88, 0x9f, 0x3a, 0x19, 0x49, 0xa1, 0x4f, 0xbc, 0x10, 0xb3, 0x0c, 0xbe,
+	0x2d, 0x0e, 0x47, 0xe1, 0x13, 0x96, 0xa4, 0xd8, 0xba, 0xc8, 0x99, 0x72, 0xf2, 0x92, 0x14, 0x52,
+	0xa1, 0x22, 0xd3, 0x12, 0xf7, 0xa3, 0x64, 0xc4, 0x24, 0xdb, 0x91, 0xe9, 0x87, 0xfb, 0x50, 0x32,
+	0x62, 0x92, 0xd5, 0x90, 0xe4, 0xa1, 0xf3, 0xfc, 0x58, 0x32, 0xd2, 0x05, 0xa6, 0x49, 0x24, 0x0e,
+	0x9e, 0xfe, 0x4a, 0x86, 0xa4, 0x78, 0xe5, 0x49, 0x1e, 0x60, 0x45, 0x70, 0x25, 0x0b, 0xe9, 0xcf,
+	0xc4, 0x2a, 0xdf, 0x11, 0x84, 0xf6, 0x3e, 0xaf, 0xa4, 0x7f, 0x32, 0x58, 0x69, 0xed, 0x2d, 0x66,
+	0x6a, 0xaf, 0x76, 0x1d, 0x66, 0x32, 0xb2, 0x65, 0xf8, 0x8e, 0x2f, 0xd3, 0x73, 0x50, 0x62, 0x38,
+	0x2c, 0x25, 0x0b, 0x62, 0xf9, 0xb1, 0x02, 0x33, 0x43, 0x6d, 0x19, 0xaa, 0x41, 0xe5, 0xbe, 0xb7,
+	0xef, 0x91, 0x8f, 0xbc, 0x46, 0x01, 0xa9, 0x30, 0x77, 0x87, 0x6c, 0xb1, 0x6b, 0xe3, 0x78, 0xbd,
+	0x3b, 0xc4, 0xc6, 0x9b, 0xa6, 0x85, 0xdd, 0xb0, 0xa1, 0xa0, 0x53, 0x30, 0xc3, 0x0d, 0xdf, 0x74,
+	0xfa, 0x0e, 0x35, 0xb0, 0xc9, 0x92, 0x71, 0x63, 0x8c, 0x31, 0x6c, 0x78, 0x61, 0xb4, 0xbb, 0xeb,
+	0x74, 0x1d, 0xec, 0xd1, 0x35, 0xd3, 0x37, 0xbb, 0x0e, 0x3d, 0x6a, 0x14, 0x19, 0x43, 0x2a, 0x4a,
+	0x56, 0xec, 0xc6, 0xf8, 0xca, 0xd3, 0x22, 0x94, 0x44, 0x87, 0x71, 0x05, 0xea, 0x06, 0xf6, 0x49,
+	0x40, 0xb7, 0x22, 0x97, 0x3a, 0xbe, 0x8b, 0x51, 0x3d, 0xbd, 0xfc, 0xec, 0xb9, 0x35, 0xe7, 0x87,
+	0x5a, 0x85, 0x75, 0xf6, 0xdb, 0x1d, 0x5d, 0x82, 0xb2, 0xe0, 0x44, 0xc3, 0xcf, 0xe5, 0x77, 0x99,
+	0x30, 0x4c, 0xdf, 0xc2, 0x54, 0xc4, 0x58, 0xbc, 0x51, 0x84, 0x92, 0x32, 0x90, 0xbc, 0xa9, 0xe6,
+	0x42, 0x2a, 0x31, 0xf7, 0x74, 0xb5, 0xbf, 0x3e, 0xfe, 0xe1, 0xcd, 0xa7, 0x63, 0x67, 0x35, 0xb5,
+	0x75, 0xf8, 0xcf, 0xd6, 0x87, 0xc4, 0xba, 0x10, 0x62, 0xda, 0x7a, 0xc8, 0x63, 0xf2, 0xa8, 0xf5,
+	0x70, 0xc3, 0x7e, 0x74, 0x55, 0x59, 0xbe, 0xa8, 0xe4, 0xd4, 0x88, 0xb3, 0x42, 0x6a, 0x46, 0x4d,
+	0xee, 0x6a, 0x34, 0x4f, 0x1f, 0xb3, 0x23, 0x0e, 0x56, 0x3b, 0xcb, 0xd5, 0x2d, 0x68, 0x28, 0xab,
+	0x2e, 0xe4, 0x98, 0xab, 0xca, 0x32, 0x7a, 0x0f, 0x26, 0xa5, 0x1a, 0xa1, 0x23, 0xc9, 0x69, 0x79,
+	0x05, 0xf3, 0x83, 0xcb, 0x52, 0xfa, 0x69, 0x2e, 0x7d, 0x56, 0xab, 0x4b, 0xe9, 0xa9, 0xe4, 0x55,
+	0xf5, 0xf9, 0xab, 0x45, 0xe5, 0xc5, 0xab, 0x45, 0xe5, 0x97, 0x57, 0x8b, 0xca, 0x93, 0xd7, 0x8b,
+	0x85, 0x17, 0xaf, 0x17, 0x0b, 0x2f, 0x5f, 0x2f, 0x16, 0xac, 0x32, 0x8f, 0xe8, 0xa5, 0xdf, 0x06,
+	0x00, 0x48, 0x20, 0x5a, 0x1c, 0x93, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EventClient is the client API for Event service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventClient interface {
+	ReportMultiple(ctx context.Context, in *EventList, opts ...grpc.CallOption) (*types.Empty, error)
+	Report(ctx context.Context, in *EventMessage, opts ...grpc.CallOption) (*types.Empty, error)
+	GetJobSetEvents(ctx context.Context, in *JobSetRequest, opts ...grpc.CallOption) (Event_GetJobSetEventsClient, error)
+	GetJobSetStatus(ctx context.Context, in *JobSetStatusRequest, opts ...grpc.CallOption) (*JobSetStatusResponse, error)
+	GetJobStatus(ctx context.Context, in *JobStatusRequest, opts ...grpc.CallOption) (*JobStatusResponse, error)
+}
+
+type eventClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventClient(cc *grpc.ClientConn) EventClient {
+	return &eventClient{cc}
+}
+
+func (c *eventClient) ReportMultiple(ctx context.Context, in *EventList, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Event/ReportMultiple", in, out, opts...)
 	if err != nil {
-		return 0, err
-	}
-	i += n7
-	if len(m.ClusterId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i += copy(dAtA[i:], m.ClusterId)
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *JobRunningEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *eventClient) Report(ctx context.Context, in *EventMessage, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Event/Report", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *JobRunningEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
-	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
-	}
-	if len(m.Queue) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
-	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintEvent(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)))
-	n8, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i:])
+func (c *eventClient) GetJobSetEvents(ctx context.Context, in *JobSetRequest, opts ...grpc.CallOption) (Event_GetJobSetEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Event_serviceDesc.Streams[0], "/api.Event/GetJobSetEvents", opts...)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	i += n8
-	if len(m.ClusterId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i += copy(dAtA[i:], m.ClusterId)
+	x := &eventGetJobSetEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return i, nil
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *JobUnableToScheduleEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+type Event_GetJobSetEventsClient interface {
+	Recv() (*EventStreamMessage, error)
+	grpc.ClientStream
+}
+
+type eventGetJobSetEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventGetJobSetEventsClient) Recv() (*EventStreamMessage, error) {
+	m := new(EventStreamMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *JobUnableToScheduleEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
-	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
-	}
-	if len(m.Queue) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
-	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintEvent(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)))
-	n9, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i:])
+func (c *eventClient) GetJobSetStatus(ctx context.Context, in *JobSetStatusRequest, opts ...grpc.CallOption) (*JobSetStatusResponse, error) {
+	out := new(JobSetStatusResponse)
+	err := c.cc.Invoke(ctx, "/api.Event/GetJobSetStatus", in, out, opts...)
 	if err != nil {
-		return 0, err
-	}
-	i += n9
-	if len(m.ClusterId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i += copy(dAtA[i:], m.ClusterId)
-	}
-	if len(m.Reason) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i += copy(dAtA[i:], m.Reason)
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *JobFailedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *eventClient) GetJobStatus(ctx context.Context, in *JobStatusRequest, opts ...grpc.CallOption) (*JobStatusResponse, error) {
+	out := new(JobStatusResponse)
+	err := c.cc.Invoke(ctx, "/api.Event/GetJobStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *JobFailedEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
-	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
-	}
-	if len(m.Queue) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
-	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintEvent(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)))
-	n10, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i:])
-	if err != nil {
-		return 0, err
+// EventServer is the server API for Event service.
+type EventServer interface {
+	ReportMultiple(context.Context, *EventList) (*types.Empty, error)
+	Report(context.Context, *EventMessage) (*types.Empty, error)
+	GetJobSetEvents(*JobSetRequest, Event_GetJobSetEventsServer) error
+	GetJobSetStatus(context.Context, *JobSetStatusRequest) (*JobSetStatusResponse, error)
+	GetJobStatus(context.Context, *JobStatusRequest) (*JobStatusResponse, error)
+}
+
+// UnimplementedEventServer can be embedded to have forward compatible implementations.
+type UnimplementedEventServer struct {
+}
+
+func (*UnimplementedEventServer) ReportMultiple(ctx context.Context, req *EventList) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMultiple not implemented")
+}
+func (*UnimplementedEventServer) Report(ctx context.Context, req *EventMessage) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Report not implemented")
+}
+func (*UnimplementedEventServer) GetJobSetEvents(req *JobSetRequest, srv Event_GetJobSetEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetJobSetEvents not implemented")
+}
+func (*UnimplementedEventServer) GetJobSetStatus(ctx context.Context, req *JobSetStatusRequest) (*JobSetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobSetStatus not implemented")
+}
+func (*UnimplementedEventServer) GetJobStatus(ctx context.Context, req *JobStatusRequest) (*JobStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobStatus not implemented")
+}
+
+func RegisterEventServer(s *grpc.Server, srv EventServer) {
+	s.RegisterService(&_Event_serviceDesc, srv)
+}
+
+func _Event_ReportMultiple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventList)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i += n10
-	if len(m.ClusterId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i += copy(dAtA[i:], m.ClusterId)
+	if interceptor == nil {
+		return srv.(EventServer).ReportMultiple(ctx, in)
 	}
-	if len(m.Reason) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i += copy(dAtA[i:], m.Reason)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Event/ReportMultiple",
 	}
-	if len(m.ExitCodes) > 0 {
-		for k, _ := range m.ExitCodes {
-			dAtA[i] = 0x3a
-			i++
-			v := m.ExitCodes[k]
-			mapSize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + sovEvent(uint64(v))
-			i = encodeVarintEvent(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x10
-			i++
-			i = encodeVarintEvent(dAtA, i, uint64(v))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).ReportMultiple(ctx, req.(*EventList))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSucceededEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Event_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventMessage)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(EventServer).Report(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Event/Report",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).Report(ctx, req.(*EventMessage))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSucceededEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
+func _Event_GetJobSetEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JobSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
+	return srv.(EventServer).GetJobSetEvents(m, &eventGetJobSetEventsServer{stream})
+}
+
+type Event_GetJobSetEventsServer interface {
+	Send(*EventStreamMessage) error
+	grpc.ServerStream
+}
+
+type eventGetJobSetEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventGetJobSetEventsServer) Send(m *EventStreamMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Event_GetJobSetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Queue) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
+	if interceptor == nil {
+		return srv.(EventServer).GetJobSetStatus(ctx, in)
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintEvent(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)))
-	n11, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i:])
-	if err != nil {
-		return 0, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Event/GetJobSetStatus",
 	}
-	i += n11
-	if len(m.ClusterId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i += copy(dAtA[i:], m.ClusterId)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).GetJobSetStatus(ctx, req.(*JobSetStatusRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobReprioritizedEvent) Marshal() (dAtA []byte, err error) {
+func _Event_GetJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).GetJobStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Event/GetJobStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).GetJobStatus(ctx, req.(*JobStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Event_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Event",
+	HandlerType: (*EventServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReportMultiple",
+			Handler:    _Event_ReportMultiple_Handler,
+		},
+		{
+			MethodName: "Report",
+			Handler:    _Event_Report_Handler,
+		},
+		{
+			MethodName: "GetJobSetStatus",
+			Handler:    _Event_GetJobSetStatus_Handler,
+		},
+		{
+			MethodName: "GetJobStatus",
+			Handler:    _Event_GetJobStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetJobSetEvents",
+			Handler:       _Event_GetJobSetEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/api/event.proto",
+}
+
+func (m *JobSubmittedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *JobReprioritizedEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *JobSubmittedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmittedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
+	{
+		size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err2 != nil {
+		return 0, err2
 	}
+	i -= n2
+	i = encodeVarintEvent(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
 		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
+		i--
+		dAtA[i] = 0x1a
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintEvent(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)))
-	n12, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i += n12
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
-func (m *JobCancellingEvent) Marshal() (dAtA []byte, err error) {
+func (m *JobQueuedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *JobCancellingEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *JobQueuedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobQueuedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
+	if m.Gated {
+		i--
+		if m.Gated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err3 != nil {
+		return 0, err3
 	}
+	i -= n3
+	i = encodeVarintEvent(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
 		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
+		i--
+		dAtA[i] = 0x1a
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintEvent(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)))
-	n13, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i += n13
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
-func (m *JobCancelledEvent) Marshal() (dAtA []byte, err error) {
+func (m *JobLeasedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *JobCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *JobLeasedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobLeasedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err4 != nil {
+		return 0, err4
 	}
+	i -= n4
+	i = encodeVarintEvent(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
 		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
+		i--
+		dAtA[i] = 0x1a
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintEvent(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)))
-	n14, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i += n14
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
-func (m *JobTerminatedEvent) Marshal() (dAtA []byte, err error) {
+func (m *JobLeaseReturnedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *JobTerminatedEvent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *JobLeaseReturnedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobLeaseReturnedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
-	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
 	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintEvent(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
 		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
+		i--
+		dAtA[i] = 0x1a
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintEvent(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)))
-	n15, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i += n15
-	if len(m.ClusterId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i += copy(dAtA[i:], m.ClusterId)
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMessage) Marshal() (dAtA []byte, err error) {
+func (m *JobLeaseExpiredEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *EventMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *JobLeaseExpiredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobLeaseExpiredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Events != nil {
-		nn16, err := m.Events.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn16
-	}
-	return i, nil
-}
-
-func (m *EventMessage_Submitted) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Submitted != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(m.Submitted.Size()))
-		n17, err := m.Submitted.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n17
-	}
-	return i, nil
-}
-func (m *EventMessage_Queued) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Queued != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(m.Queued.Size()))
-		n18, err := m.Queued.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n18
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err6 != nil {
+		return 0, err6
 	}
-	return i, nil
-}
-func (m *EventMessage_Leased) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Leased != nil {
+	i -= n6
+	i = encodeVarintEvent(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
 		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(m.Leased.Size()))
-		n19, err := m.Leased.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n19
-	}
-	return i, nil
-}
-func (m *EventMessage_LeaseReturned) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.LeaseReturned != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(m.LeaseReturned.Size()))
-		n20, err := m.LeaseReturned.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n20
 	}
-	return i, nil
-}
-func (m *EventMessage_LeaseExpired) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.LeaseExpired != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(m.LeaseExpired.Size()))
-		n21, err := m.LeaseExpired.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n21
-	}
-	return i, nil
-}
-func (m *EventMessage_Pending) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Pending != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(m.Pending.Size()))
-		n22, err := m.Pending.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n22
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return i, nil
-}
-func (m *EventMessage_Running) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Running != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(m.Running.Size()))
-		n23, err := m.Running.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n23
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_UnableToSchedule) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.UnableToSchedule != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(m.UnableToSchedule.Size()))
-		n24, err := m.UnableToSchedule.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n24
+
+func (m *JobPendingEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return dAtA[:n], nil
 }
-func (m *EventMessage_Failed) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Failed != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(m.Failed.Size()))
-		n25, err := m.Failed.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n25
-	}
-	return i, nil
+
+func (m *JobPendingEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *EventMessage_Succeeded) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Succeeded != nil {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(m.Succeeded.Size()))
-		n26, err := m.Succeeded.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n26
+
+func (m *JobPendingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return i, nil
-}
-func (m *EventMessage_Reprioritized) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Reprioritized != nil {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(m.Reprioritized.Size()))
-		n27, err := m.Reprioritized.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n27
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err7 != nil {
+		return 0, err7
 	}
-	return i, nil
-}
-func (m *EventMessage_Cancelling) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Cancelling != nil {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(m.Cancelling.Size()))
-		n28, err := m.Cancelling.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n28
+	i -= n7
+	i = encodeVarintEvent(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return i, nil
-}
-func (m *EventMessage_Cancelled) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Cancelled != nil {
-		dAtA[i] = 0x6a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(m.Cancelled.Size()))
-		n29, err := m.Cancelled.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n29
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return i, nil
-}
-func (m *EventMessage_Terminated) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Terminated != nil {
-		dAtA[i] = 0x72
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(m.Terminated.Size()))
-		n30, err := m.Terminated.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n30
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
-func (m *EventList) Marshal() (dAtA []byte, err error) {
+
+func (m *JobRunningEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *EventList) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *JobRunningEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRunningEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Events) > 0 {
-		for _, msg := range m.Events {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintEvent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintEvent(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
-func (m *EventStreamMessage) Marshal() (dAtA []byte, err error) {
+func (m *JobUnableToScheduleEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *EventStreamMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *JobUnableToScheduleEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobUnableToScheduleEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Message != nil {
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintEvent(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
 		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(m.Message.Size()))
-		n31, err := m.Message.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n31
 	}
-	return i, nil
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobFailedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *JobSetRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *JobFailedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.Watch {
-		dAtA[i] = 0x10
-		i++
-		if m.Watch {
+	if m.OOMKilled {
+		i--
+		if m.OOMKilled {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
-		i++
-	}
-	if len(m.FromMessageId) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FromMessageId)))
-		i += copy(dAtA[i:], m.FromMessageId)
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.Queue) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
+	if m.DeadlineExceeded {
+		i--
+		if m.DeadlineExceeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	return i, nil
-}
-
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.ExitCodes) > 0 {
+		for k := range m.ExitCodes {
+			v := m.ExitCodes[k]
+			baseI := i
+			i = encodeVarintEvent(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *JobSubmittedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err10 != nil {
+		return 0, err10
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n10
+	i = encodeVarintEvent(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Job.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobQueuedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSucceededEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSucceededEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSucceededEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err11 != nil {
+		return 0, err11
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n11
+	i = encodeVarintEvent(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobLeasedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobReprioritizedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobReprioritizedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReprioritizedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err12 != nil {
+		return 0, err12
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n12
+	i = encodeVarintEvent(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobLeaseReturnedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobCancellingEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancellingEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancellingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err13 != nil {
+		return 0, err13
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n13
+	i = encodeVarintEvent(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobLeaseExpiredEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobCancelledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err14 != nil {
+		return 0, err14
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n14
+	i = encodeVarintEvent(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobPendingEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobTerminatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobTerminatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobTerminatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err15 != nil {
+		return 0, err15
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n15
+	i = encodeVarintEvent(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobRunningEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobDeadlineExceededEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobDeadlineExceededEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobDeadlineExceededEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err16 != nil {
+		return 0, err16
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n16
+	i = encodeVarintEvent(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobUnableToScheduleEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobLeaseDeniedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobLeaseDeniedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobLeaseDeniedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.Reason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err17 != nil {
+		return 0, err17
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n17
+	i = encodeVarintEvent(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobFailedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobResourceOveruseEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobResourceOveruseEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobResourceOveruseEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ResourcesUsed) > 0 {
+		for k := range m.ResourcesUsed {
+			v := m.ResourcesUsed[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err19 != nil {
+		return 0, err19
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n19
+	i = encodeVarintEvent(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ExitCodes) > 0 {
-		for k, v := range m.ExitCodes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + sovEvent(uint64(v))
-			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
-		}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobSucceededEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobRequeuedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRequeuedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRequeuedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *JobReprioritizedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err20 != nil {
+		return 0, err20
 	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n20
+	i = encodeVarintEvent(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobCancellingEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.Events != nil {
+		{
+			size := m.Events.Size()
+			i -= size
+			if _, err := m.Events.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobCancelledEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+func (m *EventMessage_Submitted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobTerminatedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+func (m *EventMessage_Submitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Submitted != nil {
+		{
+			size, err := m.Submitted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-
-func (m *EventMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Events != nil {
-		n += m.Events.Size()
-	}
-	return n
+func (m *EventMessage_Queued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMessage_Submitted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Submitted != nil {
-		l = m.Submitted.Size()
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	return n
-}
-func (m *EventMessage_Queued) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *EventMessage_Queued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Queued != nil {
-		l = m.Queued.Size()
-		n += 1 + l + sovEvent(uint64(l))
+		{
+			size, err := m.Queued.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Leased) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *EventMessage_Leased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Leased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Leased != nil {
-		l = m.Leased.Size()
-		n += 1 + l + sovEvent(uint64(l))
+		{
+			size, err := m.Leased.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_LeaseReturned) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *EventMessage_LeaseReturned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_LeaseReturned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.LeaseReturned != nil {
-		l = m.LeaseReturned.Size()
-		n += 1 + l + sovEvent(uint64(l))
+		{
+			size, err := m.LeaseReturned.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_LeaseExpired) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *EventMessage_LeaseExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_LeaseExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.LeaseExpired != nil {
-		l = m.LeaseExpired.Size()
-		n += 1 + l + sovEvent(uint64(l))
+		{
+			size, err := m.LeaseExpired.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Pending) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *EventMessage_Pending) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Pending) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Pending != nil {
-		l = m.Pending.Size()
-		n += 1 + l + sovEvent(uint64(l))
+		{
+			size, err := m.Pending.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Running) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *EventMessage_Running) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Running) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Running != nil {
-		l = m.Running.Size()
-		n += 1 + l + sovEvent(uint64(l))
+		{
+			size, err := m.Running.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_UnableToSchedule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *EventMessage_UnableToSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_UnableToSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.UnableToSchedule != nil {
-		l = m.UnableToSchedule.Size()
-		n += 1 + l + sovEvent(uint64(l))
+		{
+			size, err := m.UnableToSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Failed) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *EventMessage_Failed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Failed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Failed != nil {
-		l = m.Failed.Size()
-		n += 1 + l + sovEvent(uint64(l))
+		{
+			size, err := m.Failed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Succeeded) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *EventMessage_Succeeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Succeeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Succeeded != nil {
-		l = m.Succeeded.Size()
-		n += 1 + l + sovEvent(uint64(l))
+		{
+			size, err := m.Succeeded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Reprioritized) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMessage_Reprioritized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Reprioritized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Reprioritized != nil {
+		{
+			size, err := m.Reprioritized.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_Cancelling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Cancelling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Cancelling != nil {
+		{
+			size, err := m.Cancelling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_Cancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Cancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Cancelled != nil {
+		{
+			size, err := m.Cancelled.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_Terminated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Terminated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Terminated != nil {
+		{
+			size, err := m.Terminated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_DeadlineExceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_DeadlineExceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeadlineExceeded != nil {
+		{
+			size, err := m.DeadlineExceeded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_LeaseDenied) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_LeaseDenied) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LeaseDenied != nil {
+		{
+			size, err := m.LeaseDenied.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_ResourceOveruse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_ResourceOveruse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResourceOveruse != nil {
+		{
+			size, err := m.ResourceOveruse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_Requeued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Requeued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Requeued != nil {
+		{
+			size, err := m.Requeued.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Reprioritized != nil {
-		l = m.Reprioritized.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Cancelling) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventStreamMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStreamMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStreamMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Cancelling != nil {
-		l = m.Cancelling.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Cancelled) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *JobSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Cancelled != nil {
-		l = m.Cancelled.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.FromMessageId) > 0 {
+		i -= len(m.FromMessageId)
+		copy(dAtA[i:], m.FromMessageId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FromMessageId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Watch {
+		i--
+		if m.Watch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Terminated) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *JobSetStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Terminated != nil {
-		l = m.Terminated.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *EventList) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *JobSetStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Events) > 0 {
-		for _, e := range m.Events {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
+	if m.Gated != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Gated))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Cancelled != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Cancelled))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Failed != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Succeeded != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Succeeded))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Running != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Running))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pending != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Pending))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Leased != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Leased))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Queued != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Queued))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *JobSubmittedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Job.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *JobQueuedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Gated {
+		n += 2
+	}
+	return n
+}
+
+func (m *JobLeasedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobLeaseReturnedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobLeaseExpiredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *JobPendingEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobRunningEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobUnableToScheduleEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobFailedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.ExitCodes) > 0 {
+		for k, v := range m.ExitCodes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + sovEvent(uint64(v))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	if m.DeadlineExceeded {
+		n += 2
+	}
+	if m.OOMKilled {
+		n += 2
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSucceededEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobReprioritizedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *JobCancellingEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobCancelledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobTerminatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobDeadlineExceededEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobLeaseDeniedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvent(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *JobResourceOveruseEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.ResourcesUsed) > 0 {
+		for k, v := range m.ResourcesUsed {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + l + sovEvent(uint64(l))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobRequeuedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Events != nil {
+		n += m.Events.Size()
+	}
+	return n
+}
+
+func (m *EventMessage_Submitted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Submitted != nil {
+		l = m.Submitted.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Queued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queued != nil {
+		l = m.Queued.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Leased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Leased != nil {
+		l = m.Leased.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_LeaseReturned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeaseReturned != nil {
+		l = m.LeaseReturned.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_LeaseExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeaseExpired != nil {
+		l = m.LeaseExpired.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Pending) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pending != nil {
+		l = m.Pending.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Running) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Running != nil {
+		l = m.Running.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_UnableToSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnableToSchedule != nil {
+		l = m.UnableToSchedule.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Failed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Failed != nil {
+		l = m.Failed.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Succeeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Succeeded != nil {
+		l = m.Succeeded.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Reprioritized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reprioritized != nil {
+		l = m.Reprioritized.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Cancelling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cancelling != nil {
+		l = m.Cancelling.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Cancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cancelled != nil {
+		l = m.Cancelled.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Terminated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Terminated != nil {
+		l = m.Terminated.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_DeadlineExceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeadlineExceeded != nil {
+		l = m.DeadlineExceeded.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_LeaseDenied) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeaseDenied != nil {
+		l = m.LeaseDenied.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_ResourceOveruse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceOveruse != nil {
+		l = m.ResourceOveruse.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Requeued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Requeued != nil {
+		l = m.Requeued.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventStreamMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Watch {
+		n += 2
+	}
+	l = len(m.FromMessageId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSetStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSetStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queued != 0 {
+		n += 1 + sovEvent(uint64(m.Queued))
+	}
+	if m.Leased != 0 {
+		n += 1 + sovEvent(uint64(m.Leased))
+	}
+	if m.Pending != 0 {
+		n += 1 + sovEvent(uint64(m.Pending))
+	}
+	if m.Running != 0 {
+		n += 1 + sovEvent(uint64(m.Running))
+	}
+	if m.Succeeded != 0 {
+		n += 1 + sovEvent(uint64(m.Succeeded))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovEvent(uint64(m.Failed))
+	}
+	if m.Cancelled != 0 {
+		n += 1 + sovEvent(uint64(m.Cancelled))
+	}
+	if m.Gated != 0 {
+		n += 1 + sovEvent(uint64(m.Gated))
+	}
+	return n
+}
+
+func (m *JobStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmittedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmittedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobQueuedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobQueuedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Gated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobLeasedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobLeasedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobLeaseReturnedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobLeaseReturnedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobLeaseExpiredEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobLeaseExpiredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobPendingEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobPendingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobRunningEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobRunningEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobUnableToScheduleEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobUnableToScheduleEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobFailedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExitCodes == nil {
+				m.ExitCodes = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ExitCodes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineExceeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeadlineExceeded = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OOMKilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OOMKilled = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *EventStreamMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	return n
-}
-
-func (m *JobSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.Watch {
-		n += 2
-	}
-	l = len(m.FromMessageId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	return n
-}
 
-func sovEvent(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3729,10 +7610,10 @@ func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmittedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSucceededEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmittedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSucceededEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3866,9 +7747,9 @@ func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -3878,24 +7759,23 @@ func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3921,7 +7801,7 @@ func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3944,10 +7824,10 @@ func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobQueuedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobReprioritizedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobQueuedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobReprioritizedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4103,7 +7983,7 @@ func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4126,10 +8006,10 @@ func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobLeasedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobCancellingEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobLeasedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobCancellingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4263,7 +8143,7 @@ func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4291,7 +8171,7 @@ func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4317,7 +8197,7 @@ func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4340,10 +8220,10 @@ func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobLeaseReturnedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobCancelledEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobLeaseReturnedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4476,38 +8356,6 @@ func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
@@ -4563,7 +8411,7 @@ func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
+func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4586,10 +8434,10 @@ func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobLeaseExpiredEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobTerminatedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobLeaseExpiredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobTerminatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4721,6 +8569,38 @@ func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -4745,7 +8625,7 @@ func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
+func (m *JobDeadlineExceededEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4768,10 +8648,10 @@ func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobPendingEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobDeadlineExceededEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobPendingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobDeadlineExceededEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4959,7 +8839,7 @@ func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
+func (m *JobLeaseDeniedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4982,10 +8862,10 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobRunningEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobLeaseDeniedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobRunningEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobLeaseDeniedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5149,6 +9029,25 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= LeaseDeniedReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -5173,7 +9072,7 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
+func (m *JobResourceOveruseEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5196,10 +9095,10 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobUnableToScheduleEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobResourceOveruseEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobUnableToScheduleEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobResourceOveruseEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5365,9 +9264,9 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesUsed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -5377,23 +9276,120 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if m.ResourcesUsed == nil {
+				m.ResourcesUsed = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourcesUsed[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5419,7 +9415,7 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobRequeuedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5442,10 +9438,10 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobFailedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobRequeuedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobRequeuedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5632,127 +9628,14 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCodes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExitCodes == nil {
-				m.ExitCodes = make(map[string]int32)
-			}
-			var mapkey string
-			var mapvalue int32
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthEvent
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEvent(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.ExitCodes[mapkey] = mapvalue
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5778,7 +9661,7 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
+func (m *EventMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5801,17 +9684,17 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSucceededEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSucceededEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -5821,29 +9704,32 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			v := &JobSubmittedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Submitted{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -5853,29 +9739,32 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			v := &JobQueuedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Queued{v}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leased", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -5885,27 +9774,30 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			v := &JobLeasedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Leased{v}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseReturned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5932,15 +9824,17 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+			v := &JobLeaseReturnedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Events = &EventMessage_LeaseReturned{v}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseExpired", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -5950,82 +9844,67 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
+			v := &JobLeaseExpiredEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthEvent
+			m.Events = &EventMessage_LeaseExpired{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEvent
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &JobPendingEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Events = &EventMessage_Pending{v}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6035,29 +9914,32 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			v := &JobRunningEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Running{v}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnableToSchedule", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6067,29 +9949,32 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			v := &JobUnableToScheduleEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_UnableToSchedule{v}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6099,27 +9984,30 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			v := &JobFailedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Failed{v}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6146,68 +10034,17 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+			v := &JobSucceededEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Events = &EventMessage_Succeeded{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobCancellingEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancellingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritized", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6217,29 +10054,32 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			v := &JobReprioritizedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Reprioritized{v}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelling", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6249,29 +10089,32 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			v := &JobCancellingEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Cancelling{v}
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6281,27 +10124,30 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			v := &JobCancelledEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Cancelled{v}
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6328,68 +10174,17 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+			v := &JobTerminatedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Events = &EventMessage_Terminated{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobCancelledEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineExceeded", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6399,29 +10194,32 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			v := &JobDeadlineExceededEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_DeadlineExceeded{v}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseDenied", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6431,29 +10229,32 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			v := &JobLeaseDeniedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_LeaseDenied{v}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceOveruse", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6463,27 +10264,30 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			v := &JobResourceOveruseEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_ResourceOveruse{v}
 			iNdEx = postIndex
-		case 4:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requeued", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6510,9 +10314,11 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+			v := &JobRequeuedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Events = &EventMessage_Requeued{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6538,7 +10344,7 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
+func (m *EventList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6561,17 +10367,17 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobTerminatedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobTerminatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6581,59 +10387,82 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			m.Events = append(m.Events, &EventMessage{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStreamMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStreamMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6661,11 +10490,11 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6692,41 +10521,12 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+			if m.Message == nil {
+				m.Message = &EventMessage{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6752,7 +10552,7 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMessage) Unmarshal(dAtA []byte) error {
+func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6775,17 +10575,17 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6795,32 +10595,49 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobSubmittedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Submitted{v}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Watch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Watch = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromMessageId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6830,32 +10647,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobQueuedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Queued{v}
+			m.FromMessageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leased", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6865,32 +10679,82 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobLeasedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Events = &EventMessage_Leased{v}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseReturned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6900,32 +10764,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobLeaseReturnedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_LeaseReturned{v}
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseExpired", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6935,67 +10796,82 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobLeaseExpiredEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_LeaseExpired{v}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobPendingEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
 			}
-			m.Events = &EventMessage_Pending{v}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			m.Queued = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -7005,32 +10881,16 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Queued |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &JobRunningEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Running{v}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnableToSchedule", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leased", wireType)
 			}
-			var msglen int
+			m.Leased = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -7040,32 +10900,16 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Leased |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &JobUnableToScheduleEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_UnableToSchedule{v}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
-			var msglen int
+			m.Pending = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -7075,32 +10919,16 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Pending |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &JobFailedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Failed{v}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
 			}
-			var msglen int
+			m.Running = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -7110,32 +10938,16 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Running |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &JobSucceededEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Succeeded{v}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritized", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			var msglen int
+			m.Succeeded = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -7145,32 +10957,16 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Succeeded |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &JobReprioritizedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Reprioritized{v}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cancelling", wireType)
-			}
-			var msglen int
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -7180,32 +10976,16 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Failed |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &JobCancellingEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Cancelling{v}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
+		case 7:
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
 			}
-			var msglen int
+			m.Cancelled = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -7215,32 +10995,16 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Cancelled |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &JobCancelledEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Cancelled{v}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gated", wireType)
 			}
-			var msglen int
+			m.Gated = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -7250,27 +11014,11 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Gated |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &JobTerminatedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Terminated{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -7295,7 +11043,7 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventList) Unmarshal(dAtA []byte) error {
+func (m *JobStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7318,17 +11066,17 @@ func (m *EventList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventList: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -7338,82 +11086,27 @@ func (m *EventList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, &EventMessage{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventStreamMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStreamMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7441,13 +11134,13 @@ func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -7457,27 +11150,23 @@ func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Message == nil {
-				m.Message = &EventMessage{}
-			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7503,7 +11192,7 @@ func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
+func (m *JobStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7526,15 +11215,15 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7562,63 +11251,11 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Watch", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Watch = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromMessageId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FromMessageId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7646,7 +11283,7 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7675,6 +11312,7 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -7706,10 +11344,8 @@ func skipEvent(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -7730,55 +11366,30 @@ func skipEvent(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthEvent
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthEvent
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipEvent(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthEvent
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvent
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthEvent = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowEvent   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthEvent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvent = fmt.Errorf("proto: unexpected end of group")
 )
@@ -52,6 +52,14 @@ func UnwrapEvent(message *EventMessage) (Event, error) {
 		return event.Cancelled, nil
 	case *EventMessage_Terminated:
 		return event.Terminated, nil
+	case *EventMessage_DeadlineExceeded:
+		return event.DeadlineExceeded, nil
+	case *EventMessage_LeaseDenied:
+		return event.LeaseDenied, nil
+	case *EventMessage_ResourceOveruse:
+		return event.ResourceOveruse, nil
+	case *EventMessage_Requeued:
+		return event.Requeued, nil
 	}
 	return nil, fmt.Errorf("unknow event type: %s", reflect.TypeOf(message.Events))
 }
@@ -142,6 +150,30 @@ func Wrap(event Event) (*EventMessage, error) {
 				Terminated: typed,
 			},
 		}, nil
+	case *JobDeadlineExceededEvent:
+		return &EventMessage{
+			Events: &EventMessage_DeadlineExceeded{
+				DeadlineExceeded: typed,
+			},
+		}, nil
+	case *JobLeaseDeniedEvent:
+		return &EventMessage{
+			Events: &EventMessage_LeaseDenied{
+				LeaseDenied: typed,
+			},
+		}, nil
+	case *JobResourceOveruseEvent:
+		return &EventMessage{
+			Events: &EventMessage_ResourceOveruse{
+				ResourceOveruse: typed,
+			},
+		}, nil
+	case *JobRequeuedEvent:
+		return &EventMessage{
+			Events: &EventMessage_Requeued{
+				Requeued: typed,
+			},
+		}, nil
 	}
 	return nil, fmt.Errorf("unknown event type: %s", reflect.TypeOf(event))
 }
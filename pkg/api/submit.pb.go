@@ -9,24 +9,110 @@ import (
 	fmt "fmt"
 	io "io"
 	math "math"
+	math_bits "math/bits"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type JobOrderingStrategy int32
+
+const (
+	// Jobs with higher priority (lower value) first, jobs of the same priority in submission order
+	JobOrderingStrategy_Priority JobOrderingStrategy = 0
+	// Jobs in submission order regardless of their priority
+	JobOrderingStrategy_FIFO JobOrderingStrategy = 1
+	// Jobs requesting least resources first
+	JobOrderingStrategy_ShortestResourceFirst JobOrderingStrategy = 2
+)
+
+var JobOrderingStrategy_name = map[int32]string{
+	0: "Priority",
+	1: "FIFO",
+	2: "ShortestResourceFirst",
+}
+
+var JobOrderingStrategy_value = map[string]int32{
+	"Priority":              0,
+	"FIFO":                  1,
+	"ShortestResourceFirst": 2,
+}
+
+func (x JobOrderingStrategy) String() string {
+	return proto.EnumName(JobOrderingStrategy_name, int32(x))
+}
+
+func (JobOrderingStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{0}
+}
+
+// Reason of a failed request, reported in ErrorDetail of the gRPC status for programmatic handling
+type ErrorCode int32
+
+const (
+	ErrorCode_UnknownError       ErrorCode = 0
+	ErrorCode_QueueNotFound      ErrorCode = 1
+	ErrorCode_QueueAlreadyExists ErrorCode = 2
+	ErrorCode_PermissionDenied   ErrorCode = 3
+	// Submitted job or job template is not valid
+	ErrorCode_InvalidPodSpec ErrorCode = 4
+	// Queue has reached its limit of queued jobs
+	ErrorCode_QuotaExceeded ErrorCode = 5
+	ErrorCode_JobNotFound   ErrorCode = 6
+	// Queue still has queued or leased jobs
+	ErrorCode_QueueNotEmpty ErrorCode = 7
+)
+
+var ErrorCode_name = map[int32]string{
+	0: "UnknownError",
+	1: "QueueNotFound",
+	2: "QueueAlreadyExists",
+	3: "PermissionDenied",
+	4: "InvalidPodSpec",
+	5: "QuotaExceeded",
+	6: "JobNotFound",
+	7: "QueueNotEmpty",
+}
+
+var ErrorCode_value = map[string]int32{
+	"UnknownError":       0,
+	"QueueNotFound":      1,
+	"QueueAlreadyExists": 2,
+	"PermissionDenied":   3,
+	"InvalidPodSpec":     4,
+	"QuotaExceeded":      5,
+	"JobNotFound":        6,
+	"QueueNotEmpty":      7,
+}
+
+func (x ErrorCode) String() string {
+	return proto.EnumName(ErrorCode_name, int32(x))
+}
+
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{1}
+}
 
 type JobSubmitRequestItem struct {
 	Priority           float64           `protobuf:"fixed64,1,opt,name=Priority,proto3" json:"Priority,omitempty"`
@@ -35,6 +121,24 @@ type JobSubmitRequestItem struct {
 	Annotations        map[string]string `protobuf:"bytes,5,rep,name=Annotations,proto3" json:"Annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	RequiredNodeLabels map[string]string `protobuf:"bytes,6,rep,name=RequiredNodeLabels,proto3" json:"RequiredNodeLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	PodSpec            *v1.PodSpec       `protobuf:"bytes,2,opt,name=PodSpec,proto3" json:"PodSpec,omitempty"`
+	// Jobs submitted repeatedly with the same ClientId to the same queue and job set are created only once
+	ClientId string `protobuf:"bytes,7,opt,name=ClientId,proto3" json:"ClientId,omitempty"`
+	// Name of the job template of the queue providing the pod spec, PodSpec must be empty when used
+	TemplateName string `protobuf:"bytes,8,opt,name=TemplateName,proto3" json:"TemplateName,omitempty"`
+	// Changes of the pod spec of the job template
+	TemplateOverrides *JobTemplateOverrides `protobuf:"bytes,9,opt,name=TemplateOverrides,proto3" json:"TemplateOverrides,omitempty"`
+	// Cluster the job is leased to when it has capacity for the job, e.g. because it holds cached inputs, other clusters lease the job otherwise
+	PreferredCluster string `protobuf:"bytes,10,opt,name=PreferredCluster,proto3" json:"PreferredCluster,omitempty"`
+	// Name of the priority class deciding which running jobs the job may preempt and which jobs may preempt it
+	PriorityClass string `protobuf:"bytes,11,opt,name=PriorityClass,proto3" json:"PriorityClass,omitempty"`
+	// Smallest resources the job can run with, the job is leased with resources of its pod spec or less down to these when its queue has no share for all of them
+	MinResources map[string]resource.Quantity `protobuf:"bytes,12,rep,name=MinResources,proto3" json:"MinResources" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Gated job is not leased until it is released by UngateJobs
+	Gated bool `protobuf:"varint,13,opt,name=Gated,proto3" json:"Gated,omitempty"`
+	// Job is not leased before this time, it is scheduled as any other job afterwards
+	NotBefore *time.Time `protobuf:"bytes,14,opt,name=NotBefore,proto3,stdtime" json:"NotBefore,omitempty"`
+	// Services exposing ports of the job, each port can be exposed by one service only
+	Services []*ServiceConfig `protobuf:"bytes,15,rep,name=Services,proto3" json:"Services,omitempty"`
 }
 
 func (m *JobSubmitRequestItem) Reset()         { *m = JobSubmitRequestItem{} }
@@ -51,7 +155,7 @@ func (m *JobSubmitRequestItem) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_JobSubmitRequestItem.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -112,18 +216,214 @@ func (m *JobSubmitRequestItem) GetPodSpec() *v1.PodSpec {
 	return nil
 }
 
+func (m *JobSubmitRequestItem) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *JobSubmitRequestItem) GetTemplateName() string {
+	if m != nil {
+		return m.TemplateName
+	}
+	return ""
+}
+
+func (m *JobSubmitRequestItem) GetTemplateOverrides() *JobTemplateOverrides {
+	if m != nil {
+		return m.TemplateOverrides
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetPreferredCluster() string {
+	if m != nil {
+		return m.PreferredCluster
+	}
+	return ""
+}
+
+func (m *JobSubmitRequestItem) GetPriorityClass() string {
+	if m != nil {
+		return m.PriorityClass
+	}
+	return ""
+}
+
+func (m *JobSubmitRequestItem) GetMinResources() map[string]resource.Quantity {
+	if m != nil {
+		return m.MinResources
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetGated() bool {
+	if m != nil {
+		return m.Gated
+	}
+	return false
+}
+
+func (m *JobSubmitRequestItem) GetNotBefore() *time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetServices() []*ServiceConfig {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+// Reusable pod spec of jobs submitted to a queue, referenced by JobSubmitRequestItem.TemplateName
+// swagger:model
+type JobTemplate struct {
+	Queue string `protobuf:"bytes,1,opt,name=Queue,proto3" json:"Queue,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Labels of jobs created from the template, labels of the submitted job take precedence
+	Labels  map[string]string `protobuf:"bytes,3,rep,name=Labels,proto3" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PodSpec *v1.PodSpec       `protobuf:"bytes,4,opt,name=PodSpec,proto3" json:"PodSpec,omitempty"`
+}
+
+func (m *JobTemplate) Reset()         { *m = JobTemplate{} }
+func (m *JobTemplate) String() string { return proto.CompactTextString(m) }
+func (*JobTemplate) ProtoMessage()    {}
+func (*JobTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{1}
+}
+func (m *JobTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobTemplate.Merge(m, src)
+}
+func (m *JobTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobTemplate proto.InternalMessageInfo
+
+func (m *JobTemplate) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JobTemplate) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *JobTemplate) GetPodSpec() *v1.PodSpec {
+	if m != nil {
+		return m.PodSpec
+	}
+	return nil
+}
+
+type JobTemplateOverrides struct {
+	// Replaces arguments of the first container when not empty
+	Args []string `protobuf:"bytes,1,rep,name=Args,proto3" json:"Args,omitempty"`
+	// Environment variables set in all containers, replacing variables of the same name
+	Env []v1.EnvVar `protobuf:"bytes,2,rep,name=Env,proto3" json:"Env"`
+}
+
+func (m *JobTemplateOverrides) Reset()         { *m = JobTemplateOverrides{} }
+func (m *JobTemplateOverrides) String() string { return proto.CompactTextString(m) }
+func (*JobTemplateOverrides) ProtoMessage()    {}
+func (*JobTemplateOverrides) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{2}
+}
+func (m *JobTemplateOverrides) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobTemplateOverrides) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobTemplateOverrides.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobTemplateOverrides) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobTemplateOverrides.Merge(m, src)
+}
+func (m *JobTemplateOverrides) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobTemplateOverrides) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobTemplateOverrides.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobTemplateOverrides proto.InternalMessageInfo
+
+func (m *JobTemplateOverrides) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *JobTemplateOverrides) GetEnv() []v1.EnvVar {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
 // swagger:model
 type JobSubmitRequest struct {
 	Queue           string                  `protobuf:"bytes,1,opt,name=Queue,proto3" json:"Queue,omitempty"`
 	JobSetId        string                  `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
 	JobRequestItems []*JobSubmitRequestItem `protobuf:"bytes,3,rep,name=JobRequestItems,proto3" json:"JobRequestItems,omitempty"`
+	// Rejects the whole request when any of the jobs is invalid, by default only the invalid jobs are rejected
+	Strict bool `protobuf:"varint,4,opt,name=Strict,proto3" json:"Strict,omitempty"`
+	// Cancels all queued and leased jobs of the job set when any of its jobs fails
+	CancelOnFailure bool `protobuf:"varint,5,opt,name=CancelOnFailure,proto3" json:"CancelOnFailure,omitempty"`
+	// URL receiving POST notifications of state transitions of jobs of the job set
+	CallbackUrl string `protobuf:"bytes,6,opt,name=CallbackUrl,proto3" json:"CallbackUrl,omitempty"`
+	// Defaults of items of the request, values set by an item take precedence
+	Defaults *JobSubmitDefaults `protobuf:"bytes,7,opt,name=Defaults,proto3" json:"Defaults,omitempty"`
 }
 
 func (m *JobSubmitRequest) Reset()         { *m = JobSubmitRequest{} }
 func (m *JobSubmitRequest) String() string { return proto.CompactTextString(m) }
 func (*JobSubmitRequest) ProtoMessage()    {}
 func (*JobSubmitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{1}
+	return fileDescriptor_e998bacb27df16c1, []int{3}
 }
 func (m *JobSubmitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -133,7 +433,7 @@ func (m *JobSubmitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_JobSubmitRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -173,18 +473,48 @@ func (m *JobSubmitRequest) GetJobRequestItems() []*JobSubmitRequestItem {
 	return nil
 }
 
+func (m *JobSubmitRequest) GetStrict() bool {
+	if m != nil {
+		return m.Strict
+	}
+	return false
+}
+
+func (m *JobSubmitRequest) GetCancelOnFailure() bool {
+	if m != nil {
+		return m.CancelOnFailure
+	}
+	return false
+}
+
+func (m *JobSubmitRequest) GetCallbackUrl() string {
+	if m != nil {
+		return m.CallbackUrl
+	}
+	return ""
+}
+
+func (m *JobSubmitRequest) GetDefaults() *JobSubmitDefaults {
+	if m != nil {
+		return m.Defaults
+	}
+	return nil
+}
+
 // swagger:model
 type JobCancelRequest struct {
 	JobId    string `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
 	JobSetId string `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
 	Queue    string `protobuf:"bytes,3,opt,name=Queue,proto3" json:"Queue,omitempty"`
+	// Only cancel jobs which are still queued, leased jobs are left untouched
+	OnlyIfUnstarted bool `protobuf:"varint,4,opt,name=OnlyIfUnstarted,proto3" json:"OnlyIfUnstarted,omitempty"`
 }
 
 func (m *JobCancelRequest) Reset()         { *m = JobCancelRequest{} }
 func (m *JobCancelRequest) String() string { return proto.CompactTextString(m) }
 func (*JobCancelRequest) ProtoMessage()    {}
 func (*JobCancelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{2}
+	return fileDescriptor_e998bacb27df16c1, []int{4}
 }
 func (m *JobCancelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,7 +524,7 @@ func (m *JobCancelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_JobCancelRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -234,6 +564,13 @@ func (m *JobCancelRequest) GetQueue() string {
 	return ""
 }
 
+func (m *JobCancelRequest) GetOnlyIfUnstarted() bool {
+	if m != nil {
+		return m.OnlyIfUnstarted
+	}
+	return false
+}
+
 type JobSubmitResponseItem struct {
 	JobId string `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
 	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
@@ -243,7 +580,7 @@ func (m *JobSubmitResponseItem) Reset()         { *m = JobSubmitResponseItem{} }
 func (m *JobSubmitResponseItem) String() string { return proto.CompactTextString(m) }
 func (*JobSubmitResponseItem) ProtoMessage()    {}
 func (*JobSubmitResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{3}
+	return fileDescriptor_e998bacb27df16c1, []int{5}
 }
 func (m *JobSubmitResponseItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -253,7 +590,7 @@ func (m *JobSubmitResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_JobSubmitResponseItem.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -295,7 +632,7 @@ func (m *JobSubmitResponse) Reset()         { *m = JobSubmitResponse{} }
 func (m *JobSubmitResponse) String() string { return proto.CompactTextString(m) }
 func (*JobSubmitResponse) ProtoMessage()    {}
 func (*JobSubmitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{4}
+	return fileDescriptor_e998bacb27df16c1, []int{6}
 }
 func (m *JobSubmitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +642,7 @@ func (m *JobSubmitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_JobSubmitResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -338,13 +675,38 @@ type Queue struct {
 	UserOwners     []string           `protobuf:"bytes,3,rep,name=UserOwners,proto3" json:"UserOwners,omitempty"`
 	GroupOwners    []string           `protobuf:"bytes,4,rep,name=GroupOwners,proto3" json:"GroupOwners,omitempty"`
 	ResourceLimits map[string]float64 `protobuf:"bytes,5,rep,name=ResourceLimits,proto3" json:"ResourceLimits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Namespace      string             `protobuf:"bytes,6,opt,name=Namespace,proto3" json:"Namespace,omitempty"`
+	Group          string             `protobuf:"bytes,7,opt,name=Group,proto3" json:"Group,omitempty"`
+	// Range of priorities allowed for jobs of the queue, the range is not limited when both bounds are 0
+	MinJobPriority float64 `protobuf:"fixed64,8,opt,name=MinJobPriority,proto3" json:"MinJobPriority,omitempty"`
+	MaxJobPriority float64 `protobuf:"fixed64,9,opt,name=MaxJobPriority,proto3" json:"MaxJobPriority,omitempty"`
+	// Priority of jobs submitted without priority
+	DefaultJobPriority float64 `protobuf:"fixed64,10,opt,name=DefaultJobPriority,proto3" json:"DefaultJobPriority,omitempty"`
+	// Job priorities out of the allowed range are clamped to the range instead of rejecting the jobs
+	ClampJobPriority bool `protobuf:"varint,11,opt,name=ClampJobPriority,proto3" json:"ClampJobPriority,omitempty"`
+	// Number of jobs read from the queue at once when leasing, overrides scheduling.queueLeaseBatchSize when not 0
+	LeaseBatchSize uint32 `protobuf:"varint,12,opt,name=LeaseBatchSize,proto3" json:"LeaseBatchSize,omitempty"`
+	// Resources reserved for the queue, while the queue uses less its jobs are leased ahead of other queues
+	GuaranteedResources map[string]resource.Quantity `protobuf:"bytes,13,rep,name=GuaranteedResources,proto3" json:"GuaranteedResources" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Order in which jobs read from the queue at once are leased
+	JobOrdering JobOrderingStrategy `protobuf:"varint,14,opt,name=JobOrdering,proto3,enum=api.JobOrderingStrategy" json:"JobOrdering,omitempty"`
+	// Maximum number of jobs waiting in the queue, submitted jobs over the limit are rejected, the number is not limited when 0
+	MaxQueuedJobs uint32 `protobuf:"varint,15,opt,name=MaxQueuedJobs,proto3" json:"MaxQueuedJobs,omitempty"`
+	// Maximum number of leased jobs of the queue, further jobs stay queued regardless of resources, the number is not limited when 0
+	MaxConcurrentJobs uint32 `protobuf:"varint,16,opt,name=MaxConcurrentJobs,proto3" json:"MaxConcurrentJobs,omitempty"`
+	// Pool of clusters jobs of the queue are leased to, jobs are leased to clusters of any pool when empty
+	Pool string `protobuf:"bytes,17,opt,name=Pool,proto3" json:"Pool,omitempty"`
+	// Priority factors of the queue for individual resources, PriorityFactor is used for resources not listed
+	ResourcePriorityFactors map[string]float64 `protobuf:"bytes,18,rep,name=ResourcePriorityFactors,proto3" json:"ResourcePriorityFactors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// Daily time windows the queue leases jobs in or is blocked from leasing jobs in, the queue leases jobs at any time when empty
+	SchedulingWindows []*SchedulingWindow `protobuf:"bytes,19,rep,name=SchedulingWindows,proto3" json:"SchedulingWindows,omitempty"`
 }
 
 func (m *Queue) Reset()         { *m = Queue{} }
 func (m *Queue) String() string { return proto.CompactTextString(m) }
 func (*Queue) ProtoMessage()    {}
 func (*Queue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{5}
+	return fileDescriptor_e998bacb27df16c1, []int{7}
 }
 func (m *Queue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +716,7 @@ func (m *Queue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Queue.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -408,6 +770,104 @@ func (m *Queue) GetResourceLimits() map[string]float64 {
 	return nil
 }
 
+func (m *Queue) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *Queue) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *Queue) GetMinJobPriority() float64 {
+	if m != nil {
+		return m.MinJobPriority
+	}
+	return 0
+}
+
+func (m *Queue) GetMaxJobPriority() float64 {
+	if m != nil {
+		return m.MaxJobPriority
+	}
+	return 0
+}
+
+func (m *Queue) GetDefaultJobPriority() float64 {
+	if m != nil {
+		return m.DefaultJobPriority
+	}
+	return 0
+}
+
+func (m *Queue) GetClampJobPriority() bool {
+	if m != nil {
+		return m.ClampJobPriority
+	}
+	return false
+}
+
+func (m *Queue) GetLeaseBatchSize() uint32 {
+	if m != nil {
+		return m.LeaseBatchSize
+	}
+	return 0
+}
+
+func (m *Queue) GetGuaranteedResources() map[string]resource.Quantity {
+	if m != nil {
+		return m.GuaranteedResources
+	}
+	return nil
+}
+
+func (m *Queue) GetJobOrdering() JobOrderingStrategy {
+	if m != nil {
+		return m.JobOrdering
+	}
+	return JobOrderingStrategy_Priority
+}
+
+func (m *Queue) GetMaxQueuedJobs() uint32 {
+	if m != nil {
+		return m.MaxQueuedJobs
+	}
+	return 0
+}
+
+func (m *Queue) GetMaxConcurrentJobs() uint32 {
+	if m != nil {
+		return m.MaxConcurrentJobs
+	}
+	return 0
+}
+
+func (m *Queue) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *Queue) GetResourcePriorityFactors() map[string]float64 {
+	if m != nil {
+		return m.ResourcePriorityFactors
+	}
+	return nil
+}
+
+func (m *Queue) GetSchedulingWindows() []*SchedulingWindow {
+	if m != nil {
+		return m.SchedulingWindows
+	}
+	return nil
+}
+
 // swagger:model
 type CancellationResult struct {
 	CancelledIds []string `protobuf:"bytes,1,rep,name=CancelledIds,proto3" json:"CancelledIds,omitempty"`
@@ -417,7 +877,7 @@ func (m *CancellationResult) Reset()         { *m = CancellationResult{} }
 func (m *CancellationResult) String() string { return proto.CompactTextString(m) }
 func (*CancellationResult) ProtoMessage()    {}
 func (*CancellationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{6}
+	return fileDescriptor_e998bacb27df16c1, []int{8}
 }
 func (m *CancellationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -427,7 +887,7 @@ func (m *CancellationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_CancellationResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -462,7 +922,7 @@ func (m *QueueInfoRequest) Reset()         { *m = QueueInfoRequest{} }
 func (m *QueueInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueueInfoRequest) ProtoMessage()    {}
 func (*QueueInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{7}
+	return fileDescriptor_e998bacb27df16c1, []int{9}
 }
 func (m *QueueInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -472,7 +932,7 @@ func (m *QueueInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_QueueInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -508,7 +968,7 @@ func (m *QueueInfo) Reset()         { *m = QueueInfo{} }
 func (m *QueueInfo) String() string { return proto.CompactTextString(m) }
 func (*QueueInfo) ProtoMessage()    {}
 func (*QueueInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{8}
+	return fileDescriptor_e998bacb27df16c1, []int{10}
 }
 func (m *QueueInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +978,7 @@ func (m *QueueInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_QueueInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -551,17 +1011,115 @@ func (m *QueueInfo) GetActiveJobSets() []*JobSetInfo {
 	return nil
 }
 
-type JobSetInfo struct {
-	Name       string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	QueuedJobs int32  `protobuf:"varint,2,opt,name=QueuedJobs,proto3" json:"QueuedJobs,omitempty"`
-	LeasedJobs int32  `protobuf:"varint,3,opt,name=LeasedJobs,proto3" json:"LeasedJobs,omitempty"`
+// swagger:model
+type JobSearchRequest struct {
+	Queue  string            `protobuf:"bytes,1,opt,name=Queue,proto3" json:"Queue,omitempty"`
+	Labels map[string]string `protobuf:"bytes,2,rep,name=Labels,proto3" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *JobSearchRequest) Reset()         { *m = JobSearchRequest{} }
+func (m *JobSearchRequest) String() string { return proto.CompactTextString(m) }
+func (*JobSearchRequest) ProtoMessage()    {}
+func (*JobSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{11}
+}
+func (m *JobSearchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSearchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSearchRequest.Merge(m, src)
+}
+func (m *JobSearchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSearchRequest proto.InternalMessageInfo
+
+func (m *JobSearchRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobSearchRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+// swagger:model
+type JobSearchResult struct {
+	JobIds []string `protobuf:"bytes,1,rep,name=JobIds,proto3" json:"JobIds,omitempty"`
+}
+
+func (m *JobSearchResult) Reset()         { *m = JobSearchResult{} }
+func (m *JobSearchResult) String() string { return proto.CompactTextString(m) }
+func (*JobSearchResult) ProtoMessage()    {}
+func (*JobSearchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{12}
+}
+func (m *JobSearchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSearchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSearchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobSearchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSearchResult.Merge(m, src)
+}
+func (m *JobSearchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSearchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSearchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSearchResult proto.InternalMessageInfo
+
+func (m *JobSearchResult) GetJobIds() []string {
+	if m != nil {
+		return m.JobIds
+	}
+	return nil
+}
+
+type JobSetInfo struct {
+	Name       string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	QueuedJobs int32  `protobuf:"varint,2,opt,name=QueuedJobs,proto3" json:"QueuedJobs,omitempty"`
+	LeasedJobs int32  `protobuf:"varint,3,opt,name=LeasedJobs,proto3" json:"LeasedJobs,omitempty"`
 }
 
 func (m *JobSetInfo) Reset()         { *m = JobSetInfo{} }
 func (m *JobSetInfo) String() string { return proto.CompactTextString(m) }
 func (*JobSetInfo) ProtoMessage()    {}
 func (*JobSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{9}
+	return fileDescriptor_e998bacb27df16c1, []int{13}
 }
 func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +1129,7 @@ func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_JobSetInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -611,931 +1169,10406 @@ func (m *JobSetInfo) GetLeasedJobs() int32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*JobSubmitRequestItem)(nil), "api.JobSubmitRequestItem")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.AnnotationsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.LabelsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.RequiredNodeLabelsEntry")
-	proto.RegisterType((*JobSubmitRequest)(nil), "api.JobSubmitRequest")
-	proto.RegisterType((*JobCancelRequest)(nil), "api.JobCancelRequest")
-	proto.RegisterType((*JobSubmitResponseItem)(nil), "api.JobSubmitResponseItem")
-	proto.RegisterType((*JobSubmitResponse)(nil), "api.JobSubmitResponse")
-	proto.RegisterType((*Queue)(nil), "api.Queue")
-	proto.RegisterMapType((map[string]float64)(nil), "api.Queue.ResourceLimitsEntry")
-	proto.RegisterType((*CancellationResult)(nil), "api.CancellationResult")
-	proto.RegisterType((*QueueInfoRequest)(nil), "api.QueueInfoRequest")
-	proto.RegisterType((*QueueInfo)(nil), "api.QueueInfo")
-	proto.RegisterType((*JobSetInfo)(nil), "api.JobSetInfo")
+// swagger:model
+type JobMigrateRequest struct {
+	SourceQueue string `protobuf:"bytes,1,opt,name=SourceQueue,proto3" json:"SourceQueue,omitempty"`
+	TargetQueue string `protobuf:"bytes,2,opt,name=TargetQueue,proto3" json:"TargetQueue,omitempty"`
 }
 
-func init() { proto.RegisterFile("pkg/api/submit.proto", fileDescriptor_e998bacb27df16c1) }
-
-var fileDescriptor_e998bacb27df16c1 = []byte{
-	// 836 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x8b, 0xdb, 0x46,
-	0x14, 0x5f, 0xd9, 0x6b, 0xb7, 0x7e, 0x4e, 0x1d, 0x77, 0x62, 0x27, 0x8a, 0x36, 0x08, 0x23, 0x68,
-	0x30, 0x39, 0xc8, 0xec, 0x96, 0xc0, 0x36, 0xd0, 0xc2, 0xd6, 0x78, 0x83, 0x8d, 0xd9, 0xa4, 0x0a,
-	0x4d, 0xa1, 0xbd, 0x54, 0x7f, 0x5e, 0x8c, 0xba, 0xb6, 0x46, 0xd1, 0x8c, 0x5c, 0x96, 0xd2, 0x4b,
-	0x6f, 0xbd, 0x05, 0xfa, 0x69, 0xfa, 0x0d, 0x7a, 0x0c, 0xf4, 0x52, 0x7a, 0x2a, 0xbb, 0xfd, 0x20,
-	0x65, 0x66, 0x64, 0x4b, 0xb6, 0xe5, 0x86, 0xdc, 0xe6, 0xbd, 0xf9, 0xbd, 0xdf, 0xfc, 0xde, 0x3f,
-	0x06, 0x3a, 0xf1, 0xe5, 0x6c, 0xe0, 0xc6, 0xe1, 0x80, 0xa5, 0xde, 0x22, 0xe4, 0x76, 0x9c, 0x50,
-	0x4e, 0x49, 0xd5, 0x8d, 0x43, 0xe3, 0x68, 0x46, 0xe9, 0x6c, 0x8e, 0x03, 0xe9, 0xf2, 0xd2, 0x57,
-	0x03, 0x5c, 0xc4, 0xfc, 0x4a, 0x21, 0x0c, 0xeb, 0xf2, 0x94, 0xd9, 0x21, 0x95, 0xa1, 0x3e, 0x4d,
-	0x70, 0xb0, 0x3c, 0x1e, 0xcc, 0x30, 0xc2, 0xc4, 0xe5, 0x18, 0x64, 0x98, 0x07, 0x19, 0x81, 0xc0,
-	0xb8, 0x51, 0x44, 0xb9, 0xcb, 0x43, 0x1a, 0x31, 0x75, 0x6b, 0xfd, 0x7e, 0x08, 0x9d, 0x09, 0xf5,
-	0x5e, 0xc8, 0x77, 0x1d, 0x7c, 0x9d, 0x22, 0xe3, 0x63, 0x8e, 0x0b, 0x62, 0xc0, 0x87, 0xcf, 0x93,
-	0x90, 0x26, 0x21, 0xbf, 0xd2, 0xb5, 0x9e, 0xd6, 0xd7, 0x9c, 0xb5, 0x4d, 0x1e, 0x40, 0xe3, 0xc2,
-	0x5d, 0x20, 0x8b, 0x5d, 0x1f, 0xf5, 0x6a, 0x4f, 0xeb, 0x37, 0x9c, 0xdc, 0x41, 0x3e, 0x87, 0xfa,
-	0xd4, 0xf5, 0x70, 0xce, 0xf4, 0xc3, 0x5e, 0xb5, 0xdf, 0x3c, 0xf9, 0xc4, 0x76, 0xe3, 0xd0, 0x2e,
-	0x7b, 0xc4, 0x56, 0xb8, 0x51, 0xc4, 0x93, 0x2b, 0x27, 0x0b, 0x22, 0x53, 0x68, 0x9e, 0xe5, 0x32,
-	0xf5, 0x9a, 0xe4, 0x78, 0xb4, 0x9f, 0xa3, 0x00, 0x56, 0x44, 0xc5, 0x70, 0xe2, 0x02, 0x11, 0xe0,
-	0x30, 0xc1, 0xe0, 0x82, 0x06, 0x98, 0x09, 0xab, 0x4b, 0xd2, 0xe3, 0xfd, 0xa4, 0xbb, 0x31, 0x8a,
-	0xbb, 0x84, 0x8c, 0x3c, 0x86, 0x0f, 0x9e, 0xd3, 0xe0, 0x45, 0x8c, 0xbe, 0x5e, 0xe9, 0x69, 0xfd,
-	0xe6, 0xc9, 0x91, 0xad, 0xda, 0x22, 0xe9, 0x45, 0x5b, 0xec, 0xe5, 0xb1, 0x9d, 0x41, 0x9c, 0x15,
-	0xd6, 0xf8, 0x0c, 0x9a, 0x05, 0x66, 0xd2, 0x86, 0xea, 0x25, 0xaa, 0x52, 0x37, 0x1c, 0x71, 0x24,
-	0x1d, 0xa8, 0x2d, 0xdd, 0x79, 0x8a, 0x92, 0xb5, 0xe1, 0x28, 0xe3, 0x49, 0xe5, 0x54, 0x33, 0xbe,
-	0x80, 0xf6, 0x76, 0xd6, 0xef, 0x15, 0x3f, 0x82, 0x7b, 0x7b, 0x12, 0x7c, 0x1f, 0x1a, 0xeb, 0x57,
-	0x0d, 0xda, 0xdb, 0xd5, 0x13, 0xf0, 0xaf, 0x52, 0x4c, 0x31, 0xa3, 0x50, 0x86, 0x98, 0x26, 0x81,
-	0x44, 0x3e, 0x0e, 0x32, 0x9e, 0xb5, 0x4d, 0x86, 0x70, 0x7b, 0x42, 0xbd, 0x42, 0xf5, 0x99, 0x5e,
-	0x95, 0xfd, 0xb9, 0xbf, 0xb7, 0x3f, 0xce, 0x76, 0x84, 0xf5, 0xad, 0x94, 0x32, 0x74, 0x23, 0x1f,
-	0xe7, 0x05, 0x29, 0x13, 0xea, 0x8d, 0x83, 0x95, 0x14, 0x69, 0xfc, 0xaf, 0x94, 0xb5, 0xf8, 0x6a,
-	0x41, 0xbc, 0x35, 0x84, 0x6e, 0x41, 0x04, 0x8b, 0x69, 0xc4, 0x50, 0xee, 0x48, 0xf9, 0x03, 0x1d,
-	0xa8, 0x8d, 0x92, 0x84, 0x26, 0xab, 0x82, 0x49, 0xc3, 0xfa, 0x0e, 0x3e, 0xde, 0x21, 0x21, 0xe7,
-	0x52, 0x75, 0x91, 0x93, 0xe9, 0x9a, 0xcc, 0xdd, 0xd8, 0xce, 0x3d, 0x87, 0x38, 0x3b, 0x31, 0xd6,
-	0x9b, 0x4a, 0x26, 0x9c, 0x10, 0x38, 0x14, 0x9b, 0x98, 0x29, 0x92, 0x67, 0xf2, 0x10, 0x5a, 0xab,
-	0xd5, 0x3d, 0x77, 0x7d, 0x9e, 0x29, 0xd3, 0x9c, 0x2d, 0x2f, 0x31, 0x01, 0xbe, 0x66, 0x98, 0x3c,
-	0xfb, 0x31, 0xc2, 0x44, 0xf5, 0xa0, 0xe1, 0x14, 0x3c, 0xa4, 0x07, 0xcd, 0xa7, 0x09, 0x4d, 0xe3,
-	0x0c, 0x70, 0x28, 0x01, 0x45, 0x17, 0x39, 0x87, 0x96, 0x83, 0x8c, 0xa6, 0x89, 0x8f, 0xd3, 0x70,
-	0x11, 0xf2, 0xd5, 0xfa, 0x9a, 0x32, 0x1b, 0xa9, 0xd0, 0xde, 0x04, 0xa8, 0xb5, 0xda, 0x8a, 0x32,
-	0xce, 0xe0, 0x4e, 0x09, 0xec, 0x5d, 0xc3, 0xa9, 0x15, 0x87, 0xf3, 0x14, 0x88, 0x9a, 0x86, 0xb9,
-	0xdc, 0x12, 0x07, 0x59, 0x3a, 0xe7, 0xc4, 0x82, 0x5b, 0x99, 0x17, 0x83, 0x71, 0xa0, 0x8a, 0xdd,
-	0x70, 0x36, 0x7c, 0xd6, 0x43, 0x68, 0x4b, 0xa5, 0xe3, 0xe8, 0x15, 0x5d, 0x8d, 0x52, 0x49, 0x59,
-	0xad, 0x97, 0xd0, 0x58, 0xe3, 0x4a, 0xeb, 0xfe, 0x18, 0x3e, 0x3a, 0xf3, 0x79, 0xb8, 0x44, 0x35,
-	0x5f, 0x4c, 0xaf, 0xc8, 0x62, 0xdc, 0x5e, 0xb7, 0x16, 0xb9, 0x7c, 0x63, 0x13, 0x65, 0x7d, 0x0f,
-	0x90, 0x5f, 0x96, 0x12, 0x9b, 0x00, 0xf2, 0xe5, 0x60, 0x42, 0x3d, 0x26, 0x53, 0xaf, 0x39, 0x05,
-	0x8f, 0xb8, 0x9f, 0xa2, 0xcb, 0xb2, 0xfb, 0xaa, 0xba, 0xcf, 0x3d, 0x27, 0x7f, 0x57, 0xa0, 0xae,
-	0xe6, 0x8a, 0xbc, 0x04, 0x50, 0x27, 0x19, 0xd8, 0x2d, 0xdd, 0x38, 0xe3, 0x6e, 0xf9, 0x30, 0x5a,
-	0xf7, 0x7f, 0xf9, 0xf3, 0xdf, 0xdf, 0x2a, 0x77, 0xac, 0x96, 0xf8, 0x77, 0x7e, 0xa0, 0x5e, 0xf6,
-	0x7d, 0x3d, 0xd1, 0x1e, 0x91, 0x6f, 0x00, 0x54, 0x51, 0x37, 0x79, 0x37, 0x16, 0xd4, 0xb8, 0x27,
-	0xdd, 0xbb, 0x6d, 0xda, 0x25, 0xf6, 0x25, 0x46, 0x10, 0x5f, 0x40, 0x73, 0x98, 0xa0, 0xcb, 0x51,
-	0xcd, 0x3b, 0xe4, 0x93, 0x65, 0xdc, 0xb5, 0xd5, 0x57, 0x67, 0xaf, 0xfe, 0x4a, 0x7b, 0x24, 0xfe,
-	0x4a, 0xeb, 0x48, 0xb2, 0x75, 0x8d, 0xb6, 0x60, 0x7b, 0x2d, 0xa0, 0x83, 0x9f, 0x44, 0x1d, 0x7f,
-	0x16, 0x7c, 0xcf, 0xe0, 0xd6, 0x53, 0xe4, 0x79, 0x23, 0xbb, 0x39, 0x61, 0x61, 0x00, 0x8c, 0xd6,
-	0xa6, 0xdb, 0xd2, 0x25, 0x27, 0x21, 0x3b, 0x9c, 0x5f, 0xea, 0x7f, 0x5c, 0x9b, 0xda, 0xdb, 0x6b,
-	0x53, 0xfb, 0xe7, 0xda, 0xd4, 0xde, 0xdc, 0x98, 0x07, 0x6f, 0x6f, 0xcc, 0x83, 0xbf, 0x6e, 0xcc,
-	0x03, 0xaf, 0x2e, 0x75, 0x7d, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x51, 0x7c, 0xd6, 0x42,
-	0xee, 0x07, 0x00, 0x00,
+func (m *JobMigrateRequest) Reset()         { *m = JobMigrateRequest{} }
+func (m *JobMigrateRequest) String() string { return proto.CompactTextString(m) }
+func (*JobMigrateRequest) ProtoMessage()    {}
+func (*JobMigrateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{14}
+}
+func (m *JobMigrateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobMigrateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobMigrateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobMigrateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobMigrateRequest.Merge(m, src)
+}
+func (m *JobMigrateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobMigrateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobMigrateRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_JobMigrateRequest proto.InternalMessageInfo
 
-// SubmitClient is the client API for Submit service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SubmitClient interface {
-	SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error)
-	CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error)
-	CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
-	GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error)
+func (m *JobMigrateRequest) GetSourceQueue() string {
+	if m != nil {
+		return m.SourceQueue
+	}
+	return ""
 }
 
-type submitClient struct {
-	cc *grpc.ClientConn
+func (m *JobMigrateRequest) GetTargetQueue() string {
+	if m != nil {
+		return m.TargetQueue
+	}
+	return ""
 }
 
-func NewSubmitClient(cc *grpc.ClientConn) SubmitClient {
-	return &submitClient{cc}
+// swagger:model
+type JobMigrateResponse struct {
+	MigratedIds []string `protobuf:"bytes,1,rep,name=MigratedIds,proto3" json:"MigratedIds,omitempty"`
 }
 
-func (c *submitClient) SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error) {
-	out := new(JobSubmitResponse)
-	err := c.cc.Invoke(ctx, "/api.Submit/SubmitJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *JobMigrateResponse) Reset()         { *m = JobMigrateResponse{} }
+func (m *JobMigrateResponse) String() string { return proto.CompactTextString(m) }
+func (*JobMigrateResponse) ProtoMessage()    {}
+func (*JobMigrateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{15}
+}
+func (m *JobMigrateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobMigrateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobMigrateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *JobMigrateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobMigrateResponse.Merge(m, src)
+}
+func (m *JobMigrateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobMigrateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobMigrateResponse.DiscardUnknown(m)
 }
 
-func (c *submitClient) CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error) {
-	out := new(CancellationResult)
-	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobMigrateResponse proto.InternalMessageInfo
+
+func (m *JobMigrateResponse) GetMigratedIds() []string {
+	if m != nil {
+		return m.MigratedIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *submitClient) CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Submit/CreateQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+// swagger:model
+type JobCancelByLabelRequest struct {
+	Queue  string            `protobuf:"bytes,1,opt,name=Queue,proto3" json:"Queue,omitempty"`
+	Labels map[string]string `protobuf:"bytes,2,rep,name=Labels,proto3" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *JobCancelByLabelRequest) Reset()         { *m = JobCancelByLabelRequest{} }
+func (m *JobCancelByLabelRequest) String() string { return proto.CompactTextString(m) }
+func (*JobCancelByLabelRequest) ProtoMessage()    {}
+func (*JobCancelByLabelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{16}
+}
+func (m *JobCancelByLabelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobCancelByLabelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobCancelByLabelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *JobCancelByLabelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobCancelByLabelRequest.Merge(m, src)
+}
+func (m *JobCancelByLabelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobCancelByLabelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobCancelByLabelRequest.DiscardUnknown(m)
 }
 
-func (c *submitClient) GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error) {
-	out := new(QueueInfo)
-	err := c.cc.Invoke(ctx, "/api.Submit/GetQueueInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobCancelByLabelRequest proto.InternalMessageInfo
+
+func (m *JobCancelByLabelRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return out, nil
+	return ""
 }
 
-// SubmitServer is the server API for Submit service.
-type SubmitServer interface {
-	SubmitJobs(context.Context, *JobSubmitRequest) (*JobSubmitResponse, error)
-	CancelJobs(context.Context, *JobCancelRequest) (*CancellationResult, error)
-	CreateQueue(context.Context, *Queue) (*types.Empty, error)
-	GetQueueInfo(context.Context, *QueueInfoRequest) (*QueueInfo, error)
+func (m *JobCancelByLabelRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
 }
 
-func RegisterSubmitServer(s *grpc.Server, srv SubmitServer) {
-	s.RegisterService(&_Submit_serviceDesc, srv)
+// Detail of the status of failed requests, tells clients the reason of the failure
+type ErrorDetail struct {
+	Code    ErrorCode `protobuf:"varint,1,opt,name=Code,proto3,enum=api.ErrorCode" json:"Code,omitempty"`
+	Message string    `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
 }
 
-func _Submit_SubmitJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobSubmitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).SubmitJobs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/SubmitJobs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).SubmitJobs(ctx, req.(*JobSubmitRequest))
+func (m *ErrorDetail) Reset()         { *m = ErrorDetail{} }
+func (m *ErrorDetail) String() string { return proto.CompactTextString(m) }
+func (*ErrorDetail) ProtoMessage()    {}
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{17}
+}
+func (m *ErrorDetail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorDetail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ErrorDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorDetail.Merge(m, src)
+}
+func (m *ErrorDetail) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorDetail.DiscardUnknown(m)
 }
 
-func _Submit_CancelJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobCancelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).CancelJobs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/CancelJobs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).CancelJobs(ctx, req.(*JobCancelRequest))
+var xxx_messageInfo_ErrorDetail proto.InternalMessageInfo
+
+func (m *ErrorDetail) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
 	}
-	return interceptor(ctx, in, info, handler)
+	return ErrorCode_UnknownError
 }
 
-func _Submit_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Queue)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).CreateQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/CreateQueue",
+func (m *ErrorDetail) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).CreateQueue(ctx, req.(*Queue))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Submit_GetQueueInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).GetQueueInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/GetQueueInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).GetQueueInfo(ctx, req.(*QueueInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// swagger:model
+type JobSuspendRequest struct {
+	JobIds []string `protobuf:"bytes,1,rep,name=JobIds,proto3" json:"JobIds,omitempty"`
 }
 
-var _Submit_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.Submit",
-	HandlerType: (*SubmitServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SubmitJobs",
-			Handler:    _Submit_SubmitJobs_Handler,
-		},
-		{
-			MethodName: "CancelJobs",
-			Handler:    _Submit_CancelJobs_Handler,
-		},
-		{
-			MethodName: "CreateQueue",
-			Handler:    _Submit_CreateQueue_Handler,
-		},
-		{
-			MethodName: "GetQueueInfo",
-			Handler:    _Submit_GetQueueInfo_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pkg/api/submit.proto",
+func (m *JobSuspendRequest) Reset()         { *m = JobSuspendRequest{} }
+func (m *JobSuspendRequest) String() string { return proto.CompactTextString(m) }
+func (*JobSuspendRequest) ProtoMessage()    {}
+func (*JobSuspendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{18}
 }
-
-func (m *JobSubmitRequestItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *JobSuspendRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *JobSubmitRequestItem) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Priority != 0 {
-		dAtA[i] = 0x9
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Priority))))
-		i += 8
-	}
-	if m.PodSpec != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSubmit(dAtA, i, uint64(m.PodSpec.Size()))
-		n1, err := m.PodSpec.MarshalTo(dAtA[i:])
+func (m *JobSuspendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSuspendRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i += n1
-	}
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
-	}
-	if len(m.Labels) > 0 {
-		for k, _ := range m.Labels {
-			dAtA[i] = 0x22
-			i++
-			v := m.Labels[k]
-			mapSize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			i = encodeVarintSubmit(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
-	}
-	if len(m.Annotations) > 0 {
-		for k, _ := range m.Annotations {
-			dAtA[i] = 0x2a
-			i++
-			v := m.Annotations[k]
-			mapSize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			i = encodeVarintSubmit(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
-	}
-	if len(m.RequiredNodeLabels) > 0 {
-		for k, _ := range m.RequiredNodeLabels {
-			dAtA[i] = 0x32
-			i++
-			v := m.RequiredNodeLabels[k]
-			mapSize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			i = encodeVarintSubmit(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *JobSuspendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSuspendRequest.Merge(m, src)
+}
+func (m *JobSuspendRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSuspendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSuspendRequest.DiscardUnknown(m)
 }
 
-func (m *JobSubmitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobSuspendRequest proto.InternalMessageInfo
+
+func (m *JobSuspendRequest) GetJobIds() []string {
+	if m != nil {
+		return m.JobIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobSubmitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Queue) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
-	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
-	}
-	if len(m.JobRequestItems) > 0 {
-		for _, msg := range m.JobRequestItems {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintSubmit(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+// swagger:model
+type JobSuspendResponse struct {
+	SuspendedIds []string `protobuf:"bytes,1,rep,name=SuspendedIds,proto3" json:"SuspendedIds,omitempty"`
+}
+
+func (m *JobSuspendResponse) Reset()         { *m = JobSuspendResponse{} }
+func (m *JobSuspendResponse) String() string { return proto.CompactTextString(m) }
+func (*JobSuspendResponse) ProtoMessage()    {}
+func (*JobSuspendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{19}
+}
+func (m *JobSuspendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSuspendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSuspendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *JobSuspendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSuspendResponse.Merge(m, src)
+}
+func (m *JobSuspendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSuspendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSuspendResponse.DiscardUnknown(m)
 }
 
-func (m *JobCancelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobSuspendResponse proto.InternalMessageInfo
+
+func (m *JobSuspendResponse) GetSuspendedIds() []string {
+	if m != nil {
+		return m.SuspendedIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobCancelRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
-	}
-	if len(m.JobSetId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i += copy(dAtA[i:], m.JobSetId)
-	}
-	if len(m.Queue) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i += copy(dAtA[i:], m.Queue)
-	}
-	return i, nil
+// swagger:model
+type JobResumeRequest struct {
+	JobIds []string `protobuf:"bytes,1,rep,name=JobIds,proto3" json:"JobIds,omitempty"`
 }
 
-func (m *JobSubmitResponseItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *JobResumeRequest) Reset()         { *m = JobResumeRequest{} }
+func (m *JobResumeRequest) String() string { return proto.CompactTextString(m) }
+func (*JobResumeRequest) ProtoMessage()    {}
+func (*JobResumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{20}
+}
+func (m *JobResumeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobResumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobResumeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *JobResumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobResumeRequest.Merge(m, src)
+}
+func (m *JobResumeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobResumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobResumeRequest.DiscardUnknown(m)
 }
 
-func (m *JobSubmitResponseItem) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
-		i += copy(dAtA[i:], m.JobId)
-	}
-	if len(m.Error) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
-		i += copy(dAtA[i:], m.Error)
+var xxx_messageInfo_JobResumeRequest proto.InternalMessageInfo
+
+func (m *JobResumeRequest) GetJobIds() []string {
+	if m != nil {
+		return m.JobIds
 	}
-	return i, nil
+	return nil
 }
 
-func (m *JobSubmitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// swagger:model
+type JobResumeResponse struct {
+	ResumedIds []string `protobuf:"bytes,1,rep,name=ResumedIds,proto3" json:"ResumedIds,omitempty"`
 }
 
-func (m *JobSubmitResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobResponseItems) > 0 {
-		for _, msg := range m.JobResponseItems {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintSubmit(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *JobResumeResponse) Reset()         { *m = JobResumeResponse{} }
+func (m *JobResumeResponse) String() string { return proto.CompactTextString(m) }
+func (*JobResumeResponse) ProtoMessage()    {}
+func (*JobResumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{21}
+}
+func (m *JobResumeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobResumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobResumeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *JobResumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobResumeResponse.Merge(m, src)
+}
+func (m *JobResumeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobResumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobResumeResponse.DiscardUnknown(m)
 }
 
-func (m *Queue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobResumeResponse proto.InternalMessageInfo
+
+func (m *JobResumeResponse) GetResumedIds() []string {
+	if m != nil {
+		return m.ResumedIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Queue) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.PriorityFactor != 0 {
-		dAtA[i] = 0x11
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PriorityFactor))))
-		i += 8
-	}
-	if len(m.UserOwners) > 0 {
-		for _, s := range m.UserOwners {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.GroupOwners) > 0 {
-		for _, s := range m.GroupOwners {
-			dAtA[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+// swagger:model
+type QueueDeleteRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Cancel queued and leased jobs of the queue instead of refusing to delete it
+	Force bool `protobuf:"varint,2,opt,name=Force,proto3" json:"Force,omitempty"`
+}
+
+func (m *QueueDeleteRequest) Reset()         { *m = QueueDeleteRequest{} }
+func (m *QueueDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueueDeleteRequest) ProtoMessage()    {}
+func (*QueueDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{22}
+}
+func (m *QueueDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueDeleteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ResourceLimits) > 0 {
-		for k, _ := range m.ResourceLimits {
-			dAtA[i] = 0x2a
-			i++
-			v := m.ResourceLimits[k]
-			mapSize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
-			i = encodeVarintSubmit(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x11
-			i++
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
-			i += 8
-		}
+}
+func (m *QueueDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueDeleteRequest.Merge(m, src)
+}
+func (m *QueueDeleteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueDeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueDeleteRequest proto.InternalMessageInfo
+
+func (m *QueueDeleteRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return i, nil
+	return ""
 }
 
-func (m *CancellationResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *QueueDeleteRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *CancellationResult) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.CancelledIds) > 0 {
-		for _, s := range m.CancelledIds {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+// swagger:model
+type QueueDeleteResponse struct {
+	CancelledIds []string `protobuf:"bytes,1,rep,name=CancelledIds,proto3" json:"CancelledIds,omitempty"`
+}
+
+func (m *QueueDeleteResponse) Reset()         { *m = QueueDeleteResponse{} }
+func (m *QueueDeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueueDeleteResponse) ProtoMessage()    {}
+func (*QueueDeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{23}
+}
+func (m *QueueDeleteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueDeleteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *QueueDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueDeleteResponse.Merge(m, src)
+}
+func (m *QueueDeleteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueDeleteResponse.DiscardUnknown(m)
 }
 
-func (m *QueueInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueueDeleteResponse proto.InternalMessageInfo
+
+func (m *QueueDeleteResponse) GetCancelledIds() []string {
+	if m != nil {
+		return m.CancelledIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueueInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	return i, nil
+// swagger:model
+type JobUngateRequest struct {
+	JobIds []string `protobuf:"bytes,1,rep,name=JobIds,proto3" json:"JobIds,omitempty"`
 }
 
-func (m *QueueInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *JobUngateRequest) Reset()         { *m = JobUngateRequest{} }
+func (m *JobUngateRequest) String() string { return proto.CompactTextString(m) }
+func (*JobUngateRequest) ProtoMessage()    {}
+func (*JobUngateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{24}
+}
+func (m *JobUngateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobUngateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobUngateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *JobUngateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobUngateRequest.Merge(m, src)
+}
+func (m *JobUngateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobUngateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobUngateRequest.DiscardUnknown(m)
 }
 
-func (m *QueueInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+var xxx_messageInfo_JobUngateRequest proto.InternalMessageInfo
+
+func (m *JobUngateRequest) GetJobIds() []string {
+	if m != nil {
+		return m.JobIds
 	}
-	if len(m.ActiveJobSets) > 0 {
-		for _, msg := range m.ActiveJobSets {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintSubmit(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	return nil
+}
+
+// swagger:model
+type JobUngateResponse struct {
+	UngatedIds []string `protobuf:"bytes,1,rep,name=UngatedIds,proto3" json:"UngatedIds,omitempty"`
+}
+
+func (m *JobUngateResponse) Reset()         { *m = JobUngateResponse{} }
+func (m *JobUngateResponse) String() string { return proto.CompactTextString(m) }
+func (*JobUngateResponse) ProtoMessage()    {}
+func (*JobUngateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{25}
+}
+func (m *JobUngateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobUngateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobUngateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *JobUngateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobUngateResponse.Merge(m, src)
+}
+func (m *JobUngateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobUngateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobUngateResponse.DiscardUnknown(m)
 }
 
-func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobUngateResponse proto.InternalMessageInfo
+
+func (m *JobUngateResponse) GetUngatedIds() []string {
+	if m != nil {
+		return m.UngatedIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.QueuedJobs != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintSubmit(dAtA, i, uint64(m.QueuedJobs))
-	}
-	if m.LeasedJobs != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintSubmit(dAtA, i, uint64(m.LeasedJobs))
-	}
-	return i, nil
+// swagger:model
+type QueueExportRequest struct {
 }
 
-func encodeVarintSubmit(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+func (m *QueueExportRequest) Reset()         { *m = QueueExportRequest{} }
+func (m *QueueExportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueueExportRequest) ProtoMessage()    {}
+func (*QueueExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{26}
 }
-func (m *JobSubmitRequestItem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Priority != 0 {
-		n += 9
-	}
-	if m.PodSpec != nil {
-		l = m.PodSpec.Size()
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+func (m *QueueExportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueExportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.RequiredNodeLabels) > 0 {
-		for k, v := range m.RequiredNodeLabels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+}
+func (m *QueueExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueExportRequest.Merge(m, src)
+}
+func (m *QueueExportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueExportRequest proto.InternalMessageInfo
+
+// swagger:model
+type QueueExportResponse struct {
+	Queues []*Queue `protobuf:"bytes,1,rep,name=Queues,proto3" json:"Queues,omitempty"`
+}
+
+func (m *QueueExportResponse) Reset()         { *m = QueueExportResponse{} }
+func (m *QueueExportResponse) String() string { return proto.CompactTextString(m) }
+func (*QueueExportResponse) ProtoMessage()    {}
+func (*QueueExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{27}
+}
+func (m *QueueExportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueExportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueueExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueExportResponse.Merge(m, src)
+}
+func (m *QueueExportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueExportResponse.DiscardUnknown(m)
 }
 
-func (m *JobSubmitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueueExportResponse proto.InternalMessageInfo
+
+func (m *QueueExportResponse) GetQueues() []*Queue {
+	if m != nil {
+		return m.Queues
 	}
-	var l int
-	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if len(m.JobRequestItems) > 0 {
-		for _, e := range m.JobRequestItems {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
+	return nil
+}
+
+// swagger:model
+type QueueImportRequest struct {
+	Queues []*Queue `protobuf:"bytes,1,rep,name=Queues,proto3" json:"Queues,omitempty"`
+	// Replace existing queues of the same name instead of skipping them
+	Overwrite bool `protobuf:"varint,2,opt,name=Overwrite,proto3" json:"Overwrite,omitempty"`
+}
+
+func (m *QueueImportRequest) Reset()         { *m = QueueImportRequest{} }
+func (m *QueueImportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueueImportRequest) ProtoMessage()    {}
+func (*QueueImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{28}
+}
+func (m *QueueImportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueImportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueueImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueImportRequest.Merge(m, src)
+}
+func (m *QueueImportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueImportRequest.DiscardUnknown(m)
 }
 
-func (m *JobCancelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+var xxx_messageInfo_QueueImportRequest proto.InternalMessageInfo
+
+func (m *QueueImportRequest) GetQueues() []*Queue {
+	if m != nil {
+		return m.Queues
 	}
-	return n
+	return nil
 }
 
-func (m *JobSubmitResponseItem) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueueImportRequest) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
 	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	return false
+}
+
+// swagger:model
+type QueueImportResponse struct {
+	ImportedNames []string `protobuf:"bytes,1,rep,name=ImportedNames,proto3" json:"ImportedNames,omitempty"`
+	SkippedNames  []string `protobuf:"bytes,2,rep,name=SkippedNames,proto3" json:"SkippedNames,omitempty"`
+}
+
+func (m *QueueImportResponse) Reset()         { *m = QueueImportResponse{} }
+func (m *QueueImportResponse) String() string { return proto.CompactTextString(m) }
+func (*QueueImportResponse) ProtoMessage()    {}
+func (*QueueImportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{29}
+}
+func (m *QueueImportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueImportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+}
+func (m *QueueImportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueImportResponse.Merge(m, src)
+}
+func (m *QueueImportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueImportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueImportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueImportResponse proto.InternalMessageInfo
+
+func (m *QueueImportResponse) GetImportedNames() []string {
+	if m != nil {
+		return m.ImportedNames
 	}
-	return n
+	return nil
 }
 
-func (m *JobSubmitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueueImportResponse) GetSkippedNames() []string {
+	if m != nil {
+		return m.SkippedNames
 	}
-	var l int
-	_ = l
-	if len(m.JobResponseItems) > 0 {
-		for _, e := range m.JobResponseItems {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
+	return nil
+}
+
+// swagger:model
+type JobSetsSubmitRequest struct {
+	// Job sets submitted together, either all of their jobs are submitted or none
+	JobSets []*JobSubmitRequest `protobuf:"bytes,1,rep,name=JobSets,proto3" json:"JobSets,omitempty"`
+}
+
+func (m *JobSetsSubmitRequest) Reset()         { *m = JobSetsSubmitRequest{} }
+func (m *JobSetsSubmitRequest) String() string { return proto.CompactTextString(m) }
+func (*JobSetsSubmitRequest) ProtoMessage()    {}
+func (*JobSetsSubmitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{30}
+}
+func (m *JobSetsSubmitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetsSubmitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetsSubmitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *JobSetsSubmitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetsSubmitRequest.Merge(m, src)
+}
+func (m *JobSetsSubmitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSetsSubmitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetsSubmitRequest.DiscardUnknown(m)
 }
 
-func (m *Queue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if m.PriorityFactor != 0 {
-		n += 9
+var xxx_messageInfo_JobSetsSubmitRequest proto.InternalMessageInfo
+
+func (m *JobSetsSubmitRequest) GetJobSets() []*JobSubmitRequest {
+	if m != nil {
+		return m.JobSets
 	}
-	if len(m.UserOwners) > 0 {
-		for _, s := range m.UserOwners {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
+	return nil
+}
+
+// swagger:model
+type JobSetsSubmitResponse struct {
+	JobSets []*JobSubmitResponse `protobuf:"bytes,1,rep,name=JobSets,proto3" json:"JobSets,omitempty"`
+}
+
+func (m *JobSetsSubmitResponse) Reset()         { *m = JobSetsSubmitResponse{} }
+func (m *JobSetsSubmitResponse) String() string { return proto.CompactTextString(m) }
+func (*JobSetsSubmitResponse) ProtoMessage()    {}
+func (*JobSetsSubmitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{31}
+}
+func (m *JobSetsSubmitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetsSubmitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetsSubmitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.GroupOwners) > 0 {
-		for _, s := range m.GroupOwners {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+}
+func (m *JobSetsSubmitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetsSubmitResponse.Merge(m, src)
+}
+func (m *JobSetsSubmitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSetsSubmitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetsSubmitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSetsSubmitResponse proto.InternalMessageInfo
+
+func (m *JobSetsSubmitResponse) GetJobSets() []*JobSubmitResponse {
+	if m != nil {
+		return m.JobSets
 	}
-	if len(m.ResourceLimits) > 0 {
-		for k, v := range m.ResourceLimits {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+	return nil
+}
+
+// swagger:model
+type SchedulingWindow struct {
+	// Start of the window in UTC in HH:MM format
+	Start string `protobuf:"bytes,1,opt,name=Start,proto3" json:"Start,omitempty"`
+	// End of the window in UTC in HH:MM format, the window continues over midnight when it is not after Start
+	End string `protobuf:"bytes,2,opt,name=End,proto3" json:"End,omitempty"`
+	// Days of week the window starts on (Mon, Tue, ...), every day when empty
+	Days []string `protobuf:"bytes,3,rep,name=Days,proto3" json:"Days,omitempty"`
+	// Queue does not lease jobs during blocked windows, otherwise it leases jobs only during its allowed windows
+	Blocked bool `protobuf:"varint,4,opt,name=Blocked,proto3" json:"Blocked,omitempty"`
+}
+
+func (m *SchedulingWindow) Reset()         { *m = SchedulingWindow{} }
+func (m *SchedulingWindow) String() string { return proto.CompactTextString(m) }
+func (*SchedulingWindow) ProtoMessage()    {}
+func (*SchedulingWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{32}
+}
+func (m *SchedulingWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulingWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulingWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *SchedulingWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulingWindow.Merge(m, src)
+}
+func (m *SchedulingWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulingWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulingWindow.DiscardUnknown(m)
 }
 
-func (m *CancellationResult) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_SchedulingWindow proto.InternalMessageInfo
+
+func (m *SchedulingWindow) GetStart() string {
+	if m != nil {
+		return m.Start
 	}
-	var l int
-	_ = l
-	if len(m.CancelledIds) > 0 {
-		for _, s := range m.CancelledIds {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	return ""
+}
+
+func (m *SchedulingWindow) GetEnd() string {
+	if m != nil {
+		return m.End
 	}
-	return n
+	return ""
 }
 
-func (m *QueueInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SchedulingWindow) GetDays() []string {
+	if m != nil {
+		return m.Days
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	return nil
+}
+
+func (m *SchedulingWindow) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
 	}
-	return n
+	return false
 }
 
-func (m *QueueInfo) Size() (n int) {
-	if m == nil {
-		return 0
+// swagger:model
+type StateReconcileRequest struct {
+	// Report inconsistencies without repairing them
+	DryRun bool `protobuf:"varint,1,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+}
+
+func (m *StateReconcileRequest) Reset()         { *m = StateReconcileRequest{} }
+func (m *StateReconcileRequest) String() string { return proto.CompactTextString(m) }
+func (*StateReconcileRequest) ProtoMessage()    {}
+func (*StateReconcileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{33}
+}
+func (m *StateReconcileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateReconcileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateReconcileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+}
+func (m *StateReconcileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateReconcileRequest.Merge(m, src)
+}
+func (m *StateReconcileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateReconcileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateReconcileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateReconcileRequest proto.InternalMessageInfo
+
+func (m *StateReconcileRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	if len(m.ActiveJobSets) > 0 {
+	return false
+}
+
+// swagger:model
+type StateReconcileResponse struct {
+	// Jobs leased to clusters which are not active, returned to their queue
+	ReturnedJobIds []string `protobuf:"bytes,1,rep,name=ReturnedJobIds,proto3" json:"ReturnedJobIds,omitempty"`
+	// Jobs missing in both queued and leased jobs of their queue, added back to the queue
+	RequeuedJobIds []string `protobuf:"bytes,2,rep,name=RequeuedJobIds,proto3" json:"RequeuedJobIds,omitempty"`
+	// Deleted jobs removed from job set indexes
+	RemovedJobSetEntries []string `protobuf:"bytes,3,rep,name=RemovedJobSetEntries,proto3" json:"RemovedJobSetEntries,omitempty"`
+	// Deleted jobs removed from queued or leased jobs of their queue
+	RemovedQueueEntries []string `protobuf:"bytes,4,rep,name=RemovedQueueEntries,proto3" json:"RemovedQueueEntries,omitempty"`
+	// Jobs which were associated with a cluster without being leased
+	RemovedClusterAssociations []string `protobuf:"bytes,5,rep,name=RemovedClusterAssociations,proto3" json:"RemovedClusterAssociations,omitempty"`
+}
+
+func (m *StateReconcileResponse) Reset()         { *m = StateReconcileResponse{} }
+func (m *StateReconcileResponse) String() string { return proto.CompactTextString(m) }
+func (*StateReconcileResponse) ProtoMessage()    {}
+func (*StateReconcileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{34}
+}
+func (m *StateReconcileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateReconcileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateReconcileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateReconcileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateReconcileResponse.Merge(m, src)
+}
+func (m *StateReconcileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateReconcileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateReconcileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateReconcileResponse proto.InternalMessageInfo
+
+func (m *StateReconcileResponse) GetReturnedJobIds() []string {
+	if m != nil {
+		return m.ReturnedJobIds
+	}
+	return nil
+}
+
+func (m *StateReconcileResponse) GetRequeuedJobIds() []string {
+	if m != nil {
+		return m.RequeuedJobIds
+	}
+	return nil
+}
+
+func (m *StateReconcileResponse) GetRemovedJobSetEntries() []string {
+	if m != nil {
+		return m.RemovedJobSetEntries
+	}
+	return nil
+}
+
+func (m *StateReconcileResponse) GetRemovedQueueEntries() []string {
+	if m != nil {
+		return m.RemovedQueueEntries
+	}
+	return nil
+}
+
+func (m *StateReconcileResponse) GetRemovedClusterAssociations() []string {
+	if m != nil {
+		return m.RemovedClusterAssociations
+	}
+	return nil
+}
+
+// swagger:model
+type JobReprioritizeRequest struct {
+	// Jobs to reprioritize, all queued and leased jobs of the job set are reprioritized when empty
+	JobIds []string `protobuf:"bytes,1,rep,name=JobIds,proto3" json:"JobIds,omitempty"`
+	// Job set to reprioritize, in all queues unless queue is specified
+	JobSetId string `protobuf:"bytes,2,opt,name=JobSetId,proto3" json:"JobSetId,omitempty"`
+	// Queue of the job set
+	Queue string `protobuf:"bytes,3,opt,name=Queue,proto3" json:"Queue,omitempty"`
+	// New priority of the jobs, jobs with lower priority are leased first
+	NewPriority float64 `protobuf:"fixed64,4,opt,name=NewPriority,proto3" json:"NewPriority,omitempty"`
+}
+
+func (m *JobReprioritizeRequest) Reset()         { *m = JobReprioritizeRequest{} }
+func (m *JobReprioritizeRequest) String() string { return proto.CompactTextString(m) }
+func (*JobReprioritizeRequest) ProtoMessage()    {}
+func (*JobReprioritizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{35}
+}
+func (m *JobReprioritizeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobReprioritizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobReprioritizeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobReprioritizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobReprioritizeRequest.Merge(m, src)
+}
+func (m *JobReprioritizeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobReprioritizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobReprioritizeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobReprioritizeRequest proto.InternalMessageInfo
+
+func (m *JobReprioritizeRequest) GetJobIds() []string {
+	if m != nil {
+		return m.JobIds
+	}
+	return nil
+}
+
+func (m *JobReprioritizeRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobReprioritizeRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobReprioritizeRequest) GetNewPriority() float64 {
+	if m != nil {
+		return m.NewPriority
+	}
+	return 0
+}
+
+// swagger:model
+type JobReprioritizeResponse struct {
+	ReprioritizedIds []string `protobuf:"bytes,1,rep,name=ReprioritizedIds,proto3" json:"ReprioritizedIds,omitempty"`
+}
+
+func (m *JobReprioritizeResponse) Reset()         { *m = JobReprioritizeResponse{} }
+func (m *JobReprioritizeResponse) String() string { return proto.CompactTextString(m) }
+func (*JobReprioritizeResponse) ProtoMessage()    {}
+func (*JobReprioritizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{36}
+}
+func (m *JobReprioritizeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobReprioritizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobReprioritizeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobReprioritizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobReprioritizeResponse.Merge(m, src)
+}
+func (m *JobReprioritizeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobReprioritizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobReprioritizeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobReprioritizeResponse proto.InternalMessageInfo
+
+func (m *JobReprioritizeResponse) GetReprioritizedIds() []string {
+	if m != nil {
+		return m.ReprioritizedIds
+	}
+	return nil
+}
+
+// Service exposing ports of a job, e.g. of an interactive notebook, created by the executor together with the pod of the job
+type ServiceConfig struct {
+	// Type of the service, ClusterIP when empty or NodePort
+	Type string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	// Container ports of the job exposed by the service
+	Ports []uint32 `protobuf:"varint,2,rep,packed,name=Ports,proto3" json:"Ports,omitempty"`
+}
+
+func (m *ServiceConfig) Reset()         { *m = ServiceConfig{} }
+func (m *ServiceConfig) String() string { return proto.CompactTextString(m) }
+func (*ServiceConfig) ProtoMessage()    {}
+func (*ServiceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{37}
+}
+func (m *ServiceConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceConfig.Merge(m, src)
+}
+func (m *ServiceConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceConfig proto.InternalMessageInfo
+
+func (m *ServiceConfig) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ServiceConfig) GetPorts() []uint32 {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+// Values of jobs of a submit request used by items which do not set them
+type JobSubmitDefaults struct {
+	// Priority of items with priority 0
+	Priority float64 `protobuf:"fixed64,1,opt,name=Priority,proto3" json:"Priority,omitempty"`
+	// Namespace of items without namespace
+	Namespace string `protobuf:"bytes,2,opt,name=Namespace,proto3" json:"Namespace,omitempty"`
+	// Priority class of items without priority class
+	PriorityClass string `protobuf:"bytes,3,opt,name=PriorityClass,proto3" json:"PriorityClass,omitempty"`
+	// Labels of all items, labels set by the item take precedence
+	Labels map[string]string `protobuf:"bytes,4,rep,name=Labels,proto3" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Annotations of all items, annotations set by the item take precedence
+	Annotations map[string]string `protobuf:"bytes,5,rep,name=Annotations,proto3" json:"Annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Required node labels of all items, required node labels set by the item take precedence
+	RequiredNodeLabels map[string]string `protobuf:"bytes,6,rep,name=RequiredNodeLabels,proto3" json:"RequiredNodeLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *JobSubmitDefaults) Reset()         { *m = JobSubmitDefaults{} }
+func (m *JobSubmitDefaults) String() string { return proto.CompactTextString(m) }
+func (*JobSubmitDefaults) ProtoMessage()    {}
+func (*JobSubmitDefaults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{38}
+}
+func (m *JobSubmitDefaults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSubmitDefaults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSubmitDefaults.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobSubmitDefaults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSubmitDefaults.Merge(m, src)
+}
+func (m *JobSubmitDefaults) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSubmitDefaults) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSubmitDefaults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSubmitDefaults proto.InternalMessageInfo
+
+func (m *JobSubmitDefaults) GetPriority() float64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *JobSubmitDefaults) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *JobSubmitDefaults) GetPriorityClass() string {
+	if m != nil {
+		return m.PriorityClass
+	}
+	return ""
+}
+
+func (m *JobSubmitDefaults) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *JobSubmitDefaults) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+func (m *JobSubmitDefaults) GetRequiredNodeLabels() map[string]string {
+	if m != nil {
+		return m.RequiredNodeLabels
+	}
+	return nil
+}
+
+// swagger:model
+type PermissionsRequest struct {
+}
+
+func (m *PermissionsRequest) Reset()         { *m = PermissionsRequest{} }
+func (m *PermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*PermissionsRequest) ProtoMessage()    {}
+func (*PermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{39}
+}
+func (m *PermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionsRequest.Merge(m, src)
+}
+func (m *PermissionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionsRequest proto.InternalMessageInfo
+
+// swagger:model
+type PermissionsResponse struct {
+	// Permissions granted to the calling user by its groups and scopes
+	Permissions []string `protobuf:"bytes,1,rep,name=Permissions,proto3" json:"Permissions,omitempty"`
+	// Names of queues owned by the calling user or one of its groups
+	OwnedQueues []string `protobuf:"bytes,2,rep,name=OwnedQueues,proto3" json:"OwnedQueues,omitempty"`
+}
+
+func (m *PermissionsResponse) Reset()         { *m = PermissionsResponse{} }
+func (m *PermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*PermissionsResponse) ProtoMessage()    {}
+func (*PermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{40}
+}
+func (m *PermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionsResponse.Merge(m, src)
+}
+func (m *PermissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionsResponse proto.InternalMessageInfo
+
+func (m *PermissionsResponse) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *PermissionsResponse) GetOwnedQueues() []string {
+	if m != nil {
+		return m.OwnedQueues
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("api.JobOrderingStrategy", JobOrderingStrategy_name, JobOrderingStrategy_value)
+	proto.RegisterEnum("api.ErrorCode", ErrorCode_name, ErrorCode_value)
+	proto.RegisterType((*JobSubmitRequestItem)(nil), "api.JobSubmitRequestItem")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.LabelsEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.JobSubmitRequestItem.MinResourcesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.RequiredNodeLabelsEntry")
+	proto.RegisterType((*JobTemplate)(nil), "api.JobTemplate")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobTemplate.LabelsEntry")
+	proto.RegisterType((*JobTemplateOverrides)(nil), "api.JobTemplateOverrides")
+	proto.RegisterType((*JobSubmitRequest)(nil), "api.JobSubmitRequest")
+	proto.RegisterType((*JobCancelRequest)(nil), "api.JobCancelRequest")
+	proto.RegisterType((*JobSubmitResponseItem)(nil), "api.JobSubmitResponseItem")
+	proto.RegisterType((*JobSubmitResponse)(nil), "api.JobSubmitResponse")
+	proto.RegisterType((*Queue)(nil), "api.Queue")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.Queue.GuaranteedResourcesEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "api.Queue.ResourceLimitsEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "api.Queue.ResourcePriorityFactorsEntry")
+	proto.RegisterType((*CancellationResult)(nil), "api.CancellationResult")
+	proto.RegisterType((*QueueInfoRequest)(nil), "api.QueueInfoRequest")
+	proto.RegisterType((*QueueInfo)(nil), "api.QueueInfo")
+	proto.RegisterType((*JobSearchRequest)(nil), "api.JobSearchRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSearchRequest.LabelsEntry")
+	proto.RegisterType((*JobSearchResult)(nil), "api.JobSearchResult")
+	proto.RegisterType((*JobSetInfo)(nil), "api.JobSetInfo")
+	proto.RegisterType((*JobMigrateRequest)(nil), "api.JobMigrateRequest")
+	proto.RegisterType((*JobMigrateResponse)(nil), "api.JobMigrateResponse")
+	proto.RegisterType((*JobCancelByLabelRequest)(nil), "api.JobCancelByLabelRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobCancelByLabelRequest.LabelsEntry")
+	proto.RegisterType((*ErrorDetail)(nil), "api.ErrorDetail")
+	proto.RegisterType((*JobSuspendRequest)(nil), "api.JobSuspendRequest")
+	proto.RegisterType((*JobSuspendResponse)(nil), "api.JobSuspendResponse")
+	proto.RegisterType((*JobResumeRequest)(nil), "api.JobResumeRequest")
+	proto.RegisterType((*JobResumeResponse)(nil), "api.JobResumeResponse")
+	proto.RegisterType((*QueueDeleteRequest)(nil), "api.QueueDeleteRequest")
+	proto.RegisterType((*QueueDeleteResponse)(nil), "api.QueueDeleteResponse")
+	proto.RegisterType((*JobUngateRequest)(nil), "api.JobUngateRequest")
+	proto.RegisterType((*JobUngateResponse)(nil), "api.JobUngateResponse")
+	proto.RegisterType((*QueueExportRequest)(nil), "api.QueueExportRequest")
+	proto.RegisterType((*QueueExportResponse)(nil), "api.QueueExportResponse")
+	proto.RegisterType((*QueueImportRequest)(nil), "api.QueueImportRequest")
+	proto.RegisterType((*QueueImportResponse)(nil), "api.QueueImportResponse")
+	proto.RegisterType((*JobSetsSubmitRequest)(nil), "api.JobSetsSubmitRequest")
+	proto.RegisterType((*JobSetsSubmitResponse)(nil), "api.JobSetsSubmitResponse")
+	proto.RegisterType((*SchedulingWindow)(nil), "api.SchedulingWindow")
+	proto.RegisterType((*StateReconcileRequest)(nil), "api.StateReconcileRequest")
+	proto.RegisterType((*StateReconcileResponse)(nil), "api.StateReconcileResponse")
+	proto.RegisterType((*JobReprioritizeRequest)(nil), "api.JobReprioritizeRequest")
+	proto.RegisterType((*JobReprioritizeResponse)(nil), "api.JobReprioritizeResponse")
+	proto.RegisterType((*ServiceConfig)(nil), "api.ServiceConfig")
+	proto.RegisterType((*JobSubmitDefaults)(nil), "api.JobSubmitDefaults")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitDefaults.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitDefaults.LabelsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitDefaults.RequiredNodeLabelsEntry")
+	proto.RegisterType((*PermissionsRequest)(nil), "api.PermissionsRequest")
+	proto.RegisterType((*PermissionsResponse)(nil), "api.PermissionsResponse")
+}
+
+func init() { proto.RegisterFile("pkg/api/submit.proto", fileDescriptor_e998bacb27df16c1) }
+
+var fileDescriptor_e998bacb27df16c1 = []byte{
+	// 2720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x5b, 0x6f, 0xdc, 0xc6,
+	0xf5, 0x37, 0x75, 0xb3, 0x74, 0x56, 0x97, 0xd5, 0xe8, 0x46, 0xd3, 0xfa, 0xcb, 0xfa, 0xb3, 0xb9,
+	0xa8, 0x4a, 0xbd, 0x9b, 0x28, 0x49, 0x61, 0x1b, 0xa8, 0x51, 0x6b, 0x75, 0xe9, 0xba, 0x96, 0xe5,
+	0x70, 0x6d, 0x07, 0x4d, 0x80, 0xa4, 0xdc, 0xe5, 0x68, 0xc5, 0x8a, 0x4b, 0x6e, 0x86, 0x5c, 0x59,
+	0x9b, 0x22, 0x2f, 0x45, 0xfb, 0x5a, 0x04, 0xe8, 0x6b, 0x51, 0xa0, 0x8f, 0x05, 0xfa, 0x25, 0xfa,
+	0x96, 0xc7, 0x00, 0x7d, 0xe9, 0x53, 0x53, 0x38, 0xfd, 0x20, 0xc5, 0x9c, 0x19, 0x92, 0xc3, 0xcb,
+	0xca, 0x76, 0xd3, 0x3e, 0xf4, 0x6d, 0xe7, 0x37, 0x87, 0xe7, 0x36, 0x67, 0xce, 0x65, 0x16, 0x96,
+	0xfb, 0x67, 0xdd, 0xba, 0xdd, 0x77, 0xeb, 0xe1, 0xa0, 0xdd, 0x73, 0xa3, 0x5a, 0x9f, 0x05, 0x51,
+	0x40, 0xc6, 0xed, 0xbe, 0x6b, 0x5c, 0xef, 0x06, 0x41, 0xd7, 0xa3, 0x75, 0x84, 0xda, 0x83, 0x93,
+	0x3a, 0xed, 0xf5, 0xa3, 0xa1, 0xa0, 0x30, 0x6e, 0xe4, 0x37, 0x23, 0xb7, 0x47, 0xc3, 0xc8, 0xee,
+	0xf5, 0x25, 0x81, 0x79, 0x76, 0x2b, 0xac, 0xb9, 0x01, 0xf2, 0xee, 0x04, 0x8c, 0xd6, 0xcf, 0xdf,
+	0xa9, 0x77, 0xa9, 0x4f, 0x99, 0x1d, 0x51, 0x47, 0xd2, 0xbc, 0x97, 0xd2, 0xf4, 0xec, 0xce, 0xa9,
+	0xeb, 0x53, 0x36, 0xac, 0xc7, 0x0a, 0x31, 0x1a, 0x06, 0x03, 0xd6, 0xa1, 0x85, 0xaf, 0x6e, 0x76,
+	0xdd, 0xe8, 0x74, 0xd0, 0xae, 0x75, 0x82, 0x5e, 0xbd, 0x1b, 0x74, 0x83, 0x54, 0x07, 0xbe, 0xc2,
+	0x05, 0xfe, 0x92, 0xe4, 0xeb, 0x52, 0x53, 0xce, 0xd3, 0xf6, 0xfd, 0x20, 0xb2, 0x23, 0x37, 0xf0,
+	0x43, 0xb1, 0x6b, 0x3e, 0x9f, 0x86, 0xe5, 0xfb, 0x41, 0xbb, 0x85, 0xd6, 0x5b, 0xf4, 0xb3, 0x01,
+	0x0d, 0xa3, 0x66, 0x44, 0x7b, 0xc4, 0x80, 0xe9, 0x47, 0xcc, 0x0d, 0x98, 0x1b, 0x0d, 0x75, 0x6d,
+	0x53, 0xdb, 0xd2, 0xac, 0x64, 0x4d, 0xd6, 0x61, 0xe6, 0xa1, 0xdd, 0xa3, 0x61, 0xdf, 0xee, 0x50,
+	0x7d, 0x7c, 0x53, 0xdb, 0x9a, 0xb1, 0x52, 0x80, 0xfc, 0x08, 0xa6, 0x1e, 0xd8, 0x6d, 0xea, 0x85,
+	0xfa, 0xc4, 0xe6, 0xf8, 0x56, 0x65, 0xe7, 0xf5, 0x9a, 0xdd, 0x77, 0x6b, 0x65, 0x42, 0x6a, 0x82,
+	0x6e, 0xdf, 0x8f, 0xd8, 0xd0, 0x92, 0x1f, 0x91, 0x07, 0x50, 0xb9, 0x97, 0xaa, 0xa9, 0x4f, 0x22,
+	0x8f, 0xed, 0xd1, 0x3c, 0x14, 0x62, 0xc1, 0x48, 0xfd, 0x9c, 0xd8, 0x40, 0x38, 0xb1, 0xcb, 0xa8,
+	0xf3, 0x30, 0x70, 0xa8, 0x54, 0x6c, 0x0a, 0x99, 0xbe, 0x33, 0x9a, 0x69, 0xf1, 0x1b, 0xc1, 0xbb,
+	0x84, 0x19, 0x79, 0x1f, 0xae, 0x3e, 0x0a, 0x9c, 0x56, 0x9f, 0x76, 0xf4, 0xb1, 0x4d, 0x6d, 0xab,
+	0xb2, 0x73, 0xbd, 0x26, 0xce, 0x15, 0xd9, 0xf3, 0xb3, 0xaf, 0x9d, 0xbf, 0x53, 0x93, 0x24, 0x56,
+	0x4c, 0xcb, 0x1d, 0xdc, 0xf0, 0x5c, 0xea, 0x47, 0x4d, 0x47, 0xbf, 0x8a, 0x3e, 0x4c, 0xd6, 0xc4,
+	0x84, 0xd9, 0xc7, 0xb4, 0xd7, 0xf7, 0xec, 0x88, 0x72, 0xbf, 0xea, 0xd3, 0xb8, 0x9f, 0xc1, 0xc8,
+	0x21, 0x2c, 0xc6, 0xeb, 0xe3, 0x73, 0xca, 0x98, 0xeb, 0xd0, 0x50, 0x9f, 0x41, 0x05, 0xae, 0xc5,
+	0x86, 0x15, 0x08, 0xac, 0xe2, 0x37, 0x64, 0x1b, 0xaa, 0x8f, 0x18, 0x3d, 0xa1, 0x8c, 0x51, 0xa7,
+	0xe1, 0x0d, 0xc2, 0x88, 0x32, 0x1d, 0x50, 0x60, 0x01, 0x27, 0xaf, 0xc1, 0x5c, 0x1c, 0x05, 0x0d,
+	0xcf, 0x0e, 0x43, 0xbd, 0x82, 0x84, 0x59, 0x90, 0x3c, 0x81, 0xd9, 0x23, 0xd7, 0xb7, 0x64, 0x00,
+	0x87, 0xfa, 0x2c, 0xba, 0xfb, 0xad, 0xd1, 0xee, 0x56, 0xa9, 0xd1, 0xd1, 0xbb, 0x13, 0x5f, 0xfd,
+	0xfd, 0xc6, 0x15, 0x2b, 0xc3, 0x86, 0x2c, 0xc3, 0xe4, 0x21, 0xbf, 0x07, 0xfa, 0xdc, 0xa6, 0xb6,
+	0x35, 0x6d, 0x89, 0x05, 0xb9, 0x0b, 0x33, 0x0f, 0x83, 0x68, 0x97, 0x9e, 0x04, 0x8c, 0xea, 0xf3,
+	0x68, 0xbf, 0x51, 0x13, 0x31, 0x5f, 0x8b, 0x6f, 0x46, 0xed, 0x71, 0x7c, 0x3b, 0x77, 0x27, 0xbe,
+	0xfc, 0xe6, 0x86, 0x66, 0xa5, 0x9f, 0x90, 0x1a, 0x4c, 0xb7, 0x28, 0x3b, 0x77, 0xb9, 0xa2, 0x0b,
+	0xa8, 0x28, 0x41, 0x45, 0x25, 0xd8, 0x08, 0xfc, 0x13, 0xb7, 0x6b, 0x25, 0x34, 0xc6, 0x6d, 0xa8,
+	0x28, 0x11, 0x41, 0xaa, 0x30, 0x7e, 0x46, 0xc5, 0x15, 0x99, 0xb1, 0xf8, 0x4f, 0xae, 0xe6, 0xb9,
+	0xed, 0x0d, 0x28, 0x46, 0xc3, 0x8c, 0x25, 0x16, 0x77, 0xc6, 0x6e, 0x69, 0xc6, 0x5d, 0xa8, 0xe6,
+	0xa3, 0xf5, 0x95, 0xbe, 0xdf, 0x87, 0xb5, 0x11, 0x81, 0xf9, 0x4a, 0x6c, 0x02, 0x58, 0x2c, 0x38,
+	0xbc, 0x84, 0xc1, 0x9e, 0xca, 0xa0, 0xb2, 0x53, 0x53, 0xa2, 0x3a, 0xc9, 0x56, 0xb5, 0xfe, 0x59,
+	0x17, 0xbd, 0x15, 0x67, 0xab, 0xda, 0x07, 0x03, 0xdb, 0x8f, 0xdc, 0x68, 0xa8, 0x08, 0x34, 0xbf,
+	0xd1, 0xa0, 0xa2, 0x44, 0x23, 0x57, 0xed, 0x83, 0x01, 0x1d, 0x50, 0x29, 0x4d, 0x2c, 0x08, 0x81,
+	0x09, 0x0c, 0x76, 0xa1, 0x2f, 0xfe, 0x26, 0xef, 0x25, 0xb9, 0x64, 0x1c, 0x8f, 0x66, 0x3d, 0x1f,
+	0xd9, 0xa5, 0x29, 0x44, 0xb9, 0x91, 0x13, 0x2f, 0x7f, 0x23, 0xbf, 0xc3, 0xc9, 0x9a, 0x9f, 0xc0,
+	0xb2, 0xa2, 0x54, 0x7a, 0xb7, 0x08, 0x4c, 0xdc, 0x63, 0xdd, 0x50, 0xd7, 0x36, 0xc7, 0xb9, 0x4d,
+	0xfc, 0x37, 0xd9, 0x81, 0xf1, 0x7d, 0xff, 0x5c, 0x1f, 0x43, 0x83, 0x8c, 0x32, 0xcd, 0xf6, 0xfd,
+	0xf3, 0xa7, 0x36, 0x93, 0x77, 0x80, 0x13, 0x9b, 0x7f, 0x1c, 0x83, 0x6a, 0xfe, 0xe6, 0x8c, 0x70,
+	0xa3, 0x01, 0xd3, 0x9c, 0x92, 0xf2, 0xbc, 0x22, 0xf4, 0x4c, 0xd6, 0xa4, 0x01, 0x0b, 0xf7, 0x83,
+	0xb6, 0x72, 0xf3, 0x62, 0xbf, 0x5e, 0x1b, 0x79, 0x37, 0xad, 0xfc, 0x17, 0x64, 0x15, 0xa6, 0x5a,
+	0x11, 0x73, 0x3b, 0x11, 0x3a, 0x77, 0xda, 0x92, 0x2b, 0xb2, 0x05, 0x0b, 0x0d, 0xdb, 0xef, 0x50,
+	0xef, 0xd8, 0x3f, 0xb0, 0x5d, 0x6f, 0xc0, 0xa8, 0x3e, 0x89, 0x04, 0x79, 0x98, 0x6c, 0x42, 0xa5,
+	0x61, 0x7b, 0x5e, 0xdb, 0xee, 0x9c, 0x3d, 0x61, 0x9e, 0x3e, 0x85, 0x5a, 0xaa, 0x10, 0xd9, 0x81,
+	0xe9, 0x3d, 0x7a, 0x62, 0x0f, 0xbc, 0x28, 0xc4, 0xe4, 0x58, 0xd9, 0x59, 0xcd, 0x6a, 0x18, 0xef,
+	0x5a, 0x09, 0x9d, 0xf9, 0x6b, 0x0d, 0x7d, 0x24, 0x84, 0x29, 0x3e, 0xba, 0x1f, 0xb4, 0x9b, 0x4e,
+	0xec, 0x23, 0x5c, 0x5c, 0xea, 0xa3, 0xc4, 0xab, 0xe3, 0xaa, 0x57, 0xb7, 0x60, 0xe1, 0xd8, 0xf7,
+	0x86, 0xcd, 0x93, 0x27, 0x7e, 0x18, 0xd9, 0x8c, 0x67, 0x21, 0x61, 0x7d, 0x1e, 0x36, 0x1b, 0xb0,
+	0xa2, 0xf8, 0x31, 0xec, 0x07, 0x7e, 0x48, 0xb1, 0xa2, 0x96, 0xab, 0xb2, 0x0c, 0x93, 0xfb, 0x8c,
+	0x05, 0x2c, 0x8e, 0x29, 0x5c, 0x98, 0x1f, 0xc3, 0x62, 0x81, 0x09, 0x39, 0x40, 0xfb, 0x54, 0x9e,
+	0x22, 0xb0, 0x78, 0x14, 0xe5, 0x8e, 0x2f, 0x25, 0xb1, 0x0a, 0xdf, 0x98, 0xbf, 0x99, 0x81, 0xdc,
+	0x95, 0xd3, 0x94, 0x2b, 0xf7, 0x06, 0xcc, 0xc7, 0xd9, 0xfc, 0xc0, 0xee, 0x44, 0x52, 0x33, 0xcd,
+	0xca, 0xa1, 0x64, 0x03, 0xe0, 0x49, 0x48, 0xd9, 0xf1, 0x33, 0x9f, 0x32, 0x11, 0x46, 0x33, 0x96,
+	0x82, 0xf0, 0x43, 0x3e, 0x64, 0xc1, 0xa0, 0x2f, 0x09, 0x26, 0x90, 0x40, 0x85, 0xc8, 0x01, 0xcc,
+	0xc7, 0x49, 0xe8, 0x81, 0xdb, 0x73, 0xa3, 0xb8, 0xd8, 0x6f, 0xa0, 0x35, 0xa8, 0x61, 0x2d, 0x4b,
+	0x20, 0xae, 0x79, 0xee, 0xab, 0x6c, 0x3b, 0x32, 0x95, 0x6f, 0x47, 0x78, 0xd5, 0xe0, 0x42, 0x65,
+	0x91, 0x15, 0x0b, 0x6e, 0xe5, 0x91, 0xeb, 0xdf, 0x0f, 0xda, 0x49, 0x93, 0x33, 0x2d, 0xac, 0xcc,
+	0xa2, 0x48, 0x67, 0x5f, 0xa8, 0x74, 0x33, 0x92, 0x2e, 0x83, 0x92, 0x1a, 0x10, 0x19, 0x88, 0x2a,
+	0x2d, 0x20, 0x6d, 0xc9, 0x0e, 0x2f, 0xba, 0x0d, 0xcf, 0xee, 0xf5, 0x55, 0xea, 0x0a, 0x06, 0x54,
+	0x01, 0xe7, 0x3a, 0x3c, 0xa0, 0x76, 0x48, 0x77, 0xed, 0xa8, 0x73, 0xda, 0x72, 0x3f, 0xa7, 0xfa,
+	0xec, 0xa6, 0xb6, 0x35, 0x67, 0xe5, 0x50, 0xf2, 0x31, 0x2c, 0x1d, 0x0e, 0x6c, 0x66, 0xfb, 0x11,
+	0xa5, 0x4e, 0x5a, 0x7d, 0xe7, 0xd0, 0xa9, 0xdf, 0x53, 0x9c, 0x5a, 0x42, 0xa5, 0x56, 0xdd, 0x32,
+	0x2e, 0xe4, 0x0e, 0xa6, 0xf0, 0x63, 0xe6, 0x50, 0xe6, 0xfa, 0x5d, 0x2c, 0xb4, 0xf3, 0x3b, 0x7a,
+	0x1c, 0x77, 0x31, 0xde, 0x8a, 0x78, 0xa7, 0xda, 0x1d, 0x5a, 0x2a, 0x31, 0xef, 0x1a, 0x8e, 0xec,
+	0x0b, 0x94, 0xed, 0xdc, 0x0f, 0xda, 0xbc, 0xce, 0x72, 0xfd, 0xb3, 0x20, 0xf9, 0x01, 0x2c, 0x1e,
+	0xd9, 0x17, 0x8d, 0xc0, 0xef, 0x0c, 0x18, 0xa3, 0x7e, 0x84, 0x94, 0x55, 0xa4, 0x2c, 0x6e, 0xf0,
+	0xd0, 0x7d, 0x14, 0x04, 0x9e, 0xbe, 0x28, 0x42, 0x97, 0xff, 0x26, 0x36, 0xac, 0xc5, 0x0a, 0x67,
+	0x83, 0x35, 0xd4, 0x09, 0x3a, 0xe1, 0xcd, 0x92, 0xc8, 0xca, 0x51, 0x8a, 0x10, 0x1b, 0xc5, 0x87,
+	0x34, 0x60, 0xb1, 0xd5, 0x39, 0xa5, 0xce, 0xc0, 0x73, 0xfd, 0xee, 0x87, 0xae, 0xef, 0x04, 0xcf,
+	0x42, 0x7d, 0x09, 0x99, 0xaf, 0x88, 0xb6, 0x21, 0xb7, 0x6b, 0x15, 0xe9, 0x8d, 0x7b, 0xb0, 0x54,
+	0x12, 0xd7, 0x2f, 0x2a, 0x38, 0x9a, 0x5a, 0xc3, 0xcf, 0x41, 0x1f, 0x75, 0x8a, 0xff, 0xcd, 0x52,
+	0x6e, 0xdc, 0x87, 0xf5, 0xcb, 0x1c, 0xf7, 0x2a, 0x36, 0x98, 0xb7, 0x80, 0x88, 0x64, 0xed, 0x61,
+	0x43, 0x64, 0xd1, 0x70, 0xe0, 0x45, 0xbc, 0xf7, 0x95, 0x28, 0x75, 0x9a, 0x4e, 0x5c, 0x3a, 0x33,
+	0x98, 0xf9, 0x06, 0x54, 0xf1, 0x10, 0x9b, 0xfe, 0x49, 0x10, 0x67, 0xfa, 0x92, 0x5c, 0x66, 0x3e,
+	0x85, 0x99, 0x84, 0xae, 0x8c, 0x80, 0xbc, 0x0f, 0x73, 0xf7, 0x3a, 0x91, 0x7b, 0x4e, 0x45, 0xfa,
+	0x0f, 0x65, 0x55, 0x5e, 0x48, 0xf2, 0x29, 0x8d, 0x50, 0x46, 0x96, 0xca, 0xfc, 0x83, 0x28, 0x35,
+	0x2d, 0x6a, 0xb3, 0xce, 0xe9, 0xe5, 0xe5, 0xf8, 0x76, 0xd2, 0xc1, 0x08, 0xd6, 0xff, 0x9f, 0xb2,
+	0x56, 0x3e, 0x2e, 0x6b, 0x63, 0xbe, 0x4b, 0x3f, 0xf2, 0x7d, 0x58, 0x50, 0x44, 0xa0, 0x5f, 0x57,
+	0x61, 0x0a, 0x2b, 0x4e, 0xec, 0x51, 0xb9, 0x32, 0x7f, 0x0e, 0x90, 0x1a, 0x5a, 0xea, 0xa4, 0x0d,
+	0x00, 0xe5, 0xee, 0x72, 0x59, 0x93, 0x96, 0x82, 0xf0, 0x7d, 0xcc, 0x44, 0x62, 0x7f, 0x5c, 0xec,
+	0xa7, 0x88, 0xf9, 0x21, 0x16, 0xb3, 0x23, 0xb7, 0xcb, 0x73, 0x43, 0xec, 0xad, 0x4d, 0xa8, 0xb4,
+	0x30, 0x8c, 0x54, 0x9f, 0xa9, 0x10, 0xa7, 0x78, 0x6c, 0xb3, 0x2e, 0x8d, 0x04, 0x85, 0xb0, 0x51,
+	0x85, 0xcc, 0x1f, 0x02, 0x51, 0x19, 0xcb, 0x32, 0xb9, 0x09, 0x15, 0x09, 0x29, 0xf1, 0xa3, 0x42,
+	0xe6, 0x9f, 0x35, 0x58, 0x4b, 0x3a, 0x85, 0xdd, 0x21, 0x3a, 0xf9, 0xf2, 0x53, 0xfc, 0x71, 0xee,
+	0x14, 0xb7, 0xe2, 0x53, 0x2c, 0xe3, 0xf1, 0x9f, 0x3e, 0xcc, 0x9f, 0x42, 0x05, 0xbb, 0x82, 0x3d,
+	0x1a, 0xd9, 0xae, 0x47, 0x4c, 0x98, 0x68, 0x04, 0x8e, 0x50, 0x70, 0x7e, 0x67, 0x1e, 0x35, 0xc1,
+	0x7d, 0x8e, 0x5a, 0xb8, 0x47, 0x74, 0xb8, 0x7a, 0x44, 0xc3, 0xd0, 0xee, 0xc6, 0xec, 0xe2, 0xa5,
+	0xf9, 0x96, 0xec, 0x2c, 0xc2, 0x3e, 0xf5, 0x9d, 0xd8, 0xe8, 0x51, 0xb1, 0x71, 0x0b, 0x88, 0x4a,
+	0x2c, 0x1d, 0x6c, 0xc2, 0xac, 0x84, 0x32, 0x37, 0x54, 0xc5, 0xcc, 0xed, 0xb8, 0x57, 0x19, 0xf4,
+	0xe8, 0x8b, 0xa4, 0xbc, 0x0b, 0x8b, 0x0a, 0xad, 0x14, 0xb2, 0x01, 0x20, 0x10, 0x45, 0x84, 0x82,
+	0x98, 0x77, 0x81, 0xe0, 0xd1, 0xec, 0x51, 0x8f, 0xa6, 0x51, 0x55, 0x16, 0xbe, 0xcb, 0x30, 0x79,
+	0x10, 0xb0, 0x8e, 0xf0, 0xc4, 0xb4, 0x25, 0x16, 0xe6, 0x6d, 0x58, 0xca, 0x7c, 0x9f, 0xda, 0xf6,
+	0xc2, 0xec, 0x23, 0x6c, 0x7b, 0xe2, 0x77, 0xed, 0xe8, 0x25, 0x6d, 0x8b, 0x69, 0x53, 0xdb, 0x04,
+	0xa2, 0xda, 0x96, 0x22, 0xe6, 0xb2, 0xb4, 0x6d, 0xff, 0xa2, 0x1f, 0xb0, 0xb8, 0x19, 0x4f, 0x34,
+	0x8e, 0xd1, 0x44, 0xe3, 0x29, 0x84, 0xe3, 0x5e, 0x10, 0xd2, 0x1a, 0x67, 0xc9, 0x1d, 0xf3, 0xa9,
+	0x64, 0xd8, 0xec, 0x29, 0x0c, 0x5f, 0xe6, 0x4b, 0xde, 0x5b, 0xf1, 0x69, 0xe6, 0x19, 0x73, 0xa3,
+	0xd8, 0x81, 0x29, 0x60, 0x7e, 0x0a, 0x4b, 0x19, 0xbe, 0x52, 0xa5, 0xd7, 0x60, 0x4e, 0x20, 0xd4,
+	0xc1, 0x3e, 0x4c, 0x9a, 0x98, 0x05, 0x31, 0x8c, 0xce, 0xdc, 0x7e, 0x3f, 0x26, 0x1a, 0x93, 0x61,
+	0xa4, 0x60, 0xe6, 0xa1, 0x78, 0x9d, 0xa2, 0x51, 0x98, 0x1d, 0x7d, 0xea, 0x70, 0x55, 0xe2, 0xba,
+	0xa6, 0x14, 0xdf, 0xfc, 0x00, 0x63, 0xc5, 0x54, 0x66, 0x13, 0x56, 0x72, 0x8c, 0xa4, 0xae, 0x6f,
+	0xe7, 0x39, 0xad, 0x96, 0xf7, 0xd2, 0x29, 0xab, 0x53, 0xa8, 0xe6, 0x4b, 0x3a, 0x8f, 0xb1, 0x16,
+	0xef, 0xff, 0xe3, 0xac, 0x81, 0x0b, 0x7e, 0xc9, 0xf7, 0xfd, 0x78, 0xc2, 0xe0, 0x3f, 0x79, 0x7c,
+	0xee, 0xd9, 0xc3, 0xb8, 0x5d, 0xc6, 0xdf, 0xfc, 0xae, 0xee, 0x7a, 0x41, 0xe7, 0x2c, 0x19, 0x29,
+	0xe2, 0xa5, 0x59, 0x87, 0x95, 0x56, 0x84, 0x81, 0xd3, 0x09, 0xfc, 0x8e, 0xeb, 0xa9, 0xd1, 0xb6,
+	0xc7, 0x86, 0xd6, 0xc0, 0x47, 0x79, 0xd3, 0x96, 0x5c, 0x99, 0xbf, 0x1d, 0x83, 0xd5, 0xfc, 0x17,
+	0xd2, 0xce, 0x37, 0x78, 0xb3, 0x1d, 0x0d, 0x98, 0x8f, 0x49, 0x39, 0x8d, 0xbb, 0x1c, 0x2a, 0xe8,
+	0x3e, 0x8b, 0x93, 0x7b, 0xd3, 0x89, 0xcf, 0x25, 0x87, 0x92, 0x1d, 0x58, 0xb6, 0x68, 0x2f, 0x38,
+	0x47, 0xa0, 0x45, 0x23, 0x9e, 0xd6, 0x5c, 0x1a, 0x5b, 0x56, 0xba, 0x47, 0xde, 0x86, 0x25, 0x89,
+	0x8b, 0x40, 0x96, 0x9f, 0x88, 0xd1, 0xa0, 0x6c, 0x8b, 0xdc, 0x05, 0x43, 0xc2, 0xf2, 0x05, 0xea,
+	0x5e, 0x18, 0x06, 0x1d, 0x57, 0x79, 0x1b, 0x9c, 0xb1, 0x2e, 0xa1, 0xe0, 0x33, 0xe1, 0x2a, 0xe6,
+	0x96, 0xbe, 0x68, 0x56, 0xdc, 0xcf, 0x5f, 0x74, 0x63, 0xff, 0x8d, 0xd9, 0x70, 0x13, 0x2a, 0x0f,
+	0xe9, 0xb3, 0xa4, 0x8d, 0x9f, 0xc0, 0x3e, 0x47, 0x85, 0xcc, 0x7d, 0x58, 0x2b, 0x68, 0x21, 0xcf,
+	0x65, 0x1b, 0xaa, 0x2a, 0xae, 0x64, 0x84, 0x02, 0x6e, 0xde, 0x86, 0xb9, 0xcc, 0xab, 0x14, 0x0f,
+	0xa7, 0xc7, 0xc3, 0x7e, 0x92, 0xee, 0xf8, 0x6f, 0xae, 0xe3, 0xa3, 0x80, 0xc9, 0x56, 0x66, 0xce,
+	0x12, 0x0b, 0xf3, 0xf7, 0x13, 0xca, 0x44, 0x19, 0x8f, 0xcc, 0x2f, 0xff, 0xc8, 0x3b, 0x96, 0x9f,
+	0xaa, 0x0a, 0x0f, 0x81, 0xe3, 0x65, 0x0f, 0x81, 0x77, 0x72, 0x4f, 0xc1, 0x66, 0xf9, 0x10, 0x5f,
+	0xfa, 0x88, 0xd3, 0x2c, 0x7b, 0x07, 0x7e, 0x73, 0x04, 0x83, 0xcb, 0x1f, 0x81, 0x3f, 0xb9, 0xe4,
+	0x11, 0xb8, 0x36, 0x82, 0xe3, 0x2b, 0xbc, 0x00, 0xff, 0xef, 0x3f, 0x09, 0xf2, 0x8a, 0xf3, 0x88,
+	0xb2, 0x9e, 0x1b, 0x86, 0x5c, 0x8d, 0xb8, 0xe2, 0xfc, 0x0c, 0x96, 0x32, 0x68, 0xda, 0x60, 0x29,
+	0x70, 0xdc, 0x60, 0x29, 0x10, 0xa7, 0xe0, 0x33, 0xbe, 0x23, 0xcb, 0x8b, 0xc8, 0x20, 0x2a, 0xb4,
+	0xfd, 0x13, 0x58, 0x2a, 0x19, 0x1b, 0xc9, 0x6c, 0x1a, 0x90, 0xd5, 0x2b, 0x64, 0x1a, 0x26, 0x0e,
+	0x9a, 0x07, 0xc7, 0x55, 0x8d, 0x5c, 0x83, 0x95, 0xd6, 0x29, 0xaf, 0x1d, 0x61, 0x14, 0x8f, 0x1f,
+	0x07, 0x2e, 0x0b, 0xa3, 0xea, 0xd8, 0xf6, 0x9f, 0x34, 0x98, 0x49, 0xda, 0x1f, 0x52, 0x85, 0xd9,
+	0x27, 0xfe, 0x99, 0x1f, 0x3c, 0xf3, 0x11, 0xab, 0x5e, 0x21, 0x8b, 0x30, 0x87, 0x32, 0x1f, 0x06,
+	0xd1, 0x41, 0x30, 0xf0, 0x9d, 0xaa, 0x46, 0x56, 0x65, 0x39, 0xbc, 0xe7, 0x31, 0x6a, 0x3b, 0xc3,
+	0xfd, 0x0b, 0x37, 0x8c, 0xc2, 0xea, 0x18, 0x59, 0x86, 0x6a, 0x6a, 0xc5, 0x1e, 0xf5, 0x5d, 0xea,
+	0x54, 0xc7, 0x09, 0x81, 0xf9, 0xa6, 0x7f, 0x6e, 0x7b, 0xae, 0x23, 0x1f, 0x0a, 0xab, 0x13, 0x82,
+	0x69, 0x10, 0xd9, 0xfb, 0x17, 0x1d, 0x4a, 0x1d, 0xea, 0x54, 0x27, 0xc9, 0x02, 0x0e, 0xc8, 0x89,
+	0x94, 0x29, 0x55, 0xf0, 0x3e, 0xff, 0xe7, 0xa8, 0x7a, 0x75, 0xe7, 0x2f, 0x73, 0x30, 0x25, 0xe2,
+	0x8c, 0x3c, 0x05, 0x10, 0xbf, 0xb0, 0x85, 0x2e, 0x2f, 0x5f, 0xc6, 0x88, 0x5a, 0x64, 0x5e, 0xfb,
+	0xd5, 0x5f, 0xff, 0xf9, 0xbb, 0xb1, 0x25, 0x73, 0x9e, 0xff, 0xab, 0xf4, 0x8b, 0xa0, 0x2d, 0xff,
+	0xbd, 0xba, 0xa3, 0x6d, 0x93, 0x0f, 0x01, 0x44, 0xb3, 0x92, 0xe5, 0x9b, 0x79, 0x15, 0x33, 0xd6,
+	0x10, 0x2e, 0x0e, 0x5f, 0x45, 0xc6, 0x1d, 0xa4, 0xe1, 0x8c, 0x1f, 0x03, 0x88, 0x79, 0x22, 0xa7,
+	0xb0, 0x3a, 0xc6, 0x18, 0xcb, 0x79, 0xb8, 0x9c, 0x6b, 0x88, 0xbb, 0x9c, 0xeb, 0x43, 0xa8, 0x34,
+	0x18, 0xb5, 0x23, 0xd9, 0xf3, 0x2b, 0x2d, 0x88, 0xb1, 0x5a, 0x78, 0xc5, 0x47, 0x37, 0x9a, 0xd7,
+	0x91, 0xdb, 0x8a, 0x51, 0xe5, 0xdc, 0xb0, 0x26, 0xd5, 0x7f, 0xc9, 0x13, 0xd3, 0x17, 0x9c, 0xdf,
+	0x31, 0xcc, 0x1e, 0xca, 0xf1, 0x00, 0xe7, 0x99, 0x95, 0x94, 0xa1, 0x32, 0x2c, 0x1a, 0xf3, 0x59,
+	0xd8, 0xd4, 0x91, 0x27, 0x21, 0x05, 0x9e, 0xe4, 0x23, 0xa8, 0x88, 0x16, 0x51, 0x28, 0xb8, 0x96,
+	0x7e, 0x98, 0xe9, 0x3c, 0x0d, 0xbd, 0xb8, 0x21, 0x0f, 0x4b, 0xf2, 0xde, 0x2e, 0xf2, 0x0e, 0x60,
+	0x51, 0x18, 0xaf, 0x3e, 0x8e, 0x57, 0xf3, 0x4f, 0xdc, 0x23, 0x1d, 0xf1, 0x36, 0x32, 0xde, 0x36,
+	0x5e, 0x57, 0x18, 0xa3, 0x02, 0x5f, 0x70, 0x27, 0xdf, 0x8c, 0xe4, 0xf7, 0x8a, 0x77, 0x3e, 0x4a,
+	0x46, 0x23, 0x3c, 0xc4, 0x24, 0xbc, 0xb2, 0xb3, 0x99, 0xb1, 0x56, 0xc0, 0xa5, 0x29, 0x06, 0x4a,
+	0x5c, 0x36, 0x17, 0xe2, 0x83, 0xec, 0x09, 0x02, 0xce, 0xdb, 0x87, 0xc5, 0x34, 0xf0, 0xe4, 0x40,
+	0x44, 0xd6, 0x2f, 0x9b, 0x93, 0x46, 0x87, 0xa1, 0x89, 0x72, 0xd6, 0xcd, 0xb5, 0x6c, 0x18, 0xde,
+	0x6c, 0x0f, 0x6f, 0x7a, 0x9c, 0x81, 0xb4, 0x45, 0x4e, 0x1c, 0x59, 0x5b, 0xb2, 0xa3, 0x8d, 0xb1,
+	0x56, 0xc0, 0x47, 0xd9, 0x12, 0x0a, 0x02, 0xce, 0xfb, 0x69, 0x3c, 0x7c, 0x64, 0x63, 0x3d, 0x33,
+	0xce, 0x18, 0xab, 0x79, 0x78, 0xd4, 0xe5, 0x64, 0xb8, 0x2f, 0xf9, 0x8a, 0x36, 0x3f, 0xcb, 0x37,
+	0x33, 0x4a, 0x18, 0xab, 0x79, 0x78, 0x14, 0xdf, 0x01, 0xee, 0x73, 0xbe, 0x9f, 0xc2, 0xac, 0x98,
+	0x0a, 0x64, 0xd7, 0xae, 0x44, 0x69, 0x66, 0x86, 0x30, 0xf4, 0xe2, 0x86, 0xe4, 0xbe, 0x8e, 0xdc,
+	0x57, 0xcd, 0xc5, 0x24, 0x98, 0xc2, 0x3a, 0x45, 0x12, 0x29, 0x40, 0x34, 0xef, 0x45, 0x01, 0xcd,
+	0xde, 0x08, 0x01, 0xcd, 0xde, 0x0b, 0x05, 0xb8, 0xbd, 0x58, 0x00, 0x85, 0xb9, 0x24, 0x1d, 0xf2,
+	0x2e, 0x9b, 0x5c, 0x53, 0x9e, 0x60, 0xb2, 0xcd, 0xbf, 0x61, 0x94, 0x6d, 0x49, 0x29, 0xff, 0x87,
+	0x52, 0xd6, 0x4c, 0x22, 0x9d, 0x14, 0xd2, 0x28, 0x54, 0xb2, 0xa3, 0x0b, 0xf3, 0x49, 0x6b, 0x8c,
+	0x8d, 0x32, 0x11, 0xcc, 0x4a, 0xdb, 0x6c, 0xe3, 0x7a, 0xe9, 0x9e, 0x94, 0xb4, 0x81, 0x92, 0x74,
+	0x73, 0x89, 0x4b, 0x0a, 0x39, 0x4d, 0x9d, 0xc5, 0x44, 0xe2, 0x3e, 0x64, 0x1a, 0x38, 0x3c, 0xf1,
+	0xeb, 0x69, 0xc8, 0x14, 0x1a, 0x52, 0x63, 0xbd, 0x7c, 0x53, 0x8a, 0xbb, 0x81, 0xe2, 0xae, 0x99,
+	0xcb, 0x69, 0x54, 0xa5, 0x54, 0x5c, 0xde, 0x09, 0x54, 0x0f, 0x69, 0x74, 0x34, 0x54, 0xeb, 0xb0,
+	0x38, 0xa6, 0x62, 0x65, 0x37, 0xf4, 0xe2, 0x46, 0x99, 0x9c, 0x41, 0x48, 0x59, 0xbd, 0x9f, 0x52,
+	0xdd, 0xd1, 0xb6, 0x77, 0xf5, 0xaf, 0x9e, 0x6f, 0x68, 0x5f, 0x3f, 0xdf, 0xd0, 0xfe, 0xf1, 0x7c,
+	0x43, 0xfb, 0xf2, 0xdb, 0x8d, 0x2b, 0x5f, 0x7f, 0xbb, 0x71, 0xe5, 0x6f, 0xdf, 0x6e, 0x5c, 0x69,
+	0x4f, 0x61, 0x7e, 0x7a, 0xf7, 0x5f, 0x03, 0x00, 0x00, 0x12, 0x13, 0x20, 0x54, 0x21, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SubmitClient is the client API for Submit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SubmitClient interface {
+	SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error)
+	CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error)
+	SearchJobs(ctx context.Context, in *JobSearchRequest, opts ...grpc.CallOption) (*JobSearchResult, error)
+	CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
+	GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error)
+	DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*QueueDeleteResponse, error)
+	CreateJobTemplate(ctx context.Context, in *JobTemplate, opts ...grpc.CallOption) (*types.Empty, error)
+	MigrateJobs(ctx context.Context, in *JobMigrateRequest, opts ...grpc.CallOption) (*JobMigrateResponse, error)
+	CancelJobsByLabel(ctx context.Context, in *JobCancelByLabelRequest, opts ...grpc.CallOption) (*CancellationResult, error)
+	SuspendJobs(ctx context.Context, in *JobSuspendRequest, opts ...grpc.CallOption) (*JobSuspendResponse, error)
+	ResumeJobs(ctx context.Context, in *JobResumeRequest, opts ...grpc.CallOption) (*JobResumeResponse, error)
+	UngateJobs(ctx context.Context, in *JobUngateRequest, opts ...grpc.CallOption) (*JobUngateResponse, error)
+	ExportQueues(ctx context.Context, in *QueueExportRequest, opts ...grpc.CallOption) (*QueueExportResponse, error)
+	ImportQueues(ctx context.Context, in *QueueImportRequest, opts ...grpc.CallOption) (*QueueImportResponse, error)
+	SubmitJobSets(ctx context.Context, in *JobSetsSubmitRequest, opts ...grpc.CallOption) (*JobSetsSubmitResponse, error)
+	ReconcileState(ctx context.Context, in *StateReconcileRequest, opts ...grpc.CallOption) (*StateReconcileResponse, error)
+	ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error)
+	GetMyPermissions(ctx context.Context, in *PermissionsRequest, opts ...grpc.CallOption) (*PermissionsResponse, error)
+}
+
+type submitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSubmitClient(cc *grpc.ClientConn) SubmitClient {
+	return &submitClient{cc}
+}
+
+func (c *submitClient) SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error) {
+	out := new(JobSubmitResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/SubmitJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error) {
+	out := new(CancellationResult)
+	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) SearchJobs(ctx context.Context, in *JobSearchRequest, opts ...grpc.CallOption) (*JobSearchResult, error) {
+	out := new(JobSearchResult)
+	err := c.cc.Invoke(ctx, "/api.Submit/SearchJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/CreateQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) GetQueueInfo(ctx context.Context, in *QueueInfoRequest, opts ...grpc.CallOption) (*QueueInfo, error) {
+	out := new(QueueInfo)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetQueueInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*QueueDeleteResponse, error) {
+	out := new(QueueDeleteResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/DeleteQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) CreateJobTemplate(ctx context.Context, in *JobTemplate, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/CreateJobTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) MigrateJobs(ctx context.Context, in *JobMigrateRequest, opts ...grpc.CallOption) (*JobMigrateResponse, error) {
+	out := new(JobMigrateResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/MigrateJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) CancelJobsByLabel(ctx context.Context, in *JobCancelByLabelRequest, opts ...grpc.CallOption) (*CancellationResult, error) {
+	out := new(CancellationResult)
+	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobsByLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) SuspendJobs(ctx context.Context, in *JobSuspendRequest, opts ...grpc.CallOption) (*JobSuspendResponse, error) {
+	out := new(JobSuspendResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/SuspendJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) ResumeJobs(ctx context.Context, in *JobResumeRequest, opts ...grpc.CallOption) (*JobResumeResponse, error) {
+	out := new(JobResumeResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/ResumeJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) UngateJobs(ctx context.Context, in *JobUngateRequest, opts ...grpc.CallOption) (*JobUngateResponse, error) {
+	out := new(JobUngateResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/UngateJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) ExportQueues(ctx context.Context, in *QueueExportRequest, opts ...grpc.CallOption) (*QueueExportResponse, error) {
+	out := new(QueueExportResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/ExportQueues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) ImportQueues(ctx context.Context, in *QueueImportRequest, opts ...grpc.CallOption) (*QueueImportResponse, error) {
+	out := new(QueueImportResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/ImportQueues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) SubmitJobSets(ctx context.Context, in *JobSetsSubmitRequest, opts ...grpc.CallOption) (*JobSetsSubmitResponse, error) {
+	out := new(JobSetsSubmitResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/SubmitJobSets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) ReconcileState(ctx context.Context, in *StateReconcileRequest, opts ...grpc.CallOption) (*StateReconcileResponse, error) {
+	out := new(StateReconcileResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/ReconcileState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error) {
+	out := new(JobReprioritizeResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/ReprioritizeJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) GetMyPermissions(ctx context.Context, in *PermissionsRequest, opts ...grpc.CallOption) (*PermissionsResponse, error) {
+	out := new(PermissionsResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetMyPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubmitServer is the server API for Submit service.
+type SubmitServer interface {
+	SubmitJobs(context.Context, *JobSubmitRequest) (*JobSubmitResponse, error)
+	CancelJobs(context.Context, *JobCancelRequest) (*CancellationResult, error)
+	SearchJobs(context.Context, *JobSearchRequest) (*JobSearchResult, error)
+	CreateQueue(context.Context, *Queue) (*types.Empty, error)
+	GetQueueInfo(context.Context, *QueueInfoRequest) (*QueueInfo, error)
+	DeleteQueue(context.Context, *QueueDeleteRequest) (*QueueDeleteResponse, error)
+	CreateJobTemplate(context.Context, *JobTemplate) (*types.Empty, error)
+	MigrateJobs(context.Context, *JobMigrateRequest) (*JobMigrateResponse, error)
+	CancelJobsByLabel(context.Context, *JobCancelByLabelRequest) (*CancellationResult, error)
+	SuspendJobs(context.Context, *JobSuspendRequest) (*JobSuspendResponse, error)
+	ResumeJobs(context.Context, *JobResumeRequest) (*JobResumeResponse, error)
+	UngateJobs(context.Context, *JobUngateRequest) (*JobUngateResponse, error)
+	ExportQueues(context.Context, *QueueExportRequest) (*QueueExportResponse, error)
+	ImportQueues(context.Context, *QueueImportRequest) (*QueueImportResponse, error)
+	SubmitJobSets(context.Context, *JobSetsSubmitRequest) (*JobSetsSubmitResponse, error)
+	ReconcileState(context.Context, *StateReconcileRequest) (*StateReconcileResponse, error)
+	ReprioritizeJobs(context.Context, *JobReprioritizeRequest) (*JobReprioritizeResponse, error)
+	GetMyPermissions(context.Context, *PermissionsRequest) (*PermissionsResponse, error)
+}
+
+// UnimplementedSubmitServer can be embedded to have forward compatible implementations.
+type UnimplementedSubmitServer struct {
+}
+
+func (*UnimplementedSubmitServer) SubmitJobs(ctx context.Context, req *JobSubmitRequest) (*JobSubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitJobs not implemented")
+}
+func (*UnimplementedSubmitServer) CancelJobs(ctx context.Context, req *JobCancelRequest) (*CancellationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJobs not implemented")
+}
+func (*UnimplementedSubmitServer) SearchJobs(ctx context.Context, req *JobSearchRequest) (*JobSearchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchJobs not implemented")
+}
+func (*UnimplementedSubmitServer) CreateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQueue not implemented")
+}
+func (*UnimplementedSubmitServer) GetQueueInfo(ctx context.Context, req *QueueInfoRequest) (*QueueInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueInfo not implemented")
+}
+func (*UnimplementedSubmitServer) DeleteQueue(ctx context.Context, req *QueueDeleteRequest) (*QueueDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
+}
+func (*UnimplementedSubmitServer) CreateJobTemplate(ctx context.Context, req *JobTemplate) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJobTemplate not implemented")
+}
+func (*UnimplementedSubmitServer) MigrateJobs(ctx context.Context, req *JobMigrateRequest) (*JobMigrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateJobs not implemented")
+}
+func (*UnimplementedSubmitServer) CancelJobsByLabel(ctx context.Context, req *JobCancelByLabelRequest) (*CancellationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJobsByLabel not implemented")
+}
+func (*UnimplementedSubmitServer) SuspendJobs(ctx context.Context, req *JobSuspendRequest) (*JobSuspendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendJobs not implemented")
+}
+func (*UnimplementedSubmitServer) ResumeJobs(ctx context.Context, req *JobResumeRequest) (*JobResumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJobs not implemented")
+}
+func (*UnimplementedSubmitServer) UngateJobs(ctx context.Context, req *JobUngateRequest) (*JobUngateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UngateJobs not implemented")
+}
+func (*UnimplementedSubmitServer) ExportQueues(ctx context.Context, req *QueueExportRequest) (*QueueExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportQueues not implemented")
+}
+func (*UnimplementedSubmitServer) ImportQueues(ctx context.Context, req *QueueImportRequest) (*QueueImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportQueues not implemented")
+}
+func (*UnimplementedSubmitServer) SubmitJobSets(ctx context.Context, req *JobSetsSubmitRequest) (*JobSetsSubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitJobSets not implemented")
+}
+func (*UnimplementedSubmitServer) ReconcileState(ctx context.Context, req *StateReconcileRequest) (*StateReconcileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileState not implemented")
+}
+func (*UnimplementedSubmitServer) ReprioritizeJobs(ctx context.Context, req *JobReprioritizeRequest) (*JobReprioritizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReprioritizeJobs not implemented")
+}
+func (*UnimplementedSubmitServer) GetMyPermissions(ctx context.Context, req *PermissionsRequest) (*PermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyPermissions not implemented")
+}
+
+func RegisterSubmitServer(s *grpc.Server, srv SubmitServer) {
+	s.RegisterService(&_Submit_serviceDesc, srv)
+}
+
+func _Submit_SubmitJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).SubmitJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/SubmitJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).SubmitJobs(ctx, req.(*JobSubmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_CancelJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).CancelJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CancelJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CancelJobs(ctx, req.(*JobCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_SearchJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).SearchJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/SearchJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).SearchJobs(ctx, req.(*JobSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Queue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).CreateQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CreateQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CreateQueue(ctx, req.(*Queue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_GetQueueInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).GetQueueInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetQueueInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetQueueInfo(ctx, req.(*QueueInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).DeleteQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/DeleteQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).DeleteQueue(ctx, req.(*QueueDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_CreateJobTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).CreateJobTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CreateJobTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CreateJobTemplate(ctx, req.(*JobTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_MigrateJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobMigrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).MigrateJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/MigrateJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).MigrateJobs(ctx, req.(*JobMigrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_CancelJobsByLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobCancelByLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).CancelJobsByLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CancelJobsByLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CancelJobsByLabel(ctx, req.(*JobCancelByLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_SuspendJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSuspendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).SuspendJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/SuspendJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).SuspendJobs(ctx, req.(*JobSuspendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_ResumeJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).ResumeJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ResumeJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ResumeJobs(ctx, req.(*JobResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_UngateJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobUngateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).UngateJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/UngateJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).UngateJobs(ctx, req.(*JobUngateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_ExportQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).ExportQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ExportQueues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ExportQueues(ctx, req.(*QueueExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_ImportQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).ImportQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ImportQueues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ImportQueues(ctx, req.(*QueueImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_SubmitJobSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSetsSubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).SubmitJobSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/SubmitJobSets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).SubmitJobSets(ctx, req.(*JobSetsSubmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_ReconcileState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).ReconcileState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ReconcileState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ReconcileState(ctx, req.(*StateReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_ReprioritizeJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobReprioritizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).ReprioritizeJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ReprioritizeJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ReprioritizeJobs(ctx, req.(*JobReprioritizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_GetMyPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).GetMyPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetMyPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetMyPermissions(ctx, req.(*PermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Submit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Submit",
+	HandlerType: (*SubmitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitJobs",
+			Handler:    _Submit_SubmitJobs_Handler,
+		},
+		{
+			MethodName: "CancelJobs",
+			Handler:    _Submit_CancelJobs_Handler,
+		},
+		{
+			MethodName: "SearchJobs",
+			Handler:    _Submit_SearchJobs_Handler,
+		},
+		{
+			MethodName: "CreateQueue",
+			Handler:    _Submit_CreateQueue_Handler,
+		},
+		{
+			MethodName: "GetQueueInfo",
+			Handler:    _Submit_GetQueueInfo_Handler,
+		},
+		{
+			MethodName: "DeleteQueue",
+			Handler:    _Submit_DeleteQueue_Handler,
+		},
+		{
+			MethodName: "CreateJobTemplate",
+			Handler:    _Submit_CreateJobTemplate_Handler,
+		},
+		{
+			MethodName: "MigrateJobs",
+			Handler:    _Submit_MigrateJobs_Handler,
+		},
+		{
+			MethodName: "CancelJobsByLabel",
+			Handler:    _Submit_CancelJobsByLabel_Handler,
+		},
+		{
+			MethodName: "SuspendJobs",
+			Handler:    _Submit_SuspendJobs_Handler,
+		},
+		{
+			MethodName: "ResumeJobs",
+			Handler:    _Submit_ResumeJobs_Handler,
+		},
+		{
+			MethodName: "UngateJobs",
+			Handler:    _Submit_UngateJobs_Handler,
+		},
+		{
+			MethodName: "ExportQueues",
+			Handler:    _Submit_ExportQueues_Handler,
+		},
+		{
+			MethodName: "ImportQueues",
+			Handler:    _Submit_ImportQueues_Handler,
+		},
+		{
+			MethodName: "SubmitJobSets",
+			Handler:    _Submit_SubmitJobSets_Handler,
+		},
+		{
+			MethodName: "ReconcileState",
+			Handler:    _Submit_ReconcileState_Handler,
+		},
+		{
+			MethodName: "ReprioritizeJobs",
+			Handler:    _Submit_ReprioritizeJobs_Handler,
+		},
+		{
+			MethodName: "GetMyPermissions",
+			Handler:    _Submit_GetMyPermissions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/api/submit.proto",
+}
+
+func (m *JobSubmitRequestItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmitRequestItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmitRequestItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.NotBefore != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NotBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NotBefore):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintSubmit(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Gated {
+		i--
+		if m.Gated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.MinResources) > 0 {
+		for k := range m.MinResources {
+			v := m.MinResources[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PriorityClass) > 0 {
+		i -= len(m.PriorityClass)
+		copy(dAtA[i:], m.PriorityClass)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.PriorityClass)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.PreferredCluster) > 0 {
+		i -= len(m.PreferredCluster)
+		copy(dAtA[i:], m.PreferredCluster)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.PreferredCluster)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TemplateOverrides != nil {
+		{
+			size, err := m.TemplateOverrides.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.TemplateName) > 0 {
+		i -= len(m.TemplateName)
+		copy(dAtA[i:], m.TemplateName)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.TemplateName)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RequiredNodeLabels) > 0 {
+		for k := range m.RequiredNodeLabels {
+			v := m.RequiredNodeLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PodSpec != nil {
+		{
+			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Priority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Priority))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PodSpec != nil {
+		{
+			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobTemplateOverrides) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobTemplateOverrides) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobTemplateOverrides) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Env) > 0 {
+		for iNdEx := len(m.Env) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Env[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSubmitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Defaults != nil {
+		{
+			size, err := m.Defaults.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CallbackUrl) > 0 {
+		i -= len(m.CallbackUrl)
+		copy(dAtA[i:], m.CallbackUrl)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.CallbackUrl)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CancelOnFailure {
+		i--
+		if m.CancelOnFailure {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Strict {
+		i--
+		if m.Strict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.JobRequestItems) > 0 {
+		for iNdEx := len(m.JobRequestItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobRequestItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobCancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OnlyIfUnstarted {
+		i--
+		if m.OnlyIfUnstarted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSubmitResponseItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmitResponseItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmitResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSubmitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobResponseItems) > 0 {
+		for iNdEx := len(m.JobResponseItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobResponseItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Queue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Queue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SchedulingWindows) > 0 {
+		for iNdEx := len(m.SchedulingWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SchedulingWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ResourcePriorityFactors) > 0 {
+		for k := range m.ResourcePriorityFactors {
+			v := m.ResourcePriorityFactors[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.MaxConcurrentJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.MaxConcurrentJobs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxQueuedJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.MaxQueuedJobs))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.JobOrdering != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.JobOrdering))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.GuaranteedResources) > 0 {
+		for k := range m.GuaranteedResources {
+			v := m.GuaranteedResources[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.LeaseBatchSize != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.LeaseBatchSize))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ClampJobPriority {
+		i--
+		if m.ClampJobPriority {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.DefaultJobPriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DefaultJobPriority))))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.MaxJobPriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxJobPriority))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.MinJobPriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MinJobPriority))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ResourceLimits) > 0 {
+		for k := range m.ResourceLimits {
+			v := m.ResourceLimits[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GroupOwners) > 0 {
+		for iNdEx := len(m.GroupOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GroupOwners[iNdEx])
+			copy(dAtA[i:], m.GroupOwners[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.GroupOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UserOwners) > 0 {
+		for iNdEx := len(m.UserOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UserOwners[iNdEx])
+			copy(dAtA[i:], m.UserOwners[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.UserOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PriorityFactor != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PriorityFactor))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancellationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancellationResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancellationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelledIds) > 0 {
+		for iNdEx := len(m.CancelledIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CancelledIds[iNdEx])
+			copy(dAtA[i:], m.CancelledIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.CancelledIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActiveJobSets) > 0 {
+		for iNdEx := len(m.ActiveJobSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveJobSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSearchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSearchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSearchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSearchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSearchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LeasedJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.LeasedJobs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QueuedJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.QueuedJobs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobMigrateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobMigrateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobMigrateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetQueue) > 0 {
+		i -= len(m.TargetQueue)
+		copy(dAtA[i:], m.TargetQueue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.TargetQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceQueue) > 0 {
+		i -= len(m.SourceQueue)
+		copy(dAtA[i:], m.SourceQueue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.SourceQueue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobMigrateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobMigrateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobMigrateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MigratedIds) > 0 {
+		for iNdEx := len(m.MigratedIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MigratedIds[iNdEx])
+			copy(dAtA[i:], m.MigratedIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.MigratedIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobCancelByLabelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancelByLabelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancelByLabelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ErrorDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorDetail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSuspendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSuspendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSuspendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSuspendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSuspendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSuspendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SuspendedIds) > 0 {
+		for iNdEx := len(m.SuspendedIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SuspendedIds[iNdEx])
+			copy(dAtA[i:], m.SuspendedIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.SuspendedIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobResumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobResumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobResumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobResumeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobResumeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobResumeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResumedIds) > 0 {
+		for iNdEx := len(m.ResumedIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ResumedIds[iNdEx])
+			copy(dAtA[i:], m.ResumedIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.ResumedIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueDeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelledIds) > 0 {
+		for iNdEx := len(m.CancelledIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CancelledIds[iNdEx])
+			copy(dAtA[i:], m.CancelledIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.CancelledIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobUngateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobUngateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobUngateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobUngateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobUngateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobUngateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UngatedIds) > 0 {
+		for iNdEx := len(m.UngatedIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UngatedIds[iNdEx])
+			copy(dAtA[i:], m.UngatedIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.UngatedIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueExportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueExportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueExportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueExportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueExportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueImportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueImportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueImportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Overwrite {
+		i--
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueImportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueImportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueImportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SkippedNames) > 0 {
+		for iNdEx := len(m.SkippedNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SkippedNames[iNdEx])
+			copy(dAtA[i:], m.SkippedNames[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.SkippedNames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ImportedNames) > 0 {
+		for iNdEx := len(m.ImportedNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ImportedNames[iNdEx])
+			copy(dAtA[i:], m.ImportedNames[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.ImportedNames[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSetsSubmitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetsSubmitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetsSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobSets) > 0 {
+		for iNdEx := len(m.JobSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSetsSubmitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetsSubmitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetsSubmitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobSets) > 0 {
+		for iNdEx := len(m.JobSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulingWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulingWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulingWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocked {
+		i--
+		if m.Blocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Days) > 0 {
+		for iNdEx := len(m.Days) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Days[iNdEx])
+			copy(dAtA[i:], m.Days[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Days[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateReconcileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateReconcileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateReconcileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateReconcileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateReconcileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateReconcileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedClusterAssociations) > 0 {
+		for iNdEx := len(m.RemovedClusterAssociations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedClusterAssociations[iNdEx])
+			copy(dAtA[i:], m.RemovedClusterAssociations[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.RemovedClusterAssociations[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RemovedQueueEntries) > 0 {
+		for iNdEx := len(m.RemovedQueueEntries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedQueueEntries[iNdEx])
+			copy(dAtA[i:], m.RemovedQueueEntries[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.RemovedQueueEntries[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RemovedJobSetEntries) > 0 {
+		for iNdEx := len(m.RemovedJobSetEntries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedJobSetEntries[iNdEx])
+			copy(dAtA[i:], m.RemovedJobSetEntries[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.RemovedJobSetEntries[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RequeuedJobIds) > 0 {
+		for iNdEx := len(m.RequeuedJobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequeuedJobIds[iNdEx])
+			copy(dAtA[i:], m.RequeuedJobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.RequeuedJobIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ReturnedJobIds) > 0 {
+		for iNdEx := len(m.ReturnedJobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReturnedJobIds[iNdEx])
+			copy(dAtA[i:], m.ReturnedJobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.ReturnedJobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobReprioritizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobReprioritizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReprioritizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobReprioritizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobReprioritizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReprioritizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReprioritizedIds) > 0 {
+		for iNdEx := len(m.ReprioritizedIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReprioritizedIds[iNdEx])
+			copy(dAtA[i:], m.ReprioritizedIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.ReprioritizedIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ports) > 0 {
+		dAtA9 := make([]byte, len(m.Ports)*10)
+		var j8 int
+		for _, num := range m.Ports {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintSubmit(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSubmitDefaults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmitDefaults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmitDefaults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequiredNodeLabels) > 0 {
+		for k := range m.RequiredNodeLabels {
+			v := m.RequiredNodeLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PriorityClass) > 0 {
+		i -= len(m.PriorityClass)
+		copy(dAtA[i:], m.PriorityClass)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.PriorityClass)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Priority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Priority))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnedQueues) > 0 {
+		for iNdEx := len(m.OwnedQueues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OwnedQueues[iNdEx])
+			copy(dAtA[i:], m.OwnedQueues[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.OwnedQueues[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSubmit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSubmit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *JobSubmitRequestItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		n += 9
+	}
+	if m.PodSpec != nil {
+		l = m.PodSpec.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RequiredNodeLabels) > 0 {
+		for k, v := range m.RequiredNodeLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.TemplateName)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.TemplateOverrides != nil {
+		l = m.TemplateOverrides.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.PreferredCluster)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.PriorityClass)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.MinResources) > 0 {
+		for k, v := range m.MinResources {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if m.Gated {
+		n += 2
+	}
+	if m.NotBefore != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NotBefore)
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if m.PodSpec != nil {
+		l = m.PodSpec.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobTemplateOverrides) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobSubmitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.JobRequestItems) > 0 {
+		for _, e := range m.JobRequestItems {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.Strict {
+		n += 2
+	}
+	if m.CancelOnFailure {
+		n += 2
+	}
+	l = len(m.CallbackUrl)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Defaults != nil {
+		l = m.Defaults.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobCancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.OnlyIfUnstarted {
+		n += 2
+	}
+	return n
+}
+
+func (m *JobSubmitResponseItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSubmitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobResponseItems) > 0 {
+		for _, e := range m.JobResponseItems {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Queue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.PriorityFactor != 0 {
+		n += 9
+	}
+	if len(m.UserOwners) > 0 {
+		for _, s := range m.UserOwners {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.GroupOwners) > 0 {
+		for _, s := range m.GroupOwners {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.ResourceLimits) > 0 {
+		for k, v := range m.ResourceLimits {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.MinJobPriority != 0 {
+		n += 9
+	}
+	if m.MaxJobPriority != 0 {
+		n += 9
+	}
+	if m.DefaultJobPriority != 0 {
+		n += 9
+	}
+	if m.ClampJobPriority {
+		n += 2
+	}
+	if m.LeaseBatchSize != 0 {
+		n += 1 + sovSubmit(uint64(m.LeaseBatchSize))
+	}
+	if len(m.GuaranteedResources) > 0 {
+		for k, v := range m.GuaranteedResources {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + l + sovSubmit(uint64(l))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if m.JobOrdering != 0 {
+		n += 1 + sovSubmit(uint64(m.JobOrdering))
+	}
+	if m.MaxQueuedJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.MaxQueuedJobs))
+	}
+	if m.MaxConcurrentJobs != 0 {
+		n += 2 + sovSubmit(uint64(m.MaxConcurrentJobs))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 2 + l + sovSubmit(uint64(l))
+	}
+	if len(m.ResourcePriorityFactors) > 0 {
+		for k, v := range m.ResourcePriorityFactors {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 2 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.SchedulingWindows) > 0 {
+		for _, e := range m.SchedulingWindows {
+			l = e.Size()
+			n += 2 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CancellationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CancelledIds) > 0 {
+		for _, s := range m.CancelledIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.ActiveJobSets) > 0 {
 		for _, e := range m.ActiveJobSets {
 			l = e.Size()
 			n += 1 + l + sovSubmit(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *JobSetInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if m.QueuedJobs != 0 {
-		n += 1 + sovSubmit(uint64(m.QueuedJobs))
-	}
-	if m.LeasedJobs != 0 {
-		n += 1 + sovSubmit(uint64(m.LeasedJobs))
-	}
-	return n
-}
+	return n
+}
+
+func (m *JobSearchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobSearchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobSetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.QueuedJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.QueuedJobs))
+	}
+	if m.LeasedJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.LeasedJobs))
+	}
+	return n
+}
+
+func (m *JobMigrateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceQueue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.TargetQueue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobMigrateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MigratedIds) > 0 {
+		for _, s := range m.MigratedIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobCancelByLabelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ErrorDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovSubmit(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSuspendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobSuspendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SuspendedIds) > 0 {
+		for _, s := range m.SuspendedIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobResumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobResumeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ResumedIds) > 0 {
+		for _, s := range m.ResumedIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueueDeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CancelledIds) > 0 {
+		for _, s := range m.CancelledIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobUngateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobUngateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UngatedIds) > 0 {
+		for _, s := range m.UngatedIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueExportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueueExportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueImportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.Overwrite {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueueImportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ImportedNames) > 0 {
+		for _, s := range m.ImportedNames {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.SkippedNames) > 0 {
+		for _, s := range m.SkippedNames {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobSetsSubmitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobSets) > 0 {
+		for _, e := range m.JobSets {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobSetsSubmitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobSets) > 0 {
+		for _, e := range m.JobSets {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SchedulingWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Days) > 0 {
+		for _, s := range m.Days {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.Blocked {
+		n += 2
+	}
+	return n
+}
+
+func (m *StateReconcileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *StateReconcileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReturnedJobIds) > 0 {
+		for _, s := range m.ReturnedJobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.RequeuedJobIds) > 0 {
+		for _, s := range m.RequeuedJobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.RemovedJobSetEntries) > 0 {
+		for _, s := range m.RemovedJobSetEntries {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.RemovedQueueEntries) > 0 {
+		for _, s := range m.RemovedQueueEntries {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.RemovedClusterAssociations) > 0 {
+		for _, s := range m.RemovedClusterAssociations {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobReprioritizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.NewPriority != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *JobReprioritizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReprioritizedIds) > 0 {
+		for _, s := range m.ReprioritizedIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServiceConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Ports) > 0 {
+		l = 0
+		for _, e := range m.Ports {
+			l += sovSubmit(uint64(e))
+		}
+		n += 1 + sovSubmit(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *JobSubmitDefaults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		n += 9
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.PriorityClass)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RequiredNodeLabels) > 0 {
+		for k, v := range m.RequiredNodeLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *PermissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.OwnedQueues) > 0 {
+		for _, s := range m.OwnedQueues {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSubmit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSubmit(x uint64) (n int) {
+	return sovSubmit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitRequestItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitRequestItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Priority = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PodSpec == nil {
+				m.PodSpec = &v1.PodSpec{}
+			}
+			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredNodeLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequiredNodeLabels == nil {
+				m.RequiredNodeLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RequiredNodeLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateOverrides == nil {
+				m.TemplateOverrides = &JobTemplateOverrides{}
+			}
+			if err := m.TemplateOverrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreferredCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinResources == nil {
+				m.MinResources = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MinResources[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Gated = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &ServiceConfig{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PodSpec == nil {
+				m.PodSpec = &v1.PodSpec{}
+			}
+			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobTemplateOverrides) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobTemplateOverrides: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobTemplateOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRequestItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobRequestItems = append(m.JobRequestItems, &JobSubmitRequestItem{})
+			if err := m.JobRequestItems[len(m.JobRequestItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Strict = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelOnFailure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CancelOnFailure = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Defaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Defaults == nil {
+				m.Defaults = &JobSubmitDefaults{}
+			}
+			if err := m.Defaults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyIfUnstarted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyIfUnstarted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitResponseItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitResponseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobResponseItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobResponseItems = append(m.JobResponseItems, &JobSubmitResponseItem{})
+			if err := m.JobResponseItems[len(m.JobResponseItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Queue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Queue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Queue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFactor", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PriorityFactor = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserOwners = append(m.UserOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupOwners = append(m.GroupOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourceLimits[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinJobPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MinJobPriority = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxJobPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MaxJobPriority = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultJobPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DefaultJobPriority = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClampJobPriority", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClampJobPriority = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseBatchSize", wireType)
+			}
+			m.LeaseBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuaranteedResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GuaranteedResources == nil {
+				m.GuaranteedResources = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.GuaranteedResources[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobOrdering", wireType)
+			}
+			m.JobOrdering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobOrdering |= JobOrderingStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueuedJobs", wireType)
+			}
+			m.MaxQueuedJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueuedJobs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConcurrentJobs", wireType)
+			}
+			m.MaxConcurrentJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConcurrentJobs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcePriorityFactors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourcePriorityFactors == nil {
+				m.ResourcePriorityFactors = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourcePriorityFactors[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchedulingWindows = append(m.SchedulingWindows, &SchedulingWindow{})
+			if err := m.SchedulingWindows[len(m.SchedulingWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancellationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancellationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancellationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledIds = append(m.CancelledIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveJobSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveJobSets = append(m.ActiveJobSets, &JobSetInfo{})
+			if err := m.ActiveJobSets[len(m.ActiveJobSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSearchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSearchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSearchResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSearchResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSearchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedJobs", wireType)
+			}
+			m.QueuedJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasedJobs", wireType)
+			}
+			m.LeasedJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeasedJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobMigrateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobMigrateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobMigrateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobMigrateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobMigrateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobMigrateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigratedIds = append(m.MigratedIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobCancelByLabelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobCancelByLabelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobCancelByLabelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ErrorDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= ErrorCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSuspendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSuspendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSuspendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSuspendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSuspendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSuspendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuspendedIds = append(m.SuspendedIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobResumeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobResumeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobResumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobResumeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobResumeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobResumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumedIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumedIds = append(m.ResumedIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueDeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueDeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueDeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueDeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledIds = append(m.CancelledIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobUngateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobUngateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobUngateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobUngateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobUngateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobUngateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UngatedIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UngatedIds = append(m.UngatedIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueExportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueExportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueExportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueExportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, &Queue{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueImportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueImportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueImportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, &Queue{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueImportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueImportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueImportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImportedNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImportedNames = append(m.ImportedNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkippedNames = append(m.SkippedNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetsSubmitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetsSubmitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetsSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSets = append(m.JobSets, &JobSubmitRequest{})
+			if err := m.JobSets[len(m.JobSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovSubmit(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozSubmit(x uint64) (n int) {
-	return sovSubmit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
+func (m *JobSetsSubmitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1558,26 +11591,15 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitRequestItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSetsSubmitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitRequestItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSetsSubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Priority = float64(math.Float64frombits(v))
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1604,16 +11626,67 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PodSpec == nil {
-				m.PodSpec = &v1.PodSpec{}
-			}
-			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.JobSets = append(m.JobSets, &JobSubmitResponse{})
+			if err := m.JobSets[len(m.JobSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulingWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulingWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulingWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1641,13 +11714,13 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Start = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -1657,124 +11730,29 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
+			m.End = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Days", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -1784,124 +11762,29 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Annotations[mapkey] = mapvalue
+			m.Days = append(m.Days, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredNodeLabels", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -1911,119 +11794,85 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Blocked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RequiredNodeLabels == nil {
-				m.RequiredNodeLabels = make(map[string]string)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateReconcileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateReconcileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateReconcileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.RequiredNodeLabels[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -2048,7 +11897,7 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
+func (m *StateReconcileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2071,15 +11920,15 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateReconcileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateReconcileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnedJobIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2107,11 +11956,11 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.ReturnedJobIds = append(m.ReturnedJobIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeuedJobIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2139,13 +11988,13 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.RequeuedJobIds = append(m.RequeuedJobIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobRequestItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedJobSetEntries", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -2155,25 +12004,87 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobRequestItems = append(m.JobRequestItems, &JobSubmitRequestItem{})
-			if err := m.JobRequestItems[len(m.JobRequestItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RemovedJobSetEntries = append(m.RemovedJobSetEntries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedQueueEntries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedQueueEntries = append(m.RemovedQueueEntries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedClusterAssociations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.RemovedClusterAssociations = append(m.RemovedClusterAssociations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2199,7 +12110,7 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
+func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2222,15 +12133,15 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobCancelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobReprioritizeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobReprioritizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2258,7 +12169,7 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2324,6 +12235,17 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NewPriority = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -2348,7 +12270,7 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
+func (m *JobReprioritizeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2371,47 +12293,15 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitResponseItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobReprioritizeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitResponseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobReprioritizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprioritizedIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2439,7 +12329,7 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.ReprioritizedIds = append(m.ReprioritizedIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2465,7 +12355,7 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
+func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2488,17 +12378,17 @@ func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServiceConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServiceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobResponseItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -2508,26 +12398,100 @@ func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobResponseItems = append(m.JobResponseItems, &JobSubmitResponseItem{})
-			if err := m.JobResponseItems[len(m.JobResponseItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -2552,7 +12516,7 @@ func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Queue) Unmarshal(dAtA []byte) error {
+func (m *JobSubmitDefaults) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2575,47 +12539,15 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Queue: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSubmitDefaults: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Queue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSubmitDefaults: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
 			var v uint64
 			if (iNdEx + 8) > l {
@@ -2623,10 +12555,10 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.PriorityFactor = float64(math.Float64frombits(v))
-		case 3:
+			m.Priority = float64(math.Float64frombits(v))
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserOwners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2654,11 +12586,11 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserOwners = append(m.UserOwners, string(dAtA[iNdEx:postIndex]))
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClass", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2686,11 +12618,11 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwners = append(m.GroupOwners, string(dAtA[iNdEx:postIndex]))
+			m.PriorityClass = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2717,11 +12649,11 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = make(map[string]float64)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
 			var mapkey string
-			var mapvalue float64
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -2770,13 +12702,34 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapvaluetemp uint64
-					if (iNdEx + 8) > l {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					mapvalue = math.Float64frombits(mapvaluetemp)
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -2792,66 +12745,13 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.ResourceLimits[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CancellationResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CancellationResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancellationResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -2861,82 +12761,124 @@ func (m *CancellationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CancelledIds = append(m.CancelledIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueueInfoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredNodeLabels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -2946,23 +12888,118 @@ func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.RequiredNodeLabels == nil {
+				m.RequiredNodeLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RequiredNodeLabels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2988,7 +13025,7 @@ func (m *QueueInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueInfo) Unmarshal(dAtA []byte) error {
+func (m *PermissionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3011,78 +13048,12 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PermissionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveJobSets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActiveJobSets = append(m.ActiveJobSets, &JobSetInfo{})
-			if err := m.ActiveJobSets[len(m.ActiveJobSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -3107,7 +13078,7 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
+func (m *PermissionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3130,15 +13101,15 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PermissionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3166,13 +13137,13 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedJobs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnedQueues", wireType)
 			}
-			m.QueuedJobs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -3182,30 +13153,24 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QueuedJobs |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeasedJobs", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
 			}
-			m.LeasedJobs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LeasedJobs |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.OwnedQueues = append(m.OwnedQueues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -3233,6 +13198,7 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 func skipSubmit(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -3264,10 +13230,8 @@ func skipSubmit(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -3288,55 +13252,30 @@ func skipSubmit(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthSubmit
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthSubmit
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipSubmit(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthSubmit
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSubmit
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSubmit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthSubmit = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowSubmit   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthSubmit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSubmit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSubmit = fmt.Errorf("proto: unexpected end of group")
 )
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	io "io"
 	math "math"
+	math_bits "math/bits"
 	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -15,6 +16,8 @@ import (
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	resource "k8s.io/apimachinery/pkg/api/resource"
 )
 
@@ -28,7 +31,7 @@ var _ = time.Kitchen
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type QueueReport struct {
 	Name          string                       `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
@@ -50,7 +53,7 @@ func (m *QueueReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_QueueReport.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -90,19 +93,79 @@ func (m *QueueReport) GetResourcesUsed() map[string]resource.Quantity {
 	return nil
 }
 
+type JobUsageReport struct {
+	JobId         string                       `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
+	ResourcesUsed map[string]resource.Quantity `protobuf:"bytes,2,rep,name=ResourcesUsed,proto3" json:"ResourcesUsed" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *JobUsageReport) Reset()         { *m = JobUsageReport{} }
+func (m *JobUsageReport) String() string { return proto.CompactTextString(m) }
+func (*JobUsageReport) ProtoMessage()    {}
+func (*JobUsageReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5643ccb387d55d48, []int{1}
+}
+func (m *JobUsageReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobUsageReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobUsageReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobUsageReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobUsageReport.Merge(m, src)
+}
+func (m *JobUsageReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobUsageReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobUsageReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobUsageReport proto.InternalMessageInfo
+
+func (m *JobUsageReport) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobUsageReport) GetResourcesUsed() map[string]resource.Quantity {
+	if m != nil {
+		return m.ResourcesUsed
+	}
+	return nil
+}
+
 type ClusterUsageReport struct {
 	ClusterId                string                       `protobuf:"bytes,1,opt,name=ClusterId,proto3" json:"ClusterId,omitempty"`
 	ReportTime               time.Time                    `protobuf:"bytes,2,opt,name=ReportTime,proto3,stdtime" json:"ReportTime"`
 	Queues                   []*QueueReport               `protobuf:"bytes,3,rep,name=Queues,proto3" json:"Queues,omitempty"`
 	ClusterCapacity          map[string]resource.Quantity `protobuf:"bytes,4,rep,name=ClusterCapacity,proto3" json:"ClusterCapacity" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	ClusterAvailableCapacity map[string]resource.Quantity `protobuf:"bytes,5,rep,name=ClusterAvailableCapacity,proto3" json:"ClusterAvailableCapacity" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Number of GPUs in the cluster by GPU model (value of nvidia.com/gpu.product node label)
+	GpuCapacityByType map[string]resource.Quantity `protobuf:"bytes,6,rep,name=GpuCapacityByType,proto3" json:"GpuCapacityByType" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optional samples of resources actually used by individual running jobs
+	Jobs []*JobUsageReport `protobuf:"bytes,7,rep,name=Jobs,proto3" json:"Jobs,omitempty"`
+	// Pool of the cluster, fair share of queues is calculated within each pool
+	Pool string `protobuf:"bytes,8,opt,name=Pool,proto3" json:"Pool,omitempty"`
+	// Labels of all nodes of the cluster jobs can run on, with their allocatable resources
+	NodeLabelings []*NodeLabeling `protobuf:"bytes,9,rep,name=NodeLabelings,proto3" json:"NodeLabelings,omitempty"`
 }
 
 func (m *ClusterUsageReport) Reset()         { *m = ClusterUsageReport{} }
 func (m *ClusterUsageReport) String() string { return proto.CompactTextString(m) }
 func (*ClusterUsageReport) ProtoMessage()    {}
 func (*ClusterUsageReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5643ccb387d55d48, []int{1}
+	return fileDescriptor_5643ccb387d55d48, []int{2}
 }
 func (m *ClusterUsageReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -112,7 +175,7 @@ func (m *ClusterUsageReport) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_ClusterUsageReport.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -166,52 +229,91 @@ func (m *ClusterUsageReport) GetClusterAvailableCapacity() map[string]resource.Q
 	return nil
 }
 
+func (m *ClusterUsageReport) GetGpuCapacityByType() map[string]resource.Quantity {
+	if m != nil {
+		return m.GpuCapacityByType
+	}
+	return nil
+}
+
+func (m *ClusterUsageReport) GetJobs() []*JobUsageReport {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+func (m *ClusterUsageReport) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *ClusterUsageReport) GetNodeLabelings() []*NodeLabeling {
+	if m != nil {
+		return m.NodeLabelings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueueReport)(nil), "api.QueueReport")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.QueueReport.ResourcesEntry")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.QueueReport.ResourcesUsedEntry")
+	proto.RegisterType((*JobUsageReport)(nil), "api.JobUsageReport")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.JobUsageReport.ResourcesUsedEntry")
 	proto.RegisterType((*ClusterUsageReport)(nil), "api.ClusterUsageReport")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.ClusterUsageReport.ClusterAvailableCapacityEntry")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.ClusterUsageReport.ClusterCapacityEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.ClusterUsageReport.GpuCapacityByTypeEntry")
 }
 
 func init() { proto.RegisterFile("pkg/api/usage.proto", fileDescriptor_5643ccb387d55d48) }
 
 var fileDescriptor_5643ccb387d55d48 = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xf3, 0x51, 0x91, 0x89, 0x80, 0x6a, 0x41, 0x60, 0x19, 0x70, 0xa2, 0x72, 0xc9, 0x01,
-	0xd6, 0x52, 0x00, 0xa9, 0xe2, 0x80, 0x44, 0xd2, 0x0a, 0x71, 0x01, 0xd5, 0x6a, 0x6f, 0x5c, 0x36,
-	0xc9, 0xe0, 0xae, 0x62, 0x67, 0x57, 0xf6, 0xba, 0xc8, 0xe2, 0x4f, 0xf4, 0xc6, 0x5f, 0xea, 0xb1,
-	0x47, 0x4e, 0x80, 0x92, 0x1b, 0xbf, 0x02, 0x79, 0xbd, 0x49, 0xdc, 0x84, 0xc0, 0x29, 0xb7, 0x9d,
-	0xf1, 0x7b, 0xf3, 0x9e, 0xde, 0x8c, 0x0c, 0xf7, 0xe4, 0x24, 0xf0, 0x98, 0xe4, 0x5e, 0x9a, 0xb0,
-	0x00, 0xa9, 0x8c, 0x85, 0x12, 0xa4, 0xc6, 0x24, 0x77, 0xda, 0x81, 0x10, 0x41, 0x88, 0x9e, 0x6e,
-	0x0d, 0xd3, 0xcf, 0x9e, 0xe2, 0x11, 0x26, 0x8a, 0x45, 0xb2, 0x40, 0x39, 0x8f, 0xd6, 0x01, 0x18,
-	0x49, 0x95, 0x99, 0x8f, 0x2f, 0x27, 0x87, 0x09, 0xe5, 0x22, 0x1f, 0x1d, 0xb1, 0xd1, 0x39, 0x9f,
-	0x62, 0x9c, 0x79, 0x0b, 0xad, 0x18, 0x13, 0x91, 0xc6, 0x23, 0xf4, 0x02, 0x9c, 0x62, 0xcc, 0x14,
-	0x8e, 0x0d, 0xeb, 0x79, 0xc0, 0xd5, 0x79, 0x3a, 0xa4, 0x23, 0x11, 0x79, 0x81, 0x08, 0xc4, 0x6a,
-	0x76, 0x5e, 0xe9, 0x42, 0xbf, 0x0a, 0xf8, 0xc1, 0xb7, 0x1a, 0xb4, 0x4e, 0x52, 0x4c, 0xd1, 0x47,
-	0x29, 0x62, 0x45, 0x08, 0xd4, 0x3f, 0xb0, 0x08, 0x6d, 0xab, 0x63, 0x75, 0x9b, 0xbe, 0x7e, 0x93,
-	0x01, 0x34, 0x7d, 0x23, 0x97, 0xd8, 0xd5, 0x4e, 0xad, 0xdb, 0xea, 0xb5, 0x29, 0x93, 0x9c, 0x96,
-	0x88, 0x74, 0x89, 0x38, 0x9e, 0xaa, 0x38, 0xeb, 0xd7, 0xaf, 0x7e, 0xb4, 0x2b, 0xfe, 0x8a, 0x47,
-	0x3e, 0xc2, 0xed, 0x65, 0x71, 0x96, 0xe0, 0xd8, 0xae, 0xe9, 0x41, 0x4f, 0xb7, 0x0f, 0xca, 0x51,
-	0xe5, 0x61, 0x37, 0xf9, 0x4e, 0x08, 0x77, 0x6e, 0x6a, 0x92, 0x7d, 0xa8, 0x4d, 0x30, 0x33, 0xd6,
-	0xf3, 0x27, 0x39, 0x82, 0xc6, 0x05, 0x0b, 0x53, 0xb4, 0xab, 0x1d, 0xab, 0xdb, 0xea, 0x51, 0x5a,
-	0x44, 0x4a, 0xcb, 0x91, 0x52, 0x39, 0x09, 0xb4, 0x89, 0x45, 0xa4, 0xf4, 0x24, 0x65, 0x53, 0xc5,
-	0x55, 0xe6, 0x17, 0xe4, 0xd7, 0xd5, 0x43, 0xcb, 0x91, 0x40, 0x36, 0x8d, 0xed, 0x52, 0xf1, 0xe0,
-	0x77, 0x1d, 0xc8, 0x20, 0x4c, 0x13, 0x85, 0xf1, 0x59, 0x7e, 0x58, 0x66, 0x41, 0x8f, 0xa1, 0x69,
-	0xba, 0xef, 0xc7, 0x46, 0x78, 0xd5, 0x20, 0x47, 0x00, 0x05, 0xee, 0x94, 0x47, 0x0b, 0x0f, 0x0e,
-	0x2d, 0xae, 0x8c, 0x2e, 0x2e, 0x81, 0x9e, 0x2e, 0xce, 0xb0, 0x7f, 0x2b, 0x4f, 0xf6, 0xf2, 0x67,
-	0xdb, 0xf2, 0x4b, 0x3c, 0xd2, 0x85, 0x3d, 0xbd, 0x91, 0xc4, 0x2c, 0x69, 0x7f, 0x7d, 0x49, 0xbe,
-	0xf9, 0x4e, 0x3e, 0xc1, 0x5d, 0x23, 0x3e, 0x60, 0x92, 0x8d, 0xb8, 0xca, 0xec, 0xba, 0xa6, 0x3c,
-	0xd3, 0x94, 0x4d, 0xff, 0x74, 0x0d, 0x5e, 0x5e, 0xf0, 0xfa, 0x28, 0xf2, 0x05, 0x6c, 0xd3, 0x7a,
-	0x7b, 0xc1, 0x78, 0xc8, 0x86, 0x21, 0x2e, 0x65, 0x1a, 0x5a, 0xe6, 0xd5, 0x7f, 0x64, 0x36, 0x78,
-	0x65, 0xbd, 0xad, 0xc3, 0x9d, 0x18, 0xee, 0xff, 0xcd, 0xe7, 0x4e, 0x2f, 0xec, 0x2b, 0x3c, 0xf9,
-	0xa7, 0xe9, 0x5d, 0x8a, 0xf7, 0xde, 0x41, 0x43, 0xa7, 0x47, 0xde, 0x40, 0xab, 0x48, 0xb0, 0x28,
-	0x1f, 0x6e, 0xc9, 0xd7, 0x79, 0xb0, 0x71, 0x54, 0xc7, 0xf9, 0xaf, 0xab, 0x6f, 0x5f, 0xcd, 0x5c,
-	0xeb, 0x7a, 0xe6, 0x5a, 0xbf, 0x66, 0xae, 0x75, 0x39, 0x77, 0x2b, 0xd7, 0x73, 0xb7, 0xf2, 0x7d,
-	0xee, 0x56, 0x86, 0x7b, 0x1a, 0xf9, 0xe2, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4c, 0xa6, 0x2c,
-	0x9b, 0x2f, 0x05, 0x00, 0x00,
+	// 643 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x6f, 0xd6, 0x76, 0x5b, 0x5f, 0xb5, 0xb1, 0x79, 0xd3, 0x88, 0x02, 0xb4, 0xd3, 0x90, 0xa0,
+	0x07, 0x70, 0xa4, 0x01, 0x62, 0xe2, 0x80, 0x44, 0xb7, 0x69, 0x62, 0x42, 0x83, 0x45, 0xdb, 0x8d,
+	0x8b, 0xd3, 0x9a, 0xcc, 0x6a, 0x52, 0x9b, 0xc4, 0x19, 0x8a, 0xf8, 0x12, 0xbb, 0xf1, 0x5d, 0xf8,
+	0x04, 0x3b, 0xee, 0xc8, 0x09, 0xd0, 0x76, 0xe5, 0x43, 0xa0, 0x38, 0x6e, 0x97, 0x36, 0x2b, 0x70,
+	0x29, 0x37, 0x3f, 0xe7, 0xf7, 0xe7, 0xf9, 0xbd, 0x67, 0x07, 0x56, 0x44, 0xcf, 0xb3, 0x89, 0x60,
+	0x76, 0x1c, 0x11, 0x8f, 0x62, 0x11, 0x72, 0xc9, 0x51, 0x99, 0x08, 0x66, 0x35, 0x3d, 0xce, 0x3d,
+	0x9f, 0xda, 0x6a, 0xcb, 0x8d, 0x3f, 0xd8, 0x92, 0x05, 0x34, 0x92, 0x24, 0x10, 0x19, 0xca, 0xba,
+	0x33, 0x0e, 0xa0, 0x81, 0x90, 0x89, 0xfe, 0xf8, 0xb4, 0xb7, 0x15, 0x61, 0xc6, 0x53, 0xe9, 0x80,
+	0x74, 0x4e, 0x58, 0x9f, 0x86, 0x89, 0x3d, 0xf0, 0x0a, 0x69, 0xc4, 0xe3, 0xb0, 0x43, 0x6d, 0x8f,
+	0xf6, 0x69, 0x48, 0x24, 0xed, 0x6a, 0xd6, 0x63, 0x8f, 0xc9, 0x93, 0xd8, 0xc5, 0x1d, 0x1e, 0xd8,
+	0x1e, 0xf7, 0xf8, 0xb5, 0x76, 0x1a, 0xa9, 0x40, 0xad, 0x34, 0x7c, 0x98, 0xfc, 0xc7, 0x98, 0xc6,
+	0x3a, 0xf9, 0x8d, 0x2f, 0x65, 0xa8, 0x1f, 0xa6, 0xb1, 0x43, 0x05, 0x0f, 0x25, 0x42, 0x50, 0x39,
+	0x20, 0x01, 0x35, 0x8d, 0x75, 0xa3, 0x55, 0x73, 0xd4, 0x1a, 0x6d, 0x43, 0xcd, 0xd1, 0x39, 0x44,
+	0xe6, 0xcc, 0x7a, 0xb9, 0x55, 0xdf, 0x6c, 0x62, 0x22, 0x18, 0xce, 0x11, 0xf1, 0x10, 0xb1, 0xdb,
+	0x97, 0x61, 0xd2, 0xae, 0x9c, 0x7f, 0x6f, 0x96, 0x9c, 0x6b, 0x1e, 0x7a, 0x0b, 0x0b, 0xc3, 0xe0,
+	0x38, 0xa2, 0x5d, 0xb3, 0xac, 0x84, 0xee, 0x4f, 0x16, 0x4a, 0x51, 0x79, 0xb1, 0x51, 0xbe, 0xe5,
+	0xc3, 0xe2, 0xa8, 0x27, 0x5a, 0x82, 0x72, 0x8f, 0x26, 0x3a, 0xf5, 0x74, 0x89, 0x76, 0xa0, 0x7a,
+	0x4a, 0xfc, 0x98, 0x9a, 0x33, 0xeb, 0x46, 0xab, 0xbe, 0x89, 0x71, 0x56, 0x67, 0x9c, 0xaf, 0x33,
+	0x16, 0x3d, 0x4f, 0x25, 0x31, 0xa8, 0x33, 0x3e, 0x8c, 0x49, 0x5f, 0x32, 0x99, 0x38, 0x19, 0xf9,
+	0xc5, 0xcc, 0x96, 0x61, 0x09, 0x40, 0xc5, 0xc4, 0xa6, 0xe9, 0xb8, 0xf1, 0xcb, 0x80, 0xc5, 0x7d,
+	0xee, 0x1e, 0xa7, 0x93, 0xa6, 0x9b, 0xb3, 0x0a, 0xd5, 0x7d, 0xee, 0xbe, 0xee, 0x6a, 0xc3, 0x2c,
+	0x40, 0xce, 0x78, 0x65, 0xb3, 0x16, 0x3d, 0x50, 0x16, 0xa3, 0x0a, 0xff, 0x5c, 0xdc, 0xff, 0x7f,
+	0xdc, 0xaf, 0x73, 0x80, 0xb6, 0xfd, 0x38, 0x92, 0x34, 0xcc, 0x1f, 0xf9, 0x2e, 0xd4, 0xf4, 0xee,
+	0xf0, 0xd8, 0xd7, 0x1b, 0x68, 0x07, 0x20, 0xc3, 0x1d, 0xb1, 0x60, 0x90, 0x83, 0x85, 0xb3, 0x9b,
+	0x86, 0x07, 0xb7, 0x01, 0x1f, 0x0d, 0xae, 0x62, 0x7b, 0x3e, 0x3d, 0xeb, 0xd9, 0x8f, 0xa6, 0xe1,
+	0xe4, 0x78, 0xa8, 0x05, 0xb3, 0x6a, 0x00, 0x23, 0x3d, 0x93, 0x4b, 0xe3, 0x33, 0xe9, 0xe8, 0xef,
+	0xe8, 0x3d, 0xdc, 0xd2, 0xe6, 0xdb, 0x44, 0x90, 0x0e, 0x93, 0x89, 0x59, 0x51, 0x94, 0x47, 0x8a,
+	0x52, 0xcc, 0x1f, 0x8f, 0xc1, 0xf3, 0x25, 0x1f, 0x97, 0x42, 0x9f, 0xc0, 0xd4, 0x5b, 0xaf, 0x4e,
+	0x09, 0xf3, 0x89, 0xeb, 0xd3, 0xa1, 0x4d, 0x55, 0xd9, 0x3c, 0xfb, 0x8b, 0x4d, 0x81, 0x97, 0xf7,
+	0x9b, 0x28, 0x8e, 0x5c, 0x58, 0xde, 0x13, 0xf1, 0x20, 0x6c, 0x27, 0x47, 0x89, 0xa0, 0xe6, 0xac,
+	0x72, 0xc4, 0x93, 0x1c, 0x0b, 0x84, 0xbc, 0x55, 0x51, 0x0e, 0x3d, 0x84, 0xca, 0x3e, 0x77, 0x23,
+	0x73, 0x4e, 0xc9, 0xae, 0xdc, 0x30, 0x9c, 0x8e, 0x02, 0xa4, 0x2f, 0xd0, 0x3b, 0xce, 0x7d, 0x73,
+	0x3e, 0x7b, 0x81, 0xd2, 0x35, 0x7a, 0x0e, 0x0b, 0x07, 0xbc, 0x4b, 0xdf, 0x10, 0x97, 0xfa, 0xac,
+	0xef, 0x45, 0x66, 0x4d, 0xa9, 0x2c, 0x2b, 0x95, 0xfc, 0x17, 0x67, 0x14, 0x67, 0x85, 0xb0, 0x7a,
+	0x53, 0x07, 0xa6, 0xfa, 0x54, 0x7c, 0x86, 0x7b, 0x7f, 0x6c, 0xc7, 0x54, 0xcd, 0x25, 0xac, 0xdd,
+	0xdc, 0x99, 0x69, 0xba, 0x6e, 0xee, 0x41, 0x55, 0x35, 0x12, 0xbd, 0x84, 0x7a, 0xd6, 0xcc, 0x2c,
+	0xbc, 0x3d, 0x61, 0x7a, 0xac, 0xb5, 0xc2, 0x25, 0xdd, 0x4d, 0x7f, 0x87, 0x6d, 0xf3, 0xfc, 0xb2,
+	0x61, 0x5c, 0x5c, 0x36, 0x8c, 0x9f, 0x97, 0x0d, 0xe3, 0xec, 0xaa, 0x51, 0xba, 0xb8, 0x6a, 0x94,
+	0xbe, 0x5d, 0x35, 0x4a, 0xee, 0xac, 0x42, 0x3e, 0xf9, 0x3d, 0x00, 0x3c, 0x23, 0x49, 0x17, 0x83,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -251,6 +353,14 @@ type UsageServer interface {
 	ReportUsage(context.Context, *ClusterUsageReport) (*types.Empty, error)
 }
 
+// UnimplementedUsageServer can be embedded to have forward compatible implementations.
+type UnimplementedUsageServer struct {
+}
+
+func (*UnimplementedUsageServer) ReportUsage(ctx context.Context, req *ClusterUsageReport) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportUsage not implemented")
+}
+
 func RegisterUsageServer(s *grpc.Server, srv UsageServer) {
 	s.RegisterService(&_Usage_serviceDesc, srv)
 }
@@ -289,7 +399,7 @@ var _Usage_serviceDesc = grpc.ServiceDesc{
 func (m *QueueReport) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -297,75 +407,131 @@ func (m *QueueReport) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *QueueReport) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintUsage(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if len(m.ResourcesUsed) > 0 {
+		for k := range m.ResourcesUsed {
+			v := m.ResourcesUsed[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintUsage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintUsage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.Resources) > 0 {
-		for k, _ := range m.Resources {
-			dAtA[i] = 0x12
-			i++
+		for k := range m.Resources {
 			v := m.Resources[k]
-			msgSize := 0
-			if (&v) != nil {
-				msgSize = (&v).Size()
-				msgSize += 1 + sovUsage(uint64(msgSize))
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsage(dAtA, i, uint64(size))
 			}
-			mapSize := 1 + len(k) + sovUsage(uint64(len(k))) + msgSize
-			i = encodeVarintUsage(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
 			i = encodeVarintUsage(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintUsage(dAtA, i, uint64(baseI-i))
+			i--
 			dAtA[i] = 0x12
-			i++
-			i = encodeVarintUsage(dAtA, i, uint64((&v).Size()))
-			n1, err := (&v).MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n1
 		}
 	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUsage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobUsageReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobUsageReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobUsageReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.ResourcesUsed) > 0 {
-		for k, _ := range m.ResourcesUsed {
-			dAtA[i] = 0x1a
-			i++
+		for k := range m.ResourcesUsed {
 			v := m.ResourcesUsed[k]
-			msgSize := 0
-			if (&v) != nil {
-				msgSize = (&v).Size()
-				msgSize += 1 + sovUsage(uint64(msgSize))
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsage(dAtA, i, uint64(size))
 			}
-			mapSize := 1 + len(k) + sovUsage(uint64(len(k))) + msgSize
-			i = encodeVarintUsage(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
 			i = encodeVarintUsage(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintUsage(dAtA, i, uint64(baseI-i))
+			i--
 			dAtA[i] = 0x12
-			i++
-			i = encodeVarintUsage(dAtA, i, uint64((&v).Size()))
-			n2, err := (&v).MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n2
 		}
 	}
-	return i, nil
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintUsage(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *ClusterUsageReport) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -373,99 +539,164 @@ func (m *ClusterUsageReport) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *ClusterUsageReport) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterUsageReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ClusterId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintUsage(dAtA, i, uint64(len(m.ClusterId)))
-		i += copy(dAtA[i:], m.ClusterId)
+	if len(m.NodeLabelings) > 0 {
+		for iNdEx := len(m.NodeLabelings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeLabelings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintUsage(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime)))
-	n3, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintUsage(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x42
 	}
-	i += n3
-	if len(m.Queues) > 0 {
-		for _, msg := range m.Queues {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintUsage(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsage(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.ClusterCapacity) > 0 {
-		for k, _ := range m.ClusterCapacity {
-			dAtA[i] = 0x22
-			i++
-			v := m.ClusterCapacity[k]
-			msgSize := 0
-			if (&v) != nil {
-				msgSize = (&v).Size()
-				msgSize += 1 + sovUsage(uint64(msgSize))
+	if len(m.GpuCapacityByType) > 0 {
+		for k := range m.GpuCapacityByType {
+			v := m.GpuCapacityByType[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsage(dAtA, i, uint64(size))
 			}
-			mapSize := 1 + len(k) + sovUsage(uint64(len(k))) + msgSize
-			i = encodeVarintUsage(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintUsage(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
+			i--
 			dAtA[i] = 0x12
-			i++
-			i = encodeVarintUsage(dAtA, i, uint64((&v).Size()))
-			n4, err := (&v).MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n4
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintUsage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintUsage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
 		}
 	}
 	if len(m.ClusterAvailableCapacity) > 0 {
-		for k, _ := range m.ClusterAvailableCapacity {
-			dAtA[i] = 0x2a
-			i++
+		for k := range m.ClusterAvailableCapacity {
 			v := m.ClusterAvailableCapacity[k]
-			msgSize := 0
-			if (&v) != nil {
-				msgSize = (&v).Size()
-				msgSize += 1 + sovUsage(uint64(msgSize))
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsage(dAtA, i, uint64(size))
 			}
-			mapSize := 1 + len(k) + sovUsage(uint64(len(k))) + msgSize
-			i = encodeVarintUsage(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
 			i = encodeVarintUsage(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintUsage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ClusterCapacity) > 0 {
+		for k := range m.ClusterCapacity {
+			v := m.ClusterCapacity[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsage(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0x12
-			i++
-			i = encodeVarintUsage(dAtA, i, uint64((&v).Size()))
-			n5, err := (&v).MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintUsage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintUsage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUsage(dAtA, i, uint64(size))
 			}
-			i += n5
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return i, nil
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintUsage(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintUsage(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintUsage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUsage(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
-	return offset + 1
+	return base
 }
 func (m *QueueReport) Size() (n int) {
 	if m == nil {
@@ -498,6 +729,28 @@ func (m *QueueReport) Size() (n int) {
 	return n
 }
 
+func (m *JobUsageReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovUsage(uint64(l))
+	}
+	if len(m.ResourcesUsed) > 0 {
+		for k, v := range m.ResourcesUsed {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovUsage(uint64(len(k))) + 1 + l + sovUsage(uint64(l))
+			n += mapEntrySize + 1 + sovUsage(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *ClusterUsageReport) Size() (n int) {
 	if m == nil {
 		return 0
@@ -534,18 +787,36 @@ func (m *ClusterUsageReport) Size() (n int) {
 			n += mapEntrySize + 1 + sovUsage(uint64(mapEntrySize))
 		}
 	}
+	if len(m.GpuCapacityByType) > 0 {
+		for k, v := range m.GpuCapacityByType {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovUsage(uint64(len(k))) + 1 + l + sovUsage(uint64(l))
+			n += mapEntrySize + 1 + sovUsage(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovUsage(uint64(l))
+		}
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovUsage(uint64(l))
+	}
+	if len(m.NodeLabelings) > 0 {
+		for _, e := range m.NodeLabelings {
+			l = e.Size()
+			n += 1 + l + sovUsage(uint64(l))
+		}
+	}
 	return n
 }
 
 func sovUsage(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
-	}
-	return n
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozUsage(x uint64) (n int) {
 	return sovUsage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -893,7 +1164,7 @@ func (m *QueueReport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterUsageReport) Unmarshal(dAtA []byte) error {
+func (m *JobUsageReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -916,15 +1187,15 @@ func (m *ClusterUsageReport) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterUsageReport: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobUsageReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterUsageReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobUsageReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -952,11 +1223,11 @@ func (m *ClusterUsageReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesUsed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -983,35 +1254,249 @@ func (m *ClusterUsageReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReportTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUsage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthUsage
+			if m.ResourcesUsed == nil {
+				m.ResourcesUsed = make(map[string]resource.Quantity)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUsage
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowUsage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUsage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthUsage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthUsage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUsage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthUsage
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthUsage
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipUsage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthUsage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourcesUsed[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUsage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterUsageReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUsage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterUsageReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterUsageReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReportTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
@@ -1279,6 +1764,235 @@ func (m *ClusterUsageReport) Unmarshal(dAtA []byte) error {
 			}
 			m.ClusterAvailableCapacity[mapkey] = *mapvalue
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GpuCapacityByType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GpuCapacityByType == nil {
+				m.GpuCapacityByType = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowUsage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUsage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthUsage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthUsage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUsage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthUsage
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthUsage
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipUsage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthUsage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.GpuCapacityByType[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jobs = append(m.Jobs, &JobUsageReport{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeLabelings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUsage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUsage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUsage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeLabelings = append(m.NodeLabelings, &NodeLabeling{})
+			if err := m.NodeLabelings[len(m.NodeLabelings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUsage(dAtA[iNdEx:])
@@ -1306,6 +2020,7 @@ func (m *ClusterUsageReport) Unmarshal(dAtA []byte) error {
 func skipUsage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -1337,10 +2052,8 @@ func skipUsage(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -1361,55 +2074,30 @@ func skipUsage(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthUsage
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthUsage
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowUsage
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipUsage(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthUsage
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUsage
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUsage
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthUsage = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowUsage   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthUsage        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUsage          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUsage = fmt.Errorf("proto: unexpected end of group")
 )
@@ -175,8 +175,14 @@ func updateJobInfo(info *JobInfo, event api.Event) {
 		info.Status = Queued
 	case *api.JobUnableToScheduleEvent:
 		// NOOP
+	case *api.JobLeaseDeniedEvent:
+		// NOOP
+	case *api.JobResourceOveruseEvent:
+		// NOOP
 	case *api.JobLeaseExpiredEvent:
 		info.Status = Queued
+	case *api.JobRequeuedEvent:
+		info.Status = Queued
 	case *api.JobPendingEvent:
 		info.Status = Pending
 		info.ClusterId = typed.ClusterId
@@ -193,6 +199,8 @@ func updateJobInfo(info *JobInfo, event api.Event) {
 		// TODO
 	case *api.JobTerminatedEvent:
 		// NOOP
+	case *api.JobDeadlineExceededEvent:
+		// NOOP, job failure is reported by JobFailedEvent
 	case *api.JobCancelledEvent:
 		info.Status = Cancelled
 	}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// ExportJobSetEvents writes all stored events of the job set, from the first one, to the writer as newline delimited JSON.
+// Events are streamed from the server, so even large job sets are not held in memory.
+func ExportJobSetEvents(client api.EventClient, queue, jobSetId string, context context.Context, out io.Writer) (exported int, e error) {
+	clientStream, e := client.GetJobSetEvents(context, &api.JobSetRequest{Queue: queue, Id: jobSetId, Watch: false})
+	if e != nil {
+		return 0, e
+	}
+
+	encoder := json.NewEncoder(out)
+	for {
+		msg, e := clientStream.Recv()
+		if e == io.EOF {
+			return exported, nil
+		}
+		if e != nil {
+			return exported, e
+		}
+		// keepalive sent by the server on idle stream
+		if msg.Message == nil {
+			continue
+		}
+		if e := encoder.Encode(msg); e != nil {
+			return exported, e
+		}
+		exported++
+	}
+}
@@ -15,6 +15,14 @@ func CreateQueue(submitClient api.SubmitClient, queue *api.Queue) error {
 	return e
 }
 
+func CreateJobTemplate(submitClient api.SubmitClient, template *api.JobTemplate) error {
+	ctx, cancel := common.ContextWithDefaultTimeout()
+	defer cancel()
+	_, e := submitClient.CreateJobTemplate(ctx, template)
+
+	return e
+}
+
 func SubmitJobs(submitClient api.SubmitClient, request *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
 	ctx, cancel := common.ContextWithDefaultTimeout()
 	defer cancel()
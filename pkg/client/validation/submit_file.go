@@ -48,6 +48,10 @@ func ValidateSubmitFile(filePath string) (bool, error) {
 	}
 
 	for i, job := range submitFile.Jobs {
+		// jobs using job template get pod spec from the server
+		if job.PodSpec == nil {
+			continue
+		}
 		rawPod := rawPod(job.PodSpec)
 		result, err := validate(rawPod)
 
@@ -62,6 +62,10 @@ func WatchJobSetWithJobIdsFilter(client api.EventClient, queue, jobSetId string,
 				time.Sleep(5 * time.Second)
 				break
 			}
+			// keepalive sent by the server on idle stream
+			if msg.Message == nil {
+				continue
+			}
 			lastMessageId = msg.Id
 
 			event, e := api.UnwrapEvent(msg.Message)